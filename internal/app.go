@@ -21,12 +21,26 @@ import (
 	taxPkg "github.com/paysuper/paysuper-tax-service/pkg"
 	"github.com/paysuper/paysuper-tax-service/proto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/streadway/amqp"
 	"go.uber.org/zap"
 	"log"
+	"net"
 	"net/http"
 	"time"
 )
 
+const (
+	healthCheckInterval           = time.Second
+	healthCheckConnectionInterval = 10 * time.Second
+	healthCheckBrokerTimeout      = 3 * time.Second
+)
+
+// brokerHealth is details of broker health check
+type brokerHealth struct {
+	Connection  string                `json:"connection"`
+	LastPublish *service.BrokerHealth `json:"last_publish,omitempty"`
+}
+
 type Application struct {
 	cfg        *config.Config
 	database   *database.Source
@@ -34,12 +48,15 @@ type Application struct {
 	service    micro.Service
 	httpServer *http.Server
 	router     *http.ServeMux
+	svc        *service.Service
+	health     []*health.Health
 
 	cacheExit chan bool
 	logger    *zap.Logger
 }
 
-type appHealthCheck struct{}
+// healthCheckFunc is health check implemented by function
+type healthCheckFunc func() (interface{}, error)
 
 func NewApplication() *Application {
 	return &Application{cacheExit: make(chan bool, 1)}
@@ -98,7 +115,7 @@ func (app *Application) Init() {
 	repService := repository.NewRepositoryService(constant.PayOneRepositoryServiceName, app.service.Client())
 	taxService := tax_service.NewTaxService(taxPkg.ServiceName, app.service.Client())
 
-	svc := service.NewBillingService(
+	app.svc = service.NewBillingService(
 		app.database,
 		app.cfg,
 		app.cacheExit,
@@ -109,11 +126,11 @@ func (app *Application) Init() {
 		app.redis,
 	)
 
-	if err := svc.Init(); err != nil {
+	if err := app.svc.Init(); err != nil {
 		app.logger.Fatal("[PAYONE_BILLING] Create service instance failed", zap.Error(err))
 	}
 
-	err = grpc.RegisterBillingServiceHandler(app.service.Server(), svc)
+	err = grpc.RegisterBillingServiceHandler(app.service.Server(), app.svc)
 
 	if err != nil {
 		app.logger.Fatal("[PAYONE_BILLING] Service init failed", zap.Error(err))
@@ -156,28 +173,118 @@ func (app *Application) initDatabase() {
 	app.database = db
 }
 
+// initHealth register liveness checks, which fail if instance must be restarted, and readiness checks,
+// which fail if instance can't process requests because of broken connections or stale cache
 func (app *Application) initHealth() {
-	h := health.New()
-	err := h.AddChecks([]*health.Config{
+	rebuild := &health.Config{
+		Name:     "cache-rebuild",
+		Checker:  healthCheckFunc(app.svc.CheckCacheRebuild),
+		Interval: healthCheckInterval,
+		Fatal:    true,
+	}
+
+	live := app.startHealth([]*health.Config{rebuild})
+	ready := app.startHealth([]*health.Config{
+		{
+			Name:     "mongo",
+			Checker:  healthCheckFunc(app.checkDatabase),
+			Interval: healthCheckConnectionInterval,
+			Fatal:    true,
+		},
+		{
+			Name:     "redis",
+			Checker:  healthCheckFunc(app.checkRedis),
+			Interval: healthCheckConnectionInterval,
+			Fatal:    true,
+		},
+		{
+			Name:     "broker",
+			Checker:  healthCheckFunc(app.checkBroker),
+			Interval: healthCheckConnectionInterval,
+			Fatal:    true,
+		},
 		{
-			Name:     "health-check",
-			Checker:  &appHealthCheck{},
-			Interval: time.Duration(1) * time.Second,
+			Name:     "cache-freshness",
+			Checker:  healthCheckFunc(app.svc.CheckCacheFreshness),
+			Interval: healthCheckInterval,
 			Fatal:    true,
 		},
+		rebuild,
 	})
 
-	if err != nil {
+	app.logger.Info("[PAYONE_BILLING] Health check listener started", zap.String("port", app.cfg.MetricsPort))
+
+	app.router.HandleFunc("/health", handlers.NewJSONHandlerFunc(live, nil))
+	app.router.HandleFunc("/health/live", handlers.NewJSONHandlerFunc(live, nil))
+	app.router.HandleFunc("/health/ready", handlers.NewJSONHandlerFunc(ready, nil))
+}
+
+func (app *Application) startHealth(checks []*health.Config) *health.Health {
+	h := health.New()
+
+	if err := h.AddChecks(checks); err != nil {
 		app.logger.Fatal("[PAYONE_BILLING] Health check register failed", zap.Error(err))
 	}
 
-	if err = h.Start(); err != nil {
+	if err := h.Start(); err != nil {
 		app.logger.Fatal("[PAYONE_BILLING] Health check start failed", zap.Error(err))
 	}
 
-	app.logger.Info("[PAYONE_BILLING] Health check listener started", zap.String("port", app.cfg.MetricsPort))
+	app.health = append(app.health, h)
 
-	app.router.HandleFunc("/health", handlers.NewJSONHandlerFunc(h, nil))
+	return h
+}
+
+func (app *Application) checkDatabase() (interface{}, error) {
+	if err := app.database.Ping(); err != nil {
+		return nil, err
+	}
+
+	return "ok", nil
+}
+
+func (app *Application) checkRedis() (interface{}, error) {
+	if err := app.redis.Ping().Err(); err != nil {
+		return nil, err
+	}
+
+	return "ok", nil
+}
+
+// checkBroker check that connection to broker can be opened in short time. Result of last attempt
+// to publish outbox message is added to details of check, but doesn't fail it
+func (app *Application) checkBroker() (interface{}, error) {
+	cfg := amqp.Config{
+		Heartbeat: healthCheckConnectionInterval,
+		Locale:    "en_US",
+		Dial: func(network, addr string) (net.Conn, error) {
+			conn, err := net.DialTimeout(network, addr, healthCheckBrokerTimeout)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return conn, conn.SetDeadline(time.Now().Add(healthCheckBrokerTimeout))
+		},
+	}
+	conn, err := amqp.DialConfig(app.cfg.BrokerAddress, cfg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = conn.Close(); err != nil {
+		return nil, err
+	}
+
+	res := &brokerHealth{Connection: "ok"}
+	res.LastPublish, err = app.svc.GetLastBrokerPublish()
+
+	if err != nil {
+		app.logger.Error("Get last publish to broker failed", zap.Error(err))
+	}
+
+	return res, nil
 }
 
 func (app *Application) initMetrics() {
@@ -210,9 +317,18 @@ func (app *Application) Stop() {
 	}
 	app.logger.Info("Http server stopped")
 
+	for _, h := range app.health {
+		if err := h.Stop(); err != nil {
+			app.logger.Error("Health check stop failed", zap.Error(err))
+		}
+	}
+
 	app.cacheExit <- true
 	app.logger.Info("Cache rebuilding stopped")
 
+	app.svc.Stop()
+	app.logger.Info("Outbox dispatcher stopped")
+
 	app.database.Close()
 	app.logger.Info("Database connection closed")
 
@@ -235,6 +351,6 @@ func (app *Application) Stop() {
 	}()
 }
 
-func (c *appHealthCheck) Status() (interface{}, error) {
-	return "ok", nil
+func (f healthCheckFunc) Status() (interface{}, error) {
+	return f()
 }
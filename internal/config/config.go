@@ -14,13 +14,16 @@ type CacheConfig struct {
 	ProjectTimeout       int64 `envconfig:"CACHE_PROJECT_TIMEOUT" default:"10800"`
 	CurrencyRateTimeout  int64 `envconfig:"CACHE_CURRENCY_RATE_TIMEOUT" default:"86400"`
 	PaymentMethodTimeout int64 `envconfig:"CACHE_PAYMENT_METHOD_TIMEOUT" default:"2592000"`
-	CommissionTimeout    int64 `envconfig:"CACHE_COMMISSION_TIMEOUT" default:"86400"`
 	OrderProductsTimeout int64 `envconfig:"CACHE_ORDER_PRODUCTS_TIMEOUT" default:"86400"`
 	SystemFeesTimeout    int64 `envconfig:"CACHE_SYSTEM_FEES_TIMEOUT" default:"86400"`
+	VersionCheckInterval int64 `envconfig:"CACHE_VERSION_CHECK_INTERVAL" default:"60"`
+	ProjectCacheSize     int   `envconfig:"CACHE_PROJECT_SIZE" default:"10000"`
+	MerchantCacheSize    int   `envconfig:"CACHE_MERCHANT_SIZE" default:"10000"`
 }
 
 type PaymentSystemConfig struct {
 	CardPayApiUrl string `envconfig:"CARD_PAY_API_URL" required:"true"`
+	StripeApiUrl  string `envconfig:"STRIPE_API_URL" default:"https://api.stripe.com"`
 }
 
 type CustomerTokenConfig struct {
@@ -44,6 +47,32 @@ type Config struct {
 	RedisHost          string `envconfig:"REDIS_HOST" default:"127.0.0.1:6379"`
 	RedisPassword      string `envconfig:"REDIS_PASSWORD" default:""`
 
+	IdempotencyKeyLifeTime int64 `envconfig:"IDEMPOTENCY_KEY_LIFETIME" default:"86400"`
+
+	OutboxDispatchInterval int64 `envconfig:"OUTBOX_DISPATCH_INTERVAL" default:"5"`
+	OutboxMaxAttempts      int32 `envconfig:"OUTBOX_MAX_ATTEMPTS" default:"15"`
+
+	WebhookDispatchInterval int64 `envconfig:"WEBHOOK_DISPATCH_INTERVAL" default:"5"`
+	WebhookMaxAttempts      int32 `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"10"`
+
+	CheckAccountTimeout int64 `envconfig:"CHECK_ACCOUNT_TIMEOUT" default:"5"`
+
+	PayoutPeriod           int64   `envconfig:"PAYOUT_PERIOD" default:"604800"`
+	PayoutScheduleInterval int64   `envconfig:"PAYOUT_SCHEDULE_INTERVAL" default:"3600"`
+	PayoutReservePercent   float64 `envconfig:"PAYOUT_RESERVE_PERCENT" default:"10"`
+
+	SubscriptionScheduleInterval int64 `envconfig:"SUBSCRIPTION_SCHEDULE_INTERVAL" default:"600"`
+	SubscriptionRetryAttempts    int32 `envconfig:"SUBSCRIPTION_RETRY_ATTEMPTS" default:"3"`
+	SubscriptionRetryInterval    int64 `envconfig:"SUBSCRIPTION_RETRY_INTERVAL" default:"86400"`
+	SubscriptionPendingTimeout   int64 `envconfig:"SUBSCRIPTION_PENDING_TIMEOUT" default:"172800"`
+
+	OrderExpireSweepInterval int64 `envconfig:"ORDER_EXPIRE_SWEEP_INTERVAL" default:"300"`
+	OrderExpireGracePeriod   int64 `envconfig:"ORDER_EXPIRE_GRACE_PERIOD" default:"3600"`
+	PaymentStatusGracePeriod int64 `envconfig:"PAYMENT_STATUS_GRACE_PERIOD" default:"86400"`
+
+	CurrencyRateBase    string             `envconfig:"CURRENCY_RATE_BASE"`
+	CurrencyRateSpreads map[string]float64 `envconfig:"CURRENCY_RATE_SPREADS"`
+
 	CentrifugoSecret string `envconfig:"CENTRIFUGO_SECRET" required:"true"`
 	CentrifugoURL    string `envconfig:"CENTRIFUGO_URL" required:"false" default:"http://127.0.0.1:8000"`
 	BrokerAddress    string `envconfig:"BROKER_ADDRESS" default:"amqp://127.0.0.1:5672"`
@@ -93,3 +122,81 @@ func (cfg *Config) GetCustomerTokenLength() int {
 func (cfg *Config) GetCustomerTokenExpire() time.Duration {
 	return time.Second * time.Duration(cfg.CustomerTokenConfig.LifeTime)
 }
+
+func (cfg *Config) GetIdempotencyKeyExpire() time.Duration {
+	return time.Second * time.Duration(cfg.IdempotencyKeyLifeTime)
+}
+
+func (cfg *Config) GetOutboxDispatchInterval() time.Duration {
+	return time.Second * time.Duration(cfg.OutboxDispatchInterval)
+}
+
+func (cfg *Config) GetWebhookDispatchInterval() time.Duration {
+	return time.Second * time.Duration(cfg.WebhookDispatchInterval)
+}
+
+func (cfg *Config) GetCheckAccountTimeout() time.Duration {
+	return time.Second * time.Duration(cfg.CheckAccountTimeout)
+}
+
+func (cfg *Config) GetPayoutPeriod() time.Duration {
+	return time.Second * time.Duration(cfg.PayoutPeriod)
+}
+
+func (cfg *Config) GetPayoutScheduleInterval() time.Duration {
+	return time.Second * time.Duration(cfg.PayoutScheduleInterval)
+}
+
+func (cfg *Config) GetSubscriptionScheduleInterval() time.Duration {
+	return time.Second * time.Duration(cfg.SubscriptionScheduleInterval)
+}
+
+func (cfg *Config) GetSubscriptionRetryInterval() time.Duration {
+	return time.Second * time.Duration(cfg.SubscriptionRetryInterval)
+}
+
+func (cfg *Config) GetSubscriptionPendingTimeout() time.Duration {
+	return time.Second * time.Duration(cfg.SubscriptionPendingTimeout)
+}
+
+func (cfg *Config) GetOrderExpireSweepInterval() time.Duration {
+	return time.Second * time.Duration(cfg.OrderExpireSweepInterval)
+}
+
+// GetOrderExpireGracePeriod return grace period of project to cancel unpaid expired orders,
+// default grace period returned if project hasn't own
+func (cfg *Config) GetOrderExpireGracePeriod(projectPeriod int64) time.Duration {
+	if projectPeriod > 0 {
+		return time.Second * time.Duration(projectPeriod)
+	}
+
+	return time.Second * time.Duration(cfg.OrderExpireGracePeriod)
+}
+
+// GetPaymentStatusGracePeriod return grace period of project to wait result of created payment,
+// default grace period returned if project hasn't own
+func (cfg *Config) GetPaymentStatusGracePeriod(projectPeriod int64) time.Duration {
+	if projectPeriod > 0 {
+		return time.Second * time.Duration(projectPeriod)
+	}
+
+	return time.Second * time.Duration(cfg.PaymentStatusGracePeriod)
+}
+
+func (cfg *Config) GetCacheVersionCheckInterval() time.Duration {
+	return time.Second * time.Duration(cfg.CacheConfig.VersionCheckInterval)
+}
+
+// GetCurrencyRateBase return code of currency through which cross rates are calculated
+func (cfg *Config) GetCurrencyRateBase() string {
+	if cfg.CurrencyRateBase != "" {
+		return cfg.CurrencyRateBase
+	}
+
+	return cfg.AccountingCurrency
+}
+
+// GetCurrencyRateSpread return spread in percents for currencies pair
+func (cfg *Config) GetCurrencyRateSpread(from, to string) float64 {
+	return cfg.CurrencyRateSpreads[from+"/"+to]
+}
@@ -104,6 +104,14 @@ func (s *Source) Clone() (*Source, error) {
 	return clone, nil
 }
 
+// Ping check connection to database. Copy of session is used, so connection is checked on fresh socket
+func (s *Source) Ping() error {
+	session := s.session.Copy()
+	defer session.Close()
+
+	return session.Ping()
+}
+
 func (s *Source) Drop() error {
 	return s.database.DropDatabase()
 }
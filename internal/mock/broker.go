@@ -0,0 +1,62 @@
+package mock
+
+import (
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/streadway/amqp"
+	"sync"
+)
+
+const (
+	BrokerMockErrorMessage = "broker unavailable"
+)
+
+// BrokerMock in-memory broker which store published messages instead of sending them to RabbitMQ
+type BrokerMock struct {
+	mx        sync.Mutex
+	err       error
+	published map[string][]proto.Message
+}
+
+func NewBrokerMockOk() *BrokerMock {
+	return &BrokerMock{published: make(map[string][]proto.Message)}
+}
+
+func NewBrokerMockError() *BrokerMock {
+	return &BrokerMock{
+		err:       errors.New(BrokerMockErrorMessage),
+		published: make(map[string][]proto.Message),
+	}
+}
+
+func (b *BrokerMock) Publish(topic string, msg proto.Message, h amqp.Table) error {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+
+	b.published[topic] = append(b.published[topic], proto.Clone(msg))
+
+	return nil
+}
+
+// SetAvailable switch broker to state when it accepts messages or return error on publish
+func (b *BrokerMock) SetAvailable(available bool) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if available == true {
+		b.err = nil
+	} else {
+		b.err = errors.New(BrokerMockErrorMessage)
+	}
+}
+
+func (b *BrokerMock) GetPublished(topic string) []proto.Message {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	return b.published[topic]
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"encoding/json"
+	"github.com/go-redis/redis"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	cacheInvalidationChannel = "paysuper:billing:cache:invalidation"
+	cacheVersionKey          = "paysuper:billing:cache:version"
+)
+
+// cacheSnapshot is immutable view of cached collections. Published snapshot is never changed: every change of
+// cache is made on copy of snapshot which then replaces published snapshot by atomic swap, so readers don't need
+// locks and always see consistent state of cache
+type cacheSnapshot struct {
+	currency        map[string]*billing.Currency
+	country         map[string]*billing.Country
+	currencyRate    map[int32]map[int32]*billing.CurrencyRate
+	paymentMethod   map[string]map[int32]*billing.PaymentMethod
+	paymentMethodId map[string]*billing.PaymentMethod
+	systemFees      map[string]map[string]map[string][]*billing.SystemFees
+}
+
+// getCache return current snapshot of cache. Snapshot and its maps must not be modified
+func (s *Service) getCache() *cacheSnapshot {
+	if c, ok := s.snapshot.Load().(*cacheSnapshot); ok {
+		return c
+	}
+
+	return &cacheSnapshot{}
+}
+
+// updateCache apply changes to copy of current snapshot and publish it. Maps of snapshot copy are shared with
+// published snapshot, so fn must replace maps which it changes instead of modifying them
+func (s *Service) updateCache(fn func(c *cacheSnapshot)) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	c := *s.getCache()
+	fn(&c)
+
+	s.snapshot.Store(&c)
+}
+
+// getCachedMerchant return merchant from cache, merchant is loaded from database if it isn't in cache
+func (s *Service) getCachedMerchant(id string) (*billing.Merchant, bool) {
+	v, err := s.merchants.get(id)
+
+	if err != nil {
+		s.logError("Load merchant to cache failed", []interface{}{"err", err.Error(), "id", id})
+		return nil, false
+	}
+
+	m, ok := v.(*billing.Merchant)
+
+	return m, ok
+}
+
+// getCachedProject return project from cache, project is loaded from database if it isn't in cache
+func (s *Service) getCachedProject(id string) (*billing.Project, bool) {
+	v, err := s.projects.get(id)
+
+	if err != nil {
+		s.logError("Load project to cache failed", []interface{}{"err", err.Error(), "id", id})
+		return nil, false
+	}
+
+	p, ok := v.(*billing.Project)
+
+	return p, ok
+}
+
+// getCachedCurrencies return all cached currencies by code A3, returned map must not be modified
+func (s *Service) getCachedCurrencies() map[string]*billing.Currency {
+	return s.getCache().currency
+}
+
+// getCachedPaymentMethods return all cached payment methods by id, returned map must not be modified
+func (s *Service) getCachedPaymentMethods() map[string]*billing.PaymentMethod {
+	return s.getCache().paymentMethodId
+}
+
+// getCachedCurrencyRate return current rate of currencies pair from cache
+func (s *Service) getCachedCurrencyRate(from, to int32) (*billing.CurrencyRate, bool) {
+	rate, ok := s.getCache().currencyRate[from][to]
+	return rate, ok
+}
+
+// getCachedSystemFees return versions of system fees from cache, latest versions first
+func (s *Service) getCachedSystemFees(methodId, region, cardBrand string) []*billing.SystemFees {
+	return s.getCache().systemFees[methodId][region][cardBrand]
+}
+
+// setCachedProject add or replace project in cache and notify other instances of service about change
+func (s *Service) setCachedProject(project *billing.Project) {
+	s.projects.set(project.Id, project)
+	s.publishCacheInvalidation(pkg.CollectionProject, project.Id)
+}
+
+// setCachedMerchant add or replace merchant in cache and notify other instances of service about change
+func (s *Service) setCachedMerchant(merchant *billing.Merchant) {
+	s.merchants.set(merchant.Id, merchant)
+	s.publishCacheInvalidation(pkg.CollectionMerchant, merchant.Id)
+}
+
+// cacheInvalidationEvent is notification about change of cached entity which is sent to all instances of service.
+// Version is sequence number of event, gap in versions means that instance missed some events
+type cacheInvalidationEvent struct {
+	Collection string `json:"collection"`
+	Id         string `json:"id"`
+	Version    int64  `json:"version"`
+}
+
+// publishCacheInvalidation notify all instances of service that cached entity was changed. Empty id means that
+// whole collection was changed, empty collection means that all collections were changed
+func (s *Service) publishCacheInvalidation(collection, id string) {
+	if s.redis == nil {
+		return
+	}
+
+	version, err := s.redis.Incr(cacheVersionKey).Result()
+
+	if err != nil {
+		s.logError("Increment cache version failed", []interface{}{"err", err.Error(), "collection", collection, "id", id})
+		return
+	}
+
+	b, _ := json.Marshal(&cacheInvalidationEvent{Collection: collection, Id: id, Version: version})
+
+	if err = s.redis.Publish(cacheInvalidationChannel, b).Err(); err != nil {
+		// other instances will find out about change by version of cache
+		s.logError("Publish cache invalidation event failed", []interface{}{"err", err.Error(), "event", string(b)})
+	}
+}
+
+// listenCacheInvalidation apply cache invalidation events of all instances of service to cache of current
+// instance. Cache is fully rebuilt if events were missed, what is checked by gaps in versions of events and
+// by periodic comparison of current version of cache with last applied version
+func (s *Service) listenCacheInvalidation(pubSub *redis.PubSub, version int64) {
+	defer pubSub.Close()
+
+	ticker := time.NewTicker(s.cfg.GetCacheVersionCheckInterval())
+	defer ticker.Stop()
+
+	events := pubSub.Channel()
+
+	for {
+		select {
+		case msg, ok := <-events:
+			if !ok {
+				return
+			}
+
+			e := &cacheInvalidationEvent{}
+
+			if err := json.Unmarshal([]byte(msg.Payload), e); err != nil {
+				s.logError("Cache invalidation event is invalid", []interface{}{"err", err.Error(), "event", msg.Payload})
+				continue
+			}
+
+			version = s.processCacheInvalidationEvent(e, version)
+		case <-ticker.C:
+			version = s.checkCacheVersion(version)
+		case <-s.cacheEventsExit:
+			return
+		}
+	}
+}
+
+// processCacheInvalidationEvent apply event to cache and return last applied version of cache
+func (s *Service) processCacheInvalidationEvent(e *cacheInvalidationEvent, version int64) int64 {
+	var err error
+
+	if version > 0 && e.Version > version+1 {
+		zap.S().Warnw("Cache invalidation events missed, cache will be rebuilt", "version", version, "event", e)
+		err = s.initCache()
+	} else {
+		err = s.applyCacheInvalidation(e.Collection, e.Id)
+	}
+
+	if err != nil {
+		// version isn't changed, so cache will be rebuilt on next check of version
+		s.logError("Apply cache invalidation event failed", []interface{}{"err", err.Error(), "event", e})
+		return version
+	}
+
+	if e.Version > version {
+		return e.Version
+	}
+
+	return version
+}
+
+// applyCacheInvalidation reload changed collection. Changed entity of lazy loaded collection is removed from
+// cache and will be loaded on next request, whole lazy loaded collection is removed if id is empty
+func (s *Service) applyCacheInvalidation(collection, id string) error {
+	switch collection {
+	case "":
+		return s.initCache()
+	case pkg.CollectionProject:
+		s.projects.invalidate(id)
+		return nil
+	case pkg.CollectionMerchant:
+		s.merchants.invalidate(id)
+		return nil
+	}
+
+	fn, ok := handlers[collection]
+
+	if !ok {
+		return nil
+	}
+
+	return s.cache(collection, fn(s))
+}
+
+// checkCacheVersion rebuild cache if current version of cache is greater than last applied version
+func (s *Service) checkCacheVersion(version int64) int64 {
+	current := s.getCacheVersion()
+
+	if current <= version {
+		return version
+	}
+
+	zap.S().Warnw("Cache version is changed, cache will be rebuilt", "version", version, "current", current)
+
+	if err := s.initCache(); err != nil {
+		s.logError("Rebuild cache failed", []interface{}{"err", err.Error()})
+		return version
+	}
+
+	return current
+}
+
+// getCacheVersion return current version of cache shared by all instances of service
+func (s *Service) getCacheVersion() int64 {
+	if s.redis == nil {
+		return 0
+	}
+
+	version, err := s.redis.Get(cacheVersionKey).Int64()
+
+	if err != nil && err != redis.Nil {
+		s.logError("Get cache version failed", []interface{}{"err", err.Error()})
+	}
+
+	return version
+}
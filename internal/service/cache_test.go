@@ -0,0 +1,400 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	cacheTestIterations = 500
+	cacheTestReaders    = 8
+	cacheTestCollection = "cache_test"
+)
+
+// cacheRecsTest is cache handler which return records from memory instead of database
+type cacheRecsTest struct {
+	Cacher
+	recs []interface{}
+	err  error
+}
+
+func (h *cacheRecsTest) getAll() ([]interface{}, error) {
+	return h.recs, h.err
+}
+
+// entityLoaderTest load entities from memory instead of database, onLoad is called on each load
+type entityLoaderTest struct {
+	mx     sync.Mutex
+	recs   map[string]interface{}
+	err    error
+	loads  int
+	onLoad func()
+}
+
+func (l *entityLoaderTest) getOne(id string) (interface{}, error) {
+	l.mx.Lock()
+	l.loads++
+	rec, err, onLoad := l.recs[id], l.err, l.onLoad
+	l.mx.Unlock()
+
+	if onLoad != nil {
+		onLoad()
+	}
+
+	return rec, err
+}
+
+func (l *entityLoaderTest) set(id string, rec interface{}) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if rec == nil {
+		delete(l.recs, id)
+		return
+	}
+
+	l.recs[id] = rec
+}
+
+func (l *entityLoaderTest) getLoads() int {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	return l.loads
+}
+
+type CacheTestSuite struct {
+	suite.Suite
+	service *Service
+
+	methodId   string
+	merchantId string
+	projectId  string
+
+	currencies []interface{}
+	systemFees []interface{}
+
+	projects  *entityLoaderTest
+	merchants *entityLoaderTest
+}
+
+// run tests of suite with -race flag to check concurrent access to cache
+func Test_Cache(t *testing.T) {
+	suite.Run(t, new(CacheTestSuite))
+}
+
+func (suite *CacheTestSuite) SetupTest() {
+	suite.service = NewBillingService(nil, &config.Config{CacheConfig: &config.CacheConfig{}}, make(chan bool, 1), nil, nil, nil, mock.NewBrokerMockOk(), nil)
+
+	suite.methodId = bson.NewObjectId().Hex()
+	suite.merchantId = bson.NewObjectId().Hex()
+	suite.projectId = bson.NewObjectId().Hex()
+
+	suite.currencies = []interface{}{
+		&billing.Currency{CodeInt: 643, CodeA3: "RUB", IsActive: true},
+		&billing.Currency{CodeInt: 840, CodeA3: "USD", IsActive: true},
+	}
+
+	from, _ := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	suite.systemFees = []interface{}{
+		&billing.SystemFees{
+			Id:            bson.NewObjectId().Hex(),
+			MethodId:      suite.methodId,
+			CardBrand:     "VISA",
+			IsActive:      true,
+			Version:       1,
+			EffectiveFrom: from,
+			Fees: []*billing.FeeSet{
+				{
+					MinAmounts:       map[string]float64{"RUB": 0},
+					TransactionCost:  &billing.SystemFee{Percent: 1, FixCurrency: "RUB"},
+					AuthorizationFee: &billing.SystemFee{FixAmount: 1, FixCurrency: "RUB"},
+				},
+			},
+		},
+	}
+
+	err := suite.service.cache(pkg.CollectionCurrency, suite.newHandler(newCurrencyHandler, suite.currencies))
+	assert.NoError(suite.T(), err)
+	err = suite.service.cache(pkg.CollectionSystemFees, suite.newHandler(newSystemFeeHandler, suite.systemFees))
+	assert.NoError(suite.T(), err)
+
+	suite.projects = &entityLoaderTest{recs: map[string]interface{}{
+		suite.projectId: &billing.Project{Id: suite.projectId, MerchantId: suite.merchantId},
+	}}
+	suite.merchants = &entityLoaderTest{recs: map[string]interface{}{
+		suite.merchantId: &billing.Merchant{Id: suite.merchantId},
+	}}
+	suite.service.projects = newLazyCache(pkg.CollectionProject, 0, suite.projects)
+	suite.service.merchants = newLazyCache(pkg.CollectionMerchant, 0, suite.merchants)
+}
+
+func (suite *CacheTestSuite) newHandler(fn func(*Service) Cacher, recs []interface{}) Cacher {
+	return &cacheRecsTest{Cacher: fn(suite.service), recs: recs}
+}
+
+func (suite *CacheTestSuite) TestCache_SnapshotNotChangedByUpdate() {
+	snapshot := suite.service.getCache()
+
+	suite.service.setCachedMerchant(&billing.Merchant{Id: "merchant"})
+	suite.service.setCachedProject(&billing.Project{Id: "project"})
+
+	_, ok := suite.service.getCachedMerchant("merchant")
+	assert.True(suite.T(), ok)
+	_, err := suite.service.GetProjectById("project")
+	assert.NoError(suite.T(), err)
+
+	// rebuild of one collection keeps other collections of snapshot
+	err = suite.service.cache(pkg.CollectionCurrency, suite.newHandler(newCurrencyHandler, suite.currencies[:1]))
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.service.GetCurrencyByCodeA3("USD")
+	assert.Error(suite.T(), err)
+	_, ok = suite.service.getCachedMerchant("merchant")
+	assert.True(suite.T(), ok)
+	assert.Len(suite.T(), snapshot.currency, 2)
+	assert.Len(suite.T(), snapshot.systemFees, 1)
+}
+
+func (suite *CacheTestSuite) TestCache_SetCachedMerchantPaymentMethod() {
+	pmId := bson.NewObjectId().Hex()
+	defaultPmId := bson.NewObjectId().Hex()
+	merchant := &billing.Merchant{
+		Id: suite.merchantId,
+		PaymentMethods: map[string]*billing.MerchantPaymentMethod{
+			pmId: {PaymentMethod: &billing.MerchantPaymentMethodIdentification{Id: pmId}, IsActive: true},
+		},
+	}
+
+	suite.service.updateCache(func(c *cacheSnapshot) {
+		c.paymentMethodId = map[string]*billing.PaymentMethod{
+			pmId:        {Id: pmId, Name: "Bank card"},
+			defaultPmId: {Id: defaultPmId, Name: "Qiwi"},
+		}
+	})
+	suite.service.setCachedMerchant(merchant)
+
+	pm, err := suite.service.getMerchantPaymentMethod(suite.merchantId, pmId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pmId, pm.PaymentMethod.Id)
+
+	// payment method which merchant hasn't settings for has default commission
+	pm, err = suite.service.getMerchantPaymentMethod(suite.merchantId, defaultPmId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Qiwi", pm.PaymentMethod.Name)
+	assert.Equal(suite.T(), DefaultPaymentMethodFee, pm.Commission.Fee)
+
+	pms, ok := suite.service.getMerchantPaymentMethods(suite.merchantId)
+	assert.True(suite.T(), ok)
+	assert.Len(suite.T(), pms, 2)
+
+	_, err = suite.service.getMerchantPaymentMethod(suite.merchantId, bson.NewObjectId().Hex())
+	assert.EqualError(suite.T(), err, orderErrorPaymentMethodNotAllowed)
+
+	_, err = suite.service.getMerchantPaymentMethod(bson.NewObjectId().Hex(), pmId)
+	assert.EqualError(suite.T(), err, orderErrorPaymentMethodNotAllowed)
+}
+
+func (suite *CacheTestSuite) TestCache_ConcurrentReadsDuringRebuild() {
+	var wg sync.WaitGroup
+
+	currencyHandler := suite.newHandler(newCurrencyHandler, suite.currencies)
+	systemFeesHandler := suite.newHandler(newSystemFeeHandler, suite.systemFees)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < cacheTestIterations; i++ {
+			assert.NoError(suite.T(), suite.service.cache(pkg.CollectionCurrency, currencyHandler))
+			assert.NoError(suite.T(), suite.service.cache(pkg.CollectionSystemFees, systemFeesHandler))
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < cacheTestIterations; i++ {
+			suite.service.setCachedMerchant(&billing.Merchant{Id: fmt.Sprintf("merchant_%d", i)})
+			suite.service.setCachedProject(&billing.Project{Id: suite.projectId, MerchantId: suite.merchantId})
+			suite.service.merchants.invalidate(suite.merchantId)
+
+			if i%100 == 0 {
+				suite.service.projects.invalidate("")
+			}
+		}
+	}()
+
+	for r := 0; r < cacheTestReaders; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < cacheTestIterations; i++ {
+				_, err := suite.service.GetCurrencyByCodeA3("RUB")
+				assert.NoError(suite.T(), err)
+
+				project, err := suite.service.GetProjectById(suite.projectId)
+				assert.NoError(suite.T(), err)
+
+				_, ok := suite.service.getMerchantPaymentMethods(project.MerchantId)
+				assert.True(suite.T(), ok)
+
+				res := &billing.FeeSet{}
+				req := &billing.GetSystemFeesRequest{
+					MethodId:  suite.methodId,
+					CardBrand: "VISA",
+					Amount:    100,
+					Currency:  "RUB",
+				}
+				err = suite.service.GetSystemFeesForPayment(context.TODO(), req, res)
+				assert.NoError(suite.T(), err)
+
+				for range suite.service.getCachedCurrencies() {
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	_, ok := suite.service.getCachedMerchant(fmt.Sprintf("merchant_%d", cacheTestIterations-1))
+	assert.True(suite.T(), ok)
+}
+
+func (suite *CacheTestSuite) TestCache_LazyCache_LoadOnMiss() {
+	_, ok := suite.service.getCachedMerchant(suite.merchantId)
+	assert.True(suite.T(), ok)
+	_, ok = suite.service.getCachedMerchant(suite.merchantId)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 1, suite.merchants.getLoads())
+
+	// not found entity isn't cached, so it will be found after creation
+	id := bson.NewObjectId().Hex()
+	_, ok = suite.service.getCachedMerchant(id)
+	assert.False(suite.T(), ok)
+
+	suite.merchants.set(id, &billing.Merchant{Id: id})
+
+	_, ok = suite.service.getCachedMerchant(id)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 3, suite.merchants.getLoads())
+
+	suite.merchants.err = errors.New("unit test")
+
+	_, err := suite.service.merchants.get(bson.NewObjectId().Hex())
+	assert.EqualError(suite.T(), err, "unit test")
+	_, ok = suite.service.getCachedMerchant(id)
+	assert.True(suite.T(), ok)
+}
+
+func (suite *CacheTestSuite) TestCache_LazyCache_EvictLeastRecentlyUsed() {
+	loader := &entityLoaderTest{recs: map[string]interface{}{"a": "a", "b": "b", "c": "c"}}
+	c := newLazyCache(cacheTestCollection, 2, loader)
+
+	for _, id := range []string{"a", "b", "a", "c"} {
+		v, err := c.get(id)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), id, v)
+	}
+
+	assert.Equal(suite.T(), 3, loader.getLoads())
+	assert.Equal(suite.T(), 2, c.entries.len())
+
+	// "b" was least recently used when "c" was added
+	_, ok := c.entries.get("b")
+	assert.False(suite.T(), ok)
+	_, ok = c.entries.get("a")
+	assert.True(suite.T(), ok)
+
+	c.invalidate("a")
+	_, ok = c.entries.get("a")
+	assert.False(suite.T(), ok)
+
+	c.invalidate("")
+	assert.Equal(suite.T(), 0, c.entries.len())
+}
+
+func (suite *CacheTestSuite) TestCache_LazyCache_InvalidatedDuringLoad() {
+	loader := &entityLoaderTest{recs: map[string]interface{}{"a": "old"}}
+	c := newLazyCache(cacheTestCollection, 0, loader)
+
+	// entity changed by other instance while it was loaded, loaded entity may be stale and isn't cached
+	loader.onLoad = func() { c.invalidate("a") }
+
+	v, err := c.get("a")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "old", v)
+	assert.Equal(suite.T(), 0, c.entries.len())
+
+	loader.onLoad = nil
+	loader.set("a", "new")
+
+	v, err = c.get("a")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "new", v)
+	assert.Equal(suite.T(), 1, c.entries.len())
+}
+
+func (suite *CacheTestSuite) TestCache_ProcessCacheInvalidationEvent_Entity() {
+	suite.service.setCachedProject(&billing.Project{Id: suite.projectId, MerchantId: suite.merchantId})
+	suite.projects.set(suite.projectId, &billing.Project{
+		Id:         suite.projectId,
+		MerchantId: suite.merchantId,
+		Status:     pkg.ProjectStatusInProduction,
+	})
+
+	e := &cacheInvalidationEvent{Collection: pkg.CollectionProject, Id: suite.projectId, Version: 8}
+	version := suite.service.processCacheInvalidationEvent(e, 7)
+	assert.Equal(suite.T(), int64(8), version)
+
+	project, err := suite.service.GetProjectById(suite.projectId)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ProjectStatusInProduction, project.Status)
+
+	// event of this instance received after more recent event
+	e = &cacheInvalidationEvent{Collection: pkg.CollectionProject, Id: suite.projectId, Version: 6}
+	version = suite.service.processCacheInvalidationEvent(e, version)
+	assert.Equal(suite.T(), int64(8), version)
+
+	suite.projects.set(suite.projectId, nil)
+
+	e = &cacheInvalidationEvent{Collection: pkg.CollectionProject, Id: suite.projectId, Version: 9}
+	version = suite.service.processCacheInvalidationEvent(e, version)
+	assert.Equal(suite.T(), int64(9), version)
+
+	_, err = suite.service.GetProjectById(suite.projectId)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CacheTestSuite) TestCache_ProcessCacheInvalidationEvent_Error() {
+	handlers[cacheTestCollection] = func(s *Service) Cacher {
+		return &cacheRecsTest{Cacher: newCurrencyHandler(s), err: errors.New("unit test")}
+	}
+	defer delete(handlers, cacheTestCollection)
+
+	e := &cacheInvalidationEvent{Collection: cacheTestCollection, Version: 2}
+	version := suite.service.processCacheInvalidationEvent(e, 1)
+	assert.Equal(suite.T(), int64(1), version)
+
+	_, err := suite.service.GetCurrencyByCodeA3("RUB")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CacheTestSuite) TestCache_ApplyCacheInvalidation_UnknownCollection() {
+	err := suite.service.applyCacheInvalidation("unknown", bson.NewObjectId().Hex())
+	assert.NoError(suite.T(), err)
+}
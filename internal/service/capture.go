@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+)
+
+const (
+	captureErrorNotAllowed          = "order can't be captured, only authorized payment can be captured"
+	captureErrorAmountGreaterTotal  = "capture amount can't be greater than authorized amount"
+	voidErrorNotAllowed             = "order can't be voided, only authorized payment can be voided"
+	captureErrorPaymentSystemFailed = "payment system handler for order not found"
+)
+
+// CaptureOrder charge payer for payment which was authorized early. If amount in request is empty
+// then full authorized amount will be captured
+func (s *Service) CaptureOrder(
+	ctx context.Context,
+	req *grpc.CaptureOrderRequest,
+	rsp *grpc.OrderOperationResponse,
+) error {
+	order, err := s.getOrderByUuid(req.OrderId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if order.CanBeCaptured() == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = captureErrorNotAllowed
+
+		return nil
+	}
+
+	currency := order.GetPaymentMethodOutcomeCurrency().GetCodeA3()
+	authorized := money.FromFloat(order.TotalPaymentAmount, currency)
+	captured := authorized
+
+	if req.Amount > 0 {
+		captured = money.FromFloat(req.Amount, currency)
+	}
+
+	if r, _ := captured.Compare(authorized); r > 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = captureErrorAmountGreaterTotal
+
+		return nil
+	}
+
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = captureErrorPaymentSystemFailed
+
+		return nil
+	}
+
+	amount := captured.Float64()
+	historyLen := len(order.StatusHistory)
+	err = h.Capture(order, amount)
+
+	if err != nil {
+		s.logError("Order capture failed", []interface{}{"err", err.Error(), "order_id", order.Id, "amount", amount})
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	return s.finishOrderOperation(order, historyLen, rsp)
+}
+
+// VoidOrder cancel authorization of payment and release funds held on payer account
+func (s *Service) VoidOrder(
+	ctx context.Context,
+	req *grpc.VoidOrderRequest,
+	rsp *grpc.OrderOperationResponse,
+) error {
+	order, err := s.getOrderByUuid(req.OrderId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if order.CanBeVoided() == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = voidErrorNotAllowed
+
+		return nil
+	}
+
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = captureErrorPaymentSystemFailed
+
+		return nil
+	}
+
+	historyLen := len(order.StatusHistory)
+	err = h.Void(order)
+
+	if err != nil {
+		s.logError("Order void failed", []interface{}{"err", err.Error(), "order_id", order.Id})
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	return s.finishOrderOperation(order, historyLen, rsp)
+}
+
+// finishOrderOperation save result of operation with order. If order was changed concurrently then
+// changes of order status made after historyLen entries of status history applied to actual order
+func (s *Service) finishOrderOperation(
+	order *billing.Order,
+	historyLen int,
+	rsp *grpc.OrderOperationResponse,
+) error {
+	captured := order.CapturedAmount
+	err := s.saveOrderChanges(order, historyLen, s.updateOrderWithNotification, func(actual *billing.Order) {
+		if actual.CapturedAmount == 0 {
+			actual.CapturedAmount = captured
+		}
+	})
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = order
+
+	return nil
+}
@@ -8,9 +8,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
 	"github.com/paysuper/paysuper-recurring-repository/tools"
@@ -38,9 +40,13 @@ const (
 	cardPayActionCreatePayment    = "create_payment"
 	cardPayActionRecurringPayment = "recurring_payment"
 	cardPayActionRefund           = "refund"
+	cardPayActionChangeStatus     = "change_status"
 
 	cardPayDateFormat          = "2006-01-02T15:04:05Z"
 	cardPayInitiatorCardholder = "cit"
+	cardPayInitiatorMerchant   = "mit"
+
+	cardPayOperationChangeStatus = "CHANGE_STATUS"
 )
 
 var (
@@ -66,6 +72,21 @@ var (
 			path:   "/api/refunds",
 			method: http.MethodPost,
 		},
+		cardPayActionChangeStatus: {
+			path:   "/api/payments/%s",
+			method: http.MethodPatch,
+		},
+	}
+
+	cardPayAdapter = &paymentSystemAdapter{
+		handler:            pkg.PaymentSystemHandlerCardPay,
+		new:                newCardPayHandler,
+		paymentCallback:    func() proto.Message { return &billing.CardPayPaymentCallback{} },
+		refundCallback:     func() proto.Message { return &billing.CardPayRefundCallback{} },
+		chargebackCallback: func() proto.Message { return &billing.CardPayChargebackCallback{} },
+		refundId:           cardPayRefundId,
+		chargebackId:       cardPayChargebackId,
+		verifySignature:    cardPayVerifyCallbackSignature,
 	}
 
 	successRefundResponseStatuses = map[string]bool{
@@ -75,6 +96,18 @@ var (
 		pkg.CardPayPaymentResponseStatusRefunded:   true,
 		pkg.CardPayPaymentResponseStatusCompleted:  true,
 	}
+
+	successCaptureResponseStatuses = map[string]bool{
+		pkg.CardPayPaymentResponseStatusInProgress: true,
+		pkg.CardPayPaymentResponseStatusPending:    true,
+		pkg.CardPayPaymentResponseStatusCompleted:  true,
+	}
+
+	successVoidResponseStatuses = map[string]bool{
+		pkg.CardPayPaymentResponseStatusInProgress: true,
+		pkg.CardPayPaymentResponseStatusPending:    true,
+		pkg.CardPayPaymentResponseStatusVoided:     true,
+	}
 )
 
 type cardPay struct {
@@ -121,6 +154,7 @@ type CardPayPaymentData struct {
 	Amount     float64 `json:"amount"`
 	Descriptor string  `json:"dynamic_descriptor"`
 	Note       string  `json:"note"`
+	Preauth    bool    `json:"preauth,omitempty"`
 }
 
 type CardPayRecurringData struct {
@@ -130,6 +164,7 @@ type CardPayRecurringData struct {
 	Descriptor string                      `json:"dynamic_descriptor"`
 	Note       string                      `json:"note"`
 	Initiator  string                      `json:"initiator"`
+	Preauth    bool                        `json:"preauth,omitempty"`
 }
 
 type CardPayCustomer struct {
@@ -258,6 +293,27 @@ type CardPayRefundResponse struct {
 	EwalletAccount interface{}                       `json:"ewallet_account,omitempty"`
 }
 
+type CardPayChangeStatusPaymentData struct {
+	StatusTo string  `json:"status_to"`
+	Amount   float64 `json:"amount,omitempty"`
+}
+
+type CardPayChangeStatusRequest struct {
+	Request     *CardPayRequest                 `json:"request"`
+	Operation   string                          `json:"operation"`
+	PaymentData *CardPayChangeStatusPaymentData `json:"payment_data"`
+}
+
+type CardPayChangeStatusResponsePaymentData struct {
+	Id              string  `json:"id"`
+	Status          string  `json:"status"`
+	RemainingAmount float64 `json:"remaining_amount"`
+}
+
+type CardPayChangeStatusResponse struct {
+	PaymentData *CardPayChangeStatusResponsePaymentData `json:"payment_data"`
+}
+
 func (m *CardPayRefundResponse) IsSuccessStatus() bool {
 	v, ok := successRefundResponseStatuses[m.RefundData.Status]
 	return ok && v == true
@@ -290,7 +346,9 @@ func (h *cardPay) CreatePayment(requisites map[string]string) (url string, err e
 		return
 	}
 
-	h.processor.order.Status = constant.OrderStatusPaymentSystemRejectOnCreate
+	defer func() {
+		err = h.processor.finishCreatePayment(err)
+	}()
 
 	b, _ := json.Marshal(cpOrder)
 
@@ -345,7 +403,6 @@ func (h *cardPay) CreatePayment(requisites map[string]string) (url string, err e
 		return
 	}
 
-	h.processor.order.Status = constant.OrderStatusPaymentSystemCreate
 	url = cpResponse.RedirectUrl
 
 	return
@@ -354,9 +411,13 @@ func (h *cardPay) CreatePayment(requisites map[string]string) (url string, err e
 func (h *cardPay) ProcessPayment(message proto.Message, raw, signature string) (err error) {
 	req := message.(*billing.CardPayPaymentCallback)
 	order := h.processor.order
-	order.Status = constant.OrderStatusPaymentSystemReject
+	prevStatus := order.Status
+
+	defer func() {
+		h.processor.rejectPaymentCallback(err)
+	}()
 
-	err = h.checkCallbackRequestSignature(raw, signature)
+	err = h.processor.checkCallbackSignature(raw, signature)
 
 	if err != nil {
 		return
@@ -386,10 +447,9 @@ func (h *cardPay) ProcessPayment(message proto.Message, raw, signature string) (
 		return NewError(paymentSystemErrorRequestPaymentMethodIsInvalid, pkg.StatusErrorValidation)
 	}
 
-	reqAmount := req.GetAmount()
+	reqAmount := money.FromFloat(req.GetAmount(), req.GetCurrency())
 
-	if reqAmount != order.TotalPaymentAmount ||
-		req.GetCurrency() != order.PaymentMethodOutcomeCurrency.CodeA3 {
+	if !reqAmount.Equals(order.GetChargeMoney()) {
 		return NewError(paymentSystemErrorRequestAmountOrCurrencyIsInvalid, pkg.StatusErrorValidation)
 	}
 
@@ -413,23 +473,42 @@ func (h *cardPay) ProcessPayment(message proto.Message, raw, signature string) (
 		return NewError(paymentSystemErrorRequestPaymentMethodIsInvalid, pkg.StatusErrorValidation)
 	}
 
+	var status int32
+
 	switch req.GetStatus() {
 	case pkg.CardPayPaymentResponseStatusDeclined:
-		order.Status = constant.OrderStatusPaymentSystemDeclined
+		status = constant.OrderStatusPaymentSystemDeclined
 		break
 	case pkg.CardPayPaymentResponseStatusCancelled:
-		order.Status = constant.OrderStatusPaymentSystemCanceled
+		status = constant.OrderStatusPaymentSystemCanceled
 		break
 	case pkg.CardPayPaymentResponseStatusCompleted:
-		order.Status = constant.OrderStatusPaymentSystemComplete
+		status = constant.OrderStatusPaymentSystemComplete
+		break
+	case pkg.CardPayPaymentResponseStatusAuthorized:
+		// authorized status is final only for orders which must be captured later, notification about
+		// authorization which received after capture or void of payment must be skipped too
+		if order.AuthorizeOnly == false || prevStatus == pkg.OrderStatusPaymentSystemCaptured ||
+			prevStatus == pkg.OrderStatusPaymentSystemVoided {
+			return NewError(paymentSystemErrorRequestTemporarySkipped, pkg.StatusTemporary)
+		}
+
+		status = pkg.OrderStatusPaymentSystemAuthorized
+		break
+	case pkg.CardPayPaymentResponseStatusVoided:
+		status = pkg.OrderStatusPaymentSystemVoided
 		break
 	default:
 		return NewError(paymentSystemErrorRequestTemporarySkipped, pkg.StatusTemporary)
 	}
 
+	if err = h.processor.setPaymentCallbackStatus(status, req.GetStatus()); err != nil {
+		return
+	}
+
 	order.PaymentMethodOrderId = req.GetId()
 	order.PaymentMethodOrderClosedAt = ts
-	order.PaymentMethodIncomeAmount = reqAmount
+	order.PaymentMethodIncomeAmount = reqAmount.Float64()
 	order.PaymentMethodIncomeCurrency = order.PaymentMethodOutcomeCurrency
 
 	return
@@ -552,7 +631,7 @@ func (h *cardPay) refresh(pmKey string) error {
 	return nil
 }
 
-func (h *cardPay) getUrl(action string) (string, error) {
+func (h *cardPay) getUrl(action string, params ...interface{}) (string, error) {
 	u, err := url.ParseRequestURI(h.processor.cfg.CardPayApiUrl)
 
 	if err != nil {
@@ -561,6 +640,10 @@ func (h *cardPay) getUrl(action string) (string, error) {
 
 	u.Path = cardPayPaths[action].path
 
+	if len(params) > 0 {
+		u.Path = fmt.Sprintf(u.Path, params...)
+	}
+
 	return u.String(), nil
 }
 
@@ -614,7 +697,7 @@ func (h *cardPay) getCardPayOrder(order *billing.Order, requisites map[string]st
 			Name:        it.Name,
 			Description: it.Description,
 			Count:       1,
-			Price:       it.Amount,
+			Price:       money.Round(it.Amount, order.PaymentMethodOutcomeCurrency.CodeA3),
 		})
 	}
 
@@ -657,8 +740,13 @@ func (h *cardPay) getCardPayOrder(order *billing.Order, requisites map[string]st
 		(okRecurringId && recurringId != "") {
 		cardPayOrder.RecurringData = &CardPayRecurringData{
 			Currency:  order.PaymentMethodOutcomeCurrency.CodeA3,
-			Amount:    order.TotalPaymentAmount,
+			Amount:    money.Round(order.TotalPaymentAmount, order.PaymentMethodOutcomeCurrency.CodeA3),
 			Initiator: cardPayInitiatorCardholder,
+			Preauth:   order.AuthorizeOnly,
+		}
+
+		if requisites[pkg.PaymentCreateFieldMerchantInitiated] == "1" {
+			cardPayOrder.RecurringData.Initiator = cardPayInitiatorMerchant
 		}
 
 		if okRecurringId == true && recurringId != "" {
@@ -671,7 +759,8 @@ func (h *cardPay) getCardPayOrder(order *billing.Order, requisites map[string]st
 	} else {
 		cardPayOrder.PaymentData = &CardPayPaymentData{
 			Currency: order.PaymentMethodOutcomeCurrency.CodeA3,
-			Amount:   order.TotalPaymentAmount,
+			Amount:   money.Round(order.TotalPaymentAmount, order.PaymentMethodOutcomeCurrency.CodeA3),
+			Preauth:  order.AuthorizeOnly,
 		}
 	}
 
@@ -720,9 +809,9 @@ func (h *cardPay) getCryptoCurrencyCardPayOrder(cpo *CardPayOrder, requisites ma
 	}
 }
 
-func (h *cardPay) checkCallbackRequestSignature(raw, signature string) error {
+func cardPayVerifyCallbackSignature(params *billing.PaymentMethodParams, raw, signature string) error {
 	hash := sha512.New()
-	hash.Write([]byte(raw + h.processor.order.PaymentMethod.Params.CallbackPassword))
+	hash.Write([]byte(raw + params.CallbackPassword))
 
 	if hex.EncodeToString(hash.Sum(nil)) != signature {
 		return NewError(paymentSystemErrorRequestSignatureIsInvalid, pkg.StatusErrorValidation)
@@ -731,6 +820,26 @@ func (h *cardPay) checkCallbackRequestSignature(raw, signature string) error {
 	return nil
 }
 
+func cardPayChargebackId(message proto.Message) (string, string, bool) {
+	req, ok := message.(*billing.CardPayChargebackCallback)
+
+	if !ok || req.ChargebackData == nil || req.MerchantOrder == nil {
+		return "", "", false
+	}
+
+	return req.MerchantOrder.Id, req.ChargebackData.Id, true
+}
+
+func cardPayRefundId(message proto.Message) (string, bool) {
+	req, ok := message.(*billing.CardPayRefundCallback)
+
+	if !ok || req.RefundData == nil || req.MerchantOrder == nil {
+		return "", false
+	}
+
+	return req.MerchantOrder.Id, true
+}
+
 func (t *cardPayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	ctx := context.WithValue(req.Context(), &cardPayContextKey{name: "CardPayRequestStart"}, time.Now())
 	req = req.WithContext(ctx)
@@ -830,7 +939,7 @@ func (h *cardPay) CreateRefund(refund *billing.Refund) error {
 			Id: h.processor.order.PaymentMethodOrderId,
 		},
 		RefundData: &CardPayRefundData{
-			Amount:   refund.Amount,
+			Amount:   refund.GetMoney().Float64(),
 			Currency: refund.Currency.CodeA3,
 		},
 	}
@@ -933,7 +1042,7 @@ func (h *cardPay) ProcessRefund(refund *billing.Refund, message proto.Message, r
 	req := message.(*billing.CardPayRefundCallback)
 	refund.Status = pkg.RefundStatusRejected
 
-	err = h.checkCallbackRequestSignature(raw, signature)
+	err = h.processor.checkCallbackSignature(raw, signature)
 
 	if err != nil {
 		return NewError(err.Error(), pkg.ResponseStatusBadData)
@@ -947,7 +1056,7 @@ func (h *cardPay) ProcessRefund(refund *billing.Refund, message proto.Message, r
 		return NewError(paymentSystemErrorRequestPaymentMethodIsInvalid, pkg.ResponseStatusBadData)
 	}
 
-	if req.RefundData.Amount != refund.Amount || req.RefundData.Currency != refund.Currency.CodeA3 {
+	if !money.FromFloat(req.RefundData.Amount, req.RefundData.Currency).Equals(refund.GetMoney()) {
 		return NewError(paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid, pkg.ResponseStatusBadData)
 	}
 
@@ -971,6 +1080,214 @@ func (h *cardPay) ProcessRefund(refund *billing.Refund, message proto.Message, r
 	return
 }
 
+func (h *cardPay) ProcessChargeback(dispute *billing.Dispute, message proto.Message, raw, signature string) error {
+	req := message.(*billing.CardPayChargebackCallback)
+
+	err := h.processor.checkCallbackSignature(raw, signature)
+
+	if err != nil {
+		return NewError(err.Error(), pkg.ResponseStatusBadData)
+	}
+
+	if req.PaymentMethod != h.processor.order.PaymentMethod.Params.ExternalId {
+		return NewError(paymentSystemErrorRequestPaymentMethodIsInvalid, pkg.ResponseStatusBadData)
+	}
+
+	data := req.ChargebackData
+
+	if r, err := money.FromFloat(data.Amount, data.Currency).Compare(h.processor.order.GetPaidMoney()); err != nil ||
+		data.Currency != dispute.Currency.CodeA3 || r > 0 {
+		return NewError(paymentSystemErrorChargebackAmountOrCurrencyIsInvalid, pkg.ResponseStatusBadData)
+	}
+
+	switch data.Status {
+	case pkg.CardPayChargebackStatusOpened:
+		dispute.Status = pkg.DisputeStatusOpened
+		break
+	case pkg.CardPayChargebackStatusWon:
+		dispute.Status = pkg.DisputeStatusWon
+		break
+	case pkg.CardPayChargebackStatusLost:
+		dispute.Status = pkg.DisputeStatusLost
+		break
+	default:
+		return NewError(paymentSystemErrorRequestStatusIsInvalid, pkg.ResponseStatusBadData)
+	}
+
+	if data.EvidenceDueDate != "" {
+		t, err := time.Parse(cardPayDateFormat, data.EvidenceDueDate)
+
+		if err != nil {
+			return NewError(paymentSystemErrorChargebackDueDateIsInvalid, pkg.ResponseStatusBadData)
+		}
+
+		dispute.EvidenceDueAt, _ = ptypes.TimestampProto(t)
+	}
+
+	dispute.ExternalId = data.Id
+	dispute.Amount = data.Amount
+	dispute.ReasonCode = data.ReasonCode
+	dispute.Reason = data.Reason
+
+	return nil
+}
+
+func (h *cardPay) Capture(order *billing.Order, amount float64) error {
+	rsp, err := h.changePaymentStatus(order, pkg.CardPayPaymentStatusToComplete, amount)
+
+	if err != nil {
+		return errors.New(paymentSystemErrorCaptureFailed)
+	}
+
+	if rsp.IsSuccessStatus(successCaptureResponseStatuses) == false {
+		return errors.New(paymentSystemErrorCaptureRejected)
+	}
+
+	if err = changeOrderStatus(order, pkg.OrderStatusPaymentSystemCaptured, pkg.OrderStatusChangeSourceApi, ""); err != nil {
+		return err
+	}
+
+	order.CapturedAmount = amount
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	return nil
+}
+
+func (h *cardPay) Void(order *billing.Order) error {
+	rsp, err := h.changePaymentStatus(order, pkg.CardPayPaymentStatusToReverse, 0)
+
+	if err != nil {
+		return errors.New(paymentSystemErrorVoidFailed)
+	}
+
+	if rsp.IsSuccessStatus(successVoidResponseStatuses) == false {
+		return errors.New(paymentSystemErrorVoidRejected)
+	}
+
+	if err = changeOrderStatus(order, pkg.OrderStatusPaymentSystemVoided, pkg.OrderStatusChangeSourceApi, ""); err != nil {
+		return err
+	}
+
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	return nil
+}
+
+func (h *cardPay) changePaymentStatus(
+	order *billing.Order,
+	statusTo string,
+	amount float64,
+) (*CardPayChangeStatusResponse, error) {
+	err := h.auth(order.PaymentMethod.Params.ExternalId)
+
+	if err != nil {
+		h.processor.service.logError(
+			"Auth in api failed on change payment status action",
+			[]interface{}{
+				"error", err.Error(),
+				"handler", pkg.PaymentSystemHandlerCardPay,
+			},
+		)
+
+		return nil, err
+	}
+
+	qUrl, err := h.getUrl(cardPayActionChangeStatus, order.PaymentMethodOrderId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := &CardPayChangeStatusRequest{
+		Request: &CardPayRequest{
+			Id:   bson.NewObjectId().Hex(),
+			Time: time.Now().UTC().Format(cardPayDateFormat),
+		},
+		Operation: cardPayOperationChangeStatus,
+		PaymentData: &CardPayChangeStatusPaymentData{
+			StatusTo: statusTo,
+			Amount:   money.Round(amount, order.PaymentMethodOutcomeCurrency.CodeA3),
+		},
+	}
+
+	b, err := json.Marshal(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := tools.NewLoggedHttpClient(zap.S())
+	req, err := http.NewRequest(cardPayPaths[cardPayActionChangeStatus].method, qUrl, bytes.NewBuffer(b))
+
+	if err != nil {
+		return nil, err
+	}
+
+	token := h.getToken(order.PaymentMethod.Params.ExternalId)
+	auth := strings.Title(token.TokenType) + " " + token.AccessToken
+
+	req.Header.Add(HeaderContentType, MIMEApplicationJSON)
+	req.Header.Add(HeaderAuthorization, auth)
+
+	resp, err := client.Do(req)
+
+	if err != nil || resp.StatusCode != http.StatusOK {
+		if err != nil {
+			h.processor.service.logError(
+				"Change payment status request failed",
+				[]interface{}{
+					"error", err.Error(),
+					"handler", pkg.PaymentSystemHandlerCardPay,
+					"req", data,
+				},
+			)
+		} else {
+			err = errors.New(paymentSystemErrorCreateRequestFailed)
+		}
+
+		return nil, err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	b, err = ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &CardPayChangeStatusResponse{}
+	err = json.Unmarshal(b, rsp)
+
+	if err != nil {
+		h.processor.service.logError(
+			"Change payment status response can't be unmarshal",
+			[]interface{}{
+				"error", err.Error(),
+				"handler", pkg.PaymentSystemHandlerCardPay,
+				"req", string(b),
+			},
+		)
+
+		return nil, err
+	}
+
+	return rsp, nil
+}
+
+func (m *CardPayChangeStatusResponse) IsSuccessStatus(statuses map[string]bool) bool {
+	if m.PaymentData == nil {
+		return false
+	}
+
+	v, ok := statuses[m.PaymentData.Status]
+	return ok && v == true
+}
+
 func (h *CardPayOrderRecurringResponse) IsSuccessStatus() bool {
 	if h.RecurringData == nil {
 		return false
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	orderErrorAccountRejected    = "account of payer rejected by project"
+	orderErrorAccountCheckFailed = "account of payer can't be checked by project. try request later"
+
+	// max length of response body saved to order
+	checkAccountResponseBodyLength = 1024
+)
+
+// checkAccountPayload is body of request to check account of payer
+type checkAccountPayload struct {
+	OrderId        string  `json:"order_id"`
+	ProjectOrderId string  `json:"project_order_id"`
+	Account        string  `json:"account"`
+	Amount         float64 `json:"amount"`
+	Currency       string  `json:"currency"`
+}
+
+// checkOrderAccount request project to check account of payer before payment and save result of check to order.
+// Project answer with 2xx status if account exists and with 4xx status if payment to account is impossible.
+// If request to project failed then payment is rejected only if project requires check of account
+func (s *Service) checkOrderAccount(order *billing.Order, project *billing.Project) error {
+	if project.UrlCheckAccount == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(&checkAccountPayload{
+		OrderId:        order.Uuid,
+		ProjectOrderId: order.ProjectOrderId,
+		Account:        order.ProjectAccount,
+		Amount:         order.TotalPaymentAmount,
+		Currency:       order.Currency,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	check := &billing.OrderAccountCheck{}
+	check.CheckedAt, _ = ptypes.TimestampProto(start)
+
+	code, body, err := s.postCheckAccount(project, string(payload), start)
+
+	check.ResponseCode = int32(code)
+	check.ResponseBody = body
+	check.Latency = time.Since(start).Nanoseconds() / int64(time.Millisecond)
+
+	switch {
+	case err == nil && code >= http.StatusOK && code < http.StatusMultipleChoices:
+		check.Status = pkg.OrderAccountCheckStatusOk
+	case err == nil && code >= http.StatusBadRequest && code < http.StatusInternalServerError:
+		check.Status = pkg.OrderAccountCheckStatusRejected
+	default:
+		if err == nil {
+			err = fmt.Errorf("unexpected response status %d", code)
+		}
+
+		check.Status = pkg.OrderAccountCheckStatusFailed
+		check.Error = err.Error()
+
+		s.logError(
+			"Check of payer account by project failed",
+			[]interface{}{"err", err.Error(), "order_id", order.Id, "project_id", project.Id},
+		)
+	}
+
+	order.AccountCheck = check
+
+	if check.Status == pkg.OrderAccountCheckStatusRejected {
+		return errors.New(orderErrorAccountRejected)
+	}
+
+	if check.Status == pkg.OrderAccountCheckStatusFailed && project.CheckAccountRequired {
+		return errors.New(orderErrorAccountCheckFailed)
+	}
+
+	return nil
+}
+
+// postCheckAccount send signed request to check account url of project and return status code
+// and beginning of body of response
+func (s *Service) postCheckAccount(project *billing.Project, payload string, t time.Time) (int, string, error) {
+	req, err := http.NewRequest(http.MethodPost, project.UrlCheckAccount, strings.NewReader(payload))
+
+	if err != nil {
+		return 0, "", err
+	}
+
+	timestamp := t.Unix()
+	signature := webhookSignature(project.SecretKey, strconv.FormatInt(timestamp, 10), payload)
+
+	req.Header.Add(HeaderContentType, MIMEApplicationJSON)
+	req.Header.Add(webhookSignatureHeader, fmt.Sprintf(webhookSignatureFormat, timestamp, signature))
+
+	client := tools.NewLoggedHttpClient(zap.S())
+	client.Timeout = s.cfg.GetCheckAccountTimeout()
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, checkAccountResponseBodyLength))
+
+	return resp.StatusCode, string(b), err
+}
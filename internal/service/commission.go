@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"sort"
+	"time"
+)
+
+const (
+	commissionPlanErrorTiersIncorrect    = "commission plan must contain tiers with unique volume thresholds and tier from zero volume"
+	commissionPlanErrorFeeLimitIncorrect = "minimal fee of commission plan greater than maximal fee"
+	commissionPlanErrorDatesIncorrect    = "end of effective period of commission plan must be after its start"
+	commissionPlanErrorQueryFailed       = "commission plans query failed"
+	commissionPlanErrorVersionConflict   = "commission plan was added by another request. try request later"
+)
+
+var (
+	// statuses of orders which amounts are included into monthly volume of merchant
+	commissionPlanVolumeOrderStatuses = []int32{
+		constant.OrderStatusPaymentSystemComplete,
+		constant.OrderStatusProjectInProgress,
+		constant.OrderStatusProjectComplete,
+		constant.OrderStatusProjectPending,
+		constant.OrderStatusProjectReject,
+		pkg.OrderStatusPaymentSystemCaptured,
+		pkg.OrderStatusRefundPartial,
+		pkg.OrderStatusChargebackOpened,
+		pkg.OrderStatusChargebackWon,
+	}
+)
+
+// commissionCalculator calculate payment system fee by commission plan. Calculator doesn't use storage, amounts
+// in different currencies converted by convert function
+type commissionCalculator struct {
+	convert func(amount money.Money, to string) (money.Money, error)
+}
+
+// calculate return fee for payment amount in currency of payment and information about applied version and tier
+// of plan. Volume is monthly volume of merchant before payment
+func (c *commissionCalculator) calculate(
+	plan *billing.CommissionPlan,
+	amount money.Money,
+	volume float64,
+	volumeCurrency string,
+) (money.Money, *billing.OrderCommissionPlan, error) {
+	currency := amount.Currency()
+	planVolume, err := c.toCurrency(volumeCurrency, plan.Currency, volume)
+
+	if err != nil {
+		return money.Zero(currency), nil, err
+	}
+
+	tier := plan.GetTier(planVolume.Float64())
+
+	if tier == nil {
+		return money.Zero(currency), nil, errors.New(commissionPlanErrorTiersIncorrect)
+	}
+
+	fixed, err := c.toCurrency(plan.Currency, currency, tier.FixedFee)
+
+	if err != nil {
+		return money.Zero(currency), nil, err
+	}
+
+	fee, err := amount.Multiply(tier.PercentFee / 100).Add(fixed)
+
+	if err != nil {
+		return money.Zero(currency), nil, err
+	}
+
+	minFee, err := c.toCurrency(plan.Currency, currency, plan.MinFee)
+
+	if err != nil {
+		return money.Zero(currency), nil, err
+	}
+
+	maxFee, err := c.toCurrency(plan.Currency, currency, plan.MaxFee)
+
+	if err != nil {
+		return money.Zero(currency), nil, err
+	}
+
+	if r, _ := fee.Compare(minFee); r < 0 {
+		fee = minFee
+	}
+
+	if r, _ := fee.Compare(maxFee); maxFee.IsPositive() && r > 0 {
+		fee = maxFee
+	}
+
+	applied := &billing.OrderCommissionPlan{
+		Id:            plan.Id,
+		Version:       plan.Version,
+		MonthlyVolume: planVolume.Float64(),
+		VolumeFrom:    tier.VolumeFrom,
+	}
+
+	return fee, applied, nil
+}
+
+// toCurrency return amount of plan in currency. Amount without currency considered as amount in currency
+func (c *commissionCalculator) toCurrency(from, to string, amount float64) (money.Money, error) {
+	if amount == 0 || from == "" || from == to {
+		return money.FromFloat(amount, to), nil
+	}
+
+	return c.convert(money.FromFloat(amount, from), to)
+}
+
+// newCommissionCalculator return calculator which convert amounts by currency rates effective on date
+func (s *Service) newCommissionCalculator(date time.Time) *commissionCalculator {
+	return &commissionCalculator{
+		convert: func(amount money.Money, to string) (money.Money, error) {
+			cFrom, err := s.GetCurrencyByCodeA3(amount.Currency())
+
+			if err != nil {
+				return amount, err
+			}
+
+			cTo, err := s.GetCurrencyByCodeA3(to)
+
+			if err != nil {
+				return amount, err
+			}
+
+			rec, err := s.GetCurrencyRate(cFrom.CodeInt, cTo.CodeInt, date)
+
+			if err != nil {
+				return amount, err
+			}
+
+			return amount.Convert(rec.Rate, to), nil
+		},
+	}
+}
+
+// getCommissionPlan return latest version of merchant commission plan for payment method effective on date.
+// If merchant hasn't effective plan then nil returned
+func (s *Service) getCommissionPlan(merchantId, pmId string, date time.Time) (*billing.CommissionPlan, error) {
+	if bson.IsObjectIdHex(merchantId) == false || bson.IsObjectIdHex(pmId) == false {
+		return nil, nil
+	}
+
+	query := bson.M{
+		"merchant_id":       bson.ObjectIdHex(merchantId),
+		"payment_method_id": bson.ObjectIdHex(pmId),
+		"effective_from":    bson.M{"$lte": date},
+		"$or":               []bson.M{{"effective_to": nil}, {"effective_to": bson.M{"$gt": date}}},
+	}
+
+	var plan *billing.CommissionPlan
+	err := s.db.Collection(pkg.CollectionCommissionPlan).Find(query).Sort("-version").One(&plan)
+
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		s.logError("Query to find commission plan failed", []interface{}{"err", err.Error(), "query", query})
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+// getMerchantMonthlyVolume return amount of merchant payments by payment method in accounting currency
+// of merchant from start of month till date
+func (s *Service) getMerchantMonthlyVolume(merchantId, pmId string, date time.Time) (float64, error) {
+	date = date.UTC()
+
+	query := []bson.M{
+		{
+			"$match": bson.M{
+				"project.merchant_id": bson.ObjectIdHex(merchantId),
+				"payment_method._id":  bson.ObjectIdHex(pmId),
+				"status":              bson.M{"$in": commissionPlanVolumeOrderStatuses},
+				"pm_order_close_date": bson.M{
+					"$gte": time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC),
+					"$lt":  date,
+				},
+			},
+		},
+		{"$group": bson.M{"_id": nil, "amount": bson.M{"$sum": "$amount_in_merchant_ac"}}},
+	}
+
+	var res []*struct {
+		Amount float64 `bson:"amount"`
+	}
+
+	err := s.db.Collection(pkg.CollectionOrder).Pipe(query).All(&res)
+
+	if err != nil {
+		s.logError("Query to calculate merchant monthly volume failed", []interface{}{"err", err.Error(), "query", query})
+		return 0, err
+	}
+
+	if len(res) <= 0 {
+		return 0, nil
+	}
+
+	return res[0].Amount, nil
+}
+
+// commissionPlanFromPaymentMethod return plan with one tier by commission of merchant payment method,
+// it is used for merchants without commission plans
+func commissionPlanFromPaymentMethod(c *billing.MerchantPaymentMethodCommissions) *billing.CommissionPlan {
+	tier := &billing.CommissionPlanTier{PercentFee: c.Fee}
+	plan := &billing.CommissionPlan{Tiers: []*billing.CommissionPlanTier{tier}}
+
+	if c.PerTransaction != nil {
+		tier.FixedFee = c.PerTransaction.Fee
+		plan.Currency = c.PerTransaction.Currency
+	}
+
+	return plan
+}
+
+func (s *Service) AddCommissionPlan(
+	ctx context.Context,
+	req *billing.CommissionPlan,
+	rsp *grpc.CommissionPlanResponse,
+) error {
+	if bson.IsObjectIdHex(req.MerchantId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = ledgerErrorMerchantIdIncorrect
+
+		return nil
+	}
+
+	merchant, err := s.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(req.MerchantId)})
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = merchantErrorNotFound
+
+		return nil
+	}
+
+	if _, ok := s.getCache().paymentMethodId[req.PaymentMethodId]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = orderErrorPaymentMethodNotFound
+
+		return nil
+	}
+
+	if _, ok := s.getCache().currency[req.Currency]; !ok {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = orderErrorCurrencyNotFound
+
+		return nil
+	}
+
+	if len(req.Tiers) <= 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = commissionPlanErrorTiersIncorrect
+
+		return nil
+	}
+
+	sort.Slice(req.Tiers, func(i, j int) bool {
+		return req.Tiers[i].VolumeFrom < req.Tiers[j].VolumeFrom
+	})
+
+	for i, v := range req.Tiers {
+		if (i == 0 && v.VolumeFrom != 0) || (i > 0 && v.VolumeFrom == req.Tiers[i-1].VolumeFrom) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = commissionPlanErrorTiersIncorrect
+
+			return nil
+		}
+	}
+
+	if req.MaxFee > 0 && req.MinFee > req.MaxFee {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = commissionPlanErrorFeeLimitIncorrect
+
+		return nil
+	}
+
+	if req.EffectiveFrom == nil {
+		req.EffectiveFrom = ptypes.TimestampNow()
+	}
+
+	if req.EffectiveTo != nil {
+		from, err := ptypes.Timestamp(req.EffectiveFrom)
+		to, err1 := ptypes.Timestamp(req.EffectiveTo)
+
+		if err != nil || err1 != nil || !to.After(from) {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = commissionPlanErrorDatesIncorrect
+
+			return nil
+		}
+	}
+
+	query := bson.M{
+		"merchant_id":       bson.ObjectIdHex(merchant.Id),
+		"payment_method_id": bson.ObjectIdHex(req.PaymentMethodId),
+	}
+
+	var last *billing.CommissionPlan
+	err = s.db.Collection(pkg.CollectionCommissionPlan).Find(query).Sort("-version").One(&last)
+
+	if err != nil && err != mgo.ErrNotFound {
+		s.logError("Query to find last commission plan failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = commissionPlanErrorQueryFailed
+
+		return nil
+	}
+
+	plan := &billing.CommissionPlan{
+		Id:              bson.NewObjectId().Hex(),
+		MerchantId:      merchant.Id,
+		PaymentMethodId: req.PaymentMethodId,
+		Version:         1,
+		Currency:        req.Currency,
+		Tiers:           req.Tiers,
+		MinFee:          req.MinFee,
+		MaxFee:          req.MaxFee,
+		EffectiveFrom:   req.EffectiveFrom,
+		EffectiveTo:     req.EffectiveTo,
+		UserId:          req.UserId,
+		CreatedAt:       ptypes.TimestampNow(),
+	}
+
+	if last != nil {
+		plan.Version = last.Version + 1
+	}
+
+	err = s.db.Collection(pkg.CollectionCommissionPlan).Insert(plan)
+
+	if err != nil {
+		// same version of plan was added by concurrent request
+		if mgo.IsDup(err) {
+			rsp.Status = pkg.ResponseStatusConflict
+			rsp.Message = commissionPlanErrorVersionConflict
+
+			return nil
+		}
+
+		s.logError("Query to insert commission plan failed", []interface{}{"err", err.Error(), "plan", plan})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = commissionPlanErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = plan
+
+	return nil
+}
+
+func (s *Service) ListCommissionPlans(
+	ctx context.Context,
+	req *grpc.ListCommissionPlansRequest,
+	rsp *grpc.ListCommissionPlansResponse,
+) error {
+	if bson.IsObjectIdHex(req.MerchantId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = ledgerErrorMerchantIdIncorrect
+
+		return nil
+	}
+
+	query := bson.M{"merchant_id": bson.ObjectIdHex(req.MerchantId)}
+
+	if req.PaymentMethodId != "" {
+		if bson.IsObjectIdHex(req.PaymentMethodId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = orderErrorPaymentMethodNotFound
+
+			return nil
+		}
+
+		query["payment_method_id"] = bson.ObjectIdHex(req.PaymentMethodId)
+	}
+
+	var plans []*billing.CommissionPlan
+	err := s.db.Collection(pkg.CollectionCommissionPlan).Find(query).Sort("-_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&plans)
+
+	if err != nil {
+		s.logError("Query to find commission plans failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = commissionPlanErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionCommissionPlan).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count commission plans failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = commissionPlanErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = plans
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"errors"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type CommissionCalculatorTestSuite struct {
+	suite.Suite
+	calculator *commissionCalculator
+	plan       *billing.CommissionPlan
+}
+
+func Test_CommissionCalculator(t *testing.T) {
+	suite.Run(t, new(CommissionCalculatorTestSuite))
+}
+
+func (suite *CommissionCalculatorTestSuite) SetupTest() {
+	// 1 USD = 64 RUB
+	suite.calculator = &commissionCalculator{
+		convert: func(amount money.Money, to string) (money.Money, error) {
+			switch {
+			case amount.Currency() == "USD" && to == "RUB":
+				return amount.Convert(float64(1)/64, to), nil
+			case amount.Currency() == "RUB" && to == "USD":
+				return amount.Convert(64, to), nil
+			}
+
+			return amount, errors.New("rate not found")
+		},
+	}
+
+	suite.plan = &billing.CommissionPlan{
+		Id:       "5c6d7e8f9a0b1c2d3e4f5a6b",
+		Version:  3,
+		Currency: "USD",
+		Tiers: []*billing.CommissionPlanTier{
+			{VolumeFrom: 0, PercentFee: 3, FixedFee: 0.3},
+			{VolumeFrom: 10000, PercentFee: 2.5, FixedFee: 0.25},
+			{VolumeFrom: 100000, PercentFee: 2},
+		},
+		MinFee: 0.5,
+		MaxFee: 50,
+	}
+}
+
+func (suite *CommissionCalculatorTestSuite) TestCommissionCalculator_TierByVolume() {
+	fee, applied, err := suite.calculator.calculate(suite.plan, money.FromFloat(100, "USD"), 0, "USD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3.3, fee.Float64())
+	assert.Equal(suite.T(), suite.plan.Id, applied.Id)
+	assert.Equal(suite.T(), int32(3), applied.Version)
+	assert.Equal(suite.T(), float64(0), applied.VolumeFrom)
+
+	fee, applied, err = suite.calculator.calculate(suite.plan, money.FromFloat(100, "USD"), 10000, "USD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2.75, fee.Float64())
+	assert.Equal(suite.T(), float64(10000), applied.VolumeFrom)
+
+	fee, applied, err = suite.calculator.calculate(suite.plan, money.FromFloat(100, "USD"), 250000, "USD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(2), fee.Float64())
+	assert.Equal(suite.T(), float64(100000), applied.VolumeFrom)
+}
+
+func (suite *CommissionCalculatorTestSuite) TestCommissionCalculator_ConvertVolumeAndFixedFee() {
+	// volume 640000 RUB is 10000 USD, fixed fee 0.25 USD is 16 RUB
+	fee, applied, err := suite.calculator.calculate(suite.plan, money.FromFloat(1000, "RUB"), 640000, "RUB")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(41), fee.Float64())
+	assert.Equal(suite.T(), float64(10000), applied.MonthlyVolume)
+	assert.Equal(suite.T(), float64(10000), applied.VolumeFrom)
+}
+
+func (suite *CommissionCalculatorTestSuite) TestCommissionCalculator_MinMaxFee() {
+	fee, _, err := suite.calculator.calculate(suite.plan, money.FromFloat(1, "USD"), 0, "USD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0.5, fee.Float64())
+
+	fee, _, err = suite.calculator.calculate(suite.plan, money.FromFloat(10000, "USD"), 0, "USD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(50), fee.Float64())
+
+	// limits converted to currency of payment
+	fee, _, err = suite.calculator.calculate(suite.plan, money.FromFloat(10, "RUB"), 0, "USD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(32), fee.Float64())
+}
+
+func (suite *CommissionCalculatorTestSuite) TestCommissionCalculator_PaymentMethodCommission() {
+	plan := commissionPlanFromPaymentMethod(&billing.MerchantPaymentMethodCommissions{
+		Fee: 2.5,
+		PerTransaction: &billing.MerchantPaymentMethodPerTransactionCommission{
+			Fee:      0.5,
+			Currency: "USD",
+		},
+	})
+
+	fee, applied, err := suite.calculator.calculate(plan, money.FromFloat(1000, "RUB"), 0, "")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(57), fee.Float64())
+	assert.Empty(suite.T(), applied.Id)
+}
+
+func (suite *CommissionCalculatorTestSuite) TestCommissionCalculator_ConvertError() {
+	_, _, err := suite.calculator.calculate(suite.plan, money.FromFloat(100, "EUR"), 0, "USD")
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,476 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"time"
+)
+
+const (
+	disputeErrorNotFound                = "dispute with specified identifier not found"
+	disputeErrorIdIncorrect             = "dispute identifier is incorrect"
+	disputeErrorQueryFailed             = "disputes query failed"
+	disputeErrorOrderNotFound           = "order of dispute not found"
+	disputeErrorOrderStatusNotAllowed   = "chargeback not allowed for order with current status"
+	disputeErrorStatusNotAllowed        = "dispute status can't be changed to requested status"
+	disputeErrorChangedConcurrently     = "dispute was changed by another request. try request later"
+	disputeErrorEvidenceEmpty           = "evidence must contain text or files"
+	disputeErrorEvidenceNotAllowed      = "evidence can't be added to closed dispute or after due date"
+	disputeErrorChargebackFeeConvert    = "convert chargeback fee to currency of dispute failed"
+	disputeErrorOrderStatusUpdateFailed = "update status of disputed order failed"
+	disputeErrorAlreadyExists           = "dispute of chargeback already exists"
+)
+
+var (
+	disputeErrNotFound      = errors.New(disputeErrorNotFound)
+	disputeErrAlreadyExists = errors.New(disputeErrorAlreadyExists)
+
+	disputeOrderStatuses = map[int32]int32{
+		pkg.DisputeStatusOpened:            pkg.OrderStatusChargebackOpened,
+		pkg.DisputeStatusEvidenceSubmitted: pkg.OrderStatusChargebackOpened,
+		pkg.DisputeStatusWon:               pkg.OrderStatusChargebackWon,
+		pkg.DisputeStatusLost:              constant.OrderStatusChargeback,
+	}
+)
+
+// ProcessChargebackCallback process notification of payment system about chargeback of order. First
+// notification open dispute, next notifications of same chargeback change status of dispute. Lost dispute
+// reverse funds of merchant and charge chargeback fee of payment method
+func (s *Service) ProcessChargebackCallback(
+	ctx context.Context,
+	req *grpc.CallbackRequest,
+	rsp *grpc.PaymentNotifyResponse,
+) error {
+	adapter, err := getPaymentSystemAdapter(req.Handler)
+
+	if err != nil || adapter.chargebackCallback == nil || adapter.chargebackId == nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Error = callbackHandlerIncorrect
+
+		return nil
+	}
+
+	data, err := adapter.unmarshalChargebackCallback(req.Body)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Error = callbackRequestIncorrect
+
+		return nil
+	}
+
+	orderId, externalId, ok := adapter.chargebackId(data)
+
+	if !ok || bson.IsObjectIdHex(orderId) == false || externalId == "" {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Error = callbackRequestIncorrect
+
+		return nil
+	}
+
+	order, err := s.getOrderById(orderId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Error = disputeErrorOrderNotFound
+
+		return nil
+	}
+
+	isNew := false
+	dispute, err := s.getDisputeBy(bson.M{"order_id": bson.ObjectIdHex(order.Id), "external_id": externalId})
+
+	if err != nil {
+		if err != disputeErrNotFound {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Error = err.Error()
+
+			return nil
+		}
+
+		if order.ChargebackAllowed() == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Error = disputeErrorOrderStatusNotAllowed
+
+			return nil
+		}
+
+		isNew = true
+		dispute = &billing.Dispute{
+			Id:         bson.NewObjectId().Hex(),
+			OrderId:    order.Id,
+			MerchantId: order.Project.MerchantId,
+			Currency:   order.PaymentMethodIncomeCurrency,
+			Status:     pkg.DisputeStatusOpened,
+			CreatedAt:  ptypes.TimestampNow(),
+		}
+	}
+
+	prevStatus := dispute.Status
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Error = orderErrorUnknown
+
+		return nil
+	}
+
+	pErr := h.ProcessChargeback(dispute, data, string(req.Body), req.Signature)
+
+	if pErr != nil {
+		s.logError(
+			"Chargeback callback processing failed",
+			[]interface{}{
+				"err", pErr.Error(),
+				"order_id", orderId,
+				"request", string(req.Body),
+				"signature", req.Signature,
+			},
+		)
+
+		rsp.Error = pErr.Error()
+		rsp.Status = pErr.(*Error).Status()
+
+		return nil
+	}
+
+	// repeated notification about opened chargeback mustn't discard evidence submitted by merchant
+	if dispute.Status == pkg.DisputeStatusOpened && prevStatus == pkg.DisputeStatusEvidenceSubmitted {
+		dispute.Status = prevStatus
+	}
+
+	if prevStatus == pkg.DisputeStatusWon || prevStatus == pkg.DisputeStatusLost {
+		if dispute.Status != prevStatus {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Error = disputeErrorStatusNotAllowed
+
+			return nil
+		}
+	}
+
+	dispute.UpdatedAt = ptypes.TimestampNow()
+
+	if dispute.IsClosed() && dispute.ClosedAt == nil {
+		dispute.ClosedAt = dispute.UpdatedAt
+	}
+
+	if dispute.Status == pkg.DisputeStatusLost && prevStatus != pkg.DisputeStatusLost {
+		dispute.FeeAmount, err = s.getChargebackFee(order, dispute.Currency)
+
+		if err != nil {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Error = err.Error()
+
+			return nil
+		}
+	}
+
+	err = s.saveDispute(dispute, prevStatus, isNew)
+
+	// dispute was created by concurrent notification about same chargeback,
+	// so notification processed again as change of existing dispute
+	if err == disputeErrAlreadyExists {
+		return s.ProcessChargebackCallback(ctx, req, rsp)
+	}
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Error = err.Error()
+
+		if err.Error() == disputeErrorChangedConcurrently {
+			rsp.Status = pkg.ResponseStatusConflict
+		}
+
+		return nil
+	}
+
+	if dispute.Status == pkg.DisputeStatusLost {
+		_ = s.postLedgerEntries(pkg.LedgerSourceTypeDispute, dispute.Id)
+	}
+
+	if status := disputeOrderStatuses[dispute.Status]; order.Status != status {
+		historyLen := len(order.StatusHistory)
+		err = changeOrderStatus(order, status, pkg.OrderStatusChangeSourceCallback, "chargeback "+dispute.Id)
+
+		if err == nil {
+			order.UpdatedAt = ptypes.TimestampNow()
+			err = s.saveOrderChanges(order, historyLen, s.updateOrderWithNotification, func(actual *billing.Order) {
+				actual.UpdatedAt = order.UpdatedAt
+			})
+		}
+
+		if err != nil {
+			s.logError(disputeErrorOrderStatusUpdateFailed, []interface{}{"err", err.Error(), "order_id", order.Id})
+		}
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+
+	return nil
+}
+
+func (s *Service) ListDisputes(
+	ctx context.Context,
+	req *grpc.ListDisputesRequest,
+	rsp *grpc.ListDisputesResponse,
+) error {
+	query := make(bson.M)
+
+	if req.MerchantId != "" {
+		if bson.IsObjectIdHex(req.MerchantId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = ledgerErrorMerchantIdIncorrect
+
+			return nil
+		}
+
+		query["merchant_id"] = bson.ObjectIdHex(req.MerchantId)
+	}
+
+	if req.OrderId != "" {
+		if bson.IsObjectIdHex(req.OrderId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = ledgerErrorOrderIdIncorrect
+
+			return nil
+		}
+
+		query["order_id"] = bson.ObjectIdHex(req.OrderId)
+	}
+
+	if len(req.Status) > 0 {
+		query["status"] = bson.M{"$in": req.Status}
+	}
+
+	var disputes []*billing.Dispute
+	err := s.db.Collection(pkg.CollectionDispute).Find(query).Sort("-_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&disputes)
+
+	if err != nil {
+		s.logError("Query to find disputes failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = disputeErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionDispute).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count disputes failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = disputeErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = disputes
+
+	return nil
+}
+
+func (s *Service) GetDispute(
+	ctx context.Context,
+	req *grpc.DisputeRequest,
+	rsp *grpc.DisputeResponse,
+) error {
+	dispute, err := s.getMerchantDispute(req.DisputeId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = dispute
+
+	return nil
+}
+
+// AddDisputeEvidence attach text and files submitted by merchant to dispute. Files must be uploaded to storage
+// before request, dispute keep only names and links of files
+func (s *Service) AddDisputeEvidence(
+	ctx context.Context,
+	req *grpc.AddDisputeEvidenceRequest,
+	rsp *grpc.DisputeResponse,
+) error {
+	if req.Text == "" && len(req.Files) <= 0 {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = disputeErrorEvidenceEmpty
+
+		return nil
+	}
+
+	dispute, err := s.getMerchantDispute(req.DisputeId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if dispute.EvidenceAllowed() == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = disputeErrorEvidenceNotAllowed
+
+		return nil
+	}
+
+	prevStatus := dispute.Status
+
+	dispute.Evidence = append(
+		dispute.Evidence,
+		&billing.DisputeEvidence{Text: req.Text, Files: req.Files, CreatedAt: ptypes.TimestampNow()},
+	)
+	dispute.Status = pkg.DisputeStatusEvidenceSubmitted
+	dispute.UpdatedAt = ptypes.TimestampNow()
+
+	err = s.saveDispute(dispute, prevStatus, false)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.Error()
+
+		if err.Error() == disputeErrorChangedConcurrently {
+			rsp.Status = pkg.ResponseStatusConflict
+		}
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = dispute
+
+	return nil
+}
+
+func (s *Service) getDisputeBy(query bson.M) (*billing.Dispute, error) {
+	dispute := &billing.Dispute{}
+	err := s.db.Collection(pkg.CollectionDispute).Find(query).One(dispute)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, disputeErrNotFound
+		}
+
+		s.logError("Query to find dispute failed", []interface{}{"err", err.Error(), "query", query})
+		return nil, errors.New(disputeErrorQueryFailed)
+	}
+
+	return dispute, nil
+}
+
+// getMerchantDispute return dispute by identifier. If merchant identifier is set then dispute of other
+// merchant processed as not found
+func (s *Service) getMerchantDispute(id, merchantId string) (*billing.Dispute, error) {
+	if bson.IsObjectIdHex(id) == false {
+		return nil, errors.New(disputeErrorIdIncorrect)
+	}
+
+	dispute, err := s.getDisputeBy(bson.M{"_id": bson.ObjectIdHex(id)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if merchantId != "" && dispute.MerchantId != merchantId {
+		return nil, disputeErrNotFound
+	}
+
+	return dispute, nil
+}
+
+// saveDispute insert new dispute or update existing dispute only if status of dispute wasn't changed
+// by another request
+func (s *Service) saveDispute(dispute *billing.Dispute, prevStatus int32, isNew bool) error {
+	var err error
+
+	if isNew {
+		err = s.db.Collection(pkg.CollectionDispute).Insert(dispute)
+	} else {
+		query := bson.M{"_id": bson.ObjectIdHex(dispute.Id), "status": prevStatus}
+		err = s.db.Collection(pkg.CollectionDispute).Update(query, dispute)
+	}
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return errors.New(disputeErrorChangedConcurrently)
+		}
+
+		if mgo.IsDup(err) {
+			return disputeErrAlreadyExists
+		}
+
+		s.logError("Query to save dispute failed", []interface{}{"err", err.Error(), "data", dispute})
+		return errors.New(disputeErrorQueryFailed)
+	}
+
+	return nil
+}
+
+// getChargebackFee return chargeback fee of order's payment method converted to currency of dispute.
+// Fee of payment method declared in accounting currency of PSP
+func (s *Service) getChargebackFee(order *billing.Order, currency *billing.Currency) (float64, error) {
+	params := order.PaymentMethod.Params
+
+	// fee takes from current settings of payment method, settings saved in order used if payment method removed
+	if pm, err := s.GetPaymentMethodById(order.PaymentMethod.Id); err == nil {
+		params = pm.Params
+	}
+
+	if params == nil || params.ChargebackFee <= 0 {
+		return 0, nil
+	}
+
+	fee, err := s.convertLedgerAmount(s.accountingCurrency, currency, params.ChargebackFee, time.Now())
+
+	if err != nil {
+		return 0, errors.New(disputeErrorChargebackFeeConvert)
+	}
+
+	return money.FromFloat(fee, currency.CodeA3).Float64(), nil
+}
+
+// postDisputeLedgerEntries post journal entry for lost dispute. Disputed amount returns to payer from
+// payment system receivable as for refund and chargeback fee charged from merchant as PSP revenue
+func (s *Service) postDisputeLedgerEntries(dispute *billing.Dispute, order *billing.Order) error {
+	amount := money.FromFloat(dispute.Amount, dispute.GetCurrency().GetCodeA3())
+	fee := money.FromFloat(dispute.FeeAmount, amount.Currency())
+	tax := getRefundTaxAmount(order, amount)
+
+	payable, _ := amount.Subtract(tax)
+	payable, _ = payable.Add(fee)
+
+	lines := []*ledgerLine{
+		{account: pkg.LedgerAccountTaxLiability, side: pkg.LedgerEntrySideDebit, amount: tax},
+		{account: pkg.LedgerAccountMerchantPayable, side: pkg.LedgerEntrySideDebit, amount: payable},
+		{account: pkg.LedgerAccountPaymentSystemReceivable, side: pkg.LedgerEntrySideCredit, amount: amount},
+		{account: pkg.LedgerAccountPspRevenue, side: pkg.LedgerEntrySideCredit, amount: fee},
+	}
+
+	return s.postLedgerJournal(
+		pkg.LedgerSourceTypeDispute,
+		dispute.Id,
+		dispute.MerchantId,
+		dispute.OrderId,
+		dispute.Currency,
+		order.GetRateDate(),
+		lines,
+	)
+}
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type DisputeTestSuite struct {
+	suite.Suite
+	service  *Service
+	broker   *mock.BrokerMock
+	merchant *billing.Merchant
+	order    *billing.Order
+	rub      *billing.Currency
+}
+
+func Test_Dispute(t *testing.T) {
+	suite.Run(t, new(DisputeTestSuite))
+}
+
+func (suite *DisputeTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+
+	settings := database.Connection{
+		Host:     cfg.MongoHost,
+		Database: cfg.MongoDatabase,
+		User:     cfg.MongoUser,
+		Password: cfg.MongoPassword,
+	}
+
+	db, err := database.NewDatabase(settings)
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.rub = &billing.Currency{CodeInt: 643, CodeA3: "RUB", Name: &billing.Name{Ru: "Российский рубль", En: "Russian ruble"}}
+
+	suite.merchant = &billing.Merchant{
+		Id:      bson.NewObjectId().Hex(),
+		Name:    "Unit test",
+		Banking: &billing.MerchantBanking{Currency: suite.rub},
+	}
+
+	err = db.Collection(pkg.CollectionMerchant).Insert(suite.merchant)
+	assert.NoError(suite.T(), err, "Insert merchant test data failed")
+
+	pm := &billing.PaymentMethod{
+		Id:    bson.NewObjectId().Hex(),
+		Name:  "Bank card",
+		Group: constant.PaymentSystemGroupAliasBankCard,
+		Params: &billing.PaymentMethodParams{
+			Handler:       paymentSystemHandlerMockOk,
+			ExternalId:    "BANKCARD",
+			ChargebackFee: 15,
+		},
+	}
+
+	suite.order = &billing.Order{
+		Id:     bson.NewObjectId().Hex(),
+		Uuid:   bson.NewObjectId().Hex(),
+		Status: constant.OrderStatusProjectComplete,
+		Project: &billing.ProjectOrder{
+			Id:         bson.NewObjectId().Hex(),
+			MerchantId: suite.merchant.Id,
+		},
+		PaymentMethod: &billing.PaymentMethodOrder{
+			Id:   pm.Id,
+			Name: pm.Name,
+			Params: &billing.PaymentMethodParams{
+				Handler:       paymentSystemHandlerMockOk,
+				ExternalId:    "BANKCARD",
+				ChargebackFee: 10,
+			},
+			Group: pm.Group,
+		},
+		TotalPaymentAmount:          120,
+		PaymentMethodIncomeAmount:   120,
+		PaymentMethodIncomeCurrency: suite.rub,
+		Tax:                         &billing.OrderTax{Amount: 20, Currency: suite.rub.CodeA3},
+	}
+
+	err = db.Collection(pkg.CollectionOrder).Insert(suite.order)
+	assert.NoError(suite.T(), err, "Insert order test data failed")
+
+	suite.broker = mock.NewBrokerMockOk()
+	suite.service = NewBillingService(db, cfg, make(chan bool, 1), nil, nil, nil, suite.broker, nil)
+	suite.service.accountingCurrency = suite.rub
+	suite.service.updateCache(func(c *cacheSnapshot) {
+		c.paymentMethodId = map[string]*billing.PaymentMethod{pm.Id: pm}
+	})
+
+	err = suite.service.ensureIndexes()
+	assert.NoError(suite.T(), err, "Create indexes failed")
+}
+
+func (suite *DisputeTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *DisputeTestSuite) sendChargebackCallback(status string) *grpc.PaymentNotifyResponse {
+	callback := &billing.CardPayChargebackCallback{
+		MerchantOrder: &billing.CardPayMerchantOrder{Id: suite.order.Id},
+		PaymentMethod: "BANKCARD",
+		ChargebackData: &billing.CardPayChargebackCallbackChargebackData{
+			Id:         "chargeback_1",
+			Amount:     120,
+			Currency:   suite.rub.CodeA3,
+			Status:     status,
+			ReasonCode: "4837",
+			Reason:     "No cardholder authorization",
+		},
+		CallbackTime: time.Now().UTC().Format(cardPayDateFormat),
+	}
+
+	body, err := json.Marshal(callback)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.CallbackRequest{Handler: paymentSystemHandlerMockOk, Body: body}
+	rsp := &grpc.PaymentNotifyResponse{}
+	err = suite.service.ProcessChargebackCallback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	return rsp
+}
+
+func (suite *DisputeTestSuite) getDispute() *billing.Dispute {
+	dispute, err := suite.service.getDisputeBy(bson.M{"order_id": bson.ObjectIdHex(suite.order.Id)})
+	assert.NoError(suite.T(), err)
+
+	return dispute
+}
+
+func (suite *DisputeTestSuite) getLedgerEntries() []*billing.LedgerEntry {
+	var entries []*billing.LedgerEntry
+	query := bson.M{"source_type": pkg.LedgerSourceTypeDispute}
+	err := suite.service.db.Collection(pkg.CollectionLedgerEntry).Find(query).All(&entries)
+	assert.NoError(suite.T(), err)
+
+	return entries
+}
+
+func (suite *DisputeTestSuite) TestDispute_ProcessChargebackCallback_Opened_Ok() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	dispute := suite.getDispute()
+	assert.Equal(suite.T(), pkg.DisputeStatusOpened, dispute.Status)
+	assert.Equal(suite.T(), "chargeback_1", dispute.ExternalId)
+	assert.Equal(suite.T(), "4837", dispute.ReasonCode)
+	assert.Equal(suite.T(), suite.merchant.Id, dispute.MerchantId)
+	assert.Equal(suite.T(), float64(120), dispute.Amount)
+	assert.Equal(suite.T(), suite.rub.CodeA3, dispute.Currency.CodeA3)
+	assert.Nil(suite.T(), dispute.ClosedAt)
+
+	order, err := suite.service.getOrderById(suite.order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusChargebackOpened, order.Status)
+	assert.False(suite.T(), order.RefundAllowed())
+	assert.Len(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName), 1)
+	assert.Empty(suite.T(), suite.getLedgerEntries())
+}
+
+func (suite *DisputeTestSuite) TestDispute_ProcessChargebackCallback_Lost_Ok() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rsp = suite.sendChargebackCallback(pkg.CardPayChargebackStatusLost)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	dispute := suite.getDispute()
+	assert.Equal(suite.T(), pkg.DisputeStatusLost, dispute.Status)
+	assert.Equal(suite.T(), float64(15), dispute.FeeAmount)
+	assert.NotNil(suite.T(), dispute.ClosedAt)
+
+	order, err := suite.service.getOrderById(suite.order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusChargeback, order.Status)
+
+	entries := suite.getLedgerEntries()
+	assert.Len(suite.T(), entries, 4)
+
+	amounts := make(map[string]*billing.LedgerEntry)
+
+	for _, v := range entries {
+		amounts[v.Account] = v
+		assert.Equal(suite.T(), dispute.Id, v.SourceId)
+		assert.Equal(suite.T(), suite.order.Id, v.OrderId)
+	}
+
+	assert.Equal(suite.T(), float64(20), amounts[pkg.LedgerAccountTaxLiability].Amount)
+	assert.Equal(suite.T(), float64(115), amounts[pkg.LedgerAccountMerchantPayable].Amount)
+	assert.Equal(suite.T(), pkg.LedgerEntrySideDebit, amounts[pkg.LedgerAccountMerchantPayable].Side)
+	assert.Equal(suite.T(), float64(120), amounts[pkg.LedgerAccountPaymentSystemReceivable].Amount)
+	assert.Equal(suite.T(), float64(15), amounts[pkg.LedgerAccountPspRevenue].Amount)
+
+	rsp = suite.sendChargebackCallback(pkg.CardPayChargebackStatusLost)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Len(suite.T(), suite.getLedgerEntries(), 4)
+}
+
+func (suite *DisputeTestSuite) TestDispute_ProcessChargebackCallback_Won_Ok() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rsp = suite.sendChargebackCallback(pkg.CardPayChargebackStatusWon)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	dispute := suite.getDispute()
+	assert.Equal(suite.T(), pkg.DisputeStatusWon, dispute.Status)
+	assert.Equal(suite.T(), float64(0), dispute.FeeAmount)
+
+	order, err := suite.service.getOrderById(suite.order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusChargebackWon, order.Status)
+	assert.True(suite.T(), order.RefundAllowed())
+	assert.Empty(suite.T(), suite.getLedgerEntries())
+
+	rsp = suite.sendChargebackCallback(pkg.CardPayChargebackStatusLost)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), disputeErrorStatusNotAllowed, rsp.Error)
+	assert.Equal(suite.T(), pkg.DisputeStatusWon, suite.getDispute().Status)
+}
+
+func (suite *DisputeTestSuite) TestDispute_ProcessChargebackCallback_OrderStatusNotAllowed_Error() {
+	suite.order.Status = constant.OrderStatusPaymentSystemCreate
+	err := suite.service.updateOrder(suite.order)
+	assert.NoError(suite.T(), err)
+
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), disputeErrorOrderStatusNotAllowed, rsp.Error)
+
+	n, err := suite.service.db.Collection(pkg.CollectionDispute).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, n)
+}
+
+func (suite *DisputeTestSuite) TestDispute_ProcessChargebackCallback_UnknownHandler_Error() {
+	req := &grpc.CallbackRequest{Handler: pkg.PaymentSystemHandlerStripe, Body: []byte("{}")}
+	rsp := &grpc.PaymentNotifyResponse{}
+	err := suite.service.ProcessChargebackCallback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), callbackHandlerIncorrect, rsp.Error)
+}
+
+func (suite *DisputeTestSuite) TestDispute_ProcessChargebackCallback_OrderNotFound_Error() {
+	suite.order.Id = bson.NewObjectId().Hex()
+
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), disputeErrorOrderNotFound, rsp.Error)
+}
+
+func (suite *DisputeTestSuite) TestDispute_SaveDispute_AlreadyExists_Error() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	dispute := suite.getDispute()
+	dispute.Id = bson.NewObjectId().Hex()
+
+	err := suite.service.saveDispute(dispute, pkg.DisputeStatusOpened, true)
+	assert.Equal(suite.T(), disputeErrAlreadyExists, err)
+
+	n, err := suite.service.db.Collection(pkg.CollectionDispute).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, n)
+}
+
+func (suite *DisputeTestSuite) TestDispute_AddDisputeEvidence_Ok() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	req := &grpc.AddDisputeEvidenceRequest{
+		DisputeId:  suite.getDispute().Id,
+		MerchantId: suite.merchant.Id,
+		Text:       "Goods were delivered to customer",
+		Files:      []*billing.DisputeEvidenceFile{{Name: "receipt.pdf", Url: "https://storage.local/receipt.pdf"}},
+	}
+	rsp1 := &grpc.DisputeResponse{}
+	err := suite.service.AddDisputeEvidence(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), pkg.DisputeStatusEvidenceSubmitted, rsp1.Item.Status)
+
+	dispute := suite.getDispute()
+	assert.Equal(suite.T(), pkg.DisputeStatusEvidenceSubmitted, dispute.Status)
+	assert.Len(suite.T(), dispute.Evidence, 1)
+	assert.Equal(suite.T(), req.Text, dispute.Evidence[0].Text)
+	assert.Len(suite.T(), dispute.Evidence[0].Files, 1)
+	assert.Equal(suite.T(), "receipt.pdf", dispute.Evidence[0].Files[0].Name)
+	assert.NotNil(suite.T(), dispute.Evidence[0].CreatedAt)
+
+	rsp = suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.DisputeStatusEvidenceSubmitted, suite.getDispute().Status)
+}
+
+func (suite *DisputeTestSuite) TestDispute_AddDisputeEvidence_Error() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	dispute := suite.getDispute()
+
+	req := &grpc.AddDisputeEvidenceRequest{DisputeId: dispute.Id, MerchantId: suite.merchant.Id}
+	rsp1 := &grpc.DisputeResponse{}
+	err := suite.service.AddDisputeEvidence(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp1.Status)
+	assert.Equal(suite.T(), disputeErrorEvidenceEmpty, rsp1.Message)
+
+	req.Text = "Goods were delivered to customer"
+	req.MerchantId = bson.NewObjectId().Hex()
+	rsp2 := &grpc.DisputeResponse{}
+	err = suite.service.AddDisputeEvidence(context.TODO(), req, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp2.Status)
+	assert.Equal(suite.T(), disputeErrorNotFound, rsp2.Message)
+
+	dispute.EvidenceDueAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	err = suite.service.saveDispute(dispute, dispute.Status, false)
+	assert.NoError(suite.T(), err)
+
+	req.MerchantId = suite.merchant.Id
+	rsp3 := &grpc.DisputeResponse{}
+	err = suite.service.AddDisputeEvidence(context.TODO(), req, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp3.Status)
+	assert.Equal(suite.T(), disputeErrorEvidenceNotAllowed, rsp3.Message)
+	assert.Empty(suite.T(), suite.getDispute().Evidence)
+}
+
+func (suite *DisputeTestSuite) TestDispute_ListAndGetDisputes_Ok() {
+	rsp := suite.sendChargebackCallback(pkg.CardPayChargebackStatusOpened)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	req := &grpc.ListDisputesRequest{MerchantId: suite.merchant.Id, Status: []int32{pkg.DisputeStatusOpened}}
+	rsp1 := &grpc.ListDisputesResponse{}
+	err := suite.service.ListDisputes(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), int32(1), rsp1.Count)
+	assert.Len(suite.T(), rsp1.Items, 1)
+
+	rsp2 := &grpc.DisputeResponse{}
+	err = suite.service.GetDispute(
+		context.TODO(),
+		&grpc.DisputeRequest{DisputeId: rsp1.Items[0].Id, MerchantId: suite.merchant.Id},
+		rsp2,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.Equal(suite.T(), suite.order.Id, rsp2.Item.OrderId)
+
+	req.Status = []int32{pkg.DisputeStatusLost}
+	rsp3 := &grpc.ListDisputesResponse{}
+	err = suite.service.ListDisputes(context.TODO(), req, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(0), rsp3.Count)
+}
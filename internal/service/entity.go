@@ -1,13 +1,13 @@
 package service
 
 import (
-	"context"
 	"errors"
 	"fmt"
+	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
-	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"sort"
 )
 
 type Project Currency
@@ -16,41 +16,29 @@ type Country Currency
 type Merchant Currency
 type SystemFee Currency
 
-func newProjectHandler(svc *Service) Cacher {
+func newProjectHandler(svc *Service) EntityLoader {
 	c := &Project{svc: svc}
 
 	return c
 }
 
-func (h *Project) setCache(recs []interface{}) {
-	h.svc.projectCache = make(map[string]*billing.Project, len(recs))
-
-	if len(recs) <= 0 {
-		return
-	}
-
-	for _, r := range recs {
-		project := r.(*billing.Project)
-		h.svc.projectCache[project.Id] = project
+func (h *Project) getOne(id string) (interface{}, error) {
+	if bson.IsObjectIdHex(id) == false {
+		return nil, nil
 	}
-}
-
-func (h *Project) getAll() (recs []interface{}, err error) {
-	var data []*billing.Project
 
-	err = h.svc.db.Collection(pkg.CollectionProject).Find(bson.M{}).All(&data)
+	var project *billing.Project
+	err := h.svc.db.Collection(pkg.CollectionProject).FindId(bson.ObjectIdHex(id)).One(&project)
 
-	if data != nil {
-		for _, v := range data {
-			recs = append(recs, v)
-		}
+	if err == mgo.ErrNotFound {
+		return nil, nil
 	}
 
-	return
+	return project, err
 }
 
 func (s *Service) GetProjectById(id string) (*billing.Project, error) {
-	rec, ok := s.projectCache[id]
+	rec, ok := s.getCachedProject(id)
 
 	if !ok {
 		return nil, fmt.Errorf(errorNotFound, pkg.CollectionProject)
@@ -65,11 +53,11 @@ func newPaymentMethodHandler(svc *Service) Cacher {
 	return c
 }
 
-func (h *PaymentMethod) setCache(recs []interface{}) {
+func (h *PaymentMethod) setCache(c *cacheSnapshot, recs []interface{}) {
 	recsLen := len(recs)
 
-	h.svc.paymentMethodCache = make(map[string]map[int32]*billing.PaymentMethod, recsLen)
-	h.svc.paymentMethodIdCache = make(map[string]*billing.PaymentMethod, recsLen)
+	c.paymentMethod = make(map[string]map[int32]*billing.PaymentMethod, recsLen)
+	c.paymentMethodId = make(map[string]*billing.PaymentMethod, recsLen)
 
 	if len(recs) <= 0 {
 		return
@@ -78,15 +66,15 @@ func (h *PaymentMethod) setCache(recs []interface{}) {
 	for _, r := range recs {
 		pm := r.(*billing.PaymentMethod)
 
-		if _, ok := h.svc.paymentMethodCache[pm.Group]; !ok {
-			h.svc.paymentMethodCache[pm.Group] = make(map[int32]*billing.PaymentMethod, len(pm.Currencies))
+		if _, ok := c.paymentMethod[pm.Group]; !ok {
+			c.paymentMethod[pm.Group] = make(map[int32]*billing.PaymentMethod, len(pm.Currencies))
 		}
 
 		for _, v := range pm.Currencies {
-			h.svc.paymentMethodCache[pm.Group][v] = pm
+			c.paymentMethod[pm.Group][v] = pm
 		}
 
-		h.svc.paymentMethodIdCache[pm.Id] = pm
+		c.paymentMethodId[pm.Id] = pm
 	}
 }
 
@@ -105,7 +93,7 @@ func (h *PaymentMethod) getAll() (recs []interface{}, err error) {
 }
 
 func (s *Service) GetPaymentMethodByGroupAndCurrency(group string, currency int32) (*billing.PaymentMethod, error) {
-	pmGroup, ok := s.paymentMethodCache[group]
+	pmGroup, ok := s.getCache().paymentMethod[group]
 
 	if !ok {
 		return nil, fmt.Errorf(errorNotFound, pkg.CollectionPaymentMethod)
@@ -121,7 +109,7 @@ func (s *Service) GetPaymentMethodByGroupAndCurrency(group string, currency int3
 }
 
 func (s *Service) GetPaymentMethodById(id string) (*billing.PaymentMethod, error) {
-	rec, ok := s.paymentMethodIdCache[id]
+	rec, ok := s.getCache().paymentMethodId[id]
 
 	if !ok {
 		return nil, fmt.Errorf(errorNotFound, pkg.CollectionPaymentMethod)
@@ -136,8 +124,8 @@ func newCountryHandler(svc *Service) Cacher {
 	return c
 }
 
-func (h *Country) setCache(recs []interface{}) {
-	h.svc.countryCache = make(map[string]*billing.Country, len(recs))
+func (h *Country) setCache(c *cacheSnapshot, recs []interface{}) {
+	c.country = make(map[string]*billing.Country, len(recs))
 
 	if len(recs) <= 0 {
 		return
@@ -145,7 +133,7 @@ func (h *Country) setCache(recs []interface{}) {
 
 	for _, r := range recs {
 		country := r.(*billing.Country)
-		h.svc.countryCache[country.CodeA2] = country
+		c.country[country.CodeA2] = country
 	}
 }
 
@@ -164,7 +152,7 @@ func (h *Country) getAll() (recs []interface{}, err error) {
 }
 
 func (s *Service) GetCountryByCodeA2(id string) (*billing.Country, error) {
-	rec, ok := s.countryCache[id]
+	rec, ok := s.getCache().country[id]
 
 	if !ok {
 		return nil, fmt.Errorf(errorNotFound, pkg.CollectionCountry)
@@ -173,84 +161,92 @@ func (s *Service) GetCountryByCodeA2(id string) (*billing.Country, error) {
 	return rec, nil
 }
 
-func newMerchantHandler(svc *Service) Cacher {
+func newMerchantHandler(svc *Service) EntityLoader {
 	c := &Merchant{svc: svc}
 
 	return c
 }
 
-func (h *Merchant) setCache(recs []interface{}) {
-	h.svc.merchantPaymentMethods = make(map[string]map[string]*billing.MerchantPaymentMethod)
-	h.svc.merchantCache = make(map[string]*billing.Merchant)
+func (h *Merchant) getOne(id string) (interface{}, error) {
+	if bson.IsObjectIdHex(id) == false {
+		return nil, nil
+	}
 
-	if len(recs) <= 0 {
-		return
+	var merchant *billing.Merchant
+	err := h.svc.db.Collection(pkg.CollectionMerchant).FindId(bson.ObjectIdHex(id)).One(&merchant)
+
+	if err == mgo.ErrNotFound {
+		return nil, nil
 	}
 
-	for _, r := range recs {
-		m := r.(*billing.Merchant)
-		h.svc.merchantCache[m.Id] = m
+	return merchant, err
+}
 
-		if _, ok := h.svc.merchantPaymentMethods[m.Id]; !ok {
-			h.svc.merchantPaymentMethods[m.Id] = make(map[string]*billing.MerchantPaymentMethod)
-		}
+// getMerchantPaymentMethodsWithDefaults return payment methods of merchant, payment methods which merchant
+// hasn't settings for are returned with default commissions
+func getMerchantPaymentMethodsWithDefaults(
+	m *billing.Merchant,
+	pms map[string]*billing.PaymentMethod,
+) map[string]*billing.MerchantPaymentMethod {
+	res := make(map[string]*billing.MerchantPaymentMethod, len(pms))
 
-		if len(m.PaymentMethods) > 0 {
-			for k, v := range m.PaymentMethods {
-				h.svc.merchantPaymentMethods[m.Id][k] = v
-			}
-		}
+	for k, v := range m.PaymentMethods {
+		res[k] = v
+	}
 
-		if len(h.svc.merchantPaymentMethods[m.Id]) != len(h.svc.paymentMethodIdCache) {
-			for k, v := range h.svc.paymentMethodIdCache {
-				_, ok := h.svc.merchantPaymentMethods[m.Id][k]
+	if len(res) == len(pms) {
+		return res
+	}
 
-				if ok {
-					continue
-				}
+	for k, v := range pms {
+		if _, ok := res[k]; ok {
+			continue
+		}
 
-				h.svc.merchantPaymentMethods[m.Id][k] = &billing.MerchantPaymentMethod{
-					PaymentMethod: &billing.MerchantPaymentMethodIdentification{
-						Id:   k,
-						Name: v.Name,
-					},
-					Commission: &billing.MerchantPaymentMethodCommissions{
-						Fee: DefaultPaymentMethodFee,
-						PerTransaction: &billing.MerchantPaymentMethodPerTransactionCommission{
-							Fee:      DefaultPaymentMethodPerTransactionFee,
-							Currency: DefaultPaymentMethodCurrency,
-						},
-					},
-					Integration: &billing.MerchantPaymentMethodIntegration{},
-					IsActive:    true,
-				}
-			}
+		res[k] = &billing.MerchantPaymentMethod{
+			PaymentMethod: &billing.MerchantPaymentMethodIdentification{
+				Id:   k,
+				Name: v.Name,
+			},
+			Commission: &billing.MerchantPaymentMethodCommissions{
+				Fee: DefaultPaymentMethodFee,
+				PerTransaction: &billing.MerchantPaymentMethodPerTransactionCommission{
+					Fee:      DefaultPaymentMethodPerTransactionFee,
+					Currency: DefaultPaymentMethodCurrency,
+				},
+			},
+			Integration: &billing.MerchantPaymentMethodIntegration{},
+			IsActive:    true,
 		}
 	}
-}
 
-func (h *Merchant) getAll() (recs []interface{}, err error) {
-	var data []*billing.Merchant
+	return res
+}
 
-	err = h.svc.db.Collection(pkg.CollectionMerchant).Find(bson.M{}).All(&data)
+// getMerchantPaymentMethods return payment methods of merchant with defaults for payment methods which
+// merchant hasn't settings for
+func (s *Service) getMerchantPaymentMethods(merchantId string) (map[string]*billing.MerchantPaymentMethod, bool) {
+	merchant, ok := s.getCachedMerchant(merchantId)
 
-	if data != nil {
-		for _, v := range data {
-			recs = append(recs, v)
-		}
+	if !ok {
+		return nil, false
 	}
 
-	return
+	return getMerchantPaymentMethodsWithDefaults(merchant, s.getCachedPaymentMethods()), true
 }
 
 func (s *Service) getMerchantPaymentMethod(merchantId, pmId string) (*billing.MerchantPaymentMethod, error) {
-	pms, ok := s.merchantPaymentMethods[merchantId]
+	merchant, ok := s.getCachedMerchant(merchantId)
 
 	if !ok {
 		return nil, errors.New(orderErrorPaymentMethodNotAllowed)
 	}
 
-	pm, ok := pms[pmId]
+	if pm, ok := merchant.PaymentMethods[pmId]; ok {
+		return pm, nil
+	}
+
+	pm, ok := getMerchantPaymentMethodsWithDefaults(merchant, s.getCachedPaymentMethods())[pmId]
 
 	if !ok {
 		return nil, errors.New(orderErrorPaymentMethodNotAllowed)
@@ -308,21 +304,25 @@ func newSystemFeeHandler(svc *Service) Cacher {
 }
 
 func (h *SystemFee) getAll() (recs []interface{}, err error) {
-	list := &billing.SystemFeesList{}
-	e := h.svc.GetActualSystemFeesList(context.TODO(), &grpc.EmptyRequest{}, list)
-	if e != nil {
-		h.svc.logError("Get System fees failed", []interface{}{"err", e.Error()})
-		return nil, e
+	var fees []*billing.SystemFees
+
+	// latest versions and versions with effective period, previous versions without period are history only
+	query := bson.M{"$or": []bson.M{{"is_active": true}, {"effective_to": bson.M{"$ne": nil}}}}
+	err = h.svc.db.Collection(pkg.CollectionSystemFees).Find(query).All(&fees)
+
+	if err != nil {
+		h.svc.logError("Get System fees failed", []interface{}{"err", err.Error(), "query", query})
+		return nil, err
 	}
 
-	for _, f := range list.SystemFees {
+	for _, f := range fees {
 		recs = append(recs, f)
 	}
 	return
 }
 
-func (h *SystemFee) setCache(recs []interface{}) {
-	h.svc.systemFeesCache = make(map[string]map[string]map[string]*billing.SystemFees)
+func (h *SystemFee) setCache(c *cacheSnapshot, recs []interface{}) {
+	c.systemFees = make(map[string]map[string]map[string][]*billing.SystemFees)
 
 	if len(recs) <= 0 {
 		return
@@ -331,19 +331,39 @@ func (h *SystemFee) setCache(recs []interface{}) {
 	for _, r := range recs {
 		f := r.(*billing.SystemFees)
 
-		if _, ok := h.svc.systemFeesCache[f.MethodId]; !ok {
-			h.svc.systemFeesCache[f.MethodId] = make(map[string]map[string]*billing.SystemFees)
+		if _, ok := c.systemFees[f.MethodId]; !ok {
+			c.systemFees[f.MethodId] = make(map[string]map[string][]*billing.SystemFees)
 		}
 
-		if _, ok := h.svc.systemFeesCache[f.MethodId][f.Region]; !ok {
-			h.svc.systemFeesCache[f.MethodId][f.Region] = make(map[string]*billing.SystemFees)
+		if _, ok := c.systemFees[f.MethodId][f.Region]; !ok {
+			c.systemFees[f.MethodId][f.Region] = make(map[string][]*billing.SystemFees)
 		}
 
-		if ff, ok := h.svc.systemFeesCache[f.MethodId][f.Region][f.CardBrand]; ok && ff != nil {
-			h.svc.logError(errorSystemFeeDuplicatedActive, []interface{}{"fee", ff})
-			return
+		versions := c.systemFees[f.MethodId][f.Region][f.CardBrand]
+
+		if f.IsActive && f.EffectiveTo == nil {
+			for _, ff := range versions {
+				if ff.IsActive && ff.EffectiveTo == nil {
+					h.svc.logError(errorSystemFeeDuplicatedActive, []interface{}{"fee", ff})
+				}
+			}
 		}
 
-		h.svc.systemFeesCache[f.MethodId][f.Region][f.CardBrand] = f
+		c.systemFees[f.MethodId][f.Region][f.CardBrand] = append(versions, f)
+	}
+
+	// latest versions first
+	for _, regions := range c.systemFees {
+		for _, brands := range regions {
+			for _, versions := range brands {
+				sort.Slice(versions, func(i, j int) bool {
+					if versions[i].Version != versions[j].Version {
+						return versions[i].Version > versions[j].Version
+					}
+
+					return versions[i].Id > versions[j].Id
+				})
+			}
+		}
 	}
 }
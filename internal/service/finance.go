@@ -1,36 +1,73 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"sort"
+	"time"
 )
 
+const (
+	currencyRateErrorDateIncorrect   = "date of currency rates is incorrect"
+	currencyRateErrorCurrencyUnknown = "currency rates contain unknown or inactive currency"
+	currencyRateErrorPairDuplicated  = "currency rates contain duplicated pair of currencies"
+	currencyRateErrorMissingPairs    = "currency rates not allow to calculate rates for all pairs of active currencies"
+	currencyRateErrorQueryFailed     = "currency rates query failed"
+	currencyRateErrorCacheFailed     = "currency rates saved, but cache of current rates not updated"
+	currencyRatePairMask             = "%s/%s"
+	currencyRateIdentity             = float64(1)
+
+	// identifier of document which refers to batch of current currency rates
+	currencyRateBatchCurrent = "current"
+)
+
+var (
+	currencyRateErrCacheFailed = errors.New(currencyRateErrorCacheFailed)
+)
+
+// currencyRateBatch refer to imported table of currency rates which is current. Current table is switched
+// by single update of this document, so rates of several tables never mixed in cache
+type currencyRateBatch struct {
+	Id        string        `bson:"_id"`
+	BatchId   bson.ObjectId `bson:"batch_id"`
+	Date      time.Time     `bson:"date"`
+	UpdatedAt time.Time     `bson:"updated_at"`
+}
+
 type Currency struct {
 	svc *Service
 }
 
 type CurrencyRate Currency
 type Vat Currency
-type Commission Currency
 
 func newCurrencyHandler(svc *Service) Cacher {
 	return &Currency{svc: svc}
 }
 
-func (h *Currency) setCache(recs []interface{}) {
-	h.svc.currencyCache = make(map[string]*billing.Currency, len(recs))
+func (h *Currency) setCache(c *cacheSnapshot, recs []interface{}) {
+	c.currency = make(map[string]*billing.Currency, len(recs))
+	rules := make(map[string]money.Rules, len(recs))
 
-	if len(recs) <= 0 {
-		return
-	}
+	for _, r := range recs {
+		cur := r.(*billing.Currency)
+		c.currency[cur.CodeA3] = cur
 
-	for _, c := range recs {
-		cur := c.(*billing.Currency)
-		h.svc.currencyCache[cur.CodeA3] = cur
+		if r, ok := cur.GetMoneyRules(); ok {
+			rules[cur.CodeA3] = r
+		}
 	}
+
+	// rules of removed currencies are cleared and requests never see partially rebuilt rules
+	money.ReplaceRules(rules)
 }
 
 func (h *Currency) getAll() (recs []interface{}, err error) {
@@ -48,7 +85,7 @@ func (h *Currency) getAll() (recs []interface{}, err error) {
 }
 
 func (s *Service) GetCurrencyByCodeA3(code string) (*billing.Currency, error) {
-	rec, ok := s.currencyCache[code]
+	rec, ok := s.getCache().currency[code]
 
 	if !ok {
 		return nil, fmt.Errorf(errorNotFound, pkg.CollectionCurrency)
@@ -61,28 +98,41 @@ func newCurrencyRateHandler(svc *Service) Cacher {
 	return &CurrencyRate{svc: svc}
 }
 
-func (h *CurrencyRate) setCache(recs []interface{}) {
-	h.svc.currencyRateCache = make(map[int32]map[int32]*billing.CurrencyRate, len(recs))
+func (h *CurrencyRate) setCache(c *cacheSnapshot, recs []interface{}) {
+	c.currencyRate = make(map[int32]map[int32]*billing.CurrencyRate, len(recs))
 
 	if len(recs) <= 0 {
 		return
 	}
 
-	for _, c := range recs {
-		rate := c.(*billing.CurrencyRate)
+	for _, r := range recs {
+		rate := r.(*billing.CurrencyRate)
 
-		if _, ok := h.svc.currencyRateCache[rate.CurrencyFrom]; !ok {
-			h.svc.currencyRateCache[rate.CurrencyFrom] = make(map[int32]*billing.CurrencyRate, len(recs))
+		if _, ok := c.currencyRate[rate.CurrencyFrom]; !ok {
+			c.currencyRate[rate.CurrencyFrom] = make(map[int32]*billing.CurrencyRate, len(recs))
 		}
 
-		h.svc.currencyRateCache[rate.CurrencyFrom][rate.CurrencyTo] = rate
+		c.currencyRate[rate.CurrencyFrom][rate.CurrencyTo] = rate
 	}
 }
 
 func (h *CurrencyRate) getAll() (recs []interface{}, err error) {
 	var data []*billing.CurrencyRate
 
-	err = h.svc.db.Collection(pkg.CollectionCurrencyRate).Find(bson.M{"is_active": true}).All(&data)
+	batch, err := h.svc.getCurrentCurrencyRateBatch()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// rates imported before batches were introduced are marked as active only
+	query := bson.M{"is_active": true}
+
+	if batch != nil {
+		query = bson.M{"batch_id": batch.BatchId}
+	}
+
+	err = h.svc.db.Collection(pkg.CollectionCurrencyRate).Find(query).All(&data)
 
 	if data != nil {
 		for _, v := range data {
@@ -93,105 +143,531 @@ func (h *CurrencyRate) getAll() (recs []interface{}, err error) {
 	return
 }
 
-func (s *Service) Convert(from int32, to int32, value float64) (float64, error) {
-	fRates, ok := s.currencyRateCache[from]
+// GetCurrencyRate return rate of currencies pair which was effective at specified date. If rate of pair
+// isn't stored it calculated as inverse of rate of opposite pair or as cross rate through base currency
+func (s *Service) GetCurrencyRate(from int32, to int32, date time.Time) (*billing.AppliedCurrencyRate, error) {
+	rec, err := s.getPairCurrencyRate(from, to, date)
 
-	if !ok {
-		return 0, fmt.Errorf(errorNotFound, pkg.CollectionCurrencyRate)
+	if err == nil {
+		return rec, nil
 	}
 
-	rec, ok := fRates[to]
+	base := s.currencyRateBase
 
-	if !ok {
-		return 0, fmt.Errorf(errorNotFound, pkg.CollectionCurrencyRate)
+	if base == nil || base.CodeInt == from || base.CodeInt == to {
+		return nil, err
 	}
 
-	value = value / rec.Rate
+	recFrom, err := s.getPairCurrencyRate(from, base.CodeInt, date)
+
+	if err != nil {
+		return nil, err
+	}
+
+	recTo, err := s.getPairCurrencyRate(base.CodeInt, to, date)
 
-	return tools.FormatAmount(value), nil
+	if err != nil {
+		return nil, err
+	}
+
+	rec = &billing.AppliedCurrencyRate{
+		CurrencyFrom:  from,
+		CurrencyTo:    to,
+		Rate:          recFrom.Rate * recTo.Rate,
+		Date:          recFrom.Date,
+		CrossCurrency: base.CodeInt,
+	}
+
+	// cross rate effective only since both rates of pairs with base currency are effective
+	if recFrom.Date == nil || (recTo.Date != nil && recTo.Date.Seconds > recFrom.Date.Seconds) {
+		rec.Date = recTo.Date
+	}
+
+	rec.MarketRate = rec.Rate
+
+	return rec, nil
 }
 
-func newCommissionHandler(svc *Service) Cacher {
-	return &Commission{svc: svc}
+// getPairCurrencyRate return stored rate of currencies pair or inverse of stored rate of opposite pair
+func (s *Service) getPairCurrencyRate(from int32, to int32, date time.Time) (*billing.AppliedCurrencyRate, error) {
+	rec, err := s.getStoredCurrencyRate(from, to, date)
+
+	if err == nil {
+		return &billing.AppliedCurrencyRate{
+			CurrencyFrom: from,
+			CurrencyTo:   to,
+			Rate:         rec.Rate,
+			MarketRate:   rec.Rate,
+			Date:         rec.Date,
+		}, nil
+	}
+
+	if from == to {
+		return &billing.AppliedCurrencyRate{
+			CurrencyFrom: from,
+			CurrencyTo:   to,
+			Rate:         currencyRateIdentity,
+			MarketRate:   currencyRateIdentity,
+		}, nil
+	}
+
+	rec, err = s.getStoredCurrencyRate(to, from, date)
+
+	if err != nil || rec.Rate <= 0 {
+		return nil, fmt.Errorf(errorNotFound, pkg.CollectionCurrencyRate)
+	}
+
+	return &billing.AppliedCurrencyRate{
+		CurrencyFrom: from,
+		CurrencyTo:   to,
+		Rate:         currencyRateIdentity / rec.Rate,
+		MarketRate:   currencyRateIdentity / rec.Rate,
+		Date:         rec.Date,
+		IsInverse:    true,
+	}, nil
 }
 
-func (h *Commission) setCache(recs []interface{}) {
-	h.svc.commissionCache = make(map[string]map[string]*billing.MerchantPaymentMethodCommissions, len(recs))
+// getStoredCurrencyRate return stored rate of currencies pair which was effective at specified date. Current
+// rates are taken from cache, rates which was effective before current rates are requested from database
+func (s *Service) getStoredCurrencyRate(from int32, to int32, date time.Time) (*billing.CurrencyRate, error) {
+	if rec, ok := s.getCachedCurrencyRate(from, to); ok {
+		if rec.Date == nil || rec.Date.Seconds <= date.Unix() {
+			return rec, nil
+		}
+	}
 
-	if len(recs) <= 0 {
-		return
+	var rec *billing.CurrencyRate
+	query := bson.M{"currency_from": from, "currency_to": to, "date": bson.M{"$lte": date}}
+	err := s.db.Collection(pkg.CollectionCurrencyRate).Find(query).Sort("-date").One(&rec)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			s.logError("Query to find currency rate failed", []interface{}{"err", err.Error(), "query", query})
+		}
+
+		return nil, fmt.Errorf(errorNotFound, pkg.CollectionCurrencyRate)
+	}
+
+	return rec, nil
+}
+
+// Convert convert value from one currency to another with rate which was effective at specified date
+func (s *Service) Convert(from int32, to int32, value float64, date time.Time) (float64, error) {
+	rec, err := s.GetCurrencyRate(from, to, date)
+
+	if err != nil {
+		return 0, err
 	}
 
-	for _, v := range recs {
-		typedV := v.(map[string]map[string]*billing.MerchantPaymentMethodCommissions)
+	value = value / rec.Rate
 
-		for k, v1 := range typedV {
-			h.svc.commissionCache[k] = make(map[string]*billing.MerchantPaymentMethodCommissions)
+	return s.roundAmount(value, to), nil
+}
 
-			for k1, v2 := range v1 {
-				h.svc.commissionCache[k][k1] = v2
-			}
+// roundAmount round amount to minor units of currency with specified numeric code. Amount of currency
+// which isn't active rounded to default count of minor units
+func (s *Service) roundAmount(amount float64, code int32) float64 {
+	for _, v := range s.getCachedCurrencies() {
+		if v.CodeInt == code {
+			return money.Round(amount, v.CodeA3)
 		}
 	}
+
+	return money.RoundDecimal(amount, money.DefaultExponent)
 }
 
-func (h *Commission) getAll() (recs []interface{}, err error) {
-	var merchants []*billing.Merchant
-	var projects []*billing.Project
+// convertOrderAmount convert amount of order with rate which was effective at order creation and save
+// applied rate to order
+func (s *Service) convertOrderAmount(
+	order *billing.Order,
+	from, to *billing.Currency,
+	value money.Money,
+) (money.Money, error) {
+	if value.Currency() != from.CodeA3 {
+		return money.Zero(to.CodeA3), money.ErrCurrencyMismatch
+	}
 
-	err = h.svc.db.Collection(pkg.CollectionMerchant).Find(bson.M{}).All(&merchants)
+	rec, err := s.GetCurrencyRate(from.CodeInt, to.CodeInt, order.GetRateDate())
 
 	if err != nil {
-		return
+		return money.Zero(to.CodeA3), err
 	}
 
-	for _, v := range merchants {
-		query := bson.M{"merchant_id": bson.ObjectIdHex(v.Id)}
-		err = h.svc.db.Collection(pkg.CollectionProject).Find(query).All(&projects)
+	order.CurrencyRates = appendAppliedCurrencyRate(order.CurrencyRates, rec)
 
-		if err != nil {
-			continue
+	return value.Convert(rec.Rate, to.CodeA3), nil
+}
+
+// convertOrderMerchantAmount convert paid amount of order to accounting currency of merchant with rate
+// increased by spread configured for currencies pair. Difference with amount converted by market rate
+// is PSP margin, it saved to order in payment and merchant currencies
+func (s *Service) convertOrderMerchantAmount(
+	order *billing.Order,
+	from, to *billing.Currency,
+	value money.Money,
+) (money.Money, error) {
+	if value.Currency() != from.CodeA3 {
+		return money.Zero(to.CodeA3), money.ErrCurrencyMismatch
+	}
+
+	rec, err := s.GetCurrencyRate(from.CodeInt, to.CodeInt, order.GetRateDate())
+
+	if err != nil {
+		return money.Zero(to.CodeA3), err
+	}
+
+	spread := s.cfg.GetCurrencyRateSpread(from.CodeA3, to.CodeA3)
+
+	if from.CodeInt == to.CodeInt || spread <= 0 {
+		order.FxMarginAmount = nil
+		order.CurrencyRates = appendAppliedCurrencyRate(order.CurrencyRates, rec)
+		return value.Convert(rec.Rate, to.CodeA3), nil
+	}
+
+	applied := &billing.AppliedCurrencyRate{
+		CurrencyFrom:  rec.CurrencyFrom,
+		CurrencyTo:    rec.CurrencyTo,
+		Rate:          rec.MarketRate * (1 + spread/100),
+		Date:          rec.Date,
+		MarketRate:    rec.MarketRate,
+		Spread:        spread,
+		CrossCurrency: rec.CrossCurrency,
+		IsInverse:     rec.IsInverse,
+	}
+
+	order.CurrencyRates = appendAppliedCurrencyRate(order.CurrencyRates, applied)
+
+	amount := value.Convert(applied.Rate, to.CodeA3)
+	margin := value.Multiply(spread / (100 + spread))
+
+	order.FxMarginAmount = &billing.OrderFee{
+		AmountPaymentMethodCurrency: margin.Float64(),
+		AmountMerchantCurrency:      margin.Convert(rec.MarketRate, to.CodeA3).Float64(),
+	}
+
+	return amount, nil
+}
+
+// ImportCurrencyRates save daily table of currency rates. Table must allow to calculate rates for all pairs
+// of active currencies directly, by inverse of opposite pair or through base currency. Rates become current
+// if table date isn't earlier than date of current rates, otherwise table saved as historical and used only
+// to convert amounts of operations made at that date
+func (s *Service) ImportCurrencyRates(
+	ctx context.Context,
+	req *grpc.ImportCurrencyRatesRequest,
+	rsp *grpc.ImportCurrencyRatesResponse,
+) error {
+	date, err := ptypes.Timestamp(req.Date)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = currencyRateErrorDateIncorrect
+
+		return nil
+	}
+
+	cached := s.getCachedCurrencies()
+	currencies := make(map[int32]*billing.Currency, len(cached))
+
+	for _, v := range cached {
+		currencies[v.CodeInt] = v
+	}
+
+	rates := make(map[int32]map[int32]float64)
+
+	for _, v := range req.Rates {
+		_, okFrom := currencies[v.CurrencyFrom]
+		_, okTo := currencies[v.CurrencyTo]
+
+		if !okFrom || !okTo {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = currencyRateErrorCurrencyUnknown
+
+			return nil
+		}
+
+		if _, ok := rates[v.CurrencyFrom]; !ok {
+			rates[v.CurrencyFrom] = make(map[int32]float64)
+		}
+
+		if _, ok := rates[v.CurrencyFrom][v.CurrencyTo]; ok {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = currencyRateErrorPairDuplicated
+
+			return nil
+		}
+
+		rates[v.CurrencyFrom][v.CurrencyTo] = v.Rate
+	}
+
+	// rate of currency to itself may be omitted in table
+	for _, v := range currencies {
+		if _, ok := rates[v.CodeInt]; !ok {
+			rates[v.CodeInt] = make(map[int32]float64)
 		}
 
-		for _, v1 := range projects {
-			commission := make(map[string]map[string]*billing.MerchantPaymentMethodCommissions)
-			commission[v1.Id] = make(map[string]*billing.MerchantPaymentMethodCommissions)
+		if _, ok := rates[v.CodeInt][v.CodeInt]; !ok {
+			rates[v.CodeInt][v.CodeInt] = currencyRateIdentity
+		}
+	}
+
+	hasPairRate := func(from, to int32) bool {
+		_, ok := rates[from][to]
+
+		if !ok {
+			_, ok = rates[to][from]
+		}
+
+		return ok
+	}
 
-			for k, v2 := range v.PaymentMethods {
-				commission[v1.Id][k] = v2.Commission
+	var missing []string
+
+	for _, from := range currencies {
+		for _, to := range currencies {
+			if hasPairRate(from.CodeInt, to.CodeInt) {
+				continue
+			}
+
+			base := s.currencyRateBase
+
+			if base != nil && hasPairRate(from.CodeInt, base.CodeInt) && hasPairRate(base.CodeInt, to.CodeInt) {
+				continue
 			}
 
-			if len(h.svc.paymentMethodIdCache) != len(commission[v1.Id]) {
-				for k := range h.svc.paymentMethodIdCache {
-					_, ok := commission[v1.Id][k]
+			missing = append(missing, fmt.Sprintf(currencyRatePairMask, from.CodeA3, to.CodeA3))
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = currencyRateErrorMissingPairs
+		rsp.MissingPairs = missing
+
+		return nil
+	}
 
-					if ok {
-						continue
-					}
+	isCurrent, err := s.isCurrentCurrencyRateDate(date)
 
-					commission[v1.Id][k] = h.svc.getDefaultPaymentMethodCommissions()
-				}
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = currencyRateErrorQueryFailed
+
+		return nil
+	}
+
+	batchId := bson.NewObjectId()
+	var docs []interface{}
+
+	for from, v := range rates {
+		for to, rate := range v {
+			rec := &billing.CurrencyRate{
+				Id:           bson.NewObjectId().Hex(),
+				CurrencyFrom: from,
+				CurrencyTo:   to,
+				Rate:         rate,
+				Date:         req.Date,
+				CreatedAt:    ptypes.TimestampNow(),
+				BatchId:      batchId.Hex(),
 			}
 
-			recs = append(recs, commission)
+			docs = append(docs, rec)
 		}
 	}
 
-	return
+	err = s.db.Collection(pkg.CollectionCurrencyRate).Insert(docs...)
+
+	if err != nil {
+		s.logError("Query to insert currency rates failed", []interface{}{"err", err.Error(), "date", date})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = currencyRateErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(len(docs))
+
+	if isCurrent {
+		_, err = s.switchCurrencyRateBatch(batchId, date)
+
+		if err != nil && err != currencyRateErrCacheFailed {
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = currencyRateErrorQueryFailed
+
+			return nil
+		}
+
+		// rates saved and switched, but cache of this instance will be updated by periodic refresh only
+		if err != nil {
+			rsp.Message = currencyRateErrorCacheFailed
+		}
+	}
+
+	// repeated import of table replace previously imported tables of same date. Rates which are still
+	// active aren't removed, so table which isn't current can't remove current rates
+	query := bson.M{"date": date, "batch_id": bson.M{"$ne": batchId}, "is_active": false}
+	_, err = s.db.Collection(pkg.CollectionCurrencyRate).RemoveAll(query)
+
+	if err != nil {
+		s.logError("Query to remove replaced currency rates failed", []interface{}{"err", err.Error(), "date", date})
+	}
+
+	return nil
 }
 
-func (s *Service) CalculatePmCommission(projectId, pmId string, amount float64) (float64, error) {
-	prjCom, ok := s.commissionCache[projectId]
+// isCurrentCurrencyRateDate check that table of currency rates imported for date will be current, it's
+// false if current table was imported for later date
+func (s *Service) isCurrentCurrencyRateDate(date time.Time) (bool, error) {
+	batch, err := s.getCurrentCurrencyRateBatch()
 
-	if !ok {
-		return 0, fmt.Errorf(errorNotFound, pkg.CollectionCommission)
+	if err != nil {
+		return false, err
+	}
+
+	if batch != nil {
+		return !batch.Date.After(date), nil
+	}
+
+	var last *billing.CurrencyRate
+	err = s.db.Collection(pkg.CollectionCurrencyRate).Find(bson.M{"is_active": true}).Sort("-date").One(&last)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return true, nil
+		}
+
+		s.logError("Query to find current currency rates failed", []interface{}{"err", err.Error()})
+		return false, err
 	}
 
-	prjPmCom, ok := prjCom[pmId]
+	return last.Date == nil || last.Date.Seconds <= date.Unix(), nil
+}
+
+// getCurrentCurrencyRateBatch return batch of current currency rates, nil returned if rates
+// weren't imported by batches yet
+func (s *Service) getCurrentCurrencyRateBatch() (*currencyRateBatch, error) {
+	batch := &currencyRateBatch{}
+	err := s.db.Collection(pkg.CollectionCurrencyRateBatch).FindId(currencyRateBatchCurrent).One(batch)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, nil
+		}
+
+		s.logError("Query to find current currency rates batch failed", []interface{}{"err", err.Error()})
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// switchCurrencyRateBatch make imported batch of rates current if current batch wasn't imported for later date
+// by concurrent request. After switching activity flags of rates are updated, they're used to keep current
+// rates when replaced tables removed, and cache of current rates is invalidated
+func (s *Service) switchCurrencyRateBatch(batchId bson.ObjectId, date time.Time) (bool, error) {
+	query := bson.M{"_id": currencyRateBatchCurrent, "date": bson.M{"$lte": date}}
+	change := mgo.Change{
+		Update: bson.M{"$set": bson.M{"batch_id": batchId, "date": date, "updated_at": time.Now()}},
+		Upsert: true,
+	}
+	_, err := s.db.Collection(pkg.CollectionCurrencyRateBatch).Find(query).Apply(change, nil)
+
+	if err != nil {
+		// current batch has later date, so upsert tried to insert second document with same identifier
+		if mgo.IsDup(err) {
+			return false, nil
+		}
+
+		s.logError("Query to switch current currency rates batch failed", []interface{}{"err", err.Error(), "date", date})
+		return false, err
+	}
+
+	_, err = s.db.Collection(pkg.CollectionCurrencyRate).
+		UpdateAll(bson.M{"batch_id": batchId}, bson.M{"$set": bson.M{"is_active": true}})
+
+	if err == nil {
+		query = bson.M{"is_active": true, "batch_id": bson.M{"$ne": batchId}}
+		_, err = s.db.Collection(pkg.CollectionCurrencyRate).UpdateAll(query, bson.M{"$set": bson.M{"is_active": false}})
+	}
+
+	if err != nil {
+		s.logError("Query to update activity of currency rates failed", []interface{}{"err", err.Error(), "date", date})
+	}
+
+	err = s.cache(pkg.CollectionCurrencyRate, newCurrencyRateHandler(s))
+	s.publishCacheInvalidation(pkg.CollectionCurrencyRate, "")
+
+	if err != nil {
+		s.logError("Update cache of currency rates failed", []interface{}{"err", err.Error()})
+		return true, currencyRateErrCacheFailed
+	}
+
+	return true, nil
+}
+
+// appendAppliedCurrencyRate add rate to list of rates applied to operation. Rate of same currencies pair
+// and spread replace previously applied rate
+func appendAppliedCurrencyRate(
+	rates []*billing.AppliedCurrencyRate,
+	rec *billing.AppliedCurrencyRate,
+) []*billing.AppliedCurrencyRate {
+	for i, v := range rates {
+		if v.CurrencyFrom == rec.CurrencyFrom && v.CurrencyTo == rec.CurrencyTo && v.Spread == rec.Spread {
+			rates[i] = rec
+			return rates
+		}
+	}
+
+	return append(rates, rec)
+}
+
+// CalculatePmCommission calculate payment system fee for payment amount in currency of payment by merchant
+// commission plan effective on date. If merchant hasn't commission plan for payment method then fee calculated
+// by commission of merchant payment method
+func (s *Service) CalculatePmCommission(
+	projectId, pmId string,
+	amount money.Money,
+	date time.Time,
+) (money.Money, *billing.OrderCommissionPlan, error) {
+	project, ok := s.getCachedProject(projectId)
 
 	if !ok {
-		return 0, fmt.Errorf(errorNotFound, pkg.CollectionCommission)
+		return money.Zero(amount.Currency()), nil, fmt.Errorf(errorNotFound, pkg.CollectionCommission)
+	}
+
+	merchantId := project.MerchantId
+	mPm, err := s.getMerchantPaymentMethod(merchantId, pmId)
+
+	if err != nil {
+		return money.Zero(amount.Currency()), nil, fmt.Errorf(errorNotFound, pkg.CollectionCommission)
+	}
+
+	plan, err := s.getCommissionPlan(merchantId, pmId, date)
+
+	if err != nil {
+		return money.Zero(amount.Currency()), nil, err
+	}
+
+	if plan == nil {
+		plan = commissionPlanFromPaymentMethod(mPm.Commission)
+	}
+
+	volume, volumeCurrency := float64(0), ""
+
+	// volume of merchant needed only to select tier of plan with several tiers
+	if merchant, ok := s.getCachedMerchant(merchantId); ok && merchant.GetPayoutCurrency() != nil && len(plan.Tiers) > 1 {
+		volume, err = s.getMerchantMonthlyVolume(merchantId, pmId, date)
+
+		if err != nil {
+			return money.Zero(amount.Currency()), nil, err
+		}
+
+		volumeCurrency = merchant.GetPayoutCurrency().CodeA3
 	}
 
-	return tools.FormatAmount(amount * (prjPmCom.Fee / 100)), nil
+	return s.newCommissionCalculator(date).calculate(plan, amount, volume, volumeCurrency)
 }
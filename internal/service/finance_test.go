@@ -1,13 +1,17 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/internal/database"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -306,7 +310,7 @@ func (suite *FinanceTestSuite) TestFinance_ConvertOk() {
 	origin := float64(1000)
 	expect := 15.63
 
-	amount, err := suite.service.Convert(643, 840, origin)
+	amount, err := suite.service.Convert(643, 840, origin, time.Now())
 
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), amount > 0)
@@ -314,7 +318,7 @@ func (suite *FinanceTestSuite) TestFinance_ConvertOk() {
 }
 
 func (suite *FinanceTestSuite) TestFinance_ConvertCurrencyFromError() {
-	amount, err := suite.service.Convert(980, 840, 1000)
+	amount, err := suite.service.Convert(980, 840, 1000, time.Now())
 
 	assert.Error(suite.T(), err)
 	assert.True(suite.T(), amount == 0)
@@ -322,7 +326,7 @@ func (suite *FinanceTestSuite) TestFinance_ConvertCurrencyFromError() {
 }
 
 func (suite *FinanceTestSuite) TestFinance_ConvertCurrencyToError() {
-	amount, err := suite.service.Convert(643, 980, 1000)
+	amount, err := suite.service.Convert(643, 980, 1000, time.Now())
 
 	assert.Error(suite.T(), err)
 	assert.True(suite.T(), amount == 0)
@@ -330,28 +334,480 @@ func (suite *FinanceTestSuite) TestFinance_ConvertCurrencyToError() {
 }
 
 func (suite *FinanceTestSuite) TestFinance_CalculateCommissionOk() {
-	amount := float64(100)
+	amount := money.FromFloat(100, "RUB")
 
-	commission, err := suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, amount)
+	commission, plan, err := suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, amount, time.Now())
 
 	assert.Nil(suite.T(), err)
-	assert.NotNil(suite.T(), commission)
-	assert.True(suite.T(), commission > 0)
-	assert.Equal(suite.T(), float64(2.5), commission)
+	assert.True(suite.T(), commission.IsPositive())
+	assert.Equal(suite.T(), float64(32.5), commission.Float64())
+	assert.NotNil(suite.T(), plan)
+	assert.Empty(suite.T(), plan.Id)
 }
 
 func (suite *FinanceTestSuite) TestFinance_CalculateCommissionProjectError() {
-	commission, err := suite.service.CalculatePmCommission(bson.NewObjectId().Hex(), suite.paymentMethod.Id, float64(100))
+	commission, _, err := suite.service.CalculatePmCommission(bson.NewObjectId().Hex(), suite.paymentMethod.Id, money.FromFloat(100, "RUB"), time.Now())
 
 	assert.Error(suite.T(), err)
-	assert.Equal(suite.T(), float64(0), commission)
+	assert.Equal(suite.T(), float64(0), commission.Float64())
 	assert.Equal(suite.T(), fmt.Sprintf(errorNotFound, pkg.CollectionCommission), err.Error())
 }
 
 func (suite *FinanceTestSuite) TestFinance_CalculateCommissionPaymentMethodError() {
-	commission, err := suite.service.CalculatePmCommission(suite.project.Id, bson.NewObjectId().Hex(), float64(100))
+	commission, _, err := suite.service.CalculatePmCommission(suite.project.Id, bson.NewObjectId().Hex(), money.FromFloat(100, "RUB"), time.Now())
 
 	assert.Error(suite.T(), err)
-	assert.Equal(suite.T(), float64(0), commission)
+	assert.Equal(suite.T(), float64(0), commission.Float64())
 	assert.Equal(suite.T(), fmt.Sprintf(errorNotFound, pkg.CollectionCommission), err.Error())
 }
+
+func (suite *FinanceTestSuite) TestFinance_CalculateCommissionByPlan_Ok() {
+	req := &billing.CommissionPlan{
+		MerchantId:      suite.project.MerchantId,
+		PaymentMethodId: suite.paymentMethod.Id,
+		Currency:        "RUB",
+		Tiers: []*billing.CommissionPlanTier{
+			{VolumeFrom: 100000, PercentFee: 1},
+			{VolumeFrom: 0, PercentFee: 3, FixedFee: 10},
+		},
+		MinFee: 15,
+		MaxFee: 500,
+		UserId: bson.NewObjectId().Hex(),
+	}
+	rsp := &grpc.CommissionPlanResponse{}
+
+	err := suite.service.AddCommissionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(1), rsp.Item.Version)
+	assert.Equal(suite.T(), float64(0), rsp.Item.Tiers[0].VolumeFrom)
+
+	commission, plan, err := suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, money.FromFloat(100, "RUB"), time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(15), commission.Float64())
+	assert.Equal(suite.T(), rsp.Item.Id, plan.Id)
+	assert.Equal(suite.T(), int32(1), plan.Version)
+
+	commission, _, err = suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, money.FromFloat(1000, "RUB"), time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(40), commission.Float64())
+
+	commission, _, err = suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, money.FromFloat(100000, "RUB"), time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(500), commission.Float64())
+
+	// next version of plan effective in future not applied to current payments
+	req.EffectiveFrom, _ = ptypes.TimestampProto(time.Now().Add(24 * time.Hour))
+	req.MinFee = 0
+	rsp1 := &grpc.CommissionPlanResponse{}
+
+	err = suite.service.AddCommissionPlan(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), int32(2), rsp1.Item.Version)
+
+	commission, plan, err = suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, money.FromFloat(100, "RUB"), time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(15), commission.Float64())
+	assert.Equal(suite.T(), int32(1), plan.Version)
+
+	commission, plan, err = suite.service.CalculatePmCommission(suite.project.Id, suite.paymentMethod.Id, money.FromFloat(100, "RUB"), time.Now().Add(48*time.Hour))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(13), commission.Float64())
+	assert.Equal(suite.T(), int32(2), plan.Version)
+
+	rsp2 := &grpc.ListCommissionPlansResponse{}
+	err = suite.service.ListCommissionPlans(context.TODO(), &grpc.ListCommissionPlansRequest{MerchantId: suite.project.MerchantId}, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.Equal(suite.T(), int32(2), rsp2.Count)
+}
+
+func (suite *FinanceTestSuite) TestFinance_AddCommissionPlan_TiersIncorrect_Error() {
+	req := &billing.CommissionPlan{
+		MerchantId:      suite.project.MerchantId,
+		PaymentMethodId: suite.paymentMethod.Id,
+		Currency:        "RUB",
+		Tiers: []*billing.CommissionPlanTier{
+			{VolumeFrom: 1000, PercentFee: 1},
+		},
+	}
+	rsp := &grpc.CommissionPlanResponse{}
+
+	err := suite.service.AddCommissionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), commissionPlanErrorTiersIncorrect, rsp.Message)
+
+	req.Tiers = append(req.Tiers, &billing.CommissionPlanTier{VolumeFrom: 0}, &billing.CommissionPlanTier{VolumeFrom: 1000})
+	rsp = &grpc.CommissionPlanResponse{}
+
+	err = suite.service.AddCommissionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), commissionPlanErrorTiersIncorrect, rsp.Message)
+}
+
+func (suite *FinanceTestSuite) TestFinance_AddCommissionPlan_MerchantIdIncorrect_Error() {
+	req := &billing.CommissionPlan{
+		MerchantId:      "incorrect_merchant_id",
+		PaymentMethodId: suite.paymentMethod.Id,
+		Currency:        "RUB",
+		Tiers:           []*billing.CommissionPlanTier{{VolumeFrom: 0, PercentFee: 1}},
+	}
+	rsp := &grpc.CommissionPlanResponse{}
+
+	err := suite.service.AddCommissionPlan(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), ledgerErrorMerchantIdIncorrect, rsp.Message)
+}
+
+func (suite *FinanceTestSuite) TestFinance_CommissionPlan_VersionUnique() {
+	plan := &billing.CommissionPlan{
+		Id:              bson.NewObjectId().Hex(),
+		MerchantId:      suite.project.MerchantId,
+		PaymentMethodId: suite.paymentMethod.Id,
+		Version:         1,
+		Currency:        "RUB",
+		Tiers:           []*billing.CommissionPlanTier{{VolumeFrom: 0, PercentFee: 1}},
+		EffectiveFrom:   ptypes.TimestampNow(),
+		CreatedAt:       ptypes.TimestampNow(),
+	}
+
+	err := suite.service.db.Collection(pkg.CollectionCommissionPlan).Insert(plan)
+	assert.NoError(suite.T(), err)
+
+	// plan with same version saved by concurrent request
+	plan.Id = bson.NewObjectId().Hex()
+	err = suite.service.db.Collection(pkg.CollectionCommissionPlan).Insert(plan)
+	assert.True(suite.T(), mgo.IsDup(err))
+}
+
+func (suite *FinanceTestSuite) TestFinance_ConvertByHistoricalRateOk() {
+	date, err := ptypes.TimestampProto(time.Now().Add(-48 * time.Hour))
+	assert.NoError(suite.T(), err)
+
+	rate := &billing.CurrencyRate{
+		CurrencyFrom: 643,
+		CurrencyTo:   840,
+		Rate:         50,
+		Date:         date,
+		IsActive:     false,
+	}
+	err = suite.service.db.Collection(pkg.CollectionCurrencyRate).Insert(rate)
+	assert.NoError(suite.T(), err)
+
+	amount, err := suite.service.Convert(643, 840, 1000, time.Now().Add(-24*time.Hour))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(20), amount)
+
+	amount, err = suite.service.Convert(643, 840, 1000, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 15.63, amount)
+
+	amount, err = suite.service.Convert(643, 840, 1000, time.Now().Add(-72*time.Hour))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), float64(0), amount)
+	assert.Equal(suite.T(), fmt.Sprintf(errorNotFound, pkg.CollectionCurrencyRate), err.Error())
+}
+
+func (suite *FinanceTestSuite) TestFinance_ConvertOrderAmount_SaveAppliedRate() {
+	rub := &billing.Currency{CodeInt: 643, CodeA3: "RUB"}
+	usd := &billing.Currency{CodeInt: 840, CodeA3: "USD"}
+	order := &billing.Order{CreatedAt: ptypes.TimestampNow()}
+
+	amount, err := suite.service.convertOrderAmount(order, rub, usd, money.FromFloat(1000, rub.CodeA3))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 15.63, amount.Float64())
+
+	amount, err = suite.service.convertOrderAmount(order, rub, usd, money.FromFloat(2000, rub.CodeA3))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 31.25, amount.Float64())
+
+	_, err = suite.service.convertOrderAmount(order, rub, usd, money.FromFloat(2000, usd.CodeA3))
+	assert.Equal(suite.T(), money.ErrCurrencyMismatch, err)
+
+	assert.Len(suite.T(), order.CurrencyRates, 1)
+	assert.Equal(suite.T(), int32(643), order.CurrencyRates[0].CurrencyFrom)
+	assert.Equal(suite.T(), int32(840), order.CurrencyRates[0].CurrencyTo)
+	assert.Equal(suite.T(), float64(64), order.CurrencyRates[0].Rate)
+	assert.NotNil(suite.T(), order.CurrencyRates[0].Date)
+}
+
+func (suite *FinanceTestSuite) addUsdCurrency() {
+	usd := &billing.Currency{
+		CodeInt:  840,
+		CodeA3:   "USD",
+		Name:     &billing.Name{Ru: "Доллар США", En: "US Dollar"},
+		IsActive: true,
+	}
+	err := suite.service.db.Collection(pkg.CollectionCurrency).Insert(usd)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.cache(pkg.CollectionCurrency, newCurrencyHandler(suite.service))
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ImportCurrencyRates_Ok() {
+	suite.addUsdCurrency()
+
+	req := &grpc.ImportCurrencyRatesRequest{
+		Date: ptypes.TimestampNow(),
+		Rates: []*grpc.ImportCurrencyRate{
+			{CurrencyFrom: 643, CurrencyTo: 840, Rate: 70},
+			{CurrencyFrom: 840, CurrencyTo: 643, Rate: 0.0143},
+		},
+	}
+	rsp := &grpc.ImportCurrencyRatesResponse{}
+	err := suite.service.ImportCurrencyRates(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(4), rsp.Count)
+
+	amount, err := suite.service.Convert(643, 840, 1400, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(20), amount)
+
+	amount, err = suite.service.Convert(840, 840, 10, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(10), amount)
+
+	n, err := suite.service.db.Collection(pkg.CollectionCurrencyRate).Find(bson.M{"is_active": true}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, n)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ImportCurrencyRates_Repeated_Ok() {
+	suite.addUsdCurrency()
+
+	importRates := func(date time.Time, rate float64) {
+		ts, err := ptypes.TimestampProto(date)
+		assert.NoError(suite.T(), err)
+
+		req := &grpc.ImportCurrencyRatesRequest{
+			Date:  ts,
+			Rates: []*grpc.ImportCurrencyRate{{CurrencyFrom: 643, CurrencyTo: 840, Rate: rate}},
+		}
+		rsp := &grpc.ImportCurrencyRatesResponse{}
+		err = suite.service.ImportCurrencyRates(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	}
+	countRates := func(query bson.M) int {
+		n, err := suite.service.db.Collection(pkg.CollectionCurrencyRate).Find(query).Count()
+		assert.NoError(suite.T(), err)
+
+		return n
+	}
+
+	date := time.Now().Truncate(time.Second)
+	importRates(date, 70)
+
+	batch, err := suite.service.getCurrentCurrencyRateBatch()
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), batch)
+	assert.Equal(suite.T(), 3, countRates(bson.M{"batch_id": batch.BatchId, "is_active": true}))
+
+	// table of earlier date isn't current and doesn't replace current rates
+	importRates(date.Add(-time.Hour), 50)
+
+	current, err := suite.service.getCurrentCurrencyRateBatch()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), batch.BatchId, current.BatchId)
+	assert.Equal(suite.T(), 3, countRates(bson.M{"batch_id": batch.BatchId, "is_active": true}))
+
+	amount, err := suite.service.Convert(643, 840, 1400, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(20), amount)
+
+	// repeated import of current date replaces current table
+	importRates(date, 35)
+
+	current, err = suite.service.getCurrentCurrencyRateBatch()
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), batch.BatchId, current.BatchId)
+	assert.Equal(suite.T(), 0, countRates(bson.M{"batch_id": batch.BatchId}))
+	assert.Equal(suite.T(), 3, countRates(bson.M{"is_active": true}))
+
+	amount, err = suite.service.Convert(643, 840, 1400, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(40), amount)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ImportCurrencyRates_MissingPairs() {
+	suite.addUsdCurrency()
+
+	eur := &billing.Currency{
+		CodeInt:  978,
+		CodeA3:   "EUR",
+		Name:     &billing.Name{Ru: "Евро", En: "Euro"},
+		IsActive: true,
+	}
+	err := suite.service.db.Collection(pkg.CollectionCurrency).Insert(eur)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.cache(pkg.CollectionCurrency, newCurrencyHandler(suite.service))
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.ImportCurrencyRatesRequest{
+		Date:  ptypes.TimestampNow(),
+		Rates: []*grpc.ImportCurrencyRate{{CurrencyFrom: 978, CurrencyTo: 840, Rate: 0.9}},
+	}
+	rsp := &grpc.ImportCurrencyRatesResponse{}
+	err = suite.service.ImportCurrencyRates(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), currencyRateErrorMissingPairs, rsp.Message)
+	assert.Equal(suite.T(), []string{"EUR/RUB", "RUB/EUR", "RUB/USD", "USD/RUB"}, rsp.MissingPairs)
+
+	// rates of pairs without base currency calculated as cross rates
+	req.Rates = []*grpc.ImportCurrencyRate{
+		{CurrencyFrom: 643, CurrencyTo: 978, Rate: 70},
+		{CurrencyFrom: 643, CurrencyTo: 840, Rate: 64},
+	}
+	rsp = &grpc.ImportCurrencyRatesResponse{}
+	err = suite.service.ImportCurrencyRates(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(5), rsp.Count)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ImportCurrencyRates_UnknownCurrency() {
+	req := &grpc.ImportCurrencyRatesRequest{
+		Date:  ptypes.TimestampNow(),
+		Rates: []*grpc.ImportCurrencyRate{{CurrencyFrom: 643, CurrencyTo: 980, Rate: 2.5}},
+	}
+	rsp := &grpc.ImportCurrencyRatesResponse{}
+	err := suite.service.ImportCurrencyRates(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), currencyRateErrorCurrencyUnknown, rsp.Message)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ImportCurrencyRates_HistoricalNotCurrent() {
+	suite.addUsdCurrency()
+
+	date, err := ptypes.TimestampProto(time.Now().Add(-48 * time.Hour))
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.ImportCurrencyRatesRequest{
+		Date: date,
+		Rates: []*grpc.ImportCurrencyRate{
+			{CurrencyFrom: 643, CurrencyTo: 840, Rate: 50},
+			{CurrencyFrom: 840, CurrencyTo: 643, Rate: 0.02},
+		},
+	}
+	rsp := &grpc.ImportCurrencyRatesResponse{}
+	err = suite.service.ImportCurrencyRates(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	amount, err := suite.service.Convert(643, 840, 1000, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 15.63, amount)
+
+	amount, err = suite.service.Convert(643, 840, 1000, time.Now().Add(-24*time.Hour))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(20), amount)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ConvertByInverseRateOk() {
+	amount, err := suite.service.Convert(840, 643, 10, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(640), amount)
+
+	rate, err := suite.service.GetCurrencyRate(840, 643, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), rate.IsInverse)
+	assert.Equal(suite.T(), int32(0), rate.CrossCurrency)
+}
+
+func (suite *FinanceTestSuite) TestFinance_ConvertByCrossRateOk() {
+	rate := &billing.CurrencyRate{
+		CurrencyFrom: 643,
+		CurrencyTo:   978,
+		Rate:         70,
+		Date:         ptypes.TimestampNow(),
+		IsActive:     true,
+	}
+	err := suite.service.db.Collection(pkg.CollectionCurrencyRate).Insert(rate)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.cache(pkg.CollectionCurrencyRate, newCurrencyRateHandler(suite.service))
+	assert.NoError(suite.T(), err)
+
+	amount, err := suite.service.Convert(840, 978, 100, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 91.43, amount)
+
+	rec, err := suite.service.GetCurrencyRate(840, 978, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(643), rec.CrossCurrency)
+	assert.Equal(suite.T(), 1.09375, rec.Rate)
+	assert.Equal(suite.T(), rec.Rate, rec.MarketRate)
+
+	amount, err = suite.service.Convert(840, 980, 100, time.Now())
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), float64(0), amount)
+	assert.Equal(suite.T(), fmt.Sprintf(errorNotFound, pkg.CollectionCurrencyRate), err.Error())
+}
+
+func (suite *FinanceTestSuite) TestFinance_ConvertOrderMerchantAmount_Spread() {
+	rub := &billing.Currency{CodeInt: 643, CodeA3: "RUB"}
+	usd := &billing.Currency{CodeInt: 840, CodeA3: "USD"}
+	order := &billing.Order{CreatedAt: ptypes.TimestampNow()}
+
+	amount, err := suite.service.convertOrderMerchantAmount(order, rub, usd, money.FromFloat(6400, rub.CodeA3))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(100), amount.Float64())
+	assert.Nil(suite.T(), order.FxMarginAmount)
+
+	suite.service.cfg.CurrencyRateSpreads = map[string]float64{"RUB/USD": 2}
+	defer func() { suite.service.cfg.CurrencyRateSpreads = nil }()
+
+	amount, err = suite.service.convertOrderMerchantAmount(order, rub, usd, money.FromFloat(6400, rub.CodeA3))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 98.04, amount.Float64())
+	assert.NotNil(suite.T(), order.FxMarginAmount)
+	assert.Equal(suite.T(), 125.49, order.FxMarginAmount.AmountPaymentMethodCurrency)
+	assert.Equal(suite.T(), 1.96, order.FxMarginAmount.AmountMerchantCurrency)
+
+	assert.Len(suite.T(), order.CurrencyRates, 2)
+	assert.Equal(suite.T(), float64(2), order.CurrencyRates[1].Spread)
+	assert.Equal(suite.T(), float64(64), order.CurrencyRates[1].MarketRate)
+	assert.InDelta(suite.T(), 65.28, order.CurrencyRates[1].Rate, 0.000001)
+}
+
+func (suite *FinanceTestSuite) TestFinance_Convert_RoundByCurrencyMinorUnit() {
+	jpy := &billing.Currency{
+		CodeInt:   392,
+		CodeA3:    "JPY",
+		Name:      &billing.Name{Ru: "Японская иена", En: "Japanese yen"},
+		IsActive:  true,
+		MinorUnit: &billing.CurrencyMinorUnit{Exponent: 0, RoundingMode: "down"},
+	}
+	err := suite.service.db.Collection(pkg.CollectionCurrency).Insert(jpy)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.cache(pkg.CollectionCurrency, newCurrencyHandler(suite.service))
+	assert.NoError(suite.T(), err)
+	defer money.SetRules("JPY", money.Rules{Exponent: 0, Mode: money.RoundingHalfUp})
+
+	rate := &billing.CurrencyRate{
+		CurrencyFrom: 643,
+		CurrencyTo:   392,
+		Rate:         0.6,
+		Date:         ptypes.TimestampNow(),
+		IsActive:     true,
+	}
+	err = suite.service.db.Collection(pkg.CollectionCurrencyRate).Insert(rate)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.cache(pkg.CollectionCurrencyRate, newCurrencyRateHandler(suite.service))
+	assert.NoError(suite.T(), err)
+
+	amount, err := suite.service.Convert(643, 392, 100, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(166), amount)
+}
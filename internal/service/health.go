@@ -0,0 +1,150 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// cached collection is stale if it wasn't refreshed during this count of its refresh intervals
+	cacheStaleIntervals = 2
+
+	healthErrorCacheRebuildStopped = "cache rebuilding isn't running"
+	healthErrorCacheStale          = "cache of collections is stale: %s"
+)
+
+// cacheHealth is state of refreshing of cached collections which is used by health checks
+type cacheHealth struct {
+	mx       sync.RWMutex
+	loadedAt map[string]time.Time
+	errors   map[string]string
+	rebuild  bool
+}
+
+// CacheCollectionHealth is state of cached collection returned by health check
+type CacheCollectionHealth struct {
+	LoadedAt time.Time `json:"loaded_at"`
+	Stale    bool      `json:"stale"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// BrokerHealth is result of last attempt to publish outbox message returned by health check
+type BrokerHealth struct {
+	AttemptAt time.Time `json:"attempt_at"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func newCacheHealth() *cacheHealth {
+	return &cacheHealth{loadedAt: make(map[string]time.Time), errors: make(map[string]string)}
+}
+
+func (h *cacheHealth) setLoaded(collection string, err error) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if err != nil {
+		h.errors[collection] = err.Error()
+		return
+	}
+
+	h.loadedAt[collection] = time.Now()
+	delete(h.errors, collection)
+}
+
+func (h *cacheHealth) setRebuild(rebuild bool) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.rebuild = rebuild
+}
+
+func (h *cacheHealth) isRebuild() bool {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return h.rebuild
+}
+
+// getCacheRefreshIntervals return intervals of periodic refresh of collections which are fully cached
+func (s *Service) getCacheRefreshIntervals() map[string]time.Duration {
+	return map[string]time.Duration{
+		pkg.CollectionCurrency:      time.Second * time.Duration(s.cfg.CurrencyTimeout),
+		pkg.CollectionCountry:       time.Second * time.Duration(s.cfg.CountryTimeout),
+		pkg.CollectionCurrencyRate:  time.Second * time.Duration(s.cfg.CurrencyRateTimeout),
+		pkg.CollectionPaymentMethod: time.Second * time.Duration(s.cfg.PaymentMethodTimeout),
+		pkg.CollectionSystemFees:    time.Second * time.Duration(s.cfg.SystemFeesTimeout),
+	}
+}
+
+// CheckCacheRebuild is health check which fail if periodic refresh of cache is stopped
+func (s *Service) CheckCacheRebuild() (interface{}, error) {
+	if !s.cacheHealth.isRebuild() {
+		return nil, errors.New(healthErrorCacheRebuildStopped)
+	}
+
+	return "ok", nil
+}
+
+// CheckCacheFreshness is health check which fail if some of cached collections wasn't refreshed in time,
+// state of each cached collection is returned as details of check
+func (s *Service) CheckCacheFreshness() (interface{}, error) {
+	s.cacheHealth.mx.RLock()
+	defer s.cacheHealth.mx.RUnlock()
+
+	res := make(map[string]*CacheCollectionHealth)
+	var stale []string
+
+	for collection, interval := range s.getCacheRefreshIntervals() {
+		loadedAt, ok := s.cacheHealth.loadedAt[collection]
+
+		state := &CacheCollectionHealth{
+			LoadedAt: loadedAt,
+			Stale:    !ok || time.Since(loadedAt) > interval*cacheStaleIntervals,
+			Error:    s.cacheHealth.errors[collection],
+		}
+		res[collection] = state
+
+		if state.Stale {
+			stale = append(stale, collection)
+		}
+	}
+
+	if len(stale) > 0 {
+		sort.Strings(stale)
+		return res, fmt.Errorf(healthErrorCacheStale, strings.Join(stale, ", "))
+	}
+
+	return res, nil
+}
+
+// GetLastBrokerPublish return result of last attempt to publish outbox message to broker. Result of
+// publishing is taken from outbox, so it's same for all service instances. If messages weren't published
+// yet then nil returned
+func (s *Service) GetLastBrokerPublish() (*BrokerHealth, error) {
+	var msg struct {
+		LastError string    `bson:"last_error"`
+		UpdatedAt time.Time `bson:"updated_at"`
+	}
+
+	err := s.db.Collection(pkg.CollectionOutbox).Find(bson.M{}).
+		Select(bson.M{"last_error": 1, "updated_at": 1}).
+		Sort("-updated_at").
+		One(&msg)
+
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &BrokerHealth{AttemptAt: msg.UpdatedAt, Error: msg.LastError}, nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type HealthTestSuite struct {
+	suite.Suite
+	service *Service
+	exitCh  chan bool
+}
+
+func Test_Health(t *testing.T) {
+	suite.Run(t, new(HealthTestSuite))
+}
+
+func (suite *HealthTestSuite) SetupTest() {
+	cfg := &config.Config{
+		CacheConfig: &config.CacheConfig{
+			CurrencyTimeout:      3600,
+			CountryTimeout:       3600,
+			ProjectTimeout:       3600,
+			CurrencyRateTimeout:  3600,
+			PaymentMethodTimeout: 3600,
+			SystemFeesTimeout:    3600,
+		},
+	}
+
+	suite.exitCh = make(chan bool, 1)
+	suite.service = NewBillingService(nil, cfg, suite.exitCh, nil, nil, nil, mock.NewBrokerMockOk(), nil)
+}
+
+func (suite *HealthTestSuite) loadCache(err error) {
+	for k := range suite.service.getCacheRefreshIntervals() {
+		_ = suite.service.cache(k, &cacheRecsTest{Cacher: handlers[k](suite.service), err: err})
+	}
+}
+
+func (suite *HealthTestSuite) TestHealth_CheckCacheFreshness_Ok() {
+	suite.loadCache(nil)
+
+	details, err := suite.service.CheckCacheFreshness()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), details, len(suite.service.getCacheRefreshIntervals()))
+
+	state := details.(map[string]*CacheCollectionHealth)[pkg.CollectionCurrency]
+	assert.False(suite.T(), state.Stale)
+	assert.False(suite.T(), state.LoadedAt.IsZero())
+}
+
+func (suite *HealthTestSuite) TestHealth_CheckCacheFreshness_NotLoaded() {
+	_, err := suite.service.CheckCacheFreshness()
+	assert.Error(suite.T(), err)
+}
+
+func (suite *HealthTestSuite) TestHealth_CheckCacheFreshness_Stale() {
+	suite.loadCache(nil)
+
+	// last refreshes of collection failed
+	suite.service.cacheHealth.loadedAt[pkg.CollectionCurrencyRate] = time.Now().Add(-3 * time.Hour)
+	err := suite.service.cache(pkg.CollectionCurrencyRate, &cacheRecsTest{
+		Cacher: newCurrencyRateHandler(suite.service),
+		err:    errors.New("unit test"),
+	})
+	assert.Error(suite.T(), err)
+
+	details, err := suite.service.CheckCacheFreshness()
+	assert.EqualError(suite.T(), err, fmt.Sprintf(healthErrorCacheStale, pkg.CollectionCurrencyRate))
+
+	state := details.(map[string]*CacheCollectionHealth)[pkg.CollectionCurrencyRate]
+	assert.True(suite.T(), state.Stale)
+	assert.Equal(suite.T(), "unit test", state.Error)
+
+	// successful refresh make collection fresh again
+	err = suite.service.cache(pkg.CollectionCurrencyRate, &cacheRecsTest{Cacher: newCurrencyRateHandler(suite.service)})
+	assert.NoError(suite.T(), err)
+
+	details, err = suite.service.CheckCacheFreshness()
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), details.(map[string]*CacheCollectionHealth)[pkg.CollectionCurrencyRate].Error)
+}
+
+func (suite *HealthTestSuite) TestHealth_CheckCacheRebuild() {
+	_, err := suite.service.CheckCacheRebuild()
+	assert.EqualError(suite.T(), err, healthErrorCacheRebuildStopped)
+
+	done := make(chan bool)
+
+	go func() {
+		suite.service.reBuildCache()
+		done <- true
+	}()
+
+	assert.Eventually(suite.T(), suite.service.cacheHealth.isRebuild, time.Second, 10*time.Millisecond)
+
+	_, err = suite.service.CheckCacheRebuild()
+	assert.NoError(suite.T(), err)
+
+	suite.exitCh <- true
+	<-done
+
+	_, err = suite.service.CheckCacheRebuild()
+	assert.EqualError(suite.T(), err, healthErrorCacheRebuildStopped)
+}
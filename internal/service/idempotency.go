@@ -0,0 +1,204 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/proto"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"time"
+)
+
+const (
+	idempotencyErrorKeyConflict      = "idempotency key already used for request with another data"
+	idempotencyErrorInProgress       = "request with same idempotency key is already in progress"
+	idempotencyErrorStorageFailed    = "idempotency key processing failed"
+	idempotencyErrorRequestIncorrect = "request with idempotency key can't be processed"
+
+	// key of request is unique only inside project: paysuper:idempotency:<action>:<project id>:<key>
+	idempotencyStorageMask = "paysuper:idempotency:%s:%s:%s"
+
+	idempotencyActionOrderCreate   = "order_create"
+	idempotencyActionPaymentCreate = "payment_create"
+	idempotencyActionRefundCreate  = "refund_create"
+
+	// lifetime of record for request which processing not finished yet,
+	// record will be released if process of request was broken
+	idempotencyInProgressLifeTime = time.Minute
+)
+
+var (
+	idempotencyErrKeyConflict      = errors.New(idempotencyErrorKeyConflict)
+	idempotencyErrInProgress       = errors.New(idempotencyErrorInProgress)
+	idempotencyErrStorageFailed    = errors.New(idempotencyErrorStorageFailed)
+	idempotencyErrRequestIncorrect = errors.New(idempotencyErrorRequestIncorrect)
+)
+
+type idempotencyRecord struct {
+	Hash     string `json:"hash"`
+	Response []byte `json:"response,omitempty"`
+}
+
+type idempotencyRepository struct {
+	service *Service
+	key     string
+	hash    string
+}
+
+// newIdempotencyRepository create repository to guard request of project by idempotency key.
+// If idempotency key is empty then all methods of repository do nothing
+func (s *Service) newIdempotencyRepository(
+	action, projectId, key string,
+	req proto.Message,
+) (*idempotencyRepository, error) {
+	r := &idempotencyRepository{service: s}
+
+	if key == "" {
+		return r, nil
+	}
+
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+
+	if err := buf.Marshal(req); err != nil {
+		s.logError("Marshal request with idempotency key failed", []interface{}{"error", err.Error(), "action", action})
+		return nil, idempotencyErrRequestIncorrect
+	}
+
+	hash := sha256.Sum256(buf.Bytes())
+
+	r.key = fmt.Sprintf(idempotencyStorageMask, action, projectId, key)
+	r.hash = hex.EncodeToString(hash[:])
+
+	return r, nil
+}
+
+// getOrderProjectId return identifier of project of order by uuid of order. Empty string returned
+// if order not found, request for this order will be rejected by processing
+func (s *Service) getOrderProjectId(uuid string) string {
+	order, err := s.getOrderByUuid(uuid)
+
+	if err != nil || order.Project == nil {
+		return ""
+	}
+
+	return order.Project.Id
+}
+
+// acquire lock idempotency key for current request. If request with same key and same data already processed
+// then response of this request will be written to rsp and method return true
+func (r *idempotencyRepository) acquire(rsp proto.Message) (bool, error) {
+	if r.key == "" {
+		return false, nil
+	}
+
+	b, err := json.Marshal(&idempotencyRecord{Hash: r.hash})
+
+	if err != nil {
+		return false, idempotencyErrStorageFailed
+	}
+
+	ok, err := r.service.redis.SetNX(r.key, b, idempotencyInProgressLifeTime).Result()
+
+	if err != nil {
+		r.service.logError("Lock idempotency key in Redis failed", []interface{}{"error", err.Error(), "key", r.key})
+		return false, idempotencyErrStorageFailed
+	}
+
+	if ok == true {
+		return false, nil
+	}
+
+	data, err := r.service.redis.Get(r.key).Bytes()
+
+	if err != nil {
+		if err == redis.Nil {
+			return false, idempotencyErrInProgress
+		}
+
+		r.service.logError("Get idempotency key from Redis failed", []interface{}{"error", err.Error(), "key", r.key})
+		return false, idempotencyErrStorageFailed
+	}
+
+	record := &idempotencyRecord{}
+	err = json.Unmarshal(data, record)
+
+	if err != nil {
+		r.service.logError("Unmarshal idempotency record failed", []interface{}{"error", err.Error(), "key", r.key})
+		return false, idempotencyErrStorageFailed
+	}
+
+	if record.Hash != r.hash {
+		return false, idempotencyErrKeyConflict
+	}
+
+	if len(record.Response) <= 0 {
+		return false, idempotencyErrInProgress
+	}
+
+	err = proto.Unmarshal(record.Response, rsp)
+
+	if err != nil {
+		r.service.logError("Unmarshal idempotency response failed", []interface{}{"error", err.Error(), "key", r.key})
+		return false, idempotencyErrStorageFailed
+	}
+
+	return true, nil
+}
+
+// finish save response of processed request to return it for repeated requests. Response with
+// system error or temporary status isn't saved to allow retry request with same idempotency key
+func (r *idempotencyRepository) finish(status int32, rsp proto.Message) {
+	if status == pkg.ResponseStatusSystemError || status == pkg.ResponseStatusTemporary {
+		r.release()
+		return
+	}
+
+	r.save(rsp)
+}
+
+func (r *idempotencyRepository) save(rsp proto.Message) {
+	if r.key == "" {
+		return
+	}
+
+	b, err := proto.Marshal(rsp)
+
+	if err == nil {
+		b, err = json.Marshal(&idempotencyRecord{Hash: r.hash, Response: b})
+	}
+
+	if err == nil {
+		err = r.service.redis.Set(r.key, b, r.service.cfg.GetIdempotencyKeyExpire()).Err()
+	}
+
+	if err != nil {
+		r.service.logError("Save idempotency key response failed", []interface{}{"error", err.Error(), "key", r.key})
+		r.release()
+	}
+}
+
+func (r *idempotencyRepository) release() {
+	if r.key == "" {
+		return
+	}
+
+	if err := r.service.redis.Del(r.key).Err(); err != nil {
+		r.service.logError("Release idempotency key failed", []interface{}{"error", err.Error(), "key", r.key})
+	}
+}
+
+func getIdempotencyErrorStatus(err error) int32 {
+	if err == idempotencyErrKeyConflict || err == idempotencyErrInProgress {
+		return pkg.ResponseStatusConflict
+	}
+
+	if err == idempotencyErrRequestIncorrect {
+		return pkg.ResponseStatusBadData
+	}
+
+	return pkg.ResponseStatusSystemError
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+)
+
+type collectionIndex struct {
+	collection string
+	index      mgo.Index
+}
+
+// indexes of collections which protect from duplicates created by concurrent requests
+// and by background workers of several service instances, and indexes of periodic queries
+var collectionIndexes = []*collectionIndex{
+	{
+		// only one not failed payout can be created for payout period of merchant.
+		// Failed status is greatest status of payout
+		collection: pkg.CollectionPayout,
+		index: mgo.Index{
+			Name:          "merchant_period_from_not_failed",
+			Key:           []string{"merchant_id", "period_from"},
+			Unique:        true,
+			PartialFilter: bson.M{"status": bson.M{"$lt": pkg.PayoutStatusFailed}},
+		},
+	},
+	{
+		// number of version of system fees is unique for method, region and card brand.
+		// Versions created before versioning was introduced haven't number
+		collection: pkg.CollectionSystemFees,
+		index: mgo.Index{
+			Name:          "method_region_card_brand_version",
+			Key:           []string{"method_id", "region", "card_brand", "version"},
+			Unique:        true,
+			PartialFilter: bson.M{"version": bson.M{"$gt": 0}},
+		},
+	},
+	{
+		// number of version of commission plan is unique for payment method of merchant
+		collection: pkg.CollectionCommissionPlan,
+		index: mgo.Index{
+			Name:   "merchant_payment_method_version",
+			Key:    []string{"merchant_id", "payment_method_id", "version"},
+			Unique: true,
+		},
+	},
+	{
+		// journal entry of business operation has one line for every account and side,
+		// so repeated posting of operation can't duplicate ledger entries
+		collection: pkg.CollectionLedgerEntry,
+		index: mgo.Index{
+			Name:   "source_account_side",
+			Key:    []string{"source_type", "source_id", "account", "side"},
+			Unique: true,
+		},
+	},
+	{
+		// only one not posted journal entry of business operation can wait for retry
+		collection: pkg.CollectionLedgerPosting,
+		index: mgo.Index{
+			Name:   "source",
+			Key:    []string{"source_type", "source_id"},
+			Unique: true,
+		},
+	},
+	{
+		// only one dispute can be created for chargeback of order
+		collection: pkg.CollectionDispute,
+		index: mgo.Index{
+			Name:   "order_external_id",
+			Key:    []string{"order_id", "external_id"},
+			Unique: true,
+		},
+	},
+	{
+		// rates of current batch are loaded to cache
+		collection: pkg.CollectionCurrencyRate,
+		index: mgo.Index{
+			Name: "batch_id",
+			Key:  []string{"batch_id"},
+		},
+	},
+	{
+		// last attempt to publish outbox message is requested by broker health check
+		collection: pkg.CollectionOutbox,
+		index: mgo.Index{
+			Name: "updated_at",
+			Key:  []string{"-updated_at"},
+		},
+	},
+}
+
+// ensureIndexes create indexes of collections if they don't exist
+func (s *Service) ensureIndexes() error {
+	for _, v := range collectionIndexes {
+		err := s.db.Collection(v.collection).EnsureIndex(v.index)
+
+		if err != nil {
+			s.logError(
+				"Ensure index of collection failed",
+				[]interface{}{"err", err.Error(), "collection", v.collection, "index", v.index.Name},
+			)
+
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"time"
+)
+
+const (
+	ledgerErrorUnbalanced              = "journal entry is unbalanced"
+	ledgerErrorLineCurrencyIncorrect   = "currency of journal entry line is incorrect"
+	ledgerErrorCurrencyNotFound        = "currency of journal entry not found"
+	ledgerErrorQueryFailed             = "ledger entries query failed"
+	ledgerErrorMerchantIdIncorrect     = "merchant identifier is incorrect"
+	ledgerErrorOrderIdIncorrect        = "order identifier is incorrect"
+	ledgerErrorMerchantCurrencyNotSet  = "merchant accounting currency not set"
+	ledgerErrorConvertToCurrencyFailed = "convert ledger entry amount to currency failed"
+)
+
+type ledgerLine struct {
+	account string
+	side    string
+	amount  money.Money
+}
+
+// postOrderLedgerEntries post journal entry for completed order. Payment system receivable is debited
+// by paid amount without payment system fee, fee is recorded as PSP cost. Tax liability, PSP revenue, PSP FX
+// revenue and merchant payable are credited by tax amount, PSP fee, margin from currency rate spread and rest
+// of paid amount accordingly
+func (s *Service) postOrderLedgerEntries(order *billing.Order) error {
+	currency := order.GetPaymentMethodIncomeCurrency().GetCodeA3()
+	gross := order.GetPaidMoney()
+	ratio := float64(1)
+
+	// order can be captured partially, so all amounts must be proportionally decreased
+	if order.TotalPaymentAmount > 0 {
+		ratio = order.PaymentMethodIncomeAmount / order.TotalPaymentAmount
+	}
+
+	tax, psFee, pspFee, fxMargin := money.Zero(currency), money.Zero(currency), money.Zero(currency), money.Zero(currency)
+
+	if order.Tax != nil {
+		tax = money.FromFloat(order.Tax.Amount, currency).Multiply(ratio)
+	}
+
+	if order.PaymentSystemFeeAmount != nil {
+		psFee = money.FromFloat(order.PaymentSystemFeeAmount.AmountPaymentMethodCurrency, currency).Multiply(ratio)
+	}
+
+	if order.PspFeeAmount != nil {
+		pspFee = money.FromFloat(order.PspFeeAmount.AmountPaymentMethodCurrency, currency).Multiply(ratio)
+	}
+
+	if order.FxMarginAmount != nil {
+		fxMargin = money.FromFloat(order.FxMarginAmount.AmountPaymentMethodCurrency, currency).Multiply(ratio)
+	}
+
+	receivable, _ := gross.Subtract(psFee)
+	deductions, _ := money.Sum(currency, tax, pspFee, fxMargin)
+	payable, _ := gross.Subtract(deductions)
+
+	lines := []*ledgerLine{
+		{account: pkg.LedgerAccountPaymentSystemReceivable, side: pkg.LedgerEntrySideDebit, amount: receivable},
+		{account: pkg.LedgerAccountPaymentSystemCost, side: pkg.LedgerEntrySideDebit, amount: psFee},
+		{account: pkg.LedgerAccountTaxLiability, side: pkg.LedgerEntrySideCredit, amount: tax},
+		{account: pkg.LedgerAccountPspRevenue, side: pkg.LedgerEntrySideCredit, amount: pspFee},
+		{account: pkg.LedgerAccountPspFxRevenue, side: pkg.LedgerEntrySideCredit, amount: fxMargin},
+		{account: pkg.LedgerAccountMerchantPayable, side: pkg.LedgerEntrySideCredit, amount: payable},
+	}
+
+	return s.postLedgerJournal(
+		pkg.LedgerSourceTypeOrder,
+		order.Id,
+		order.Project.MerchantId,
+		order.Id,
+		order.PaymentMethodIncomeCurrency,
+		order.GetRateDate(),
+		lines,
+	)
+}
+
+// postRefundLedgerEntries post journal entry for completed refund. Refunded amount decrease payment system
+// receivable, tax liability and merchant payable. Fees of PSP and payment system aren't returned on refund
+func (s *Service) postRefundLedgerEntries(refund *billing.Refund, order *billing.Order) error {
+	amount := refund.GetMoney()
+	tax := money.FromFloat(refund.TaxAmount, amount.Currency())
+
+	// refunds created before tax amount was calculated for refund
+	if !tax.IsPositive() {
+		tax = getRefundTaxAmount(order, amount)
+	}
+
+	payable, _ := amount.Subtract(tax)
+
+	lines := []*ledgerLine{
+		{account: pkg.LedgerAccountTaxLiability, side: pkg.LedgerEntrySideDebit, amount: tax},
+		{account: pkg.LedgerAccountMerchantPayable, side: pkg.LedgerEntrySideDebit, amount: payable},
+		{account: pkg.LedgerAccountPaymentSystemReceivable, side: pkg.LedgerEntrySideCredit, amount: amount},
+	}
+
+	return s.postLedgerJournal(
+		pkg.LedgerSourceTypeRefund,
+		refund.Id,
+		order.Project.MerchantId,
+		order.Id,
+		refund.Currency,
+		order.GetRateDate(),
+		lines,
+	)
+}
+
+// postLedgerJournal save balanced journal entry for business operation. Journal entry for every
+// business operation posted only once, so repeated posting of same operation do nothing. Amounts are
+// converted to accounting currencies with rates which was effective at rate date of operation
+func (s *Service) postLedgerJournal(
+	sourceType, sourceId, merchantId, orderId string,
+	currency *billing.Currency,
+	rateDate time.Time,
+	lines []*ledgerLine,
+) error {
+	if currency == nil {
+		return errors.New(ledgerErrorCurrencyNotFound)
+	}
+
+	debit, credit := money.Zero(currency.CodeA3), money.Zero(currency.CodeA3)
+
+	for _, l := range lines {
+		var err error
+
+		if l.side == pkg.LedgerEntrySideDebit {
+			debit, err = debit.Add(l.amount)
+		} else {
+			credit, err = credit.Add(l.amount)
+		}
+
+		if err != nil {
+			return errors.New(ledgerErrorLineCurrencyIncorrect)
+		}
+	}
+
+	if !debit.Equals(credit) {
+		return errors.New(ledgerErrorUnbalanced)
+	}
+
+	merchantCurrency := currency
+	merchant, err := s.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(merchantId)})
+
+	if err != nil {
+		return err
+	}
+
+	if merchant.GetPayoutCurrency() != nil {
+		merchantCurrency = merchant.GetPayoutCurrency()
+	}
+
+	journalId, err := s.getLedgerJournalId(sourceType, sourceId)
+
+	if err != nil {
+		return err
+	}
+
+	createdAt := ptypes.TimestampNow()
+
+	var entries []*billing.LedgerEntry
+
+	for _, l := range lines {
+		if l.amount.IsZero() {
+			continue
+		}
+
+		entry := &billing.LedgerEntry{
+			Id:               bson.NewObjectId().Hex(),
+			JournalId:        journalId,
+			SourceType:       sourceType,
+			SourceId:         sourceId,
+			MerchantId:       merchantId,
+			OrderId:          orderId,
+			Account:          l.account,
+			Side:             l.side,
+			Amount:           l.amount.Float64(),
+			Currency:         currency.CodeA3,
+			MerchantCurrency: merchantCurrency.CodeA3,
+			PspCurrency:      s.accountingCurrency.CodeA3,
+			CreatedAt:        createdAt,
+		}
+
+		entry.AmountMerchantCurrency, err = s.convertLedgerAmount(currency, merchantCurrency, l.amount.Float64(), rateDate)
+
+		if err != nil {
+			return err
+		}
+
+		entry.AmountPspCurrency, err = s.convertLedgerAmount(currency, s.accountingCurrency, l.amount.Float64(), rateDate)
+
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	// entries inserted one by one, so repeated posting of journal entry which was saved partially
+	// inserts only missed lines. Unique index of ledger entries prevents duplicated lines
+	for _, v := range entries {
+		err = s.db.Collection(pkg.CollectionLedgerEntry).Insert(v)
+
+		if err != nil && !mgo.IsDup(err) {
+			s.logError("Query to insert ledger entry failed", []interface{}{"err", err.Error(), "data", v})
+			return errors.New(ledgerErrorQueryFailed)
+		}
+	}
+
+	return nil
+}
+
+// getLedgerJournalId return identifier of journal entry of business operation which was already posted
+// or new identifier if journal entry of operation wasn't posted yet
+func (s *Service) getLedgerJournalId(sourceType, sourceId string) (string, error) {
+	query := bson.M{"source_type": sourceType, "source_id": bson.ObjectIdHex(sourceId)}
+	entry := &billing.LedgerEntry{}
+	err := s.db.Collection(pkg.CollectionLedgerEntry).Find(query).One(entry)
+
+	if err == mgo.ErrNotFound {
+		return bson.NewObjectId().Hex(), nil
+	}
+
+	if err != nil {
+		s.logError("Query to find ledger entry failed", []interface{}{"err", err.Error(), "query", query})
+		return "", errors.New(ledgerErrorQueryFailed)
+	}
+
+	return entry.JournalId, nil
+}
+
+func (s *Service) convertLedgerAmount(from, to *billing.Currency, amount float64, date time.Time) (float64, error) {
+	if from.CodeInt == to.CodeInt {
+		return amount, nil
+	}
+
+	amount, err := s.Convert(from.CodeInt, to.CodeInt, amount, date)
+
+	if err != nil {
+		s.logError(
+			ledgerErrorConvertToCurrencyFailed,
+			[]interface{}{"err", err.Error(), "from", from.CodeInt, "to", to.CodeInt},
+		)
+
+		return 0, errors.New(ledgerErrorConvertToCurrencyFailed)
+	}
+
+	return amount, nil
+}
+
+// GetMerchantBalance return amount which PSP owes to merchant in accounting currency of merchant
+func (s *Service) GetMerchantBalance(
+	ctx context.Context,
+	req *grpc.GetMerchantBalanceRequest,
+	rsp *grpc.GetMerchantBalanceResponse,
+) error {
+	if bson.IsObjectIdHex(req.MerchantId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = ledgerErrorMerchantIdIncorrect
+
+		return nil
+	}
+
+	merchant, err := s.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(req.MerchantId)})
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if merchant.GetPayoutCurrency() == nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = ledgerErrorMerchantCurrencyNotSet
+
+		return nil
+	}
+
+	var res []*struct {
+		Side   string  `bson:"_id"`
+		Amount float64 `bson:"amount"`
+	}
+
+	query := []bson.M{
+		{
+			"$match": bson.M{
+				"merchant_id":       bson.ObjectIdHex(merchant.Id),
+				"account":           pkg.LedgerAccountMerchantPayable,
+				"merchant_currency": merchant.GetPayoutCurrency().CodeA3,
+			},
+		},
+		{"$group": bson.M{"_id": "$side", "amount": bson.M{"$sum": "$amount_merchant_currency"}}},
+	}
+
+	err = s.db.Collection(pkg.CollectionLedgerEntry).Pipe(query).All(&res)
+
+	if err != nil {
+		s.logError("Query to calculate merchant balance failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = ledgerErrorQueryFailed
+
+		return nil
+	}
+
+	balance := &billing.MerchantBalance{
+		MerchantId: merchant.Id,
+		Currency:   merchant.GetPayoutCurrency().CodeA3,
+	}
+
+	debit, credit := money.Zero(balance.Currency), money.Zero(balance.Currency)
+
+	for _, v := range res {
+		if v.Side == pkg.LedgerEntrySideDebit {
+			debit = money.FromFloat(v.Amount, balance.Currency)
+		} else {
+			credit = money.FromFloat(v.Amount, balance.Currency)
+		}
+	}
+
+	total, _ := credit.Subtract(debit)
+
+	balance.Debit = debit.Float64()
+	balance.Credit = credit.Float64()
+	balance.Balance = total.Float64()
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = balance
+
+	return nil
+}
+
+func (s *Service) ListLedgerEntries(
+	ctx context.Context,
+	req *grpc.ListLedgerEntriesRequest,
+	rsp *grpc.ListLedgerEntriesResponse,
+) error {
+	query := make(bson.M)
+
+	if req.MerchantId != "" {
+		if bson.IsObjectIdHex(req.MerchantId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = ledgerErrorMerchantIdIncorrect
+
+			return nil
+		}
+
+		query["merchant_id"] = bson.ObjectIdHex(req.MerchantId)
+	}
+
+	if req.OrderId != "" {
+		if bson.IsObjectIdHex(req.OrderId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = ledgerErrorOrderIdIncorrect
+
+			return nil
+		}
+
+		query["order_id"] = bson.ObjectIdHex(req.OrderId)
+	}
+
+	if req.Account != "" {
+		query["account"] = req.Account
+	}
+
+	var entries []*billing.LedgerEntry
+	err := s.db.Collection(pkg.CollectionLedgerEntry).Find(query).Sort("_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&entries)
+
+	if err != nil {
+		s.logError("Query to find ledger entries failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = ledgerErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionLedgerEntry).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count ledger entries failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = ledgerErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = entries
+
+	return nil
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"time"
+)
+
+const (
+	ledgerPostingErrorSourceTypeUnknown = "source type of ledger posting is unknown"
+
+	ledgerPostingBatchSize = 100
+)
+
+// ledgerPosting is journal entry of business operation which must be posted to ledger. Posting saved before
+// journal entry and removed after journal entry was posted, so failed postings are retried by dispatcher
+// until ledger is consistent with completed business operations
+type ledgerPosting struct {
+	Id            bson.ObjectId `bson:"_id"`
+	SourceType    string        `bson:"source_type"`
+	SourceId      bson.ObjectId `bson:"source_id"`
+	Attempts      int32         `bson:"attempts"`
+	LastError     string        `bson:"last_error"`
+	NextAttemptAt time.Time     `bson:"next_attempt_at"`
+	CreatedAt     time.Time     `bson:"created_at"`
+	UpdatedAt     time.Time     `bson:"updated_at"`
+}
+
+// postLedgerEntries post journal entry of completed business operation to ledger. Posting created
+// as reserved by current process, so journal entry posted immediately and dispatcher will take it
+// only if posting failed
+func (s *Service) postLedgerEntries(sourceType, sourceId string) error {
+	now := time.Now()
+	posting := &ledgerPosting{
+		Id:            bson.NewObjectId(),
+		SourceType:    sourceType,
+		SourceId:      bson.ObjectIdHex(sourceId),
+		NextAttemptAt: now.Add(outboxClaimLifeTime),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err := s.db.Collection(pkg.CollectionLedgerPosting).Insert(posting)
+
+	if err != nil {
+		// posting of operation already waits for retry, dispatcher will post it
+		if mgo.IsDup(err) {
+			return nil
+		}
+
+		s.logError(
+			"Insert ledger posting failed",
+			[]interface{}{"err", err.Error(), "source_type", sourceType, "source_id", sourceId},
+		)
+
+		return errors.New(ledgerErrorQueryFailed)
+	}
+
+	return s.processLedgerPosting(posting)
+}
+
+// processLedgerPosting post journal entry to ledger and remove posting. If posting failed then
+// next attempt will be scheduled with exponential backoff
+func (s *Service) processLedgerPosting(posting *ledgerPosting) error {
+	pErr := s.postLedgerEntriesBySource(posting.SourceType, posting.SourceId.Hex())
+
+	if pErr == nil {
+		err := s.db.Collection(pkg.CollectionLedgerPosting).RemoveId(posting.Id)
+
+		if err != nil {
+			s.logError("Remove ledger posting failed", []interface{}{"err", err.Error(), "posting_id", posting.Id})
+		}
+
+		return nil
+	}
+
+	s.logError(
+		"Post ledger entries failed",
+		[]interface{}{
+			"err", pErr.Error(),
+			"source_type", posting.SourceType,
+			"source_id", posting.SourceId,
+			"attempts", posting.Attempts,
+		},
+	)
+
+	now := time.Now()
+	posting.Attempts++
+	posting.LastError = pErr.Error()
+	posting.NextAttemptAt = now.Add(getOutboxBackoff(posting.Attempts))
+	posting.UpdatedAt = now
+
+	err := s.db.Collection(pkg.CollectionLedgerPosting).UpdateId(posting.Id, posting)
+
+	if err != nil {
+		s.logError("Update ledger posting failed", []interface{}{"err", err.Error(), "posting_id", posting.Id})
+	}
+
+	return pErr
+}
+
+// postLedgerEntriesBySource load business operation with its order and post journal entry of operation
+func (s *Service) postLedgerEntriesBySource(sourceType, sourceId string) error {
+	switch sourceType {
+	case pkg.LedgerSourceTypeOrder:
+		order, err := s.getOrderById(sourceId)
+
+		if err != nil {
+			return err
+		}
+
+		return s.postOrderLedgerEntries(order)
+	case pkg.LedgerSourceTypeRefund:
+		refund, err := s.getRefundById(sourceId)
+
+		if err != nil {
+			return err
+		}
+
+		order, err := s.getOrderById(refund.Order.Id)
+
+		if err != nil {
+			return err
+		}
+
+		return s.postRefundLedgerEntries(refund, order)
+	case pkg.LedgerSourceTypeDispute:
+		dispute, err := s.getDisputeBy(bson.M{"_id": bson.ObjectIdHex(sourceId)})
+
+		if err != nil {
+			return err
+		}
+
+		order, err := s.getOrderById(dispute.OrderId)
+
+		if err != nil {
+			return err
+		}
+
+		return s.postDisputeLedgerEntries(dispute, order)
+	}
+
+	return errors.New(ledgerPostingErrorSourceTypeUnknown)
+}
+
+func (s *Service) dispatchLedger() {
+	ticker := time.NewTicker(s.cfg.GetOutboxDispatchInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.dispatchLedgerPostings()
+		case <-s.ledgerPostingExit:
+			return
+		}
+	}
+}
+
+// dispatchLedgerPostings retry failed postings which time of next attempt has come
+// and return count of posted journal entries
+func (s *Service) dispatchLedgerPostings() int {
+	posted := 0
+
+	for i := 0; i < ledgerPostingBatchSize; i++ {
+		posting, err := s.claimLedgerPosting()
+
+		if err != nil {
+			if err != mgo.ErrNotFound {
+				s.logError("Claim ledger posting failed", []interface{}{"err", err.Error()})
+			}
+
+			break
+		}
+
+		if s.processLedgerPosting(posting) == nil {
+			posted++
+		}
+	}
+
+	return posted
+}
+
+// claimLedgerPosting reserve one posting for current process, so several service instances
+// can dispatch postings simultaneously
+func (s *Service) claimLedgerPosting() (*ledgerPosting, error) {
+	now := time.Now()
+	query := bson.M{"next_attempt_at": bson.M{"$lte": now}}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"next_attempt_at": now.Add(outboxClaimLifeTime)}},
+		ReturnNew: true,
+	}
+
+	posting := &ledgerPosting{}
+	_, err := s.db.Collection(pkg.CollectionLedgerPosting).Find(query).Sort("next_attempt_at").Apply(change, posting)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return posting, nil
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type LedgerTestSuite struct {
+	suite.Suite
+	service  *Service
+	merchant *billing.Merchant
+	order    *billing.Order
+	rub      *billing.Currency
+	usd      *billing.Currency
+}
+
+func Test_Ledger(t *testing.T) {
+	suite.Run(t, new(LedgerTestSuite))
+}
+
+func (suite *LedgerTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+
+	settings := database.Connection{
+		Host:     cfg.MongoHost,
+		Database: cfg.MongoDatabase,
+		User:     cfg.MongoUser,
+		Password: cfg.MongoPassword,
+	}
+
+	db, err := database.NewDatabase(settings)
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.rub = &billing.Currency{CodeInt: 643, CodeA3: "RUB", Name: &billing.Name{Ru: "Российский рубль", En: "Russian ruble"}}
+	suite.usd = &billing.Currency{CodeInt: 840, CodeA3: "USD", Name: &billing.Name{Ru: "Доллар США", En: "USA dollar"}}
+
+	suite.merchant = &billing.Merchant{
+		Id:      bson.NewObjectId().Hex(),
+		Name:    "Unit test",
+		Banking: &billing.MerchantBanking{Currency: suite.usd},
+	}
+
+	err = db.Collection(pkg.CollectionMerchant).Insert(suite.merchant)
+	assert.NoError(suite.T(), err, "Insert merchant test data failed")
+
+	suite.order = &billing.Order{
+		Id:     bson.NewObjectId().Hex(),
+		Uuid:   bson.NewObjectId().Hex(),
+		Status: constant.OrderStatusPaymentSystemComplete,
+		Project: &billing.ProjectOrder{
+			Id:         bson.NewObjectId().Hex(),
+			MerchantId: suite.merchant.Id,
+		},
+		TotalPaymentAmount:          120,
+		PaymentMethodIncomeAmount:   120,
+		PaymentMethodIncomeCurrency: suite.rub,
+		Tax:                         &billing.OrderTax{Amount: 20, Currency: suite.rub.CodeA3},
+		PaymentSystemFeeAmount:      &billing.OrderFeePaymentSystem{AmountPaymentMethodCurrency: 3},
+		PspFeeAmount:                &billing.OrderFeePsp{AmountPaymentMethodCurrency: 6},
+	}
+
+	suite.service = NewBillingService(db, cfg, make(chan bool, 1), nil, nil, nil, mock.NewBrokerMockOk(), nil)
+	suite.service.accountingCurrency = suite.usd
+	suite.service.updateCache(func(c *cacheSnapshot) {
+		c.currencyRate = map[int32]map[int32]*billing.CurrencyRate{
+			suite.rub.CodeInt: {
+				suite.usd.CodeInt: {CurrencyFrom: suite.rub.CodeInt, CurrencyTo: suite.usd.CodeInt, Rate: 60},
+			},
+		}
+	})
+
+	err = suite.service.ensureIndexes()
+	assert.NoError(suite.T(), err, "Create indexes failed")
+}
+
+func (suite *LedgerTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *LedgerTestSuite) getLedgerEntries(query bson.M) []*billing.LedgerEntry {
+	var entries []*billing.LedgerEntry
+	err := suite.service.db.Collection(pkg.CollectionLedgerEntry).Find(query).All(&entries)
+	assert.NoError(suite.T(), err)
+
+	return entries
+}
+
+func (suite *LedgerTestSuite) assertJournalBalanced(entries []*billing.LedgerEntry) {
+	debit, credit := float64(0), float64(0)
+
+	for _, v := range entries {
+		assert.Equal(suite.T(), entries[0].JournalId, v.JournalId)
+
+		if v.Side == pkg.LedgerEntrySideDebit {
+			debit += v.Amount
+		} else {
+			credit += v.Amount
+		}
+	}
+
+	assert.Equal(suite.T(), tools.FormatAmount(debit), tools.FormatAmount(credit))
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostOrderLedgerEntries_Ok() {
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	entries := suite.getLedgerEntries(bson.M{"source_type": pkg.LedgerSourceTypeOrder})
+	assert.Len(suite.T(), entries, 5)
+	suite.assertJournalBalanced(entries)
+
+	amounts := make(map[string]*billing.LedgerEntry)
+
+	for _, v := range entries {
+		amounts[v.Account] = v
+		assert.Equal(suite.T(), suite.order.Id, v.OrderId)
+		assert.Equal(suite.T(), suite.merchant.Id, v.MerchantId)
+		assert.Equal(suite.T(), suite.rub.CodeA3, v.Currency)
+		assert.Equal(suite.T(), suite.usd.CodeA3, v.MerchantCurrency)
+		assert.Equal(suite.T(), suite.usd.CodeA3, v.PspCurrency)
+		assert.Equal(suite.T(), tools.FormatAmount(v.Amount/60), v.AmountMerchantCurrency)
+	}
+
+	assert.Equal(suite.T(), float64(117), amounts[pkg.LedgerAccountPaymentSystemReceivable].Amount)
+	assert.Equal(suite.T(), float64(3), amounts[pkg.LedgerAccountPaymentSystemCost].Amount)
+	assert.Equal(suite.T(), float64(20), amounts[pkg.LedgerAccountTaxLiability].Amount)
+	assert.Equal(suite.T(), float64(6), amounts[pkg.LedgerAccountPspRevenue].Amount)
+	assert.Equal(suite.T(), float64(94), amounts[pkg.LedgerAccountMerchantPayable].Amount)
+	assert.Equal(suite.T(), pkg.LedgerEntrySideCredit, amounts[pkg.LedgerAccountMerchantPayable].Side)
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostOrderLedgerEntries_PostedOnce_Ok() {
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	entries := suite.getLedgerEntries(bson.M{"source_id": bson.ObjectIdHex(suite.order.Id)})
+	assert.Len(suite.T(), entries, 5)
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostOrderLedgerEntries_PostedPartially_Ok() {
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	query := bson.M{"account": bson.M{"$in": []string{pkg.LedgerAccountTaxLiability, pkg.LedgerAccountPspRevenue}}}
+	_, err = suite.service.db.Collection(pkg.CollectionLedgerEntry).RemoveAll(query)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	entries := suite.getLedgerEntries(bson.M{"source_id": bson.ObjectIdHex(suite.order.Id)})
+	assert.Len(suite.T(), entries, 5)
+	suite.assertJournalBalanced(entries)
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostLedgerEntries_Ok() {
+	err := suite.service.db.Collection(pkg.CollectionOrder).Insert(suite.order)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.postLedgerEntries(pkg.LedgerSourceTypeOrder, suite.order.Id)
+	assert.NoError(suite.T(), err)
+
+	assert.Len(suite.T(), suite.getLedgerEntries(bson.M{"source_id": bson.ObjectIdHex(suite.order.Id)}), 5)
+
+	n, err := suite.service.db.Collection(pkg.CollectionLedgerPosting).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, n)
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostLedgerEntries_RetriedAfterFail_Ok() {
+	err := suite.service.db.Collection(pkg.CollectionOrder).Insert(suite.order)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.db.Collection(pkg.CollectionMerchant).RemoveId(bson.ObjectIdHex(suite.merchant.Id))
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.postLedgerEntries(pkg.LedgerSourceTypeOrder, suite.order.Id)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), suite.getLedgerEntries(bson.M{}))
+
+	posting := &ledgerPosting{}
+	err = suite.service.db.Collection(pkg.CollectionLedgerPosting).Find(bson.M{}).One(posting)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.LedgerSourceTypeOrder, posting.SourceType)
+	assert.Equal(suite.T(), suite.order.Id, posting.SourceId.Hex())
+	assert.Equal(suite.T(), int32(1), posting.Attempts)
+	assert.NotEmpty(suite.T(), posting.LastError)
+
+	// time of next attempt hasn't come yet
+	assert.Equal(suite.T(), 0, suite.service.dispatchLedgerPostings())
+
+	err = suite.service.db.Collection(pkg.CollectionMerchant).Insert(suite.merchant)
+	assert.NoError(suite.T(), err)
+
+	update := bson.M{"$set": bson.M{"next_attempt_at": time.Now().Add(-time.Minute)}}
+	err = suite.service.db.Collection(pkg.CollectionLedgerPosting).UpdateId(posting.Id, update)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), 1, suite.service.dispatchLedgerPostings())
+
+	entries := suite.getLedgerEntries(bson.M{"source_id": bson.ObjectIdHex(suite.order.Id)})
+	assert.Len(suite.T(), entries, 5)
+	suite.assertJournalBalanced(entries)
+
+	n, err := suite.service.db.Collection(pkg.CollectionLedgerPosting).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, n)
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostOrderLedgerEntries_PartialCapture_Ok() {
+	suite.order.PaymentMethodIncomeAmount = 60
+
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	entries := suite.getLedgerEntries(bson.M{"account": pkg.LedgerAccountMerchantPayable})
+	assert.Len(suite.T(), entries, 1)
+	assert.Equal(suite.T(), float64(47), entries[0].Amount)
+
+	suite.assertJournalBalanced(suite.getLedgerEntries(bson.M{}))
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostOrderLedgerEntries_CurrencyRateNotFound_Error() {
+	suite.service.updateCache(func(c *cacheSnapshot) {
+		c.currencyRate = map[int32]map[int32]*billing.CurrencyRate{}
+	})
+
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), ledgerErrorConvertToCurrencyFailed, err.Error())
+	assert.Empty(suite.T(), suite.getLedgerEntries(bson.M{}))
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostOrderLedgerEntries_MerchantNotFound_Error() {
+	suite.order.Project.MerchantId = bson.NewObjectId().Hex()
+
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), ErrMerchantNotFound, err)
+}
+
+func (suite *LedgerTestSuite) TestLedger_PostRefundLedgerEntries_Ok() {
+	refund := &billing.Refund{
+		Id:       bson.NewObjectId().Hex(),
+		Amount:   60,
+		Currency: suite.rub,
+		Status:   pkg.RefundStatusCompleted,
+	}
+
+	err := suite.service.postRefundLedgerEntries(refund, suite.order)
+	assert.NoError(suite.T(), err)
+
+	entries := suite.getLedgerEntries(bson.M{"source_type": pkg.LedgerSourceTypeRefund})
+	assert.Len(suite.T(), entries, 3)
+	suite.assertJournalBalanced(entries)
+
+	for _, v := range entries {
+		switch v.Account {
+		case pkg.LedgerAccountTaxLiability:
+			assert.Equal(suite.T(), pkg.LedgerEntrySideDebit, v.Side)
+			assert.Equal(suite.T(), float64(10), v.Amount)
+		case pkg.LedgerAccountMerchantPayable:
+			assert.Equal(suite.T(), pkg.LedgerEntrySideDebit, v.Side)
+			assert.Equal(suite.T(), float64(50), v.Amount)
+		case pkg.LedgerAccountPaymentSystemReceivable:
+			assert.Equal(suite.T(), pkg.LedgerEntrySideCredit, v.Side)
+			assert.Equal(suite.T(), float64(60), v.Amount)
+		default:
+			assert.Fail(suite.T(), "unexpected ledger account", v.Account)
+		}
+	}
+}
+
+func (suite *LedgerTestSuite) TestLedger_GetMerchantBalance_Ok() {
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	refund := &billing.Refund{Id: bson.NewObjectId().Hex(), Amount: 60, Currency: suite.rub}
+	err = suite.service.postRefundLedgerEntries(refund, suite.order)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.GetMerchantBalanceRequest{MerchantId: suite.merchant.Id}
+	rsp := &grpc.GetMerchantBalanceResponse{}
+	err = suite.service.GetMerchantBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), suite.usd.CodeA3, rsp.Item.Currency)
+	assert.Equal(suite.T(), tools.FormatAmount(94.0/60), rsp.Item.Credit)
+	assert.Equal(suite.T(), tools.FormatAmount(50.0/60), rsp.Item.Debit)
+	assert.Equal(suite.T(), tools.FormatAmount(rsp.Item.Credit-rsp.Item.Debit), rsp.Item.Balance)
+}
+
+func (suite *LedgerTestSuite) TestLedger_GetMerchantBalance_MerchantNotFound_Error() {
+	req := &grpc.GetMerchantBalanceRequest{MerchantId: bson.NewObjectId().Hex()}
+	rsp := &grpc.GetMerchantBalanceResponse{}
+	err := suite.service.GetMerchantBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), merchantErrorNotFound, rsp.Message)
+}
+
+func (suite *LedgerTestSuite) TestLedger_GetMerchantBalance_IncorrectMerchantId_Error() {
+	req := &grpc.GetMerchantBalanceRequest{MerchantId: "incorrect_id"}
+	rsp := &grpc.GetMerchantBalanceResponse{}
+	err := suite.service.GetMerchantBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), ledgerErrorMerchantIdIncorrect, rsp.Message)
+}
+
+func (suite *LedgerTestSuite) TestLedger_ListLedgerEntries_Ok() {
+	err := suite.service.postOrderLedgerEntries(suite.order)
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.ListLedgerEntriesRequest{MerchantId: suite.merchant.Id, OrderId: suite.order.Id, Limit: 2}
+	rsp := &grpc.ListLedgerEntriesResponse{}
+	err = suite.service.ListLedgerEntries(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(5), rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 2)
+
+	req.Account = pkg.LedgerAccountPspRevenue
+	req.Limit = 10
+	rsp1 := &grpc.ListLedgerEntriesResponse{}
+	err = suite.service.ListLedgerEntries(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(1), rsp1.Count)
+	assert.Equal(suite.T(), float64(6), rsp1.Items[0].Amount)
+}
+
+func (suite *LedgerTestSuite) TestLedger_ListLedgerEntries_IncorrectOrderId_Error() {
+	req := &grpc.ListLedgerEntriesRequest{OrderId: "incorrect_id"}
+	rsp := &grpc.ListLedgerEntriesResponse{}
+	err := suite.service.ListLedgerEntries(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), ledgerErrorOrderIdIncorrect, rsp.Message)
+}
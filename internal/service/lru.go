@@ -0,0 +1,212 @@
+package service
+
+import (
+	"container/list"
+	"github.com/prometheus/client_golang/prometheus"
+	"sync"
+	"time"
+)
+
+var (
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "billing_cache_hits_total",
+			Help: "Count of requests of entities found in cache",
+		},
+		[]string{"collection"},
+	)
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "billing_cache_misses_total",
+			Help: "Count of requests of entities which were loaded from database",
+		},
+		[]string{"collection"},
+	)
+	cacheLoadDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "billing_cache_load_duration_seconds",
+			Help:    "Time of loading of entity or whole collection to cache",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"collection"},
+	)
+	cacheEntries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "billing_cache_entries",
+			Help: "Count of entities in cache of collection",
+		},
+		[]string{"collection"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(cacheHits, cacheMisses, cacheLoadDuration, cacheEntries)
+}
+
+// EntityLoader load single entity of collection from database. Nil entity returned if entity not found
+type EntityLoader interface {
+	getOne(id string) (interface{}, error)
+}
+
+type lruEntry struct {
+	key   string
+	value interface{}
+}
+
+// lruCache is thread safe cache of limited size, least recently used entries are evicted when cache is full.
+// Cache without size limit is used if size isn't positive
+type lruCache struct {
+	mx         sync.Mutex
+	size       int
+	items      map[string]*list.Element
+	order      *list.List
+	generation uint64
+}
+
+func newLruCache(size int) *lruCache {
+	return &lruCache{size: size, items: make(map[string]*list.Element), order: list.New()}
+}
+
+func (c *lruCache) get(key string) (interface{}, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	el, ok := c.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+
+	return el.Value.(*lruEntry).value, true
+}
+
+func (c *lruCache) add(key string, value interface{}) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.addLocked(key, value)
+}
+
+// addIfGeneration add entry only if cache wasn't invalidated since generation was taken, so entity loaded
+// before its change doesn't replace invalidated entry
+func (c *lruCache) addIfGeneration(key string, value interface{}, generation uint64) bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.generation != generation {
+		return false
+	}
+
+	c.addLocked(key, value)
+
+	return true
+}
+
+func (c *lruCache) addLocked(key string, value interface{}) {
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry).value = value
+		c.order.MoveToFront(el)
+
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&lruEntry{key: key, value: value})
+
+	if c.size > 0 && c.order.Len() > c.size {
+		el := c.order.Back()
+		c.order.Remove(el)
+		delete(c.items, el.Value.(*lruEntry).key)
+	}
+}
+
+func (c *lruCache) remove(key string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.generation++
+
+	if el, ok := c.items[key]; ok {
+		c.order.Remove(el)
+		delete(c.items, key)
+	}
+}
+
+func (c *lruCache) purge() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.generation++
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+func (c *lruCache) getGeneration() uint64 {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.generation
+}
+
+func (c *lruCache) len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.order.Len()
+}
+
+// lazyCache is cache of collection which entities are loaded from database on first request
+// and kept in LRU cache of limited size
+type lazyCache struct {
+	collection string
+	entries    *lruCache
+	loader     EntityLoader
+}
+
+func newLazyCache(collection string, size int, loader EntityLoader) *lazyCache {
+	return &lazyCache{collection: collection, entries: newLruCache(size), loader: loader}
+}
+
+// get return entity from cache or load it from database on cache miss. Nil entity returned if entity not found
+func (c *lazyCache) get(id string) (interface{}, error) {
+	if v, ok := c.entries.get(id); ok {
+		cacheHits.WithLabelValues(c.collection).Inc()
+		return v, nil
+	}
+
+	cacheMisses.WithLabelValues(c.collection).Inc()
+
+	generation := c.entries.getGeneration()
+	start := time.Now()
+	v, err := c.loader.getOne(id)
+	cacheLoadDuration.WithLabelValues(c.collection).Observe(time.Since(start).Seconds())
+
+	if err != nil || v == nil {
+		return nil, err
+	}
+
+	if c.entries.addIfGeneration(id, v, generation) {
+		cacheEntries.WithLabelValues(c.collection).Set(float64(c.entries.len()))
+	}
+
+	return v, nil
+}
+
+// set add or replace entity in cache
+func (c *lazyCache) set(id string, v interface{}) {
+	c.entries.add(id, v)
+	cacheEntries.WithLabelValues(c.collection).Set(float64(c.entries.len()))
+}
+
+// invalidate remove entity from cache, entity will be loaded from database on next request.
+// All entities are removed if id is empty
+func (c *lazyCache) invalidate(id string) {
+	if id == "" {
+		c.entries.purge()
+	} else {
+		c.entries.remove(id)
+	}
+
+	cacheEntries.WithLabelValues(c.collection).Set(float64(c.entries.len()))
+}
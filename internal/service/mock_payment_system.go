@@ -8,6 +8,28 @@ import (
 	"gopkg.in/mgo.v2/bson"
 )
 
+// mock adapters don't verify signature of callbacks, so they registered in tests only
+var (
+	paymentSystemMockOkAdapter = &paymentSystemAdapter{
+		handler:            paymentSystemHandlerMockOk,
+		new:                NewPaymentSystemMockOk,
+		paymentCallback:    func() proto.Message { return &billing.CardPayPaymentCallback{} },
+		refundCallback:     func() proto.Message { return &billing.CardPayRefundCallback{} },
+		chargebackCallback: func() proto.Message { return &billing.CardPayChargebackCallback{} },
+		refundId:           cardPayRefundId,
+		chargebackId:       cardPayChargebackId,
+	}
+	paymentSystemMockErrorAdapter = &paymentSystemAdapter{
+		handler:            paymentSystemHandlerMockError,
+		new:                NewPaymentSystemMockError,
+		paymentCallback:    func() proto.Message { return &billing.CardPayPaymentCallback{} },
+		refundCallback:     func() proto.Message { return &billing.CardPayRefundCallback{} },
+		chargebackCallback: func() proto.Message { return &billing.CardPayChargebackCallback{} },
+		refundId:           cardPayRefundId,
+		chargebackId:       cardPayChargebackId,
+	}
+)
+
 type PaymentSystemMockOk struct {
 	processor *paymentProcessor
 }
@@ -58,6 +80,45 @@ func (m *PaymentSystemMockOk) ProcessRefund(
 	return
 }
 
+func (m *PaymentSystemMockOk) Capture(order *billing.Order, amount float64) error {
+	if err := changeOrderStatus(order, pkg.OrderStatusPaymentSystemCaptured, pkg.OrderStatusChangeSourceApi, ""); err != nil {
+		return err
+	}
+
+	order.CapturedAmount = amount
+
+	return nil
+}
+
+func (m *PaymentSystemMockOk) Void(order *billing.Order) error {
+	return changeOrderStatus(order, pkg.OrderStatusPaymentSystemVoided, pkg.OrderStatusChangeSourceApi, "")
+}
+
+func (m *PaymentSystemMockOk) ProcessChargeback(
+	dispute *billing.Dispute,
+	message proto.Message,
+	raw,
+	signature string,
+) error {
+	req := message.(*billing.CardPayChargebackCallback)
+
+	switch req.ChargebackData.Status {
+	case pkg.CardPayChargebackStatusWon:
+		dispute.Status = pkg.DisputeStatusWon
+	case pkg.CardPayChargebackStatusLost:
+		dispute.Status = pkg.DisputeStatusLost
+	default:
+		dispute.Status = pkg.DisputeStatusOpened
+	}
+
+	dispute.ExternalId = req.ChargebackData.Id
+	dispute.Amount = req.ChargebackData.Amount
+	dispute.ReasonCode = req.ChargebackData.ReasonCode
+	dispute.Reason = req.ChargebackData.Reason
+
+	return nil
+}
+
 func (m *PaymentSystemMockError) CreatePayment(map[string]string) (string, error) {
 	return "", nil
 }
@@ -87,3 +148,20 @@ func (m *PaymentSystemMockError) ProcessRefund(
 ) (err error) {
 	return NewError(paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid, pkg.ResponseStatusBadData)
 }
+
+func (m *PaymentSystemMockError) Capture(order *billing.Order, amount float64) error {
+	return errors.New(paymentSystemErrorCaptureRejected)
+}
+
+func (m *PaymentSystemMockError) Void(order *billing.Order) error {
+	return errors.New(paymentSystemErrorVoidRejected)
+}
+
+func (m *PaymentSystemMockError) ProcessChargeback(
+	dispute *billing.Dispute,
+	message proto.Message,
+	raw,
+	signature string,
+) error {
+	return NewError(paymentSystemErrorChargebackAmountOrCurrencyIsInvalid, pkg.ResponseStatusBadData)
+}
@@ -202,65 +202,78 @@ func (s *Service) ChangeMerchant(
 		}
 	}
 
-	if merchant.ChangesAllowed() == false {
-		return errors.New(merchantErrorChangeNotAllowed)
-	}
+	apply := func(merchant *billing.Merchant) error {
+		if merchant.ChangesAllowed() == false {
+			return errors.New(merchantErrorChangeNotAllowed)
+		}
 
-	if req.Country != "" {
-		country, err := s.GetCountryByCodeA2(req.Country)
+		if req.Country != "" {
+			country, err := s.GetCountryByCodeA2(req.Country)
 
-		if err != nil {
-			s.logError("Get country for merchant failed", []interface{}{"err", err.Error(), "request", req})
-			return errors.New(merchantErrorCountryNotFound)
+			if err != nil {
+				s.logError("Get country for merchant failed", []interface{}{"err", err.Error(), "request", req})
+				return errors.New(merchantErrorCountryNotFound)
+			}
+
+			merchant.Country = country
 		}
 
-		merchant.Country = country
-	}
+		merchant.Banking = &billing.MerchantBanking{}
 
-	merchant.Banking = &billing.MerchantBanking{}
+		if req.Banking != nil && req.Banking.Currency != "" {
+			currency, err := s.GetCurrencyByCodeA3(req.Banking.Currency)
 
-	if req.Banking != nil && req.Banking.Currency != "" {
-		currency, err := s.GetCurrencyByCodeA3(req.Banking.Currency)
+			if err != nil {
+				s.logError("Get currency for merchant failed", []interface{}{"err", err.Error(), "request", req})
+				return errors.New(merchantErrorCurrencyNotFound)
+			}
 
-		if err != nil {
-			s.logError("Get currency for merchant failed", []interface{}{"err", err.Error(), "request", req})
-			return errors.New(merchantErrorCurrencyNotFound)
-		}
-
-		merchant.Banking.Currency = currency
-	}
-
-	merchant.Name = req.Name
-	merchant.AlternativeName = req.AlternativeName
-	merchant.Website = req.Website
-	merchant.State = req.State
-	merchant.Zip = req.Zip
-	merchant.City = req.City
-	merchant.Address = req.Address
-	merchant.AddressAdditional = req.AddressAdditional
-	merchant.RegistrationNumber = req.RegistrationNumber
-	merchant.TaxId = req.TaxId
-	merchant.Contacts = req.Contacts
-	merchant.Banking.Name = req.Banking.Name
-	merchant.Banking.Address = req.Banking.Address
-	merchant.Banking.AccountNumber = req.Banking.AccountNumber
-	merchant.Banking.Swift = req.Banking.Swift
-	merchant.Banking.Details = req.Banking.Details
-	merchant.UpdatedAt = ptypes.TimestampNow()
+			merchant.Banking.Currency = currency
+		}
+
+		merchant.Name = req.Name
+		merchant.AlternativeName = req.AlternativeName
+		merchant.Website = req.Website
+		merchant.State = req.State
+		merchant.Zip = req.Zip
+		merchant.City = req.City
+		merchant.Address = req.Address
+		merchant.AddressAdditional = req.AddressAdditional
+		merchant.RegistrationNumber = req.RegistrationNumber
+		merchant.TaxId = req.TaxId
+		merchant.Contacts = req.Contacts
+		merchant.Banking.Name = req.Banking.Name
+		merchant.Banking.Address = req.Banking.Address
+		merchant.Banking.AccountNumber = req.Banking.AccountNumber
+		merchant.Banking.Swift = req.Banking.Swift
+		merchant.Banking.Details = req.Banking.Details
+		merchant.UpdatedAt = ptypes.TimestampNow()
+
+		return nil
+	}
 
 	if isNew {
+		if err = apply(merchant); err != nil {
+			return err
+		}
+
 		err = s.db.Collection(pkg.CollectionMerchant).Insert(merchant)
+
+		if err != nil {
+			s.logError("Query to change merchant data failed", []interface{}{"err", err.Error(), "data", merchant})
+			return errors.New(merchantErrorUnknown)
+		}
 	} else {
-		err = s.db.Collection(pkg.CollectionMerchant).UpdateId(bson.ObjectIdHex(merchant.Id), merchant)
-	}
+		// changes applied again if merchant was changed by another request
+		merchant, err = s.saveMerchantChanges(merchant, apply)
 
-	if err != nil {
-		s.logError("Query to change merchant data failed", []interface{}{"err", err.Error(), "data", merchant})
-		return errors.New(merchantErrorUnknown)
+		if err != nil {
+			return err
+		}
 	}
 
 	s.mapMerchantData(rsp, merchant)
-	s.merchantCache[merchant.Id] = merchant
+	s.setCachedMerchant(merchant)
 
 	return
 }
@@ -276,35 +289,45 @@ func (s *Service) ChangeMerchantStatus(
 		return err
 	}
 
-	if req.Status == pkg.MerchantStatusAgreementRequested && merchant.Status != pkg.MerchantStatusDraft {
-		return errors.New(merchantErrorAgreementRequested)
-	}
+	var nStatuses *billing.SystemNotificationStatuses
 
-	if req.Status == pkg.MerchantStatusOnReview && merchant.Status != pkg.MerchantStatusAgreementRequested {
-		return errors.New(merchantErrorOnReview)
-	}
+	merchant, err = s.saveMerchantChanges(merchant, func(merchant *billing.Merchant) error {
+		if req.Status == pkg.MerchantStatusAgreementRequested && merchant.Status != pkg.MerchantStatusDraft {
+			return errors.New(merchantErrorAgreementRequested)
+		}
 
-	if req.Status == pkg.MerchantStatusAgreementSigning && merchant.CanChangeStatusToSigning() == false {
-		return errors.New(merchantErrorSigning)
-	}
+		if req.Status == pkg.MerchantStatusOnReview && merchant.Status != pkg.MerchantStatusAgreementRequested {
+			return errors.New(merchantErrorOnReview)
+		}
 
-	if req.Status == pkg.MerchantStatusAgreementSigned && (merchant.Status != pkg.MerchantStatusAgreementSigning ||
-		merchant.HasMerchantSignature != true || merchant.HasPspSignature != true) {
-		return errors.New(merchantErrorSigned)
-	}
+		if req.Status == pkg.MerchantStatusAgreementSigning && merchant.CanChangeStatusToSigning() == false {
+			return errors.New(merchantErrorSigning)
+		}
 
-	nStatuses := &billing.SystemNotificationStatuses{From: merchant.Status, To: req.Status}
-	merchant.Status = req.Status
+		if req.Status == pkg.MerchantStatusAgreementSigned && (merchant.Status != pkg.MerchantStatusAgreementSigning ||
+			merchant.HasMerchantSignature != true || merchant.HasPspSignature != true) {
+			return errors.New(merchantErrorSigned)
+		}
 
-	if req.Status == pkg.MerchantStatusAgreementSigned {
-		merchant.IsSigned = true
-	}
+		nStatuses = &billing.SystemNotificationStatuses{From: merchant.Status, To: req.Status}
+		merchant.Status = req.Status
 
-	if req.Status == pkg.MerchantStatusDraft {
-		merchant.AgreementType = 0
-		merchant.HasPspSignature = false
-		merchant.HasMerchantSignature = false
-		merchant.IsSigned = false
+		if req.Status == pkg.MerchantStatusAgreementSigned {
+			merchant.IsSigned = true
+		}
+
+		if req.Status == pkg.MerchantStatusDraft {
+			merchant.AgreementType = 0
+			merchant.HasPspSignature = false
+			merchant.HasMerchantSignature = false
+			merchant.IsSigned = false
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
 	}
 
 	if title, ok := NotificationStatusChangeTitles[req.Status]; ok {
@@ -315,15 +338,8 @@ func (s *Service) ChangeMerchantStatus(
 		}
 	}
 
-	err = s.db.Collection(pkg.CollectionMerchant).UpdateId(bson.ObjectIdHex(merchant.Id), merchant)
-
-	if err != nil {
-		s.logError("Query to change merchant data failed", []interface{}{"err", err.Error(), "data", rsp})
-		return errors.New(merchantErrorUnknown)
-	}
-
 	s.mapMerchantData(rsp, merchant)
-	s.merchantCache[merchant.Id] = merchant
+	s.setCachedMerchant(merchant)
 
 	return nil
 }
@@ -342,45 +358,59 @@ func (s *Service) ChangeMerchantData(
 		return nil
 	}
 
-	if req.AgreementType > 0 && merchant.AgreementType != req.AgreementType {
-		if merchant.ChangesAllowed() == false {
-			rsp.Status = pkg.ResponseStatusBadData
-			rsp.Message = merchantErrorAgreementTypeSelectNotAllow
+	var nStatuses *billing.SystemNotificationStatuses
+	var nTitle string
 
-			return nil
+	merchant, err = s.saveMerchantChanges(merchant, func(merchant *billing.Merchant) error {
+		nStatuses = nil
+
+		if req.AgreementType > 0 && merchant.AgreementType != req.AgreementType {
+			if merchant.ChangesAllowed() == false {
+				return errors.New(merchantErrorAgreementTypeSelectNotAllow)
+			}
+
+			nStatuses = &billing.SystemNotificationStatuses{From: merchant.Status, To: pkg.MerchantStatusAgreementRequested}
+			nTitle = NotificationStatusChangeTitles[merchant.Status]
+
+			merchant.Status = pkg.MerchantStatusAgreementRequested
+			merchant.AgreementType = req.AgreementType
 		}
 
-		nStatuses := &billing.SystemNotificationStatuses{From: merchant.Status, To: pkg.MerchantStatusAgreementRequested}
-		_, err = s.addNotification(NotificationStatusChangeTitles[merchant.Status], "", merchant.Id, "", nStatuses)
+		merchant.HasPspSignature = req.HasPspSignature
+		merchant.HasMerchantSignature = req.HasMerchantSignature
+		merchant.AgreementSentViaMail = req.AgreementSentViaMail
+		merchant.MailTrackingLink = req.MailTrackingLink
+		merchant.IsSigned = merchant.HasPspSignature == true && merchant.HasMerchantSignature == true
 
-		if err != nil {
-			s.logError("Add notification failed", []interface{}{"err", err.Error(), "data", merchant})
+		if merchant.NeedMarkESignAgreementAsSigned() == true {
+			merchant.Status = pkg.MerchantStatusAgreementSigned
 		}
 
-		merchant.Status = pkg.MerchantStatusAgreementRequested
-		merchant.AgreementType = req.AgreementType
-	}
+		return nil
+	})
+
+	if err != nil {
+		if err.Error() == merchantErrorAgreementTypeSelectNotAllow {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = err.Error()
 
-	merchant.HasPspSignature = req.HasPspSignature
-	merchant.HasMerchantSignature = req.HasMerchantSignature
-	merchant.AgreementSentViaMail = req.AgreementSentViaMail
-	merchant.MailTrackingLink = req.MailTrackingLink
-	merchant.IsSigned = merchant.HasPspSignature == true && merchant.HasMerchantSignature == true
+			return nil
+		}
 
-	if merchant.NeedMarkESignAgreementAsSigned() == true {
-		merchant.Status = pkg.MerchantStatusAgreementSigned
+		return err
 	}
 
-	err = s.db.Collection(pkg.CollectionMerchant).UpdateId(bson.ObjectIdHex(merchant.Id), merchant)
+	if nStatuses != nil {
+		_, err = s.addNotification(nTitle, "", merchant.Id, "", nStatuses)
 
-	if err != nil {
-		s.logError("Query to change merchant data failed", []interface{}{"err", err.Error(), "data", merchant})
-		return errors.New(merchantErrorUnknown)
+		if err != nil {
+			s.logError("Add notification failed", []interface{}{"err", err.Error(), "data", merchant})
+		}
 	}
 
 	rsp.Status = pkg.ResponseStatusOk
 	rsp.Item = merchant
-	s.merchantCache[merchant.Id] = merchant
+	s.setCachedMerchant(merchant)
 
 	return nil
 }
@@ -399,18 +429,18 @@ func (s *Service) SetMerchantS3Agreement(
 		return nil
 	}
 
-	merchant.S3AgreementName = req.S3AgreementName
-
-	err = s.db.Collection(pkg.CollectionMerchant).UpdateId(bson.ObjectIdHex(merchant.Id), merchant)
+	merchant, err = s.saveMerchantChanges(merchant, func(merchant *billing.Merchant) error {
+		merchant.S3AgreementName = req.S3AgreementName
+		return nil
+	})
 
 	if err != nil {
-		s.logError("Query to change merchant data failed", []interface{}{"err", err.Error(), "data", merchant})
-		return errors.New(merchantErrorUnknown)
+		return err
 	}
 
 	rsp.Status = pkg.ResponseStatusOk
 	rsp.Item = merchant
-	s.merchantCache[merchant.Id] = merchant
+	s.setCachedMerchant(merchant)
 
 	return nil
 }
@@ -561,7 +591,7 @@ func (s *Service) GetMerchantPaymentMethod(
 	}
 
 	rsp.Status = pkg.ResponseStatusOk
-	pms, ok := s.merchantPaymentMethods[req.MerchantId]
+	pms, ok := s.getMerchantPaymentMethods(req.MerchantId)
 
 	if ok {
 		pm, ok := pms[req.PaymentMethodId]
@@ -633,7 +663,7 @@ func (s *Service) ListMerchantPaymentMethods(
 		return nil
 	}
 
-	mPms, ok := s.merchantPaymentMethods[req.MerchantId]
+	mPms, ok := s.getMerchantPaymentMethods(req.MerchantId)
 
 	for _, pm := range pms {
 		mPm, ok1 := mPms[pm.Id]
@@ -674,7 +704,7 @@ func (s *Service) ChangeMerchantPaymentMethod(
 		return
 	}
 
-	pm, ok := s.paymentMethodIdCache[req.PaymentMethod.Id]
+	pm, ok := s.getCache().paymentMethodId[req.PaymentMethod.Id]
 
 	if !ok {
 		rsp.Status = pkg.ResponseStatusBadData
@@ -686,7 +716,7 @@ func (s *Service) ChangeMerchantPaymentMethod(
 	req.Integration.Integrated = req.HasIntegration()
 
 	if req.HasPerTransactionCurrency() {
-		if _, ok := s.currencyCache[req.GetPerTransactionCurrency()]; !ok {
+		if _, ok := s.getCache().currency[req.GetPerTransactionCurrency()]; !ok {
 			rsp.Status = pkg.ResponseStatusBadData
 			rsp.Message = orderErrorCurrencyNotFound
 
@@ -694,10 +724,6 @@ func (s *Service) ChangeMerchantPaymentMethod(
 		}
 	}
 
-	if len(merchant.PaymentMethods) <= 0 {
-		merchant.PaymentMethods = make(map[string]*billing.MerchantPaymentMethod)
-	}
-
 	mpm := &billing.MerchantPaymentMethod{
 		PaymentMethod: req.PaymentMethod,
 		Commission:    req.Commission,
@@ -705,8 +731,6 @@ func (s *Service) ChangeMerchantPaymentMethod(
 		IsActive:      req.IsActive,
 	}
 
-	merchant.PaymentMethods[pm.Id] = mpm
-
 	// insert in history collection first than really update merchant
 	history := &billing.MerchantPaymentMethodHistory{
 		Id:            bson.NewObjectId().Hex(),
@@ -725,26 +749,30 @@ func (s *Service) ChangeMerchantPaymentMethod(
 		return
 	}
 
-	err = s.db.Collection(pkg.CollectionMerchant).UpdateId(bson.ObjectIdHex(merchant.Id), merchant)
+	// only changed payment method applied to actual merchant if merchant was changed by another request
+	merchant, err = s.saveMerchantChanges(merchant, func(merchant *billing.Merchant) error {
+		if len(merchant.PaymentMethods) <= 0 {
+			merchant.PaymentMethods = make(map[string]*billing.MerchantPaymentMethod)
+		}
 
-	if err != nil {
-		s.logError("Query to update merchant payment methods failed", []interface{}{"error", err.Error(), "query", merchant})
+		merchant.PaymentMethods[pm.Id] = mpm
+
+		return nil
+	})
 
+	if err != nil {
 		rsp.Status = pkg.ResponseStatusBadData
 		rsp.Message = orderErrorUnknown
 
-		return
-	}
-
-	s.mx.Lock()
-	defer s.mx.Unlock()
+		if err == errVersionConflict {
+			rsp.Status = pkg.ResponseStatusConflict
+			rsp.Message = err.Error()
+		}
 
-	if _, ok := s.merchantPaymentMethods[merchant.Id]; !ok {
-		s.merchantPaymentMethods[merchant.Id] = make(map[string]*billing.MerchantPaymentMethod)
+		return
 	}
 
-	s.merchantCache[merchant.Id] = merchant
-	s.merchantPaymentMethods[merchant.Id][pm.Id] = merchant.PaymentMethods[pm.Id]
+	s.setCachedMerchant(merchant)
 
 	rsp.Status = pkg.ResponseStatusOk
 	rsp.Item = merchant.PaymentMethods[pm.Id]
@@ -768,6 +796,55 @@ func (s *Service) getMerchantBy(query bson.M) (merchant *billing.Merchant, err e
 	return
 }
 
+// updateMerchant save merchant if it wasn't changed by another request after it was read,
+// otherwise errVersionConflict returned
+func (s *Service) updateMerchant(merchant *billing.Merchant) error {
+	err := s.updateVersioned(pkg.CollectionMerchant, merchant.Id, &merchant.Version, merchant)
+
+	if err != nil {
+		if err == errVersionConflict {
+			return err
+		}
+
+		s.logError("Query to change merchant data failed", []interface{}{"err", err.Error(), "data", merchant})
+		return errors.New(merchantErrorUnknown)
+	}
+
+	return nil
+}
+
+// saveMerchantChanges apply change to merchant and save it. If merchant was changed by another request
+// after it was read then change applied again to actual merchant
+func (s *Service) saveMerchantChanges(
+	merchant *billing.Merchant,
+	change func(merchant *billing.Merchant) error,
+) (*billing.Merchant, error) {
+	id := merchant.Id
+	attempt := 0
+
+	err := retryOnVersionConflict(func() error {
+		var err error
+
+		if attempt > 0 {
+			merchant, err = s.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(id)})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		attempt++
+
+		if err = change(merchant); err != nil {
+			return err
+		}
+
+		return s.updateMerchant(merchant)
+	})
+
+	return merchant, err
+}
+
 func (s *Service) mapMerchantData(rsp *billing.Merchant, merchant *billing.Merchant) {
 	rsp.Id = merchant.Id
 	rsp.User = merchant.User
@@ -796,6 +873,7 @@ func (s *Service) mapMerchantData(rsp *billing.Merchant, merchant *billing.Merch
 	rsp.AgreementType = merchant.AgreementType
 	rsp.AgreementSentViaMail = merchant.AgreementSentViaMail
 	rsp.MailTrackingLink = merchant.MailTrackingLink
+	rsp.Version = merchant.Version
 }
 
 func (s *Service) addNotification(
@@ -1457,7 +1457,7 @@ func (suite *OnboardingTestSuite) TestOnboarding_ListMerchantPaymentMethods_Merc
 
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), len(rsp.PaymentMethods) > 0)
-	assert.Len(suite.T(), rsp.PaymentMethods, len(suite.service.paymentMethodIdCache))
+	assert.Len(suite.T(), rsp.PaymentMethods, len(suite.service.getCache().paymentMethodId))
 
 	for _, v := range rsp.PaymentMethods {
 		assert.True(suite.T(), v.PaymentMethod.Id != "")
@@ -1482,9 +1482,9 @@ func (suite *OnboardingTestSuite) TestOnboarding_ListMerchantPaymentMethods_Exis
 
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), len(rsp.PaymentMethods) > 0)
-	assert.Len(suite.T(), rsp.PaymentMethods, len(suite.service.paymentMethodIdCache))
+	assert.Len(suite.T(), rsp.PaymentMethods, len(suite.service.getCache().paymentMethodId))
 
-	_, ok := suite.service.merchantPaymentMethods[suite.merchant.Id]
+	_, ok := suite.service.getMerchantPaymentMethods(suite.merchant.Id)
 	assert.True(suite.T(), ok)
 
 	for _, v := range rsp.PaymentMethods {
@@ -1554,10 +1554,11 @@ func (suite *OnboardingTestSuite) TestOnboarding_ListMerchantPaymentMethods_NewM
 
 	assert.Nil(suite.T(), err)
 	assert.True(suite.T(), len(rspListMerchantPaymentMethods.PaymentMethods) > 0)
-	assert.Len(suite.T(), rspListMerchantPaymentMethods.PaymentMethods, len(suite.service.paymentMethodIdCache))
+	assert.Len(suite.T(), rspListMerchantPaymentMethods.PaymentMethods, len(suite.service.getCache().paymentMethodId))
 
-	_, ok := suite.service.merchantPaymentMethods[rsp.Id]
-	assert.False(suite.T(), ok)
+	cMerchant, ok := suite.service.getCachedMerchant(rsp.Id)
+	assert.True(suite.T(), ok)
+	assert.Empty(suite.T(), cMerchant.PaymentMethods)
 
 	for _, v := range rspListMerchantPaymentMethods.PaymentMethods {
 		assert.True(suite.T(), v.PaymentMethod.Id != "")
@@ -1601,10 +1602,10 @@ func (suite *OnboardingTestSuite) TestOnboarding_ListMerchantPaymentMethods_NewM
 	assert.NotNil(suite.T(), rspMerchantPaymentMethodAdd.Item)
 	assert.True(suite.T(), len(rspMerchantPaymentMethodAdd.Item.PaymentMethod.Id) > 0)
 
-	_, ok = suite.service.merchantPaymentMethods[rsp.Id]
+	cMerchant, ok = suite.service.getCachedMerchant(rsp.Id)
 	assert.True(suite.T(), ok)
-	assert.True(suite.T(), len(suite.service.merchantPaymentMethods[rsp.Id]) > 0)
-	pm, ok := suite.service.merchantPaymentMethods[rsp.Id][suite.pmBankCard.Id]
+	assert.True(suite.T(), len(cMerchant.PaymentMethods) > 0)
+	pm, ok := cMerchant.PaymentMethods[suite.pmBankCard.Id]
 	assert.True(suite.T(), ok)
 
 	assert.Equal(suite.T(), reqMerchantPaymentMethodAdd.PaymentMethod.Id, pm.PaymentMethod.Id)
@@ -2575,3 +2576,50 @@ func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantStatus_UserNotifi
 	assert.Equal(suite.T(), int32(0), rsp2.Count)
 	assert.Empty(suite.T(), rsp2.Items)
 }
+
+func (suite *OnboardingTestSuite) TestOnboarding_ChangeMerchantPaymentMethod_ChangedConcurrently_Ok() {
+	// merchant read by another request before payment method changed
+	stale, err := suite.service.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(suite.merchant.Id)})
+	assert.NoError(suite.T(), err)
+
+	req := &grpc.MerchantPaymentMethodRequest{
+		MerchantId: suite.merchant.Id,
+		PaymentMethod: &billing.MerchantPaymentMethodIdentification{
+			Id:   suite.pmQiwi.Id,
+			Name: suite.pmQiwi.Name,
+		},
+		Commission: &billing.MerchantPaymentMethodCommissions{
+			Fee: 5,
+			PerTransaction: &billing.MerchantPaymentMethodPerTransactionCommission{
+				Fee:      100,
+				Currency: "RUB",
+			},
+		},
+		Integration: &billing.MerchantPaymentMethodIntegration{},
+		IsActive:    true,
+		UserId:      bson.NewObjectId().Hex(),
+	}
+	rsp := &grpc.MerchantPaymentMethodResponse{}
+	err = suite.service.ChangeMerchantPaymentMethod(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	err = suite.service.updateMerchant(stale)
+	assert.Equal(suite.T(), errVersionConflict, err)
+
+	merchant, err := suite.service.saveMerchantChanges(stale, func(merchant *billing.Merchant) error {
+		merchant.S3AgreementName = "agreement.pdf"
+		return nil
+	})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stale.Version+2, merchant.Version)
+
+	merchant1, err := suite.service.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(suite.merchant.Id)})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "agreement.pdf", merchant1.S3AgreementName)
+	assert.Equal(suite.T(), merchant.Version, merchant1.Version)
+
+	pm, ok := merchant1.PaymentMethods[suite.pmQiwi.Id]
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), req.Commission.Fee, pm.Commission.Fee)
+}
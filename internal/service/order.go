@@ -4,17 +4,16 @@ import (
 	"context"
 	"crypto/sha512"
 	"encoding/hex"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/ProtocolONE/geoip-service/pkg/proto"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
-	protobuf "github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/uuid"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
@@ -22,7 +21,6 @@ import (
 	repo "github.com/paysuper/paysuper-recurring-repository/pkg/proto/repository"
 	"github.com/paysuper/paysuper-recurring-repository/tools"
 	"github.com/paysuper/paysuper-tax-service/proto"
-	"github.com/streadway/amqp"
 	"github.com/ttacon/libphonenumber"
 	"go.uber.org/zap"
 	"regexp"
@@ -88,6 +86,8 @@ const (
 	paymentCreateBankCardFieldIssuerName    = "bank_issuer_name"
 	paymentCreateBankCardFieldIssuerCountry = "bank_issuer_country"
 
+	paymentCreateBankCardFieldIssuerCountryCode = "bank_issuer_country_code"
+
 	orderDefaultDescription      = "Payment by order # %s"
 	orderInlineFormImagesUrlMask = "//%s%s"
 
@@ -156,6 +156,40 @@ func (s *Service) OrderCreateProcess(
 	req *billing.OrderCreateRequest,
 	rsp *billing.Order,
 ) error {
+	projectId := req.ProjectId
+
+	// project of order created by customer token is known from token settings only
+	if req.IdempotencyKey != "" && req.Token != "" {
+		if token, err := s.getTokenBy(req.Token); err == nil {
+			projectId = token.Settings.ProjectId
+		}
+	}
+
+	idempotency, err := s.newIdempotencyRepository(idempotencyActionOrderCreate, projectId, req.IdempotencyKey, req)
+
+	if err != nil {
+		return err
+	}
+
+	replayed, err := idempotency.acquire(rsp)
+
+	if err != nil || replayed == true {
+		return err
+	}
+
+	err = s.orderCreateProcess(req, rsp)
+
+	if err != nil {
+		idempotency.release()
+		return err
+	}
+
+	idempotency.save(rsp)
+
+	return nil
+}
+
+func (s *Service) orderCreateProcess(req *billing.OrderCreateRequest, rsp *billing.Order) error {
 	processor := &OrderCreateRequestProcessor{
 		Service: s,
 		request: req,
@@ -445,6 +479,43 @@ func (s *Service) PaymentCreateProcess(
 	req *grpc.PaymentCreateRequest,
 	rsp *grpc.PaymentCreateResponse,
 ) error {
+	var projectId string
+
+	if req.IdempotencyKey != "" {
+		projectId = s.getOrderProjectId(req.Data[pkg.PaymentCreateFieldOrderId])
+	}
+
+	idempotency, err := s.newIdempotencyRepository(idempotencyActionPaymentCreate, projectId, req.IdempotencyKey, req)
+
+	if err == nil {
+		var replayed bool
+		replayed, err = idempotency.acquire(rsp)
+
+		if replayed == true {
+			return nil
+		}
+	}
+
+	if err != nil {
+		rsp.Status = getIdempotencyErrorStatus(err)
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	err = s.paymentCreateProcess(req, rsp)
+
+	if err != nil {
+		idempotency.release()
+		return err
+	}
+
+	idempotency.finish(rsp.Status, rsp)
+
+	return nil
+}
+
+func (s *Service) paymentCreateProcess(req *grpc.PaymentCreateRequest, rsp *grpc.PaymentCreateResponse) error {
 	processor := &PaymentCreateProcessor{
 		service:        s,
 		data:           req.Data,
@@ -502,19 +573,43 @@ func (s *Service) PaymentCreateProcess(
 		return nil
 	}
 
+	err = s.processOrderSystemFees(order)
+
+	if err != nil {
+		s.logError("Calculate system fees of order failed", []interface{}{"err", err.Error(), "order_id", order.Id})
+
+		rsp.Message = orderCurrencyConvertationError
+		rsp.Status = pkg.ResponseStatusSystemError
+
+		return nil
+	}
+
+	err = s.checkOrderAccount(order, processor.checked.project)
+
+	if err != nil {
+		_ = s.updateOrder(order)
+
+		rsp.Message = err.Error()
+		rsp.Status = pkg.ResponseStatusBadData
+
+		return nil
+	}
+
 	if _, ok := order.PaymentRequisites[pkg.PaymentCreateFieldRecurringId]; ok {
 		req.Data[pkg.PaymentCreateFieldRecurringId] = order.PaymentRequisites[pkg.PaymentCreateFieldRecurringId]
 		delete(order.PaymentRequisites, pkg.PaymentCreateFieldRecurringId)
 	}
 
-	err = s.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	err = s.updateOrder(order)
 
 	if err != nil {
-		s.logError("Update order data failed", []interface{}{"err", err.Error(), "order", order})
-
-		rsp.Message = orderErrorUnknown
+		rsp.Message = err.Error()
 		rsp.Status = pkg.ResponseStatusSystemError
 
+		if err == errVersionConflict {
+			rsp.Status = pkg.ResponseStatusConflict
+		}
+
 		return nil
 	}
 
@@ -536,13 +631,19 @@ func (s *Service) PaymentCreateProcess(
 		return nil
 	}
 
+	historyLen := len(order.StatusHistory)
 	url, err := h.CreatePayment(req.Data)
-	errDb := s.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
 
-	if errDb != nil {
-		s.logError("Update order data failed", []interface{}{"err", errDb.Error(), "order", order})
+	// callback of payment system can be processed before payment creation result saved,
+	// so status set by callback must be kept
+	errDb := s.saveOrderChanges(order, historyLen, s.updateOrder, func(actual *billing.Order) {
+		if actual.PaymentMethodOrderId == "" {
+			actual.PaymentMethodOrderId = order.PaymentMethodOrderId
+		}
+	})
 
-		rsp.Message = orderErrorUnknown
+	if errDb != nil {
+		rsp.Message = errDb.Error()
 		rsp.Status = pkg.ResponseStatusSystemError
 
 		return nil
@@ -573,27 +674,42 @@ func (s *Service) PaymentCallbackProcess(
 	req *grpc.PaymentNotifyRequest,
 	rsp *grpc.PaymentNotifyResponse,
 ) error {
+	// order can be changed by concurrent request after it was read, in this case
+	// callback processed again with actual order
+	err := retryOnVersionConflict(func() error {
+		rsp.Reset()
+		return s.paymentCallbackProcess(req, rsp)
+	})
+
+	if err == errVersionConflict {
+		rsp.Error = err.Error()
+		rsp.Status = pkg.StatusErrorSystem
+
+		return nil
+	}
+
+	return err
+}
+
+func (s *Service) paymentCallbackProcess(req *grpc.PaymentNotifyRequest, rsp *grpc.PaymentNotifyResponse) error {
 	order, err := s.getOrderById(req.OrderId)
 
 	if err != nil {
 		return errors.New(orderErrorNotFound)
 	}
 
-	var data protobuf.Message
-
-	switch order.PaymentMethod.Params.Handler {
-	case pkg.PaymentSystemHandlerCardPay:
-		data = &billing.CardPayPaymentCallback{}
-		err := json.Unmarshal(req.Request, data)
+	adapter, err := getPaymentSystemAdapter(order.PaymentMethod.Params.Handler)
 
-		if err != nil {
-			return errors.New(paymentRequestIncorrect)
-		}
-		break
-	default:
+	if err != nil {
 		return errors.New(orderErrorPaymentMethodNotFound)
 	}
 
+	data, err := adapter.unmarshalPaymentCallback(req.Request)
+
+	if err != nil {
+		return errors.New(paymentRequestIncorrect)
+	}
+
 	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
 
 	if err != nil {
@@ -623,11 +739,17 @@ func (s *Service) PaymentCallbackProcess(
 		}
 	}
 
-	err = s.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	if pErr == nil {
+		err = s.updateOrderWithNotification(order)
+	} else {
+		err = s.updateOrder(order)
+	}
 
-	if err != nil {
-		s.logError("Update order data failed", []interface{}{"err", err.Error(), "order", order})
+	if err == errVersionConflict {
+		return err
+	}
 
+	if err != nil {
 		rsp.Error = orderErrorUnknown
 		rsp.Status = pkg.StatusErrorSystem
 
@@ -635,15 +757,15 @@ func (s *Service) PaymentCallbackProcess(
 	}
 
 	if pErr == nil {
+		if order.Status == constant.OrderStatusPaymentSystemComplete {
+			_ = s.postLedgerEntries(pkg.LedgerSourceTypeOrder, order.Id)
+		}
+
 		if h.IsRecurringCallback(data) {
 			s.saveRecurringCard(order, h.GetRecurringId(data))
 		}
 
-		err = s.broker.Publish(constant.PayOneTopicNotifyPaymentName, order, amqp.Table{"x-retry-count": int32(0)})
-
-		if err != nil {
-			s.logError("Publish notify message to queue failed", []interface{}{"err", err.Error(), "order", order})
-		}
+		s.processSubscriptionOrder(order)
 
 		rsp.Status = pkg.StatusOK
 	}
@@ -856,8 +978,8 @@ func (s *Service) ProcessBillingAddress(
 	rsp.Item = &grpc.ProcessBillingAddressResponseItem{
 		HasVat:      order.Tax.Amount > 0,
 		Vat:         order.Tax.Amount,
-		Amount:      tools.FormatAmount(order.PaymentMethodOutcomeAmount),
-		TotalAmount: tools.FormatAmount(order.TotalPaymentAmount),
+		Amount:      money.Round(order.PaymentMethodOutcomeAmount, order.PaymentMethodOutcomeCurrency.CodeA3),
+		TotalAmount: money.Round(order.TotalPaymentAmount, order.PaymentMethodOutcomeCurrency.CodeA3),
 	}
 
 	return nil
@@ -889,10 +1011,16 @@ func (s *Service) saveRecurringCard(order *billing.Order, recurringId string) {
 	}
 }
 
+// updateOrder save order if it wasn't changed by another request after it was read,
+// otherwise errVersionConflict returned
 func (s *Service) updateOrder(order *billing.Order) error {
-	err := s.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	err := s.updateVersioned(pkg.CollectionOrder, order.Id, &order.Version, order)
 
 	if err != nil {
+		if err == errVersionConflict {
+			return err
+		}
+
 		s.logError("Update order data failed", []interface{}{"error", err.Error(), "order", order})
 		return errors.New(orderErrorUnknown)
 	}
@@ -900,6 +1028,46 @@ func (s *Service) updateOrder(order *billing.Order) error {
 	return nil
 }
 
+// saveOrderChanges save order by save function. If order was changed by another request after it was read then
+// changes of status made after historyLen entries of status history replayed on actual order, other changes
+// copied to actual order by merge function and saving repeated. Status changes not allowed for actual order
+// are skipped, so status set by concurrent request (for example by payment system callback) isn't lost
+func (s *Service) saveOrderChanges(
+	order *billing.Order,
+	historyLen int,
+	save func(order *billing.Order) error,
+	merge func(actual *billing.Order),
+) error {
+	return retryOnVersionConflict(func() error {
+		err := save(order)
+
+		if err != errVersionConflict {
+			return err
+		}
+
+		actual, err := s.getOrderById(order.Id)
+
+		if err != nil {
+			return err
+		}
+
+		changes := order.StatusHistory[historyLen:]
+		historyLen = len(actual.StatusHistory)
+
+		for _, v := range changes {
+			_ = changeOrderStatus(actual, v.To, v.Source, v.Reason)
+		}
+
+		if merge != nil {
+			merge(actual)
+		}
+
+		*order = *actual
+
+		return errVersionConflict
+	})
+}
+
 func (s *Service) getOrderById(id string) (order *billing.Order, err error) {
 	err = s.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(id)).One(&order)
 
@@ -971,8 +1139,7 @@ func (s *Service) getBinData(pan string) (data *BinData) {
 
 func (v *OrderCreateRequestProcessor) prepareOrder() (*billing.Order, error) {
 	id := bson.NewObjectId().Hex()
-	amount := tools.FormatAmount(v.checked.amount)
-	merAccAmount := amount
+	amount := money.FromFloat(v.checked.amount, v.checked.currency.CodeA3)
 	merchantPayoutCurrency := v.checked.merchant.GetPayoutCurrency()
 
 	if (v.request.UrlVerify != "" || v.request.UrlNotify != "") && v.checked.project.AllowDynamicNotifyUrls == false {
@@ -983,16 +1150,6 @@ func (v *OrderCreateRequestProcessor) prepareOrder() (*billing.Order, error) {
 		return nil, errors.New(orderErrorDynamicRedirectUrlsNotAllowed)
 	}
 
-	if merchantPayoutCurrency != nil && v.checked.currency.CodeInt != merchantPayoutCurrency.CodeInt {
-		amnt, err := v.Service.Convert(v.checked.currency.CodeInt, merchantPayoutCurrency.CodeInt, amount)
-
-		if err != nil {
-			return nil, err
-		}
-
-		merAccAmount = amnt
-	}
-
 	order := &billing.Order{
 		Id: id,
 		Project: &billing.ProjectOrder{
@@ -1011,28 +1168,39 @@ func (v *OrderCreateRequestProcessor) prepareOrder() (*billing.Order, error) {
 		Description:                        fmt.Sprintf(orderDefaultDescription, id),
 		ProjectOrderId:                     v.request.OrderId,
 		ProjectAccount:                     v.request.Account,
-		ProjectIncomeAmount:                amount,
+		ProjectIncomeAmount:                amount.Float64(),
 		ProjectIncomeCurrency:              v.checked.currency,
-		ProjectOutcomeAmount:               amount,
+		ProjectOutcomeAmount:               amount.Float64(),
 		ProjectOutcomeCurrency:             v.checked.currency,
 		ProjectParams:                      v.request.Other,
 		Status:                             constant.OrderStatusNew,
 		CreatedAt:                          ptypes.TimestampNow(),
 		IsJsonRequest:                      v.request.IsJson,
-		AmountInMerchantAccountingCurrency: merAccAmount,
-		PaymentMethodOutcomeAmount:         amount,
+		AmountInMerchantAccountingCurrency: amount.Float64(),
+		PaymentMethodOutcomeAmount:         amount.Float64(),
 		PaymentMethodOutcomeCurrency:       v.checked.currency,
-		PaymentMethodIncomeAmount:          amount,
+		PaymentMethodIncomeAmount:          amount.Float64(),
 		PaymentMethodIncomeCurrency:        v.checked.currency,
 
 		Uuid:            uuid.New().String(),
 		User:            v.checked.user,
-		Amount:          amount,
+		Amount:          amount.Float64(),
 		Currency:        v.checked.currency.CodeA3,
 		Products:        v.checked.products,
 		Items:           v.checked.items,
 		Metadata:        v.checked.metadata,
 		PrivateMetadata: v.checked.privateMetadata,
+		AuthorizeOnly:   v.request.AuthorizeOnly,
+	}
+
+	if merchantPayoutCurrency != nil && v.checked.currency.CodeInt != merchantPayoutCurrency.CodeInt {
+		amnt, err := v.Service.convertOrderAmount(order, v.checked.currency, merchantPayoutCurrency, amount)
+
+		if err != nil {
+			return nil, err
+		}
+
+		order.AmountInMerchantAccountingCurrency = amnt.Float64()
 	}
 
 	if order.User != nil && order.User.Address != nil {
@@ -1088,7 +1256,7 @@ func (v *OrderCreateRequestProcessor) processProject() error {
 		return errors.New(orderErrorProjectInactive)
 	}
 
-	merchant, ok := v.merchantCache[project.MerchantId]
+	merchant, ok := v.getCachedMerchant(project.MerchantId)
 
 	if !ok {
 		return errors.New(orderErrorProjectMerchantNotFound)
@@ -1259,7 +1427,7 @@ func (v *OrderCreateRequestProcessor) processPaymentMethod(pm *billing.PaymentMe
 }
 
 func (v *OrderCreateRequestProcessor) processLimitAmounts() (err error) {
-	amount := v.checked.amount
+	amount := money.FromFloat(v.checked.amount, v.checked.currency.CodeA3)
 
 	if v.checked.project.LimitsCurrency != "" && v.checked.project.LimitsCurrency != v.checked.currency.CodeA3 {
 		currency, err := v.GetCurrencyByCodeA3(v.checked.project.LimitsCurrency)
@@ -1268,18 +1436,23 @@ func (v *OrderCreateRequestProcessor) processLimitAmounts() (err error) {
 			return err
 		}
 
-		amount, err = v.Convert(v.checked.currency.CodeInt, currency.CodeInt, amount)
+		rec, err := v.GetCurrencyRate(v.checked.currency.CodeInt, currency.CodeInt, time.Now())
 
 		if err != nil {
 			return err
 		}
+
+		amount = amount.Convert(rec.Rate, currency.CodeA3)
 	}
 
-	if amount < v.checked.project.MinPaymentAmount {
+	minAmount := money.FromFloat(v.checked.project.MinPaymentAmount, amount.Currency())
+	maxAmount := money.FromFloat(v.checked.project.MaxPaymentAmount, amount.Currency())
+
+	if r, _ := amount.Compare(minAmount); r < 0 {
 		return errors.New(orderErrorAmountLowerThanMinAllowed)
 	}
 
-	if v.checked.project.MaxPaymentAmount > 0 && amount > v.checked.project.MaxPaymentAmount {
+	if r, _ := amount.Compare(maxAmount); maxAmount.IsPositive() && r > 0 {
 		return errors.New(orderErrorAmountGreaterThanMaxAllowed)
 	}
 
@@ -1329,9 +1502,12 @@ func (v *OrderCreateRequestProcessor) processSignature() error {
 	return nil
 }
 
-// Calculate VAT for order
+// Calculate VAT for order. Amounts are rounded by rules of payment currency and total amount rounded
+// to cash increment of currency
 func (v *OrderCreateRequestProcessor) processOrderVat(order *billing.Order) {
-	order.TotalPaymentAmount = order.PaymentMethodOutcomeAmount
+	currency := order.PaymentMethodOutcomeCurrency.CodeA3
+	amount := money.FromFloat(order.PaymentMethodOutcomeAmount, currency)
+	order.TotalPaymentAmount = amount.RoundCash().Float64()
 
 	order.Tax = &billing.OrderTax{
 		Type:     taxTypeVat,
@@ -1372,9 +1548,14 @@ func (v *OrderCreateRequestProcessor) processOrderVat(order *billing.Order) {
 		req.UserData.State = rsp.Rate.State
 	}
 
-	order.Tax.Rate = tools.FormatAmount(float64(rsp.Rate.Rate))
-	order.Tax.Amount = tools.FormatAmount(order.PaymentMethodOutcomeAmount * float64(rsp.Rate.Rate))
-	order.TotalPaymentAmount = tools.FormatAmount(order.TotalPaymentAmount + float64(order.Tax.Amount))
+	// rate converted by its shortest decimal representation to avoid float32 precision error in tax amount
+	rate, _ := strconv.ParseFloat(strconv.FormatFloat(float64(rsp.Rate.Rate), 'f', -1, 32), 64)
+	tax := amount.Multiply(rate)
+	total, _ := amount.Add(tax)
+
+	order.Tax.Rate = rate
+	order.Tax.Amount = tax.Float64()
+	order.TotalPaymentAmount = total.RoundCash().Float64()
 
 	return
 }
@@ -1382,37 +1563,42 @@ func (v *OrderCreateRequestProcessor) processOrderVat(order *billing.Order) {
 // Calculate all possible commissions for order, i.e. payment system fee amount, PSP (P1) fee amount,
 // commission shifted from project to user and VAT
 func (v *OrderCreateRequestProcessor) processOrderCommissions(o *billing.Order) error {
-	merchant, _ := v.merchantCache[o.Project.MerchantId]
+	merchant, _ := v.getCachedMerchant(o.Project.MerchantId)
 
 	mAccCur := merchant.GetPayoutCurrency()
-	pmOutCur := o.PaymentMethodOutcomeCurrency.CodeInt
-	amount := float64(0)
+	pmOutCur := o.PaymentMethodOutcomeCurrency
 
 	// calculate commissions to selected payment method
-	commission, err := v.Service.CalculatePmCommission(o.Project.Id, o.PaymentMethod.Id, o.PaymentMethodOutcomeAmount)
+	commission, plan, err := v.Service.CalculatePmCommission(
+		o.Project.Id,
+		o.PaymentMethod.Id,
+		money.FromFloat(o.PaymentMethodOutcomeAmount, pmOutCur.CodeA3),
+		o.GetRateDate(),
+	)
 
 	if err != nil {
 		return err
 	}
 
-	// save information about payment system commission
+	// save information about payment system commission and commission plan used to calculate it
+	o.CommissionPlan = plan
 	o.PaymentSystemFeeAmount = &billing.OrderFeePaymentSystem{
-		AmountPaymentMethodCurrency: tools.FormatAmount(commission),
+		AmountPaymentMethodCurrency: commission.Float64(),
 	}
 
 	// convert payment system amount of fee to accounting currency of payment system
-	amount, err = v.Service.Convert(pmOutCur, o.PaymentMethod.PaymentSystem.AccountingCurrency.CodeInt, commission)
+	amount, err := v.Service.convertOrderAmount(o, pmOutCur, o.PaymentMethod.PaymentSystem.AccountingCurrency, commission)
 
 	if err != nil {
 		return err
 	}
 
-	o.PaymentSystemFeeAmount.AmountPaymentSystemCurrency = amount
+	o.PaymentSystemFeeAmount.AmountPaymentSystemCurrency = amount.Float64()
 
 	if mAccCur != nil {
 		// convert payment system amount of fee to accounting currency of merchant
-		amount, _ = v.Service.Convert(pmOutCur, mAccCur.CodeInt, commission)
-		o.PaymentSystemFeeAmount.AmountMerchantCurrency = amount
+		amount, _ = v.Service.convertOrderAmount(o, pmOutCur, mAccCur, commission)
+		o.PaymentSystemFeeAmount.AmountMerchantCurrency = amount.Float64()
 	}
 
 	return nil
@@ -1509,13 +1695,14 @@ func (v *OrderCreateRequestProcessor) processUserData() (err error) {
 func (v *PaymentFormProcessor) processRenderFormPaymentMethods() ([]*billing.PaymentFormPaymentMethod, error) {
 	var projectPms []*billing.PaymentFormPaymentMethod
 
-	project, ok := v.service.projectCache[v.order.Project.Id]
+	cache := v.service.getCache()
+	project, ok := v.service.getCachedProject(v.order.Project.Id)
 
 	if !ok {
 		return projectPms, errors.New(orderErrorProjectNotFound)
 	}
 
-	for _, val := range v.service.paymentMethodCache {
+	for _, val := range cache.paymentMethod {
 		pm, ok := val[v.order.PaymentMethodOutcomeCurrency.CodeInt]
 
 		if !ok || pm.IsActive == false ||
@@ -1770,6 +1957,7 @@ func (v *PaymentCreateProcessor) processPaymentFormData() error {
 			order.PaymentRequisites[paymentCreateBankCardFieldCategory] = bin.CardCategory
 			order.PaymentRequisites[paymentCreateBankCardFieldIssuerName] = bin.BankName
 			order.PaymentRequisites[paymentCreateBankCardFieldIssuerCountry] = bin.BankCountryName
+			order.PaymentRequisites[paymentCreateBankCardFieldIssuerCountryCode] = bin.BankCountryCodeA2
 		}
 	} else {
 		account := ""
@@ -1802,11 +1990,13 @@ func (v *PaymentCreateProcessor) processPaymentFormData() error {
 
 func (v *PaymentCreateProcessor) processPaymentAmounts() (err error) {
 	order := v.checked.order
+	amount := money.FromFloat(order.PaymentMethodOutcomeAmount, order.PaymentMethodIncomeCurrency.CodeA3)
 
-	order.ProjectOutcomeAmount, err = v.service.Convert(
-		order.PaymentMethodIncomeCurrency.CodeInt,
-		order.ProjectOutcomeCurrency.CodeInt,
-		order.PaymentMethodOutcomeAmount,
+	projectOutcomeAmount, err := v.service.convertOrderAmount(
+		order,
+		order.PaymentMethodIncomeCurrency,
+		order.ProjectOutcomeCurrency,
+		amount,
 	)
 
 	if err != nil {
@@ -1823,10 +2013,12 @@ func (v *PaymentCreateProcessor) processPaymentAmounts() (err error) {
 		return
 	}
 
-	order.AmountInPspAccountingCurrency, err = v.service.Convert(
-		order.PaymentMethodIncomeCurrency.CodeInt,
-		v.service.accountingCurrency.CodeInt,
-		order.PaymentMethodOutcomeAmount,
+	order.ProjectOutcomeAmount = projectOutcomeAmount.Float64()
+	pspAmount, err := v.service.convertOrderAmount(
+		order,
+		order.PaymentMethodIncomeCurrency,
+		v.service.accountingCurrency,
+		amount,
 	)
 
 	if err != nil {
@@ -1843,14 +2035,16 @@ func (v *PaymentCreateProcessor) processPaymentAmounts() (err error) {
 		return
 	}
 
-	merchant, _ := v.service.merchantCache[order.Project.MerchantId]
+	order.AmountInPspAccountingCurrency = pspAmount.Float64()
+	merchant, _ := v.service.getCachedMerchant(order.Project.MerchantId)
 	merchantPayoutCurrency := merchant.GetPayoutCurrency()
 
 	if merchantPayoutCurrency != nil {
-		order.AmountOutMerchantAccountingCurrency, err = v.service.Convert(
-			order.PaymentMethodIncomeCurrency.CodeInt,
-			merchantPayoutCurrency.CodeInt,
-			order.PaymentMethodOutcomeAmount,
+		merchantAmount, err := v.service.convertOrderMerchantAmount(
+			order,
+			order.PaymentMethodIncomeCurrency,
+			merchantPayoutCurrency,
+			amount,
 		)
 
 		if err != nil {
@@ -1864,14 +2058,17 @@ func (v *PaymentCreateProcessor) processPaymentAmounts() (err error) {
 				},
 			)
 
-			return
+			return err
 		}
+
+		order.AmountOutMerchantAccountingCurrency = merchantAmount.Float64()
 	}
 
-	order.AmountInPaymentSystemAccountingCurrency, err = v.service.Convert(
-		order.PaymentMethodIncomeCurrency.CodeInt,
-		order.PaymentMethod.GetAccountingCurrency().CodeInt,
-		order.PaymentMethodOutcomeAmount,
+	psAmount, err := v.service.convertOrderAmount(
+		order,
+		order.PaymentMethodIncomeCurrency,
+		order.PaymentMethod.GetAccountingCurrency(),
+		amount,
 	)
 
 	if err != nil {
@@ -1884,8 +2081,12 @@ func (v *PaymentCreateProcessor) processPaymentAmounts() (err error) {
 				"order_id", order.Id,
 			},
 		)
+
+		return
 	}
 
+	order.AmountInPaymentSystemAccountingCurrency = psAmount.Float64()
+
 	return
 }
 
@@ -1913,25 +2114,34 @@ func (s *Service) GetOrderProducts(projectId string, productIds []string) ([]*gr
 }
 
 func (s *Service) GetOrderProductsAmount(products []*grpc.Product, currency string) (float64, error) {
-	if len(products) == 0 {
-		return 0, errors.New(orderErrorProductsEmpty)
+	amount, err := s.getOrderProductsMoney(products, currency)
+
+	if err != nil {
+		return 0, err
 	}
 
-	sum := float64(0)
+	return amount.Float64(), nil
+}
+
+// getOrderProductsMoney return total price of products in currency
+func (s *Service) getOrderProductsMoney(products []*grpc.Product, currency string) (money.Money, error) {
+	sum := money.Zero(currency)
+
+	if len(products) == 0 {
+		return sum, errors.New(orderErrorProductsEmpty)
+	}
 
 	for _, p := range products {
 		amount, err := p.GetPriceInCurrency(currency)
 
 		if err != nil {
-			return 0, errors.New(orderErrorNoProductsCommonCurrency)
+			return money.Zero(currency), errors.New(orderErrorNoProductsCommonCurrency)
 		}
 
-		sum += amount
+		sum, _ = sum.Add(money.FromFloat(amount, currency))
 	}
 
-	totalAmount := float64(tools.FormatAmount(sum))
-
-	return totalAmount, nil
+	return sum, nil
 }
 
 func (s *Service) GetOrderProductsItems(products []*grpc.Product, language string, currency string) ([]*billing.OrderItem, error) {
@@ -1991,6 +2201,7 @@ func (s *Service) GetOrderProductsItems(products []*grpc.Product, language strin
 			Metadata:    p.Metadata,
 			Amount:      amount,
 			Currency:    currency,
+			Quantity:    1,
 		}
 		result = append(result, item)
 	}
@@ -2034,7 +2245,7 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 	defaultCurrency := s.accountingCurrency
 	zap.S().Infow(fmt.Sprintf(logInfo, "accountingCurrency"), "currency", defaultCurrency.CodeA3, "order.Uuid", order.Uuid)
 
-	merchant, _ := s.merchantCache[order.Project.MerchantId]
+	merchant, _ := s.getCachedMerchant(order.Project.MerchantId)
 	merchantPayoutCurrency := merchant.GetPayoutCurrency()
 
 	if merchantPayoutCurrency != nil {
@@ -2063,13 +2274,13 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 	itemsCurrency = currency.CodeA3
 
 	// try to get order Amount in requested currency
-	amount, err := s.GetOrderProductsAmount(orderProducts, currency.CodeA3)
+	amount, err := s.getOrderProductsMoney(orderProducts, currency.CodeA3)
 	if err != nil {
 		if currency.CodeA3 == defaultCurrency.CodeA3 {
 			return err
 		}
 		// try to get order Amount in default currency, if it differs from requested one
-		amount, err = s.GetOrderProductsAmount(orderProducts, defaultCurrency.CodeA3)
+		amount, err = s.getOrderProductsMoney(orderProducts, defaultCurrency.CodeA3)
 		if err != nil {
 			return err
 		}
@@ -2077,7 +2288,7 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 
 		itemsCurrency = defaultCurrency.CodeA3
 		// converting Amount from default currency to requested
-		amount, err = s.Convert(defaultCurrency.CodeInt, currency.CodeInt, amount)
+		amount, err = s.convertOrderAmount(order, defaultCurrency, currency, amount)
 		if err != nil {
 			return err
 		}
@@ -2097,7 +2308,7 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 	merAccAmount := amount
 	projectOutcomeCurrency := currency
 	if merchantPayoutCurrency != nil && currency.CodeInt != merchantPayoutCurrency.CodeInt {
-		amount, err := s.Convert(currency.CodeInt, merchantPayoutCurrency.CodeInt, amount)
+		amount, err := s.convertOrderAmount(order, currency, merchantPayoutCurrency, amount)
 
 		if err != nil {
 			return err
@@ -2112,11 +2323,11 @@ func (s *Service) ProcessOrderProducts(order *billing.Order) error {
 	order.PaymentMethodOutcomeCurrency = currency
 	order.PaymentMethodIncomeCurrency = currency
 
-	order.Amount = amount
-	order.ProjectIncomeAmount = amount
-	order.ProjectOutcomeAmount = merAccAmount
-	order.PaymentMethodOutcomeAmount = amount
-	order.PaymentMethodIncomeAmount = amount
+	order.Amount = amount.Float64()
+	order.ProjectIncomeAmount = amount.Float64()
+	order.ProjectOutcomeAmount = merAccAmount.Float64()
+	order.PaymentMethodOutcomeAmount = amount.Float64()
+	order.PaymentMethodIncomeAmount = amount.Float64()
 
 	order.Items = items
 
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"time"
+)
+
+const (
+	orderExpireReasonFormExpired          = "payment form expired"
+	orderExpireReasonPaymentStatusUnknown = "result of payment wasn't received from payment system"
+
+	// max count of orders of one project changed by one run of sweeper
+	orderExpireBatchSize = 100
+)
+
+var (
+	// statuses of orders which weren't paid and payment of which isn't processing by payment system
+	orderUnpaidStatuses = []int32{constant.OrderStatusNew, constant.OrderStatusPaymentSystemRejectOnCreate}
+)
+
+func (s *Service) sweepExpiredOrders() {
+	ticker := time.NewTicker(s.cfg.GetOrderExpireSweepInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.expireOrders(time.Now())
+		case <-s.orderExpireExit:
+			return
+		}
+	}
+}
+
+// expireOrders cancel unpaid orders which payment form expired more than grace period of project ago and
+// mark orders which result of created payment wasn't received from payment system during grace period of project.
+// Return count of changed orders
+func (s *Service) expireOrders(now time.Time) int {
+	query := bson.M{
+		"status": bson.M{
+			"$in": append([]int32{constant.OrderStatusPaymentSystemCreate}, orderUnpaidStatuses...),
+		},
+		"expire_date_to_form_input": bson.M{"$lt": now},
+	}
+
+	var projects []bson.ObjectId
+	err := s.db.Collection(pkg.CollectionOrder).Find(query).Distinct("project._id", &projects)
+
+	if err != nil {
+		s.logError("Query to find projects of expired orders failed", []interface{}{"err", err.Error(), "query", query})
+		return 0
+	}
+
+	count := 0
+
+	for _, id := range projects {
+		project, ok := s.getCachedProject(id.Hex())
+
+		// orders of removed project are expired by default grace periods
+		if !ok {
+			project = &billing.Project{Id: id.Hex()}
+		}
+
+		count += s.expireProjectOrders(
+			id,
+			orderUnpaidStatuses,
+			now.Add(-s.cfg.GetOrderExpireGracePeriod(project.OrderExpireGracePeriod)),
+			s.cancelExpiredOrder,
+		)
+		count += s.expireProjectOrders(
+			id,
+			[]int32{constant.OrderStatusPaymentSystemCreate},
+			now.Add(-s.cfg.GetPaymentStatusGracePeriod(project.PaymentStatusGracePeriod)),
+			s.markOrderPaymentStatusUnknown,
+		)
+	}
+
+	return count
+}
+
+// expireProjectOrders apply expire function to orders of project with statuses which payment form
+// expired before time and return count of changed orders
+func (s *Service) expireProjectOrders(
+	projectId bson.ObjectId,
+	statuses []int32,
+	expiredBefore time.Time,
+	expire func(order *billing.Order) error,
+) int {
+	query := bson.M{
+		"project._id":               projectId,
+		"status":                    bson.M{"$in": statuses},
+		"expire_date_to_form_input": bson.M{"$lt": expiredBefore},
+	}
+
+	var orders []*billing.Order
+	err := s.db.Collection(pkg.CollectionOrder).Find(query).Sort("expire_date_to_form_input").
+		Limit(orderExpireBatchSize).All(&orders)
+
+	if err != nil {
+		s.logError("Query to find expired orders failed", []interface{}{"err", err.Error(), "query", query})
+		return 0
+	}
+
+	count := 0
+
+	for _, order := range orders {
+		// order changed by another request is checked again by next run of sweeper
+		if err := expire(order); err != nil {
+			if err != errVersionConflict {
+				s.logError("Expire order failed", []interface{}{"err", err.Error(), "order_id", order.Id})
+			}
+
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// cancelExpiredOrder move unpaid order to canceled by timeout status and notify merchant about abandoned checkout
+func (s *Service) cancelExpiredOrder(order *billing.Order) error {
+	err := changeOrderStatus(
+		order,
+		pkg.OrderStatusCanceledByTimeout,
+		pkg.OrderStatusChangeSourceSweeper,
+		orderExpireReasonFormExpired,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	return s.updateOrderWithOutboxMessage(order, pkg.AbandonedCheckoutTopicName)
+}
+
+// markOrderPaymentStatusUnknown mark order which payment was created in payment system, but result of payment
+// wasn't received. Order stay available to notification about result of payment
+func (s *Service) markOrderPaymentStatusUnknown(order *billing.Order) error {
+	err := changeOrderStatus(
+		order,
+		pkg.OrderStatusPaymentSystemStatusUnknown,
+		pkg.OrderStatusChangeSourceSweeper,
+		orderExpireReasonPaymentStatusUnknown,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	if err = s.updateOrder(order); err != nil {
+		return err
+	}
+
+	// renewal of subscription is failed, retry of renewal payment will be scheduled
+	s.processSubscriptionOrder(order)
+
+	return nil
+}
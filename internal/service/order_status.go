@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+)
+
+const (
+	orderErrorStatusTransitionNotAllowed = "order status can't be changed from %d to %d"
+)
+
+// changeOrderStatus move order to status if transition is allowed by order state machine and append change
+// to status history of order. Change to the same status isn't saved to history
+func changeOrderStatus(order *billing.Order, status int32, source, reason string) error {
+	if order.Status == status {
+		return nil
+	}
+
+	if order.CanChangeStatusTo(status) == false {
+		return fmt.Errorf(orderErrorStatusTransitionNotAllowed, order.Status, status)
+	}
+
+	order.StatusHistory = append(order.StatusHistory, &billing.OrderStatusChange{
+		From:      order.Status,
+		To:        status,
+		Source:    source,
+		Reason:    reason,
+		CreatedAt: ptypes.TimestampNow(),
+	})
+	order.Status = status
+
+	return nil
+}
+
+// GetOrderStatusHistory return timeline of changes of order status
+func (s *Service) GetOrderStatusHistory(
+	ctx context.Context,
+	req *grpc.GetOrderStatusHistoryRequest,
+	rsp *grpc.GetOrderStatusHistoryResponse,
+) error {
+	order, err := s.getOrderByUuid(req.OrderId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.CurrentStatus = order.Status
+	rsp.Items = order.StatusHistory
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type OrderStatusTestSuite struct {
+	suite.Suite
+	order *billing.Order
+}
+
+func Test_OrderStatus(t *testing.T) {
+	suite.Run(t, new(OrderStatusTestSuite))
+}
+
+func (suite *OrderStatusTestSuite) SetupTest() {
+	suite.order = &billing.Order{Status: constant.OrderStatusNew}
+}
+
+func (suite *OrderStatusTestSuite) TestOrderStatus_Change_Ok() {
+	err := changeOrderStatus(suite.order, constant.OrderStatusPaymentSystemCreate, pkg.OrderStatusChangeSourceApi, "")
+	assert.NoError(suite.T(), err)
+
+	err = changeOrderStatus(
+		suite.order,
+		constant.OrderStatusPaymentSystemComplete,
+		pkg.OrderStatusChangeSourceCallback,
+		"completed",
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, suite.order.Status)
+	assert.Len(suite.T(), suite.order.StatusHistory, 2)
+
+	change := suite.order.StatusHistory[1]
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemCreate, change.From)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, change.To)
+	assert.Equal(suite.T(), pkg.OrderStatusChangeSourceCallback, change.Source)
+	assert.Equal(suite.T(), "completed", change.Reason)
+	assert.NotNil(suite.T(), change.CreatedAt)
+}
+
+func (suite *OrderStatusTestSuite) TestOrderStatus_Change_SameStatus_Ok() {
+	err := changeOrderStatus(suite.order, constant.OrderStatusNew, pkg.OrderStatusChangeSourceAdmin, "")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), suite.order.StatusHistory)
+}
+
+func (suite *OrderStatusTestSuite) TestOrderStatus_Change_NotAllowed_Error() {
+	suite.order.Status = constant.OrderStatusPaymentSystemComplete
+
+	err := changeOrderStatus(
+		suite.order,
+		constant.OrderStatusPaymentSystemRejectOnCreate,
+		pkg.OrderStatusChangeSourceCallback,
+		"",
+	)
+	assert.EqualError(
+		suite.T(),
+		err,
+		fmt.Sprintf(
+			orderErrorStatusTransitionNotAllowed,
+			constant.OrderStatusPaymentSystemComplete,
+			constant.OrderStatusPaymentSystemRejectOnCreate,
+		),
+	)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, suite.order.Status)
+	assert.Empty(suite.T(), suite.order.StatusHistory)
+}
+
+func (suite *OrderStatusTestSuite) TestOrderStatus_FinalStatuses() {
+	final := []int32{
+		constant.OrderStatusRefund,
+		constant.OrderStatusChargeback,
+		pkg.OrderStatusPaymentSystemVoided,
+		pkg.OrderStatusCanceledByTimeout,
+	}
+
+	for _, from := range final {
+		for _, to := range []int32{constant.OrderStatusPaymentSystemComplete, constant.OrderStatusPaymentSystemReject} {
+			order := &billing.Order{Status: from}
+			assert.False(suite.T(), order.CanChangeStatusTo(to), "%d -> %d", from, to)
+		}
+	}
+}
+
+func (suite *OrderStatusTestSuite) TestOrderStatus_AuthorizedPayment_Ok() {
+	suite.order.Status = pkg.OrderStatusPaymentSystemAuthorized
+	assert.False(suite.T(), suite.order.CanChangeStatusTo(constant.OrderStatusPaymentSystemReject))
+	assert.True(suite.T(), suite.order.CanChangeStatusTo(pkg.OrderStatusPaymentSystemCaptured))
+
+	suite.order.Status = pkg.OrderStatusPaymentSystemCaptured
+	assert.True(suite.T(), suite.order.CanChangeStatusTo(constant.OrderStatusPaymentSystemComplete))
+	assert.False(suite.T(), suite.order.CanChangeStatusTo(pkg.OrderStatusPaymentSystemAuthorized))
+}
+
+func (suite *OrderStatusTestSuite) TestOrderStatus_Expired_Ok() {
+	assert.True(suite.T(), suite.order.CanChangeStatusTo(pkg.OrderStatusCanceledByTimeout))
+
+	suite.order.Status = constant.OrderStatusPaymentSystemCreate
+	assert.False(suite.T(), suite.order.CanChangeStatusTo(pkg.OrderStatusCanceledByTimeout))
+	assert.True(suite.T(), suite.order.CanChangeStatusTo(pkg.OrderStatusPaymentSystemStatusUnknown))
+
+	suite.order.Status = pkg.OrderStatusPaymentSystemStatusUnknown
+	assert.True(suite.T(), suite.order.CanChangeStatusTo(constant.OrderStatusPaymentSystemComplete))
+	assert.True(suite.T(), suite.order.CanChangeStatusTo(constant.OrderStatusPaymentSystemReject))
+}
@@ -23,9 +23,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -1085,6 +1088,21 @@ func (suite *OrderTestSuite) TestOrder_GetProductsOrderAmount_Ok() {
 	assert.Equal(suite.T(), amount, float64(111))
 }
 
+func (suite *OrderTestSuite) TestOrder_GetProductsOrderAmount_CurrencyExponent_Ok() {
+	p := []*grpc.Product{
+		{Prices: []*grpc.ProductPrice{{Currency: "KWD", Amount: 1.2345}, {Currency: "JPY", Amount: 100.5}}},
+		{Prices: []*grpc.ProductPrice{{Currency: "KWD", Amount: 0.1}, {Currency: "JPY", Amount: 10.4}}},
+	}
+
+	amount, err := suite.service.GetOrderProductsAmount(p, "KWD")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1.335, amount)
+
+	amount, err = suite.service.GetOrderProductsAmount(p, "JPY")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(111), amount)
+}
+
 func (suite *OrderTestSuite) TestOrder_GetProductsOrderAmount_EmptyProducts_Fail() {
 	_, err := suite.service.GetOrderProductsAmount([]*grpc.Product{}, suite.merchantDefaultCurrency)
 	assert.Error(suite.T(), err)
@@ -3749,14 +3767,15 @@ func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_Ok() {
 	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
 	assert.NotNil(suite.T(), order1)
 
-	commission, ok := suite.service.commissionCache[order1.Project.Id][order1.PaymentMethod.Id]
-	assert.True(suite.T(), ok)
+	mPm, err := suite.service.getMerchantPaymentMethod(order1.Project.MerchantId, order1.PaymentMethod.Id)
+	assert.NoError(suite.T(), err)
+	commission := mPm.Commission
 	assert.NotNil(suite.T(), commission)
 
-	merchant, ok := suite.service.merchantCache[order1.Project.MerchantId]
+	merchant, ok := suite.service.getCachedMerchant(order1.Project.MerchantId)
 	assert.True(suite.T(), ok)
 
-	rate, ok := suite.service.currencyRateCache[order1.PaymentMethodOutcomeCurrency.CodeInt][merchant.GetPayoutCurrency().CodeInt]
+	rate, ok := suite.service.getCache().currencyRate[order1.PaymentMethodOutcomeCurrency.CodeInt][merchant.GetPayoutCurrency().CodeInt]
 	assert.True(suite.T(), ok)
 	assert.NotNil(suite.T(), rate)
 
@@ -5134,3 +5153,861 @@ func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_NotOwnBankCard_Error
 	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp1.Status)
 	assert.Equal(suite.T(), orderErrorRecurringCardNotOwnToUser, rsp1.Message)
 }
+
+func (suite *OrderTestSuite) createAuthorizedOrder(handler string) *billing.Order {
+	req := &billing.OrderCreateRequest{
+		ProjectId:     suite.projectFixedAmount.Id,
+		Currency:      "RUB",
+		Amount:        100,
+		Account:       "unit test",
+		Description:   "unit test",
+		OrderId:       bson.NewObjectId().Hex(),
+		Products:      suite.productIds,
+		AuthorizeOnly: true,
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), rsp.AuthorizeOnly)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+
+	var order *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
+	assert.NotNil(suite.T(), order)
+	assert.True(suite.T(), order.AuthorizeOnly)
+
+	order.Status = pkg.OrderStatusPaymentSystemAuthorized
+	order.PaymentMethod.Params.Handler = handler
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.Nil(suite.T(), err)
+
+	return order
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_Ok() {
+	order := suite.createAuthorizedOrder("mock_ok")
+
+	req := &grpc.CaptureOrderRequest{OrderId: order.Uuid}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Empty(suite.T(), rsp.Message)
+	assert.NotNil(suite.T(), rsp.Item)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemCaptured), rsp.Item.Status)
+	assert.Equal(suite.T(), order.TotalPaymentAmount, rsp.Item.CapturedAmount)
+
+	var order1 *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
+	assert.NotNil(suite.T(), order1)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemCaptured), order1.Status)
+	assert.Equal(suite.T(), order.TotalPaymentAmount, order1.CapturedAmount)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_PartialAmount_Ok() {
+	order := suite.createAuthorizedOrder("mock_ok")
+
+	req := &grpc.CaptureOrderRequest{OrderId: order.Uuid, Amount: 10}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), float64(10), rsp.Item.CapturedAmount)
+	assert.Equal(suite.T(), float64(10), rsp.Item.GetChargeAmount())
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_OrderNotFound_Error() {
+	req := &grpc.CaptureOrderRequest{OrderId: uuid.New().String()}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_NotAuthorized_Error() {
+	order := suite.createAuthorizedOrder("mock_ok")
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	err := suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.Nil(suite.T(), err)
+
+	req := &grpc.CaptureOrderRequest{OrderId: order.Uuid}
+	rsp := &grpc.OrderOperationResponse{}
+	err = suite.service.CaptureOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), captureErrorNotAllowed, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_AmountGreaterTotal_Error() {
+	order := suite.createAuthorizedOrder("mock_ok")
+
+	req := &grpc.CaptureOrderRequest{OrderId: order.Uuid, Amount: order.TotalPaymentAmount + 1}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), captureErrorAmountGreaterTotal, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_CaptureOrder_PaymentSystemReject_Error() {
+	order := suite.createAuthorizedOrder("mock_error")
+
+	req := &grpc.CaptureOrderRequest{OrderId: order.Uuid}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.CaptureOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), paymentSystemErrorCaptureRejected, rsp.Message)
+
+	var order1 *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
+	assert.NotNil(suite.T(), order1)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemAuthorized), order1.Status)
+}
+
+func (suite *OrderTestSuite) TestOrder_VoidOrder_Ok() {
+	order := suite.createAuthorizedOrder("mock_ok")
+
+	req := &grpc.VoidOrderRequest{OrderId: order.Uuid}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.VoidOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemVoided), rsp.Item.Status)
+
+	var order1 *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
+	assert.NotNil(suite.T(), order1)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemVoided), order1.Status)
+
+	rsp1 := &grpc.OrderOperationResponse{}
+	err = suite.service.CaptureOrder(context.TODO(), &grpc.CaptureOrderRequest{OrderId: order.Uuid}, rsp1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp1.Status)
+	assert.Equal(suite.T(), captureErrorNotAllowed, rsp1.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_VoidOrder_NotAuthorized_Error() {
+	order := suite.createAuthorizedOrder("mock_ok")
+	order.AuthorizeOnly = false
+	err := suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.Nil(suite.T(), err)
+
+	req := &grpc.VoidOrderRequest{OrderId: order.Uuid}
+	rsp := &grpc.OrderOperationResponse{}
+	err = suite.service.VoidOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), voidErrorNotAllowed, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_VoidOrder_PaymentSystemReject_Error() {
+	order := suite.createAuthorizedOrder("mock_error")
+
+	req := &grpc.VoidOrderRequest{OrderId: order.Uuid}
+	rsp := &grpc.OrderOperationResponse{}
+	err := suite.service.VoidOrder(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), paymentSystemErrorVoidRejected, rsp.Message)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_IdempotencyKey_Ok() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:      suite.project.Id,
+		PaymentMethod:  suite.paymentMethod.Group,
+		Currency:       "RUB",
+		Amount:         100,
+		Account:        "unit test",
+		Description:    "unit test",
+		IdempotencyKey: bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), len(rsp.Id) > 0)
+
+	rsp1 := &billing.Order{}
+	err = suite.service.OrderCreateProcess(context.TODO(), req, rsp1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), rsp.Id, rsp1.Id)
+	assert.Equal(suite.T(), rsp.Uuid, rsp1.Uuid)
+	assert.Equal(suite.T(), rsp.TotalPaymentAmount, rsp1.TotalPaymentAmount)
+
+	n, err := suite.service.db.Collection(pkg.CollectionOrder).Find(bson.M{"uuid": rsp.Uuid}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, n)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_IdempotencyKeyConflict_Error() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:      suite.project.Id,
+		PaymentMethod:  suite.paymentMethod.Group,
+		Currency:       "RUB",
+		Amount:         100,
+		Account:        "unit test",
+		Description:    "unit test",
+		IdempotencyKey: bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+
+	req.Amount = 200
+	rsp1 := &billing.Order{}
+	err = suite.service.OrderCreateProcess(context.TODO(), req, rsp1)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), idempotencyErrorKeyConflict, err.Error())
+	assert.Empty(suite.T(), rsp1.Id)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_IdempotencyKeyOfAnotherProject_Ok() {
+	key := bson.NewObjectId().Hex()
+	req := &billing.OrderCreateRequest{
+		ProjectId:      suite.project.Id,
+		PaymentMethod:  suite.paymentMethod.Group,
+		Currency:       "RUB",
+		Amount:         100,
+		Account:        "unit test",
+		Description:    "unit test",
+		IdempotencyKey: key,
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.Nil(suite.T(), err)
+
+	// same idempotency key used by another project isn't conflict
+	req1 := &billing.OrderCreateRequest{
+		ProjectId:      suite.projectFixedAmount.Id,
+		Currency:       "RUB",
+		Amount:         100,
+		Account:        "unit test",
+		Description:    "unit test",
+		Products:       suite.productIds,
+		IdempotencyKey: key,
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	rsp1 := &billing.Order{}
+	err = suite.service.OrderCreateProcess(context.TODO(), req1, rsp1)
+	assert.Nil(suite.T(), err)
+	assert.NotEmpty(suite.T(), rsp1.Id)
+	assert.NotEqual(suite.T(), rsp.Id, rsp1.Id)
+	assert.Equal(suite.T(), suite.projectFixedAmount.Id, rsp1.Project.Id)
+}
+
+func (suite *OrderTestSuite) TestOrder_OrderCreateProcess_IdempotencyKeyFailedRequest_Retry() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:      suite.inactiveProject.Id,
+		PaymentMethod:  suite.paymentMethod.Group,
+		Currency:       "RUB",
+		Amount:         100,
+		Account:        "unit test",
+		Description:    "unit test",
+		IdempotencyKey: bson.NewObjectId().Hex(),
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), orderErrorProjectInactive, err.Error())
+
+	err = suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), orderErrorProjectInactive, err.Error())
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_IdempotencyKey_Ok() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.projectFixedAmount.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		Products:    suite.productIds,
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	order := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, order)
+	assert.Nil(suite.T(), err)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+		IdempotencyKey: bson.NewObjectId().Hex(),
+	}
+
+	rsp := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), rsp.Status, rsp1.Status)
+	assert.Equal(suite.T(), rsp.RedirectUrl, rsp1.RedirectUrl)
+	assert.Equal(suite.T(), rsp.NeedRedirect, rsp1.NeedRedirect)
+
+	createPaymentRequest.Data[pkg.PaymentCreateFieldHolder] = "Mrs. Card Holder"
+	rsp2 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp2)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusConflict, rsp2.Status)
+	assert.Equal(suite.T(), idempotencyErrorKeyConflict, rsp2.Message)
+}
+
+// paymentCreateWithCheckAccount create payment for project which check account of payer by stand-in http server
+func (suite *OrderTestSuite) paymentCreateWithCheckAccount(
+	server *webhookServerTest,
+	required bool,
+) (*grpc.PaymentCreateResponse, *billing.Order) {
+	suite.project.UrlCheckAccount = server.server.URL
+	suite.project.CheckAccountRequired = required
+	suite.service.setCachedProject(suite.project)
+
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	order := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, order)
+	assert.Nil(suite.T(), err)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            time.Now().AddDate(1, 0, 0).Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp)
+	assert.Nil(suite.T(), err)
+
+	var order1 *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(order.Id)).One(&order1)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), order1.AccountCheck)
+
+	return rsp, order1
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CheckAccount_Ok() {
+	server := newWebhookServerTest()
+	defer server.server.Close()
+
+	rsp, order := suite.paymentCreateWithCheckAccount(server, true)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.True(suite.T(), len(rsp.RedirectUrl) > 0)
+
+	assert.Equal(suite.T(), pkg.OrderAccountCheckStatusOk, order.AccountCheck.Status)
+	assert.EqualValues(suite.T(), http.StatusOK, order.AccountCheck.ResponseCode)
+	assert.Equal(suite.T(), "ok", order.AccountCheck.ResponseBody)
+	assert.Empty(suite.T(), order.AccountCheck.Error)
+	assert.True(suite.T(), order.AccountCheck.Latency >= 0)
+	assert.NotNil(suite.T(), order.AccountCheck.CheckedAt)
+
+	assert.Len(suite.T(), server.requests, 1)
+
+	payload := new(checkAccountPayload)
+	err := json.Unmarshal([]byte(server.payloads[0]), payload)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), order.Uuid, payload.OrderId)
+	assert.Equal(suite.T(), order.ProjectOrderId, payload.ProjectOrderId)
+	assert.Equal(suite.T(), order.ProjectAccount, payload.Account)
+
+	header := server.requests[0].Header.Get(webhookSignatureHeader)
+	parts := strings.Split(header, ",")
+	assert.Len(suite.T(), parts, 2)
+
+	timestamp := strings.TrimPrefix(parts[0], "t=")
+	signature := webhookSignature(suite.project.SecretKey, timestamp, server.payloads[0])
+	assert.Equal(suite.T(), "v1="+signature, parts[1])
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CheckAccount_Rejected_Error() {
+	server := newWebhookServerTest()
+	defer server.server.Close()
+	server.setResponse(http.StatusNotFound, "account not found")
+
+	rsp, order := suite.paymentCreateWithCheckAccount(server, false)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorAccountRejected, rsp.Message)
+	assert.Empty(suite.T(), rsp.RedirectUrl)
+
+	assert.Equal(suite.T(), pkg.OrderAccountCheckStatusRejected, order.AccountCheck.Status)
+	assert.EqualValues(suite.T(), http.StatusNotFound, order.AccountCheck.ResponseCode)
+	assert.Equal(suite.T(), "account not found", order.AccountCheck.ResponseBody)
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CheckAccount_FailedOptional_Ok() {
+	server := newWebhookServerTest()
+	defer server.server.Close()
+	server.setResponse(http.StatusInternalServerError, "error")
+
+	rsp, order := suite.paymentCreateWithCheckAccount(server, false)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.True(suite.T(), len(rsp.RedirectUrl) > 0)
+
+	assert.Equal(suite.T(), pkg.OrderAccountCheckStatusFailed, order.AccountCheck.Status)
+	assert.EqualValues(suite.T(), http.StatusInternalServerError, order.AccountCheck.ResponseCode)
+	assert.NotEmpty(suite.T(), order.AccountCheck.Error)
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CheckAccount_FailedRequired_Error() {
+	server := newWebhookServerTest()
+	defer server.server.Close()
+	server.setResponse(http.StatusInternalServerError, "error")
+
+	rsp, order := suite.paymentCreateWithCheckAccount(server, true)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorAccountCheckFailed, rsp.Message)
+
+	assert.Equal(suite.T(), pkg.OrderAccountCheckStatusFailed, order.AccountCheck.Status)
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCreateProcess_CheckAccount_Timeout_Error() {
+	server := &webhookServerTest{
+		server: httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(2 * time.Second)
+		})),
+	}
+	defer server.server.Close()
+	suite.service.cfg.CheckAccountTimeout = 1
+
+	rsp, order := suite.paymentCreateWithCheckAccount(server, true)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorAccountCheckFailed, rsp.Message)
+
+	assert.Equal(suite.T(), pkg.OrderAccountCheckStatusFailed, order.AccountCheck.Status)
+	assert.EqualValues(suite.T(), 0, order.AccountCheck.ResponseCode)
+	assert.True(suite.T(), order.AccountCheck.Latency >= 1000)
+}
+
+func (suite *OrderTestSuite) TestOrder_GetOrderStatusHistory_Ok() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	order := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, order)
+	assert.Nil(suite.T(), err)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	err = suite.service.UpdateOrder(context.TODO(), order, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	order.Status = constant.OrderStatusProjectComplete
+	order.StatusHistory = nil
+	err = suite.service.UpdateOrder(context.TODO(), order, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rsp := &grpc.GetOrderStatusHistoryResponse{}
+	err = suite.service.GetOrderStatusHistory(context.TODO(), &grpc.GetOrderStatusHistoryRequest{OrderId: order.Uuid}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), constant.OrderStatusProjectComplete, rsp.CurrentStatus)
+	assert.Len(suite.T(), rsp.Items, 2)
+	assert.Equal(suite.T(), constant.OrderStatusNew, rsp.Items[0].From)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, rsp.Items[0].To)
+	assert.Equal(suite.T(), pkg.OrderStatusChangeSourceAdmin, rsp.Items[0].Source)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, rsp.Items[1].From)
+	assert.Equal(suite.T(), constant.OrderStatusProjectComplete, rsp.Items[1].To)
+}
+
+func (suite *OrderTestSuite) TestOrder_GetOrderStatusHistory_NotFound_Error() {
+	rsp := &grpc.GetOrderStatusHistoryResponse{}
+	err := suite.service.GetOrderStatusHistory(
+		context.TODO(),
+		&grpc.GetOrderStatusHistoryRequest{OrderId: uuid.New().String()},
+		rsp,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), orderErrorNotFound, rsp.Message)
+	assert.Empty(suite.T(), rsp.Items)
+}
+
+func (suite *OrderTestSuite) TestOrder_UpdateOrder_StatusNotAllowed_Error() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	order := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, order)
+	assert.Nil(suite.T(), err)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	err = suite.service.UpdateOrder(context.TODO(), order, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	order.Status = constant.OrderStatusPaymentSystemRejectOnCreate
+	err = suite.service.UpdateOrder(context.TODO(), order, &grpc.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemComplete, order1.Status)
+	assert.Len(suite.T(), order1.StatusHistory, 1)
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_ConcurrentCallbacks_Ok() {
+	order, callbackData := suite.createOrderCallback()
+
+	order, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+
+	count := 3
+	responses := make([]*grpc.PaymentNotifyResponse, count)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			responses[i] = &grpc.PaymentNotifyResponse{}
+			err := suite.service.PaymentCallbackProcess(context.TODO(), callbackData, responses[i])
+			assert.NoError(suite.T(), err)
+		}(i)
+	}
+
+	wg.Wait()
+
+	for _, v := range responses {
+		assert.Equal(suite.T(), pkg.StatusOK, v.Status)
+	}
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order1.Status)
+	assert.Equal(suite.T(), order.Version+int64(count), order1.Version)
+
+	completed := 0
+
+	for _, v := range order1.StatusHistory {
+		if v.To == constant.OrderStatusPaymentSystemComplete {
+			completed++
+		}
+	}
+
+	assert.Equal(suite.T(), 1, completed)
+}
+
+func (suite *OrderTestSuite) TestOrder_PaymentCallbackProcess_StaleOrderSave_Ok() {
+	order, callbackData := suite.createOrderCallback()
+
+	// order read by payment creation request before callback processed
+	stale, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+
+	callbackResponse := &grpc.PaymentNotifyResponse{}
+	err = suite.service.PaymentCallbackProcess(context.TODO(), callbackData, callbackResponse)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusOK, callbackResponse.Status)
+
+	version := stale.Version
+	err = suite.service.updateOrder(stale)
+	assert.Equal(suite.T(), errVersionConflict, err)
+	assert.Equal(suite.T(), version, stale.Version)
+
+	historyLen := len(stale.StatusHistory)
+	err = changeOrderStatus(stale, constant.OrderStatusPaymentSystemReject, pkg.OrderStatusChangeSourceApi, "")
+	assert.NoError(suite.T(), err)
+	stale.PaymentMethodOrderId = "stale_payment_method_order_id"
+
+	err = suite.service.saveOrderChanges(stale, historyLen, suite.service.updateOrder, nil)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), stale.Status)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order1.Status)
+	assert.NotEqual(suite.T(), "stale_payment_method_order_id", order1.PaymentMethodOrderId)
+	assert.Equal(suite.T(), stale.Version, order1.Version)
+}
+
+// createOrderCallback create order with created payment and return it with request of payment system
+// callback which complete payment
+func (suite *OrderTestSuite) createOrderCallback() (*billing.Order, *grpc.PaymentNotifyRequest) {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.projectFixedAmount.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		Products:    suite.productIds,
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	order := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, order)
+	assert.Nil(suite.T(), err)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         order.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.paymentMethod.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+
+	callbackRequest := &billing.CardPayPaymentCallback{
+		PaymentMethod: suite.paymentMethod.Params.ExternalId,
+		CallbackTime:  time.Now().Format("2006-01-02T15:04:05Z"),
+		MerchantOrder: &billing.CardPayMerchantOrder{
+			Id:          order1.Id,
+			Description: order1.Description,
+			Items: []*billing.CardPayItem{
+				{
+					Name:        order1.Items[0].Name,
+					Description: order1.Items[0].Name,
+					Count:       1,
+					Price:       order1.Items[0].Amount,
+				},
+			},
+		},
+		CardAccount: &billing.CallbackCardPayBankCardAccount{
+			Holder:             order1.PaymentRequisites[pkg.PaymentCreateFieldHolder],
+			IssuingCountryCode: "RU",
+			MaskedPan:          order1.PaymentRequisites[pkg.PaymentCreateFieldPan],
+			Token:              bson.NewObjectId().Hex(),
+		},
+		Customer: &billing.CardPayCustomer{
+			Email:  order1.User.Email,
+			Ip:     order1.User.Ip,
+			Id:     order1.ProjectAccount,
+			Locale: "Europe/Moscow",
+		},
+		PaymentData: &billing.CallbackCardPayPaymentData{
+			Id:          bson.NewObjectId().Hex(),
+			Amount:      order1.TotalPaymentAmount,
+			Currency:    order1.PaymentMethodOutcomeCurrency.CodeA3,
+			Description: order1.Description,
+			Is_3D:       true,
+			Rrn:         bson.NewObjectId().Hex(),
+			Status:      pkg.CardPayPaymentResponseStatusCompleted,
+		},
+	}
+
+	buf, err := json.Marshal(callbackRequest)
+	assert.Nil(suite.T(), err)
+
+	hash := sha512.New()
+	hash.Write([]byte(string(buf) + order1.PaymentMethod.Params.CallbackPassword))
+
+	callbackData := &grpc.PaymentNotifyRequest{
+		OrderId:   order1.Id,
+		Request:   buf,
+		Signature: hex.EncodeToString(hash.Sum(nil)),
+	}
+
+	return order1, callbackData
+}
+
+func (suite *OrderTestSuite) TestOrder_ExpireOrders_CanceledByTimeout_Ok() {
+	order := suite.createExpiredOrder(2 * time.Hour)
+
+	count := suite.service.expireOrders(time.Now())
+	assert.Equal(suite.T(), 1, count)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusCanceledByTimeout, order1.Status)
+	assert.NotEmpty(suite.T(), order1.StatusHistory)
+
+	change := order1.StatusHistory[len(order1.StatusHistory)-1]
+	assert.Equal(suite.T(), constant.OrderStatusNew, change.From)
+	assert.Equal(suite.T(), pkg.OrderStatusChangeSourceSweeper, change.Source)
+
+	var messages []*billing.OutboxMessage
+	err = suite.service.db.Collection(pkg.CollectionOutbox).
+		Find(bson.M{"topic": pkg.AbandonedCheckoutTopicName, "order._id": bson.ObjectIdHex(order.Id)}).
+		All(&messages)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), messages, 1)
+
+	count = suite.service.expireOrders(time.Now())
+	assert.Equal(suite.T(), 0, count)
+}
+
+func (suite *OrderTestSuite) TestOrder_ExpireOrders_ProjectGracePeriod_Ok() {
+	order := suite.createExpiredOrder(2 * time.Hour)
+
+	project := *suite.projectFixedAmount
+	project.OrderExpireGracePeriod = int64((3 * time.Hour).Seconds())
+
+	err := suite.service.updateProject(&project, suite.projectFixedAmount)
+	assert.NoError(suite.T(), err)
+
+	count := suite.service.expireOrders(time.Now())
+	assert.Equal(suite.T(), 0, count)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusNew, order1.Status)
+
+	count = suite.service.expireOrders(time.Now().Add(2 * time.Hour))
+	assert.Equal(suite.T(), 1, count)
+}
+
+func (suite *OrderTestSuite) TestOrder_ExpireOrders_PaymentStatusUnknown_Ok() {
+	order, _ := suite.createOrderCallback()
+	assert.Equal(suite.T(), constant.OrderStatusPaymentSystemCreate, order.Status)
+
+	suite.setOrderExpireDate(order.Id, time.Now().Add(-2*time.Hour))
+
+	count := suite.service.expireOrders(time.Now())
+	assert.Equal(suite.T(), 0, count)
+
+	count = suite.service.expireOrders(time.Now().Add(24 * time.Hour))
+	assert.Equal(suite.T(), 1, count)
+
+	order1, err := suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusPaymentSystemStatusUnknown, order1.Status)
+	assert.True(suite.T(), order1.CanChangeStatusTo(constant.OrderStatusPaymentSystemComplete))
+}
+
+func (suite *OrderTestSuite) createExpiredOrder(expiredAgo time.Duration) *billing.Order {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.projectFixedAmount.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		Products:    suite.productIds,
+		User: &billing.OrderUser{
+			Email: "test@unit.unit",
+			Ip:    "127.0.0.1",
+		},
+	}
+
+	order := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), constant.OrderStatusNew, order.Status)
+
+	suite.setOrderExpireDate(order.Id, time.Now().Add(-expiredAgo))
+
+	return order
+}
+
+func (suite *OrderTestSuite) setOrderExpireDate(id string, date time.Time) {
+	err := suite.service.db.Collection(pkg.CollectionOrder).
+		UpdateId(bson.ObjectIdHex(id), bson.M{"$set": bson.M{"expire_date_to_form_input": date}})
+	assert.NoError(suite.T(), err)
+}
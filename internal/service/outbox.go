@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/streadway/amqp"
+	"time"
+)
+
+const (
+	outboxErrorQueryFailed   = "outbox messages query failed"
+	outboxErrorIdIncorrect   = "outbox message identifier is incorrect"
+	outboxErrorOrderNotSaved = "order changes which message notifies about weren't saved"
+
+	// time while message is reserved by one dispatcher and can't be taken by another
+	outboxClaimLifeTime = time.Minute
+	outboxBackoffBase   = 5 * time.Second
+	outboxBackoffMax    = time.Hour
+	outboxBatchSize     = 100
+)
+
+// Broker publish messages to message queue, implemented by RabbitMQ broker
+type Broker interface {
+	Publish(topic string, msg proto.Message, h amqp.Table) error
+}
+
+// updateOrderWithNotification update order and save notification about order changes to outbox,
+// after that changes of order sent to project by webhook
+func (s *Service) updateOrderWithNotification(order *billing.Order) error {
+	if err := s.updateOrderWithOutboxMessage(order, constant.PayOneTopicNotifyPaymentName); err != nil {
+		return err
+	}
+
+	s.sendOrderWebhook(order)
+
+	return nil
+}
+
+// updateOrderWithOutboxMessage update order and save message with order to topic to outbox.
+// Used mongo driver doesn't support multi documents transactions, so outbox message is written before
+// order and removed if order update failed. Message stamped by version which order will get after update,
+// so if process crashed before order was updated then dispatcher drops message instead of publishing it.
+// Outbox message created as reserved by current process, so it publish immediately and dispatcher
+// will take it only if publish failed
+func (s *Service) updateOrderWithOutboxMessage(order *billing.Order, topic string) error {
+	now := time.Now()
+	msg := &billing.OutboxMessage{
+		Id:           bson.NewObjectId().Hex(),
+		Topic:        topic,
+		Order:        order,
+		Status:       pkg.OutboxMessageStatusPending,
+		OrderVersion: order.Version + 1,
+	}
+	msg.NextAttemptAt, _ = ptypes.TimestampProto(now.Add(outboxClaimLifeTime))
+	msg.CreatedAt, _ = ptypes.TimestampProto(now)
+	msg.UpdatedAt = msg.CreatedAt
+
+	err := s.db.Collection(pkg.CollectionOutbox).Insert(msg)
+
+	if err != nil {
+		s.logError("Insert outbox message failed", []interface{}{"error", err.Error(), "order_id", order.Id})
+		return errors.New(orderErrorUnknown)
+	}
+
+	err = s.updateOrder(order)
+
+	if err != nil {
+		if err := s.db.Collection(pkg.CollectionOutbox).RemoveId(bson.ObjectIdHex(msg.Id)); err != nil {
+			s.logError("Remove outbox message failed", []interface{}{"error", err.Error(), "message_id", msg.Id})
+		}
+
+		return err
+	}
+
+	_ = s.publishOutboxMessage(msg)
+
+	return nil
+}
+
+// publishOutboxMessage publish message to broker and save result of publishing. If publish failed then
+// next attempt will be scheduled with exponential backoff, after max attempts count message marks as failed
+func (s *Service) publishOutboxMessage(msg *billing.OutboxMessage) error {
+	pErr := s.broker.Publish(msg.Topic, msg.Order, amqp.Table{"x-retry-count": int32(0)})
+	now := time.Now()
+
+	if pErr == nil {
+		msg.Status = pkg.OutboxMessageStatusDelivered
+		msg.LastError = ""
+		msg.DeliveredAt, _ = ptypes.TimestampProto(now)
+	} else {
+		s.logError(
+			"Publish outbox message to queue failed",
+			[]interface{}{"error", pErr.Error(), "message_id", msg.Id, "attempts", msg.Attempts},
+		)
+
+		msg.Attempts++
+		msg.LastError = pErr.Error()
+
+		if msg.Attempts >= s.cfg.OutboxMaxAttempts {
+			msg.Status = pkg.OutboxMessageStatusFailed
+		}
+
+		msg.NextAttemptAt, _ = ptypes.TimestampProto(now.Add(getOutboxBackoff(msg.Attempts)))
+	}
+
+	msg.UpdatedAt, _ = ptypes.TimestampProto(now)
+	err := s.db.Collection(pkg.CollectionOutbox).UpdateId(bson.ObjectIdHex(msg.Id), msg)
+
+	if err != nil {
+		s.logError("Update outbox message failed", []interface{}{"error", err.Error(), "message_id", msg.Id})
+	}
+
+	return pErr
+}
+
+func (s *Service) dispatchOutbox() {
+	ticker := time.NewTicker(s.cfg.GetOutboxDispatchInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.dispatchOutboxMessages()
+		case <-s.outboxExit:
+			return
+		}
+	}
+}
+
+// dispatchOutboxMessages publish pending messages which time of next attempt has come
+// and return count of delivered messages
+func (s *Service) dispatchOutboxMessages() int {
+	delivered := 0
+
+	for i := 0; i < outboxBatchSize; i++ {
+		msg, err := s.claimOutboxMessage()
+
+		if err != nil {
+			if err != mgo.ErrNotFound {
+				s.logError("Claim outbox message failed", []interface{}{"error", err.Error()})
+			}
+
+			break
+		}
+
+		saved, err := s.isOutboxOrderSaved(msg)
+
+		if err != nil {
+			continue
+		}
+
+		if saved == false {
+			s.dropOutboxMessage(msg)
+			continue
+		}
+
+		if s.publishOutboxMessage(msg) == nil {
+			delivered++
+		}
+	}
+
+	return delivered
+}
+
+// claimOutboxMessage reserve one pending message for current process, so several service instances
+// can dispatch outbox simultaneously without publishing same message twice
+func (s *Service) claimOutboxMessage() (*billing.OutboxMessage, error) {
+	now := time.Now()
+	query := bson.M{"status": pkg.OutboxMessageStatusPending, "next_attempt_at": bson.M{"$lte": now}}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"next_attempt_at": now.Add(outboxClaimLifeTime)}},
+		ReturnNew: true,
+	}
+
+	msg := &billing.OutboxMessage{}
+	_, err := s.db.Collection(pkg.CollectionOutbox).Find(query).Sort("next_attempt_at").Apply(change, msg)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// isOutboxOrderSaved check that order changes which message notifies about were saved. Message claimed
+// by dispatcher only after claim of process created it expired, so if order still has lower version
+// then process crashed between writing of message and order update
+func (s *Service) isOutboxOrderSaved(msg *billing.OutboxMessage) (bool, error) {
+	if msg.OrderVersion <= 0 || msg.Order == nil {
+		return true, nil
+	}
+
+	query := bson.M{"_id": bson.ObjectIdHex(msg.Order.Id), "version": bson.M{"$gte": msg.OrderVersion}}
+	count, err := s.db.Collection(pkg.CollectionOrder).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to check order of outbox message failed", []interface{}{"error", err.Error(), "query", query})
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// dropOutboxMessage mark message which order changes weren't saved as dropped, so it never published
+func (s *Service) dropOutboxMessage(msg *billing.OutboxMessage) {
+	s.logError(outboxErrorOrderNotSaved, []interface{}{"message_id", msg.Id, "order_version", msg.OrderVersion})
+
+	msg.Status = pkg.OutboxMessageStatusDropped
+	msg.LastError = outboxErrorOrderNotSaved
+	msg.UpdatedAt, _ = ptypes.TimestampProto(time.Now())
+
+	err := s.db.Collection(pkg.CollectionOutbox).UpdateId(bson.ObjectIdHex(msg.Id), msg)
+
+	if err != nil {
+		s.logError("Update outbox message failed", []interface{}{"error", err.Error(), "message_id", msg.Id})
+	}
+}
+
+func getOutboxBackoff(attempts int32) time.Duration {
+	backoff := outboxBackoffBase
+
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+
+		if backoff >= outboxBackoffMax {
+			return outboxBackoffMax
+		}
+	}
+
+	return backoff
+}
+
+func (s *Service) ListOutboxMessages(
+	ctx context.Context,
+	req *grpc.ListOutboxMessagesRequest,
+	rsp *grpc.ListOutboxMessagesResponse,
+) error {
+	query := bson.M{"status": req.Status}
+
+	if req.OrderId != "" {
+		if bson.IsObjectIdHex(req.OrderId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = orderErrorNotFound
+
+			return nil
+		}
+
+		query["order._id"] = bson.ObjectIdHex(req.OrderId)
+	}
+
+	var messages []*billing.OutboxMessage
+	err := s.db.Collection(pkg.CollectionOutbox).Find(query).Sort("_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&messages)
+
+	if err != nil {
+		s.logError("Query to find outbox messages failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = outboxErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionOutbox).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count outbox messages failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = outboxErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = messages
+
+	return nil
+}
+
+// ReplayOutboxMessages schedule undelivered messages to publish again. If identifiers of messages
+// not passed in request then all failed messages will be scheduled
+func (s *Service) ReplayOutboxMessages(
+	ctx context.Context,
+	req *grpc.ReplayOutboxMessagesRequest,
+	rsp *grpc.ReplayOutboxMessagesResponse,
+) error {
+	query := bson.M{"status": pkg.OutboxMessageStatusFailed}
+
+	if len(req.Ids) > 0 {
+		var ids []bson.ObjectId
+
+		for _, id := range req.Ids {
+			if bson.IsObjectIdHex(id) == false {
+				rsp.Status = pkg.ResponseStatusBadData
+				rsp.Message = outboxErrorIdIncorrect
+
+				return nil
+			}
+
+			ids = append(ids, bson.ObjectIdHex(id))
+		}
+
+		query = bson.M{"_id": bson.M{"$in": ids}, "status": bson.M{"$ne": pkg.OutboxMessageStatusDelivered}}
+	}
+
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"status":          pkg.OutboxMessageStatusPending,
+			"attempts":        int32(0),
+			"next_attempt_at": now,
+			"updated_at":      now,
+		},
+	}
+	info, err := s.db.Collection(pkg.CollectionOutbox).UpdateAll(query, update)
+
+	if err != nil {
+		s.logError("Query to replay outbox messages failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = outboxErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(info.Updated)
+
+	return nil
+}
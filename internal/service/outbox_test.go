@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type OutboxTestSuite struct {
+	suite.Suite
+	service *Service
+	broker  *mock.BrokerMock
+	order   *billing.Order
+}
+
+func Test_Outbox(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
+
+func (suite *OutboxTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+	cfg.OutboxMaxAttempts = 2
+
+	settings := database.Connection{
+		Host:     cfg.MongoHost,
+		Database: cfg.MongoDatabase,
+		User:     cfg.MongoUser,
+		Password: cfg.MongoPassword,
+	}
+
+	db, err := database.NewDatabase(settings)
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.order = &billing.Order{
+		Id:     bson.NewObjectId().Hex(),
+		Uuid:   bson.NewObjectId().Hex(),
+		Status: constant.OrderStatusPaymentSystemCreate,
+		Project: &billing.ProjectOrder{
+			Id:         bson.NewObjectId().Hex(),
+			MerchantId: bson.NewObjectId().Hex(),
+		},
+	}
+
+	err = db.Collection(pkg.CollectionOrder).Insert(suite.order)
+	assert.NoError(suite.T(), err, "Insert order test data failed")
+
+	suite.broker = mock.NewBrokerMockOk()
+	suite.service = NewBillingService(db, cfg, make(chan bool, 1), nil, nil, nil, suite.broker, nil)
+}
+
+func (suite *OutboxTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *OutboxTestSuite) getOutboxMessages(status int32) []*billing.OutboxMessage {
+	var messages []*billing.OutboxMessage
+	err := suite.service.db.Collection(pkg.CollectionOutbox).Find(bson.M{"status": status}).All(&messages)
+	assert.NoError(suite.T(), err)
+
+	return messages
+}
+
+func (suite *OutboxTestSuite) makeOutboxMessagesOverdue() {
+	_, err := suite.service.db.Collection(pkg.CollectionOutbox).UpdateAll(
+		bson.M{},
+		bson.M{"$set": bson.M{"next_attempt_at": time.Now().Add(-time.Second)}},
+	)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_UpdateOrderWithNotification_Ok() {
+	suite.order.Status = constant.OrderStatusPaymentSystemComplete
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	order, err := suite.service.getOrderById(suite.order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order.Status)
+
+	published := suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName)
+	assert.Len(suite.T(), published, 1)
+	assert.Equal(suite.T(), suite.order.Id, published[0].(*billing.Order).Id)
+
+	messages := suite.getOutboxMessages(pkg.OutboxMessageStatusDelivered)
+	assert.Len(suite.T(), messages, 1)
+	assert.Equal(suite.T(), suite.order.Id, messages[0].Order.Id)
+	assert.NotNil(suite.T(), messages[0].DeliveredAt)
+	assert.Empty(suite.T(), suite.getOutboxMessages(pkg.OutboxMessageStatusPending))
+}
+
+func (suite *OutboxTestSuite) TestOutbox_UpdateOrderWithNotification_BrokerUnavailable_Ok() {
+	suite.broker.SetAvailable(false)
+	suite.order.Status = constant.OrderStatusPaymentSystemComplete
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	order, err := suite.service.getOrderById(suite.order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order.Status)
+
+	messages := suite.getOutboxMessages(pkg.OutboxMessageStatusPending)
+	assert.Len(suite.T(), messages, 1)
+	assert.Equal(suite.T(), int32(1), messages[0].Attempts)
+	assert.Equal(suite.T(), mock.BrokerMockErrorMessage, messages[0].LastError)
+
+	next, err := ptypes.Timestamp(messages[0].NextAttemptAt)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), next.After(time.Now()))
+
+	suite.broker.SetAvailable(true)
+	assert.Equal(suite.T(), 0, suite.service.dispatchOutboxMessages())
+	assert.Empty(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName))
+
+	suite.makeOutboxMessagesOverdue()
+	assert.Equal(suite.T(), 1, suite.service.dispatchOutboxMessages())
+	assert.Len(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName), 1)
+	assert.Len(suite.T(), suite.getOutboxMessages(pkg.OutboxMessageStatusDelivered), 1)
+
+	assert.Equal(suite.T(), 0, suite.service.dispatchOutboxMessages())
+	assert.Len(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName), 1)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_UpdateOrderWithNotification_OrderUpdateFailed_Error() {
+	suite.order.Id = bson.NewObjectId().Hex()
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), orderErrorUnknown, err.Error())
+
+	n, err := suite.service.db.Collection(pkg.CollectionOutbox).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, n)
+	assert.Empty(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName))
+}
+
+func (suite *OutboxTestSuite) TestOutbox_DispatchMessages_OrderNotSaved_Dropped() {
+	// message written by process which crashed before order was updated
+	msg := &billing.OutboxMessage{
+		Id:           bson.NewObjectId().Hex(),
+		Topic:        constant.PayOneTopicNotifyPaymentName,
+		Order:        suite.order,
+		Status:       pkg.OutboxMessageStatusPending,
+		OrderVersion: 1,
+	}
+	msg.NextAttemptAt, _ = ptypes.TimestampProto(time.Now().Add(-time.Second))
+
+	err := suite.service.db.Collection(pkg.CollectionOutbox).Insert(msg)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), 0, suite.service.dispatchOutboxMessages())
+	assert.Empty(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName))
+	assert.Empty(suite.T(), suite.getOutboxMessages(pkg.OutboxMessageStatusPending))
+
+	messages := suite.getOutboxMessages(pkg.OutboxMessageStatusDropped)
+	assert.Len(suite.T(), messages, 1)
+	assert.Equal(suite.T(), outboxErrorOrderNotSaved, messages[0].LastError)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_ListAndReplayFailedMessages_Ok() {
+	suite.broker.SetAvailable(false)
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	suite.makeOutboxMessagesOverdue()
+	assert.Equal(suite.T(), 0, suite.service.dispatchOutboxMessages())
+
+	req := &grpc.ListOutboxMessagesRequest{Status: pkg.OutboxMessageStatusFailed, OrderId: suite.order.Id}
+	rsp := &grpc.ListOutboxMessagesResponse{}
+	err = suite.service.ListOutboxMessages(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(1), rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 1)
+	assert.Equal(suite.T(), int32(2), rsp.Items[0].Attempts)
+
+	suite.makeOutboxMessagesOverdue()
+	suite.broker.SetAvailable(true)
+	assert.Equal(suite.T(), 0, suite.service.dispatchOutboxMessages())
+
+	rsp1 := &grpc.ReplayOutboxMessagesResponse{}
+	err = suite.service.ReplayOutboxMessages(context.TODO(), &grpc.ReplayOutboxMessagesRequest{}, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), int32(1), rsp1.Count)
+
+	assert.Equal(suite.T(), 1, suite.service.dispatchOutboxMessages())
+	assert.Len(suite.T(), suite.broker.GetPublished(constant.PayOneTopicNotifyPaymentName), 1)
+
+	rsp2 := &grpc.ListOutboxMessagesResponse{}
+	err = suite.service.ListOutboxMessages(context.TODO(), req, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(0), rsp2.Count)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_ReplayMessagesById_Ok() {
+	suite.broker.SetAvailable(false)
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	messages := suite.getOutboxMessages(pkg.OutboxMessageStatusPending)
+	assert.Len(suite.T(), messages, 1)
+
+	req := &grpc.ReplayOutboxMessagesRequest{Ids: []string{messages[0].Id}}
+	rsp := &grpc.ReplayOutboxMessagesResponse{}
+	err = suite.service.ReplayOutboxMessages(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(1), rsp.Count)
+
+	suite.broker.SetAvailable(true)
+	assert.Equal(suite.T(), 1, suite.service.dispatchOutboxMessages())
+
+	rsp1 := &grpc.ReplayOutboxMessagesResponse{}
+	err = suite.service.ReplayOutboxMessages(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(0), rsp1.Count)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_ReplayMessages_IncorrectId_Error() {
+	req := &grpc.ReplayOutboxMessagesRequest{Ids: []string{"incorrect_id"}}
+	rsp := &grpc.ReplayOutboxMessagesResponse{}
+	err := suite.service.ReplayOutboxMessages(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), outboxErrorIdIncorrect, rsp.Message)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_GetLastBrokerPublish_Ok() {
+	publish, err := suite.service.GetLastBrokerPublish()
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), publish)
+
+	err = suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	publish, err = suite.service.GetLastBrokerPublish()
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), publish)
+	assert.Empty(suite.T(), publish.Error)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_GetLastBrokerPublish_PublishFailed_Ok() {
+	suite.broker.SetAvailable(false)
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	publish, err := suite.service.GetLastBrokerPublish()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), mock.BrokerMockErrorMessage, publish.Error)
+
+	// result of publishing restored by delivery of message with next attempt
+	suite.broker.SetAvailable(true)
+	suite.makeOutboxMessagesOverdue()
+	assert.Equal(suite.T(), 1, suite.service.dispatchOutboxMessages())
+
+	publish, err = suite.service.GetLastBrokerPublish()
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), publish.Error)
+}
+
+func (suite *OutboxTestSuite) TestOutbox_GetOutboxBackoff() {
+	assert.Equal(suite.T(), outboxBackoffBase, getOutboxBackoff(1))
+	assert.Equal(suite.T(), 2*outboxBackoffBase, getOutboxBackoff(2))
+	assert.Equal(suite.T(), 8*outboxBackoffBase, getOutboxBackoff(4))
+	assert.Equal(suite.T(), outboxBackoffMax, getOutboxBackoff(30))
+}
@@ -1,11 +1,13 @@
 package service
 
 import (
+	"encoding/json"
 	"errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/pkg"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
 )
 
 const (
@@ -13,6 +15,8 @@ const (
 	paymentSystemHandlerMockError = "mock_error"
 
 	paymentSystemErrorHandlerNotFound                        = "handler for specified payment system not found"
+	paymentSystemErrorAdapterIsInvalid                       = "payment system adapter must have handler name and constructor"
+	paymentSystemErrorAdapterAlreadyRegistered               = "payment system adapter with same handler name already registered"
 	paymentSystemErrorAuthenticateFailed                     = "authentication failed"
 	paymentSystemErrorUnknownPaymentMethod                   = "unknown payment method"
 	paymentSystemErrorCreateRequestFailed                    = "order can't be create. try request later"
@@ -26,15 +30,31 @@ const (
 	paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid = "amount or currency from request not match with value in refund"
 	paymentSystemErrorRequestTemporarySkipped                = "notification skipped with temporary status"
 	paymentSystemErrorRecurringFailed                        = "recurring payment failed"
+	paymentSystemErrorCaptureFailed                          = "payment capture failed. try request later"
+	paymentSystemErrorCaptureRejected                        = "payment capture request rejected"
+	paymentSystemErrorVoidFailed                             = "payment void failed. try request later"
+	paymentSystemErrorVoidRejected                           = "payment void request rejected"
+	paymentSystemErrorChargebackAmountOrCurrencyIsInvalid    = "amount or currency from request not match with value in dispute"
+	paymentSystemErrorChargebackDueDateIsInvalid             = "evidence due date in request is invalid"
+	paymentSystemErrorChargebackNotSupported                 = "chargebacks not supported by payment system"
 
 	defaultHttpClientTimeout = 10
 	defaultResponseBodyLimit = 512
 )
 
-var paymentSystemHandlers = map[string]func(*paymentProcessor) PaymentSystem{
-	pkg.PaymentSystemHandlerCardPay: newCardPayHandler,
-	paymentSystemHandlerMockOk:      NewPaymentSystemMockOk,
-	paymentSystemHandlerMockError:   NewPaymentSystemMockError,
+var paymentSystemAdapters = map[string]*paymentSystemAdapter{}
+
+func init() {
+	adapters := []*paymentSystemAdapter{
+		cardPayAdapter,
+		stripeAdapter,
+	}
+
+	for _, adapter := range adapters {
+		if err := registerPaymentSystemAdapter(adapter); err != nil {
+			panic(err)
+		}
+	}
 }
 
 type Error struct {
@@ -54,6 +74,36 @@ type PaymentSystem interface {
 	GetRecurringId(request proto.Message) string
 	CreateRefund(refund *billing.Refund) error
 	ProcessRefund(refund *billing.Refund, message proto.Message, raw, signature string) (err error)
+	// Capture charge amount (full or partial) from payment authorized with order option authorize_only
+	Capture(order *billing.Order, amount float64) error
+	// Void cancel authorization of payment and release funds held on payer account
+	Void(order *billing.Order) error
+	// ProcessChargeback fill dispute with data of chargeback callback and set status of dispute
+	ProcessChargeback(dispute *billing.Dispute, message proto.Message, raw, signature string) error
+}
+
+// paymentSystemAdapter describe integration with payment system. Adapter declare name of handler which
+// equal to value of payment method handler param, constructor of payment system handler, factories of messages
+// to unmarshal callbacks of payment system and function to verify signature of callbacks
+type paymentSystemAdapter struct {
+	handler string
+	new     func(*paymentProcessor) PaymentSystem
+
+	// paymentCallback, refundCallback and chargebackCallback return empty messages to unmarshal body
+	// of callback request
+	paymentCallback    func() proto.Message
+	refundCallback     func() proto.Message
+	chargebackCallback func() proto.Message
+
+	// refundId return identifier of refund in billing from unmarshalled refund callback message
+	refundId func(message proto.Message) (string, bool)
+
+	// chargebackId return identifier of order in billing and identifier of chargeback in payment system
+	// from unmarshalled chargeback callback message
+	chargebackId func(message proto.Message) (string, string, bool)
+
+	// verifySignature check signature of raw callback request with payment method params
+	verifySignature func(params *billing.PaymentMethodParams, raw, signature string) error
 }
 
 type paymentProcessor struct {
@@ -66,15 +116,85 @@ func (s *Service) NewPaymentSystem(
 	cfg *config.PaymentSystemConfig,
 	order *billing.Order,
 ) (PaymentSystem, error) {
-	h, ok := paymentSystemHandlers[order.PaymentMethod.Params.Handler]
+	adapter, err := getPaymentSystemAdapter(order.PaymentMethod.Params.Handler)
+
+	if err != nil {
+		return nil, err
+	}
+
+	processor := &paymentProcessor{cfg: cfg, order: order, service: s}
+
+	return adapter.new(processor), nil
+}
+
+func registerPaymentSystemAdapter(adapter *paymentSystemAdapter) error {
+	if adapter == nil || adapter.handler == "" || adapter.new == nil {
+		return errors.New(paymentSystemErrorAdapterIsInvalid)
+	}
+
+	if _, ok := paymentSystemAdapters[adapter.handler]; ok {
+		return errors.New(paymentSystemErrorAdapterAlreadyRegistered)
+	}
+
+	paymentSystemAdapters[adapter.handler] = adapter
+
+	return nil
+}
+
+func getPaymentSystemAdapter(handler string) (*paymentSystemAdapter, error) {
+	adapter, ok := paymentSystemAdapters[handler]
 
 	if !ok {
 		return nil, errors.New(paymentSystemErrorHandlerNotFound)
 	}
 
-	processor := &paymentProcessor{cfg: cfg, order: order, service: s}
+	return adapter, nil
+}
+
+// unmarshalPaymentCallback return message of payment callback declared by adapter filled with data from raw request
+func (a *paymentSystemAdapter) unmarshalPaymentCallback(raw []byte) (proto.Message, error) {
+	if a.paymentCallback == nil {
+		return nil, errors.New(paymentSystemErrorHandlerNotFound)
+	}
+
+	message := a.paymentCallback()
+
+	if err := json.Unmarshal(raw, message); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// unmarshalRefundCallback return message of refund callback declared by adapter filled with data from raw request
+func (a *paymentSystemAdapter) unmarshalRefundCallback(raw []byte) (proto.Message, error) {
+	if a.refundCallback == nil {
+		return nil, errors.New(paymentSystemErrorHandlerNotFound)
+	}
 
-	return h(processor), nil
+	message := a.refundCallback()
+
+	if err := json.Unmarshal(raw, message); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// unmarshalChargebackCallback return message of chargeback callback declared by adapter filled with data
+// from raw request
+func (a *paymentSystemAdapter) unmarshalChargebackCallback(raw []byte) (proto.Message, error) {
+	if a.chargebackCallback == nil {
+		return nil, errors.New(paymentSystemErrorHandlerNotFound)
+	}
+
+	message := a.chargebackCallback()
+
+	if err := json.Unmarshal(raw, message); err != nil {
+		return nil, err
+	}
+
+	return message, nil
 }
 
 func NewError(text string, status int32) error {
@@ -89,6 +209,65 @@ func (e *Error) Status() int32 {
 	return e.status
 }
 
+// checkCallbackSignature verify signature of callback request with verifier declared by adapter of order's
+// payment system. If adapter not declare verifier then signature not checked
+func (h *paymentProcessor) checkCallbackSignature(raw, signature string) error {
+	adapter, err := getPaymentSystemAdapter(h.order.PaymentMethod.Params.Handler)
+
+	if err != nil {
+		return NewError(err.Error(), pkg.StatusErrorSystem)
+	}
+
+	if adapter.verifySignature == nil {
+		return nil
+	}
+
+	return adapter.verifySignature(h.order.PaymentMethod.Params, raw, signature)
+}
+
+// finishCreatePayment move order to status by result of request to create payment in payment system.
+// Error of request is returned as is, error of status change is returned only for successful request
+func (h *paymentProcessor) finishCreatePayment(err error) error {
+	status, reason := constant.OrderStatusPaymentSystemCreate, ""
+
+	if err != nil {
+		status, reason = constant.OrderStatusPaymentSystemRejectOnCreate, err.Error()
+	}
+
+	sErr := changeOrderStatus(h.order, status, pkg.OrderStatusChangeSourceApi, reason)
+
+	if err == nil && sErr != nil {
+		return NewError(sErr.Error(), pkg.StatusErrorValidation)
+	}
+
+	return err
+}
+
+// rejectPaymentCallback move order to rejected status if payment callback is invalid. Callbacks skipped
+// with temporary status don't change order and order which status can't be rejected stay unchanged
+func (h *paymentProcessor) rejectPaymentCallback(err error) {
+	if err == nil {
+		return
+	}
+
+	if e, ok := err.(*Error); ok && e.Status() == pkg.StatusTemporary {
+		return
+	}
+
+	_ = changeOrderStatus(h.order, constant.OrderStatusPaymentSystemReject, pkg.OrderStatusChangeSourceCallback, err.Error())
+}
+
+// setPaymentCallbackStatus move order to status received in payment callback
+func (h *paymentProcessor) setPaymentCallbackStatus(status int32, reason string) error {
+	err := changeOrderStatus(h.order, status, pkg.OrderStatusChangeSourceCallback, reason)
+
+	if err != nil {
+		return NewError(err.Error(), pkg.StatusErrorValidation)
+	}
+
+	return nil
+}
+
 func (h *paymentProcessor) cutBytes(body []byte, limit int) string {
 	sBody := string(body)
 	r := []rune(sBody)
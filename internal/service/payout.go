@@ -0,0 +1,567 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"time"
+)
+
+const (
+	payoutErrorNotFound         = "payout with specified identifier not found"
+	payoutErrorIdIncorrect      = "payout identifier is incorrect"
+	payoutErrorQueryFailed      = "payouts query failed"
+	payoutErrorStatusNotAllowed = "payout status can't be changed to requested status"
+	payoutErrorCurrencyNotFound = "currency of payout not found"
+	payoutErrorPostLedgerFailed = "post payout to ledger failed"
+	payoutErrorMerchantUpdate   = "update last payout of merchant failed"
+)
+
+var (
+	payoutErrStatusNotAllowed = errors.New(payoutErrorStatusNotAllowed)
+)
+
+type payoutLedgerTotal struct {
+	Id struct {
+		SourceType string `bson:"source_type"`
+		Account    string `bson:"account"`
+		Side       string `bson:"side"`
+		InPeriod   bool   `bson:"in_period"`
+	} `bson:"_id"`
+	Amount float64 `bson:"amount"`
+}
+
+func (s *Service) schedulePayouts() {
+	ticker := time.NewTicker(s.cfg.GetPayoutScheduleInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.createPayouts(time.Now())
+		case <-s.payoutExit:
+			return
+		}
+	}
+}
+
+// createPayouts create payouts for all merchants which payout period is over and return count of created payouts
+func (s *Service) createPayouts(now time.Time) int {
+	var merchants []*billing.Merchant
+	query := bson.M{"banking.currency": bson.M{"$ne": nil}}
+	err := s.db.Collection(pkg.CollectionMerchant).Find(query).All(&merchants)
+
+	if err != nil {
+		s.logError("Query to find merchants for payouts failed", []interface{}{"err", err.Error(), "query", query})
+		return 0
+	}
+
+	created := 0
+
+	for _, merchant := range merchants {
+		payout, err := s.createMerchantPayout(merchant, now)
+
+		if err != nil {
+			s.logError("Create merchant payout failed", []interface{}{"err", err.Error(), "merchant_id", merchant.Id})
+			continue
+		}
+
+		if payout != nil {
+			created++
+		}
+	}
+
+	return created
+}
+
+// createMerchantPayout create draft payout for merchant if payout period of merchant is over. Payout amount is
+// balance of merchant payable account on end of period without amount of payouts which wait for payment and
+// without reserve which held from period turnover. Reserve of previous period released in current payout.
+// Payout isn't created if payout for the same period was created by another instance of service
+func (s *Service) createMerchantPayout(merchant *billing.Merchant, now time.Time) (*billing.Payout, error) {
+	currency := merchant.GetPayoutCurrency()
+
+	if currency == nil {
+		return nil, errors.New(ledgerErrorMerchantCurrencyNotSet)
+	}
+
+	from, err := s.getMerchantPayoutPeriodFrom(merchant)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if now.Sub(from) < s.cfg.GetPayoutPeriod() {
+		return nil, nil
+	}
+
+	var totals []*payoutLedgerTotal
+	query := []bson.M{
+		{
+			"$match": bson.M{
+				"merchant_id":       bson.ObjectIdHex(merchant.Id),
+				"merchant_currency": currency.CodeA3,
+				"created_at":        bson.M{"$lt": now},
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id": bson.M{
+					"source_type": "$source_type",
+					"account":     "$account",
+					"side":        "$side",
+					"in_period":   bson.M{"$gte": []interface{}{"$created_at", from}},
+				},
+				"amount": bson.M{"$sum": "$amount_merchant_currency"},
+			},
+		},
+	}
+
+	err = s.db.Collection(pkg.CollectionLedgerEntry).Pipe(query).All(&totals)
+
+	if err != nil {
+		s.logError("Query to calculate payout amounts failed", []interface{}{"err", err.Error(), "query", query})
+		return nil, errors.New(payoutErrorQueryFailed)
+	}
+
+	pending, err := s.getMerchantPendingPayoutsAmount(merchant.Id, currency.CodeA3)
+
+	if err != nil {
+		return nil, err
+	}
+
+	payout := &billing.Payout{
+		Id:         bson.NewObjectId().Hex(),
+		MerchantId: merchant.Id,
+		Currency:   currency.CodeA3,
+		Status:     pkg.PayoutStatusDraft,
+	}
+	zero := money.Zero(currency.CodeA3)
+	balance, orders, refunds, chargebacks, fees, tax := zero, zero, zero, zero, zero, zero
+
+	for _, v := range totals {
+		amount := money.FromFloat(v.Amount, currency.CodeA3)
+
+		if v.Id.Account == pkg.LedgerAccountMerchantPayable {
+			if v.Id.Side == pkg.LedgerEntrySideCredit {
+				balance, _ = balance.Add(amount)
+			} else {
+				balance, _ = balance.Subtract(amount)
+			}
+		}
+
+		if v.Id.InPeriod == false {
+			continue
+		}
+
+		switch v.Id.SourceType {
+		case pkg.LedgerSourceTypeOrder:
+			if v.Id.Side == pkg.LedgerEntrySideCredit {
+				orders, _ = orders.Add(amount)
+			}
+
+			if v.Id.Account == pkg.LedgerAccountPspRevenue || v.Id.Account == pkg.LedgerAccountPspFxRevenue {
+				fees, _ = fees.Add(amount)
+			}
+
+			if v.Id.Account == pkg.LedgerAccountTaxLiability {
+				tax, _ = tax.Add(amount)
+			}
+		case pkg.LedgerSourceTypeRefund:
+			if v.Id.Side == pkg.LedgerEntrySideDebit {
+				refunds, _ = refunds.Add(amount)
+			}
+
+			if v.Id.Account == pkg.LedgerAccountTaxLiability {
+				tax, _ = tax.Subtract(amount)
+			}
+		case pkg.LedgerSourceTypeDispute:
+			if v.Id.Side == pkg.LedgerEntrySideDebit {
+				chargebacks, _ = chargebacks.Add(amount)
+			}
+
+			if v.Id.Account == pkg.LedgerAccountTaxLiability {
+				tax, _ = tax.Subtract(amount)
+			}
+		}
+	}
+
+	deductions, _ := money.Sum(currency.CodeA3, refunds, chargebacks, fees, tax)
+	turnover, _ := orders.Subtract(deductions)
+	reserve := zero
+
+	if turnover.IsPositive() {
+		reserve = turnover.Multiply(s.cfg.PayoutReservePercent / 100)
+	}
+
+	available, _ := balance.Subtract(pending)
+	previous, _ := available.Subtract(turnover)
+	amount, _ := available.Subtract(reserve)
+
+	payout.OrdersAmount = orders.Float64()
+	payout.RefundsAmount = refunds.Float64()
+	payout.ChargebacksAmount = chargebacks.Float64()
+	payout.FeesAmount = fees.Float64()
+	payout.TaxAmount = tax.Float64()
+	payout.ReserveAmount = reserve.Float64()
+	payout.PreviousBalance = previous.Float64()
+	payout.Amount = amount.Float64()
+
+	if !amount.IsPositive() {
+		return nil, nil
+	}
+
+	payout.PeriodFrom, _ = ptypes.TimestampProto(from)
+	payout.PeriodTo, _ = ptypes.TimestampProto(now)
+	payout.CreatedAt = payout.PeriodTo
+	payout.UpdatedAt = payout.PeriodTo
+
+	err = s.db.Collection(pkg.CollectionPayout).Insert(payout)
+
+	// payout for period was created by another instance of service at the same time
+	if mgo.IsDup(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		s.logError("Query to insert payout failed", []interface{}{"err", err.Error(), "data", payout})
+		return nil, errors.New(payoutErrorQueryFailed)
+	}
+
+	return payout, nil
+}
+
+// getMerchantPayoutPeriodFrom return start of current payout period - end of period of last not failed payout
+// or date of merchant creation if merchant hasn't payouts yet
+func (s *Service) getMerchantPayoutPeriodFrom(merchant *billing.Merchant) (time.Time, error) {
+	payout := &billing.Payout{}
+	query := bson.M{"merchant_id": bson.ObjectIdHex(merchant.Id), "status": bson.M{"$ne": pkg.PayoutStatusFailed}}
+	err := s.db.Collection(pkg.CollectionPayout).Find(query).Sort("-period_to").One(payout)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			s.logError("Query to find last payout failed", []interface{}{"err", err.Error(), "query", query})
+			return time.Time{}, errors.New(payoutErrorQueryFailed)
+		}
+
+		if merchant.CreatedAt == nil {
+			return time.Time{}, nil
+		}
+
+		return ptypes.Timestamp(merchant.CreatedAt)
+	}
+
+	return ptypes.Timestamp(payout.PeriodTo)
+}
+
+// getMerchantPendingPayoutsAmount return amount of payouts which was created but not paid yet
+func (s *Service) getMerchantPendingPayoutsAmount(merchantId, currency string) (money.Money, error) {
+	var res []*struct {
+		Amount float64 `bson:"amount"`
+	}
+
+	total := money.Zero(currency)
+	query := bson.M{
+		"merchant_id": bson.ObjectIdHex(merchantId),
+		"status":      bson.M{"$in": []int32{pkg.PayoutStatusDraft, pkg.PayoutStatusApproved}},
+	}
+
+	err := s.db.Collection(pkg.CollectionPayout).Find(query).Select(bson.M{"amount": 1}).All(&res)
+
+	if err != nil {
+		s.logError("Query to calculate pending payouts amount failed", []interface{}{"err", err.Error(), "query", query})
+		return total, errors.New(payoutErrorQueryFailed)
+	}
+
+	for _, v := range res {
+		total, _ = total.Add(money.FromFloat(v.Amount, currency))
+	}
+
+	return total, nil
+}
+
+func (s *Service) getPayoutById(id string) (*billing.Payout, error) {
+	if bson.IsObjectIdHex(id) == false {
+		return nil, errors.New(payoutErrorIdIncorrect)
+	}
+
+	payout := &billing.Payout{}
+	err := s.db.Collection(pkg.CollectionPayout).FindId(bson.ObjectIdHex(id)).One(payout)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			s.logError("Query to find payout by id failed", []interface{}{"err", err.Error(), "id", id})
+		}
+
+		return nil, errors.New(payoutErrorNotFound)
+	}
+
+	return payout, nil
+}
+
+// updatePayoutStatus save payout with new status only if status of payout wasn't changed by another request
+func (s *Service) updatePayoutStatus(payout *billing.Payout, prevStatus int32) error {
+	payout.UpdatedAt = ptypes.TimestampNow()
+	query := bson.M{"_id": bson.ObjectIdHex(payout.Id), "status": prevStatus}
+	err := s.db.Collection(pkg.CollectionPayout).Update(query, payout)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return payoutErrStatusNotAllowed
+		}
+
+		s.logError("Query to update payout failed", []interface{}{"err", err.Error(), "data", payout})
+		return errors.New(payoutErrorQueryFailed)
+	}
+
+	return nil
+}
+
+// postPayoutLedgerEntries post journal entry for paid payout, payout decrease debt of PSP to merchant
+func (s *Service) postPayoutLedgerEntries(payout *billing.Payout) error {
+	currency, err := s.GetCurrencyByCodeA3(payout.Currency)
+
+	if err != nil {
+		return errors.New(payoutErrorCurrencyNotFound)
+	}
+
+	amount := money.FromFloat(payout.Amount, currency.CodeA3)
+	lines := []*ledgerLine{
+		{account: pkg.LedgerAccountMerchantPayable, side: pkg.LedgerEntrySideDebit, amount: amount},
+		{account: pkg.LedgerAccountPspCash, side: pkg.LedgerEntrySideCredit, amount: amount},
+	}
+
+	return s.postLedgerJournal(pkg.LedgerSourceTypePayout, payout.Id, payout.MerchantId, "", currency, time.Now(), lines)
+}
+
+func (s *Service) updateMerchantLastPayout(payout *billing.Payout) error {
+	merchant, err := s.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(payout.MerchantId)})
+
+	if err != nil {
+		return err
+	}
+
+	merchant, err = s.saveMerchantChanges(merchant, func(merchant *billing.Merchant) error {
+		merchant.LastPayout = &billing.MerchantLastPayout{
+			Date:   payout.PaidAt,
+			Amount: payout.Amount,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New(payoutErrorMerchantUpdate)
+	}
+
+	s.setCachedMerchant(merchant)
+
+	return nil
+}
+
+func (s *Service) ListPayouts(
+	ctx context.Context,
+	req *grpc.ListPayoutsRequest,
+	rsp *grpc.ListPayoutsResponse,
+) error {
+	query := make(bson.M)
+
+	if req.MerchantId != "" {
+		if bson.IsObjectIdHex(req.MerchantId) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = ledgerErrorMerchantIdIncorrect
+
+			return nil
+		}
+
+		query["merchant_id"] = bson.ObjectIdHex(req.MerchantId)
+	}
+
+	if len(req.Status) > 0 {
+		query["status"] = bson.M{"$in": req.Status}
+	}
+
+	var payouts []*billing.Payout
+	err := s.db.Collection(pkg.CollectionPayout).Find(query).Sort("-_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&payouts)
+
+	if err != nil {
+		s.logError("Query to find payouts failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionPayout).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count payouts failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = payouts
+
+	return nil
+}
+
+// ApprovePayout confirm draft payout, only approved payout can be paid
+func (s *Service) ApprovePayout(
+	ctx context.Context,
+	req *grpc.PayoutRequest,
+	rsp *grpc.PayoutResponse,
+) error {
+	payout, err := s.getPayoutById(req.PayoutId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if payout.Status != pkg.PayoutStatusDraft {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = payoutErrorStatusNotAllowed
+
+		return nil
+	}
+
+	payout.Status = pkg.PayoutStatusApproved
+	payout.ApprovedAt = ptypes.TimestampNow()
+
+	return s.finishPayoutOperation(payout, pkg.PayoutStatusDraft, rsp)
+}
+
+// MarkPayoutPaid save result of money transfer to merchant. Repeated request for paid payout with same
+// transaction identifier finish posting payout to ledger and merchant if it was broken early
+func (s *Service) MarkPayoutPaid(
+	ctx context.Context,
+	req *grpc.MarkPayoutPaidRequest,
+	rsp *grpc.PayoutResponse,
+) error {
+	payout, err := s.getPayoutById(req.PayoutId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	repeated := payout.Status == pkg.PayoutStatusPaid && payout.TransactionId == req.TransactionId
+
+	if payout.Status != pkg.PayoutStatusApproved && repeated == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = payoutErrorStatusNotAllowed
+
+		return nil
+	}
+
+	if repeated == false {
+		payout.Status = pkg.PayoutStatusPaid
+		payout.TransactionId = req.TransactionId
+		payout.PaidAt = ptypes.TimestampNow()
+
+		err = s.updatePayoutStatus(payout, pkg.PayoutStatusApproved)
+
+		if err != nil {
+			rsp.Status = getPayoutErrorStatus(err)
+			rsp.Message = err.Error()
+
+			return nil
+		}
+	}
+
+	err = s.postPayoutLedgerEntries(payout)
+
+	if err != nil {
+		s.logError(payoutErrorPostLedgerFailed, []interface{}{"err", err.Error(), "payout_id", payout.Id})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = payoutErrorPostLedgerFailed
+
+		return nil
+	}
+
+	err = s.updateMerchantLastPayout(payout)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = payout
+
+	return nil
+}
+
+// MarkPayoutFailed save failure of money transfer to merchant, amount of failed payout will be included to next payout
+func (s *Service) MarkPayoutFailed(
+	ctx context.Context,
+	req *grpc.MarkPayoutFailedRequest,
+	rsp *grpc.PayoutResponse,
+) error {
+	payout, err := s.getPayoutById(req.PayoutId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	prevStatus := payout.Status
+
+	if prevStatus != pkg.PayoutStatusDraft && prevStatus != pkg.PayoutStatusApproved {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = payoutErrorStatusNotAllowed
+
+		return nil
+	}
+
+	payout.Status = pkg.PayoutStatusFailed
+	payout.FailureReason = req.Reason
+
+	return s.finishPayoutOperation(payout, prevStatus, rsp)
+}
+
+func (s *Service) finishPayoutOperation(payout *billing.Payout, prevStatus int32, rsp *grpc.PayoutResponse) error {
+	err := s.updatePayoutStatus(payout, prevStatus)
+
+	if err != nil {
+		rsp.Status = getPayoutErrorStatus(err)
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = payout
+
+	return nil
+}
+
+func getPayoutErrorStatus(err error) int32 {
+	if err == payoutErrStatusNotAllowed {
+		return pkg.ResponseStatusBadData
+	}
+
+	return pkg.ResponseStatusSystemError
+}
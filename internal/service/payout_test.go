@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type PayoutTestSuite struct {
+	suite.Suite
+	service  *Service
+	merchant *billing.Merchant
+	usd      *billing.Currency
+}
+
+func Test_Payout(t *testing.T) {
+	suite.Run(t, new(PayoutTestSuite))
+}
+
+func (suite *PayoutTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+	cfg.PayoutPeriod = 7 * 86400
+	cfg.PayoutReservePercent = 10
+
+	settings := database.Connection{
+		Host:     cfg.MongoHost,
+		Database: cfg.MongoDatabase,
+		User:     cfg.MongoUser,
+		Password: cfg.MongoPassword,
+	}
+
+	db, err := database.NewDatabase(settings)
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.usd = &billing.Currency{CodeInt: 840, CodeA3: "USD", Name: &billing.Name{Ru: "Доллар США", En: "USA dollar"}}
+
+	createdAt, err := ptypes.TimestampProto(time.Now().Add(-8 * 24 * time.Hour))
+	assert.NoError(suite.T(), err)
+
+	suite.merchant = &billing.Merchant{
+		Id:        bson.NewObjectId().Hex(),
+		Name:      "Unit test",
+		Banking:   &billing.MerchantBanking{Currency: suite.usd},
+		CreatedAt: createdAt,
+	}
+
+	err = db.Collection(pkg.CollectionMerchant).Insert(suite.merchant)
+	assert.NoError(suite.T(), err, "Insert merchant test data failed")
+
+	suite.service = NewBillingService(db, cfg, make(chan bool, 1), nil, nil, nil, mock.NewBrokerMockOk(), nil)
+
+	err = suite.service.ensureIndexes()
+	assert.NoError(suite.T(), err)
+	suite.service.accountingCurrency = suite.usd
+	suite.service.updateCache(func(c *cacheSnapshot) {
+		c.currency = map[string]*billing.Currency{suite.usd.CodeA3: suite.usd}
+	})
+
+	order := &billing.Order{
+		Id:     bson.NewObjectId().Hex(),
+		Uuid:   bson.NewObjectId().Hex(),
+		Status: constant.OrderStatusPaymentSystemComplete,
+		Project: &billing.ProjectOrder{
+			Id:         bson.NewObjectId().Hex(),
+			MerchantId: suite.merchant.Id,
+		},
+		TotalPaymentAmount:          120,
+		PaymentMethodIncomeAmount:   120,
+		PaymentMethodIncomeCurrency: suite.usd,
+		Tax:                         &billing.OrderTax{Amount: 20, Currency: suite.usd.CodeA3},
+		PaymentSystemFeeAmount:      &billing.OrderFeePaymentSystem{AmountPaymentMethodCurrency: 3},
+		PspFeeAmount:                &billing.OrderFeePsp{AmountPaymentMethodCurrency: 6},
+	}
+
+	err = suite.service.postOrderLedgerEntries(order)
+	assert.NoError(suite.T(), err)
+
+	refund := &billing.Refund{Id: bson.NewObjectId().Hex(), Amount: 60, Currency: suite.usd}
+	err = suite.service.postRefundLedgerEntries(refund, order)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *PayoutTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *PayoutTestSuite) getPayouts() []*billing.Payout {
+	var payouts []*billing.Payout
+	err := suite.service.db.Collection(pkg.CollectionPayout).Find(bson.M{}).Sort("_id").All(&payouts)
+	assert.NoError(suite.T(), err)
+
+	return payouts
+}
+
+func (suite *PayoutTestSuite) payPayout(payout *billing.Payout) {
+	rsp := &grpc.PayoutResponse{}
+	err := suite.service.ApprovePayout(context.TODO(), &grpc.PayoutRequest{PayoutId: payout.Id}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	req := &grpc.MarkPayoutPaidRequest{PayoutId: payout.Id, TransactionId: "transaction_id"}
+	rsp1 := &grpc.PayoutResponse{}
+	err = suite.service.MarkPayoutPaid(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+}
+
+func (suite *PayoutTestSuite) TestPayout_CreatePayouts_Ok() {
+	now := time.Now().Add(time.Minute)
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(now))
+
+	payouts := suite.getPayouts()
+	assert.Len(suite.T(), payouts, 1)
+	assert.Equal(suite.T(), suite.merchant.Id, payouts[0].MerchantId)
+	assert.Equal(suite.T(), suite.usd.CodeA3, payouts[0].Currency)
+	assert.Equal(suite.T(), pkg.PayoutStatusDraft, payouts[0].Status)
+	assert.Equal(suite.T(), float64(120), payouts[0].OrdersAmount)
+	assert.Equal(suite.T(), float64(60), payouts[0].RefundsAmount)
+	assert.Equal(suite.T(), float64(6), payouts[0].FeesAmount)
+	assert.Equal(suite.T(), float64(10), payouts[0].TaxAmount)
+	assert.Equal(suite.T(), 4.4, payouts[0].ReserveAmount)
+	assert.Equal(suite.T(), float64(0), payouts[0].PreviousBalance)
+	assert.Equal(suite.T(), 39.6, payouts[0].Amount)
+
+	assert.Equal(suite.T(), 0, suite.service.createPayouts(now.Add(time.Hour)))
+	assert.Len(suite.T(), suite.getPayouts(), 1)
+}
+
+func (suite *PayoutTestSuite) TestPayout_CreatePayouts_CreatedConcurrently_Ok() {
+	// payout for the same period inserted by another instance of service after period was calculated
+	payout := &billing.Payout{
+		Id:         bson.NewObjectId().Hex(),
+		MerchantId: suite.merchant.Id,
+		Currency:   suite.usd.CodeA3,
+		Status:     pkg.PayoutStatusDraft,
+		PeriodFrom: suite.merchant.CreatedAt,
+		PeriodTo:   suite.merchant.CreatedAt,
+	}
+	err := suite.service.db.Collection(pkg.CollectionPayout).Insert(payout)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), 0, suite.service.createPayouts(time.Now().Add(time.Minute)))
+	assert.Len(suite.T(), suite.getPayouts(), 1)
+}
+
+func (suite *PayoutTestSuite) TestPayout_CreatePayouts_PeriodNotOver_Ok() {
+	assert.Equal(suite.T(), 0, suite.service.createPayouts(time.Now().Add(-2*24*time.Hour)))
+	assert.Empty(suite.T(), suite.getPayouts())
+}
+
+func (suite *PayoutTestSuite) TestPayout_MarkPayoutPaid_Ok() {
+	now := time.Now().Add(time.Minute)
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(now))
+
+	payout := suite.getPayouts()[0]
+	suite.payPayout(payout)
+
+	payout = suite.getPayouts()[0]
+	assert.Equal(suite.T(), pkg.PayoutStatusPaid, payout.Status)
+	assert.Equal(suite.T(), "transaction_id", payout.TransactionId)
+	assert.NotNil(suite.T(), payout.ApprovedAt)
+	assert.NotNil(suite.T(), payout.PaidAt)
+
+	merchant, err := suite.service.getMerchantBy(bson.M{"_id": bson.ObjectIdHex(suite.merchant.Id)})
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), merchant.LastPayout)
+	assert.Equal(suite.T(), payout.Amount, merchant.LastPayout.Amount)
+
+	var entries []*billing.LedgerEntry
+	query := bson.M{"source_type": pkg.LedgerSourceTypePayout, "source_id": bson.ObjectIdHex(payout.Id)}
+	err = suite.service.db.Collection(pkg.CollectionLedgerEntry).Find(query).All(&entries)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), entries, 2)
+
+	req := &grpc.GetMerchantBalanceRequest{MerchantId: suite.merchant.Id}
+	rsp := &grpc.GetMerchantBalanceResponse{}
+	err = suite.service.GetMerchantBalance(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4.4, rsp.Item.Balance)
+
+	// reserve of previous period must be released in next payout
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(now.Add(8*24*time.Hour)))
+
+	payouts := suite.getPayouts()
+	assert.Len(suite.T(), payouts, 2)
+	assert.Equal(suite.T(), 4.4, payouts[1].PreviousBalance)
+	assert.Equal(suite.T(), float64(0), payouts[1].ReserveAmount)
+	assert.Equal(suite.T(), 4.4, payouts[1].Amount)
+}
+
+func (suite *PayoutTestSuite) TestPayout_MarkPayoutPaid_Repeated_Ok() {
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(time.Now().Add(time.Minute)))
+
+	payout := suite.getPayouts()[0]
+	suite.payPayout(payout)
+
+	req := &grpc.MarkPayoutPaidRequest{PayoutId: payout.Id, TransactionId: "transaction_id"}
+	rsp := &grpc.PayoutResponse{}
+	err := suite.service.MarkPayoutPaid(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+
+	n, err := suite.service.db.Collection(pkg.CollectionLedgerEntry).
+		Find(bson.M{"source_type": pkg.LedgerSourceTypePayout}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, n)
+
+	req.TransactionId = "another_transaction_id"
+	rsp1 := &grpc.PayoutResponse{}
+	err = suite.service.MarkPayoutPaid(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp1.Status)
+	assert.Equal(suite.T(), payoutErrorStatusNotAllowed, rsp1.Message)
+}
+
+func (suite *PayoutTestSuite) TestPayout_MarkPayoutPaid_NotApproved_Error() {
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(time.Now().Add(time.Minute)))
+
+	req := &grpc.MarkPayoutPaidRequest{PayoutId: suite.getPayouts()[0].Id, TransactionId: "transaction_id"}
+	rsp := &grpc.PayoutResponse{}
+	err := suite.service.MarkPayoutPaid(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), payoutErrorStatusNotAllowed, rsp.Message)
+}
+
+func (suite *PayoutTestSuite) TestPayout_ApprovePayout_NotFound_Error() {
+	rsp := &grpc.PayoutResponse{}
+	err := suite.service.ApprovePayout(context.TODO(), &grpc.PayoutRequest{PayoutId: bson.NewObjectId().Hex()}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), payoutErrorNotFound, rsp.Message)
+}
+
+func (suite *PayoutTestSuite) TestPayout_MarkPayoutFailed_Ok() {
+	now := time.Now().Add(time.Minute)
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(now))
+
+	req := &grpc.MarkPayoutFailedRequest{PayoutId: suite.getPayouts()[0].Id, Reason: "incorrect bank account"}
+	rsp := &grpc.PayoutResponse{}
+	err := suite.service.MarkPayoutFailed(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.PayoutStatusFailed, rsp.Item.Status)
+	assert.Equal(suite.T(), "incorrect bank account", rsp.Item.FailureReason)
+
+	// period of failed payout must be included to next payout
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(now.Add(time.Hour)))
+
+	payouts := suite.getPayouts()
+	assert.Len(suite.T(), payouts, 2)
+	assert.Equal(suite.T(), 39.6, payouts[1].Amount)
+}
+
+func (suite *PayoutTestSuite) TestPayout_ListPayouts_Ok() {
+	assert.Equal(suite.T(), 1, suite.service.createPayouts(time.Now().Add(time.Minute)))
+
+	req := &grpc.ListPayoutsRequest{MerchantId: suite.merchant.Id, Status: []int32{pkg.PayoutStatusDraft}}
+	rsp := &grpc.ListPayoutsResponse{}
+	err := suite.service.ListPayouts(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(1), rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 1)
+
+	req.Status = []int32{pkg.PayoutStatusPaid}
+	rsp1 := &grpc.ListPayoutsResponse{}
+	err = suite.service.ListPayouts(context.TODO(), req, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(0), rsp1.Count)
+	assert.Empty(suite.T(), rsp1.Items)
+}
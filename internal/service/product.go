@@ -66,6 +66,12 @@ func (s *Service) CreateOrUpdateProduct(ctx context.Context, req *grpc.Product,
 		return err
 	}
 
+	for _, plan := range req.Plans {
+		if plan.Id == "" {
+			plan.Id = bson.NewObjectId().Hex()
+		}
+	}
+
 	// Prevent duplicated products (by projectId+sku)
 	dupQuery := bson.M{"project_id": bson.ObjectIdHex(req.ProjectId), "sku": req.Sku, "deleted": false}
 	found, err := s.db.Collection(pkg.CollectionProduct).Find(dupQuery).Count()
@@ -107,6 +113,7 @@ func (s *Service) CreateOrUpdateProduct(ctx context.Context, req *grpc.Product,
 	res.Deleted = req.Deleted
 	res.MerchantId = req.MerchantId
 	res.ProjectId = req.ProjectId
+	res.Plans = req.Plans
 
 	return nil
 }
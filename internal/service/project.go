@@ -31,7 +31,7 @@ func (s *Service) ChangeProject(
 	var project *billing.Project
 	var err error
 
-	if _, ok := s.merchantCache[req.MerchantId]; !ok {
+	if _, ok := s.getCachedMerchant(req.MerchantId); !ok {
 		rsp.Status = pkg.ResponseStatusNotFound
 		rsp.Message = merchantErrorNotFound
 
@@ -57,7 +57,7 @@ func (s *Service) ChangeProject(
 	}
 
 	if req.CallbackCurrency != "" {
-		if _, ok := s.currencyCache[req.CallbackCurrency]; !ok {
+		if _, ok := s.getCache().currency[req.CallbackCurrency]; !ok {
 			rsp.Status = pkg.ResponseStatusBadData
 			rsp.Message = projectErrorCallbackCurrencyIncorrect
 
@@ -66,7 +66,7 @@ func (s *Service) ChangeProject(
 	}
 
 	if req.LimitsCurrency != "" {
-		if _, ok := s.currencyCache[req.LimitsCurrency]; !ok {
+		if _, ok := s.getCache().currency[req.LimitsCurrency]; !ok {
 			rsp.Status = pkg.ResponseStatusBadData
 			rsp.Message = projectErrorLimitCurrencyIncorrect
 
@@ -97,7 +97,7 @@ func (s *Service) ChangeProject(
 	rsp.Status = pkg.ResponseStatusOk
 	rsp.Item = project
 
-	s.updateProjectCache(project)
+	s.setCachedProject(project)
 
 	return nil
 }
@@ -189,6 +189,7 @@ func (s *Service) ListProjects(
 				"signature_required":          "$signature_required",
 				"send_notify_email":           "$send_notify_email",
 				"url_check_account":           "$url_check_account",
+				"check_account_required":      "$check_account_required",
 				"url_process_payment":         "$url_process_payment",
 				"url_redirect_fail":           "$url_redirect_fail",
 				"url_redirect_success":        "$url_redirect_success",
@@ -196,6 +197,9 @@ func (s *Service) ListProjects(
 				"created_at":                  "$created_at",
 				"updated_at":                  "$updated_at",
 				"products_count":              bson.M{"$size": "$products"},
+				"version":                     "$version",
+				"order_expire_grace_period":   "$order_expire_grace_period",
+				"payment_status_grace_period": "$payment_status_grace_period",
 			},
 		},
 		{"$skip": req.Offset},
@@ -249,19 +253,18 @@ func (s *Service) DeleteProject(
 		return nil
 	}
 
-	project.Status = pkg.ProjectStatusDeleted
-	err = s.db.Collection(pkg.CollectionProject).UpdateId(bson.ObjectIdHex(project.Id), project)
+	err = s.saveProjectChanges(project, func(project *billing.Project) {
+		project.Status = pkg.ProjectStatusDeleted
+	})
 
 	if err != nil {
-		s.logError("Query to delete project failed", []interface{}{"err", err.Error(), "data", project})
-
 		rsp.Status = pkg.ResponseStatusSystemError
-		rsp.Message = orderErrorUnknown
+		rsp.Message = err.Error()
 
 		return nil
 	}
 
-	s.updateProjectCache(project)
+	s.setCachedProject(project)
 
 	return nil
 }
@@ -280,6 +283,35 @@ func (s *Service) getProjectBy(query bson.M) (project *billing.Project, err erro
 	return
 }
 
+// saveProjectChanges apply change to project and save it if project wasn't changed by another request
+// after it was read, otherwise project re-read and change applied again
+func (s *Service) saveProjectChanges(project *billing.Project, change func(project *billing.Project)) error {
+	return retryOnVersionConflict(func() error {
+		change(project)
+
+		err := s.updateVersioned(pkg.CollectionProject, project.Id, &project.Version, project)
+
+		if err == nil {
+			return nil
+		}
+
+		if err != errVersionConflict {
+			s.logError("Query to update project failed", []interface{}{"err", err.Error(), "data", project})
+			return errors.New(orderErrorUnknown)
+		}
+
+		actual, err := s.getProjectBy(bson.M{"_id": bson.ObjectIdHex(project.Id)})
+
+		if err != nil {
+			return err
+		}
+
+		*project = *actual
+
+		return errVersionConflict
+	})
+}
+
 func (s *Service) createProject(req *billing.Project) (*billing.Project, error) {
 	project := &billing.Project{
 		Id:                       bson.NewObjectId().Hex(),
@@ -299,6 +331,9 @@ func (s *Service) createProject(req *billing.Project) (*billing.Project, error)
 		SignatureRequired:        req.SignatureRequired,
 		SendNotifyEmail:          req.SendNotifyEmail,
 		UrlCheckAccount:          req.UrlCheckAccount,
+		CheckAccountRequired:     req.CheckAccountRequired,
+		OrderExpireGracePeriod:   req.OrderExpireGracePeriod,
+		PaymentStatusGracePeriod: req.PaymentStatusGracePeriod,
 		UrlProcessPayment:        req.UrlProcessPayment,
 		UrlRedirectFail:          req.UrlRedirectFail,
 		UrlRedirectSuccess:       req.UrlRedirectSuccess,
@@ -314,62 +349,48 @@ func (s *Service) createProject(req *billing.Project) (*billing.Project, error)
 		return nil, errors.New(orderErrorUnknown)
 	}
 
-	// Add payment methods default commissions to created project
-	s.mx.Lock()
-	defer s.mx.Unlock()
-
-	s.commissionCache[project.Id] = make(map[string]*billing.MerchantPaymentMethodCommissions)
-
-	for k := range s.paymentMethodIdCache {
-		s.commissionCache[project.Id][k] = s.getDefaultPaymentMethodCommissions()
-	}
-
 	return project, nil
 }
 
+// updateProject apply changes from request to project and save it. If project was changed by
+// another request then changes applied again to actual project
 func (s *Service) updateProject(req *billing.Project, project *billing.Project) error {
-	project.Name = req.Name
-	project.CallbackCurrency = req.CallbackCurrency
-	project.CreateOrderAllowedUrls = req.CreateOrderAllowedUrls
-	project.AllowDynamicNotifyUrls = req.AllowDynamicNotifyUrls
-	project.AllowDynamicRedirectUrls = req.AllowDynamicRedirectUrls
-	project.LimitsCurrency = req.LimitsCurrency
-	project.MinPaymentAmount = req.MinPaymentAmount
-	project.MaxPaymentAmount = req.MaxPaymentAmount
-	project.NotifyEmails = req.NotifyEmails
-	project.IsProductsCheckout = req.IsProductsCheckout
-	project.SecretKey = req.SecretKey
-	project.SignatureRequired = req.SignatureRequired
-	project.SendNotifyEmail = req.SendNotifyEmail
-	project.UrlRedirectFail = req.UrlRedirectFail
-	project.UrlRedirectSuccess = req.UrlRedirectSuccess
-	project.Status = req.Status
-	project.UpdatedAt = ptypes.TimestampNow()
-
-	if project.NeedChangeStatusToDraft(req) == true {
-		project.Status = pkg.ProjectStatusDraft
-	}
-
-	project.CallbackProtocol = req.CallbackProtocol
-	project.UrlCheckAccount = req.UrlCheckAccount
-	project.UrlProcessPayment = req.UrlProcessPayment
+	err := s.saveProjectChanges(project, func(project *billing.Project) {
+		project.Name = req.Name
+		project.CallbackCurrency = req.CallbackCurrency
+		project.CreateOrderAllowedUrls = req.CreateOrderAllowedUrls
+		project.AllowDynamicNotifyUrls = req.AllowDynamicNotifyUrls
+		project.AllowDynamicRedirectUrls = req.AllowDynamicRedirectUrls
+		project.LimitsCurrency = req.LimitsCurrency
+		project.MinPaymentAmount = req.MinPaymentAmount
+		project.MaxPaymentAmount = req.MaxPaymentAmount
+		project.NotifyEmails = req.NotifyEmails
+		project.IsProductsCheckout = req.IsProductsCheckout
+		project.SecretKey = req.SecretKey
+		project.SignatureRequired = req.SignatureRequired
+		project.SendNotifyEmail = req.SendNotifyEmail
+		project.UrlRedirectFail = req.UrlRedirectFail
+		project.UrlRedirectSuccess = req.UrlRedirectSuccess
+		project.Status = req.Status
+		project.UpdatedAt = ptypes.TimestampNow()
+
+		if project.NeedChangeStatusToDraft(req) == true {
+			project.Status = pkg.ProjectStatusDraft
+		}
 
-	err := s.db.Collection(pkg.CollectionProject).UpdateId(bson.ObjectIdHex(project.Id), project)
+		project.CallbackProtocol = req.CallbackProtocol
+		project.UrlCheckAccount = req.UrlCheckAccount
+		project.CheckAccountRequired = req.CheckAccountRequired
+		project.UrlProcessPayment = req.UrlProcessPayment
+		project.OrderExpireGracePeriod = req.OrderExpireGracePeriod
+		project.PaymentStatusGracePeriod = req.PaymentStatusGracePeriod
+	})
 
 	if err != nil {
-		s.logError("Query to update project failed", []interface{}{"err", err.Error(), "data", project})
-		return errors.New(orderErrorUnknown)
+		return err
 	}
 
 	project.ProductsCount = s.getProductsCountByProject(project.Id)
 
 	return nil
 }
-
-func (s *Service) updateProjectCache(project *billing.Project) {
-	s.mx.Lock()
-	s.projectCache[project.Id] = project
-	s.mx.Unlock()
-
-	return
-}
@@ -274,7 +274,7 @@ func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_NewProject_Ok()
 	assert.Equal(suite.T(), project.IsProductsCheckout, rsp.Item.IsProductsCheckout)
 	assert.Equal(suite.T(), project.Status, rsp.Item.Status)
 
-	cProject, ok := suite.service.projectCache[project.Id]
+	cProject, ok := suite.service.getCachedProject(project.Id)
 	assert.True(suite.T(), ok)
 	assert.Equal(suite.T(), project.Id, cProject.Id)
 	assert.Equal(suite.T(), project.MerchantId, cProject.MerchantId)
@@ -287,10 +287,10 @@ func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_NewProject_Ok()
 	assert.Equal(suite.T(), project.IsProductsCheckout, cProject.IsProductsCheckout)
 	assert.Equal(suite.T(), project.Status, cProject.Status)
 
-	pms, ok := suite.service.commissionCache[project.Id]
+	pms, ok := suite.service.getMerchantPaymentMethods(cProject.MerchantId)
 	assert.True(suite.T(), ok)
 	assert.NotEmpty(suite.T(), pms)
-	assert.Equal(suite.T(), len(pms), len(suite.service.paymentMethodIdCache))
+	assert.Equal(suite.T(), len(pms), len(suite.service.getCache().paymentMethodId))
 }
 
 func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_ExistProject_Ok() {
@@ -327,7 +327,7 @@ func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_ExistProject_Ok
 	assert.Equal(suite.T(), project.IsProductsCheckout, rsp.Item.IsProductsCheckout)
 	assert.Equal(suite.T(), project.Status, rsp.Item.Status)
 
-	cProject, ok := suite.service.projectCache[project.Id]
+	cProject, ok := suite.service.getCachedProject(project.Id)
 	assert.True(suite.T(), ok)
 	assert.Equal(suite.T(), project.Id, cProject.Id)
 	assert.Equal(suite.T(), project.MerchantId, cProject.MerchantId)
@@ -443,7 +443,7 @@ func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_LimitCurrencyNo
 }
 
 func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_MgoInsertError() {
-	suite.service.merchantCache["qwerty"] = suite.merchant
+	suite.service.merchants.set("qwerty", suite.merchant)
 
 	req := &billing.Project{
 		MerchantId:         "qwerty",
@@ -463,7 +463,7 @@ func (suite *ProjectCRUDTestSuite) TestProjectCRUD_ChangeProject_MgoInsertError(
 	assert.Equal(suite.T(), orderErrorUnknown, rsp.Message)
 	assert.Nil(suite.T(), rsp.Item)
 
-	delete(suite.service.merchantCache, "qwerty")
+	suite.service.merchants.invalidate("qwerty")
 }
 
 func (suite *ProjectCRUDTestSuite) TestProjectCRUD_GetProject_Ok() {
@@ -735,7 +735,7 @@ func (suite *ProjectCRUDTestSuite) TestProjectCRUD_DeleteProject_Ok() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), pkg.ProjectStatusDeleted, project.Status)
 
-	project1, ok := suite.service.projectCache[rsp.Item.Id]
+	project1, ok := suite.service.getCachedProject(rsp.Item.Id)
 	assert.True(suite.T(), ok)
 	assert.Equal(suite.T(), project.Status, project1.Status)
 }
@@ -2,14 +2,13 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
-	protobuf "github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
@@ -21,17 +20,34 @@ const (
 	refundErrorPaymentAmountLess = "refund unavailable, because payment amount less than total refunds amount"
 	refundErrorNotFound          = "refund with specified data not found"
 	refundErrorOrderNotFound     = "information about payment for refund with specified data not found"
+	refundErrorAmountEmpty       = "refund amount or refunded order items must be specified"
+	refundErrorItemNotFound      = "refunded item not found in order"
+	refundErrorItemQuantity      = "quantity of refunded item must be greater than zero"
+	refundErrorItemQuantityLess  = "refund unavailable, because ordered quantity of item less than total refunded quantity"
+	refundErrorItemsAmount       = "refund amount not equal to amount of refunded items"
 
 	refundDefaultReasonMask = "Refund by order #%s"
 )
 
+var (
+	// statuses of refunds which money wasn't and won't be returned to customer
+	refundNotCountedStatuses = []int32{
+		pkg.RefundStatusRejected,
+		pkg.RefundStatusPaymentSystemDeclined,
+		pkg.RefundStatusPaymentSystemCanceled,
+	}
+)
+
 type RefundError struct {
 	err    string
 	status int32
 }
 
 type createRefundChecked struct {
-	order *billing.Order
+	order     *billing.Order
+	amount    money.Money
+	taxAmount money.Money
+	items     []*billing.RefundItem
 }
 
 type createRefundProcessor struct {
@@ -45,6 +61,43 @@ func (s *Service) CreateRefund(
 	req *grpc.CreateRefundRequest,
 	rsp *grpc.CreateRefundResponse,
 ) error {
+	var projectId string
+
+	if req.IdempotencyKey != "" {
+		projectId = s.getOrderProjectId(req.OrderId)
+	}
+
+	idempotency, err := s.newIdempotencyRepository(idempotencyActionRefundCreate, projectId, req.IdempotencyKey, req)
+
+	if err == nil {
+		var replayed bool
+		replayed, err = idempotency.acquire(rsp)
+
+		if replayed == true {
+			return nil
+		}
+	}
+
+	if err != nil {
+		rsp.Status = getIdempotencyErrorStatus(err)
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	err = s.createRefund(req, rsp)
+
+	if err != nil {
+		idempotency.release()
+		return err
+	}
+
+	idempotency.finish(rsp.Status, rsp)
+
+	return nil
+}
+
+func (s *Service) createRefund(req *grpc.CreateRefundRequest, rsp *grpc.CreateRefundResponse) error {
 	processor := &createRefundProcessor{
 		service: s,
 		request: req,
@@ -78,13 +131,37 @@ func (s *Service) CreateRefund(
 		return nil
 	}
 
-	err = s.db.Collection(pkg.CollectionRefund).UpdateId(bson.ObjectIdHex(refund.Id), refund)
+	// callback of payment system can be processed before result of refund creation saved,
+	// in this case result of refund creation merged to refund changed by callback
+	err = retryOnVersionConflict(func() error {
+		err := s.updateRefund(refund)
 
-	if err != nil {
-		s.logError("Query to update refund failed", []interface{}{"err", err.Error(), "data", refund})
+		if err != errVersionConflict {
+			return err
+		}
+
+		actual, err := s.getRefundById(refund.Id)
+
+		if err != nil {
+			return err
+		}
+
+		if actual.Status == pkg.RefundStatusCreated {
+			actual.Status = refund.Status
+		}
+
+		if actual.ExternalId == "" {
+			actual.ExternalId = refund.ExternalId
+		}
 
+		*refund = *actual
+
+		return errVersionConflict
+	})
+
+	if err != nil {
 		rsp.Status = pkg.ResponseStatusBadData
-		rsp.Message = orderErrorUnknown
+		rsp.Message = err.Error()
 
 		return nil
 	}
@@ -160,39 +237,56 @@ func (s *Service) ProcessRefundCallback(
 	req *grpc.CallbackRequest,
 	rsp *grpc.PaymentNotifyResponse,
 ) error {
-	var data protobuf.Message
-	var refundId string
-	var refund *billing.Refund
+	// refund can be changed by concurrent request after it was read, in this case
+	// callback processed again with actual refund
+	err := retryOnVersionConflict(func() error {
+		rsp.Reset()
+		return s.processRefundCallback(req, rsp)
+	})
+
+	if err == errVersionConflict {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Error = err.Error()
 
-	switch req.Handler {
-	case pkg.PaymentSystemHandlerCardPay:
-		data = &billing.CardPayRefundCallback{}
-		err := json.Unmarshal(req.Body, &data)
+		return nil
+	}
 
-		if err != nil ||
-			data.(*billing.CardPayRefundCallback).RefundData == nil {
-			rsp.Status = pkg.ResponseStatusBadData
-			rsp.Error = callbackRequestIncorrect
+	return err
+}
 
-			return nil
-		}
+func (s *Service) processRefundCallback(req *grpc.CallbackRequest, rsp *grpc.PaymentNotifyResponse) error {
+	var refund *billing.Refund
+
+	adapter, err := getPaymentSystemAdapter(req.Handler)
 
-		refundId = data.(*billing.CardPayRefundCallback).MerchantOrder.Id
-		break
-	default:
+	if err != nil || adapter.refundCallback == nil || adapter.refundId == nil {
 		rsp.Status = pkg.ResponseStatusBadData
 		rsp.Error = callbackHandlerIncorrect
 
 		return nil
 	}
 
-	err := s.db.Collection(pkg.CollectionRefund).FindId(bson.ObjectIdHex(refundId)).One(&refund)
+	data, err := adapter.unmarshalRefundCallback(req.Body)
 
-	if err != nil || refund == nil {
-		if err != nil && err != mgo.ErrNotFound {
-			s.logError("Query to find refund by id failed", []interface{}{"err", err.Error(), "id", refundId})
-		}
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Error = callbackRequestIncorrect
+
+		return nil
+	}
+
+	refundId, ok := adapter.refundId(data)
 
+	if !ok || bson.IsObjectIdHex(refundId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Error = callbackRequestIncorrect
+
+		return nil
+	}
+
+	refund, err = s.getRefundById(refundId)
+
+	if err != nil {
 		rsp.Status = pkg.ResponseStatusNotFound
 		rsp.Error = refundErrorNotFound
 
@@ -240,31 +334,52 @@ func (s *Service) ProcessRefundCallback(
 		}
 	}
 
-	err = s.db.Collection(pkg.CollectionRefund).UpdateId(bson.ObjectIdHex(refundId), refund)
+	err = s.updateRefund(refund)
 
-	if err != nil {
-		s.logError("Update refund data failed", []interface{}{"err", err.Error(), "refund", refund})
+	if err == errVersionConflict {
+		return err
+	}
 
+	if err != nil {
 		rsp.Error = orderErrorUnknown
 		rsp.Status = pkg.ResponseStatusSystemError
 
 		return nil
 	}
 
-	if pErr == nil {
+	// order status changed only by refund which money was returned to customer
+	if pErr == nil && refund.Status == pkg.RefundStatusCompleted {
+		_ = s.postLedgerEntries(pkg.LedgerSourceTypeRefund, refund.Id)
+
+		// refunds which are still processed by payment system can be declined, so order becomes fully
+		// refunded only by completed refunds
 		processor := &createRefundProcessor{service: s}
-		refundedAmount, _ := processor.getRefundedAmount(order)
+		refundedAmount, _ := processor.getCompletedRefundsAmount(order)
+		status := pkg.OrderStatusRefundPartial
+
+		if refundedAmount.Equals(order.GetPaidMoney()) {
+			status = constant.OrderStatusRefund
+		}
 
-		if refundedAmount == order.PaymentMethodIncomeAmount {
-			order.Status = constant.OrderStatusRefund
-			order.UpdatedAt = ptypes.TimestampNow()
+		if order.Status != status {
+			historyLen := len(order.StatusHistory)
+			err = changeOrderStatus(order, status, pkg.OrderStatusChangeSourceCallback, "refund "+refund.Id)
 
-			err = s.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+			if err == nil {
+				order.UpdatedAt = ptypes.TimestampNow()
+				err = s.saveOrderChanges(order, historyLen, s.updateOrder, func(actual *billing.Order) {
+					actual.UpdatedAt = order.UpdatedAt
+				})
+			}
 
 			if err != nil {
 				s.logError("Update order data failed", []interface{}{"err", err.Error(), "order", order})
 			}
 		}
+	}
+
+	if pErr == nil {
+		s.sendRefundWebhook(refund, order)
 
 		rsp.Status = pkg.ResponseStatusOk
 	}
@@ -272,6 +387,37 @@ func (s *Service) ProcessRefundCallback(
 	return nil
 }
 
+func (s *Service) getRefundById(id string) (refund *billing.Refund, err error) {
+	err = s.db.Collection(pkg.CollectionRefund).FindId(bson.ObjectIdHex(id)).One(&refund)
+
+	if err != nil && err != mgo.ErrNotFound {
+		s.logError("Query to find refund by id failed", []interface{}{"err", err.Error(), "id", id})
+	}
+
+	if refund == nil {
+		return refund, errors.New(refundErrorNotFound)
+	}
+
+	return
+}
+
+// updateRefund save refund if it wasn't changed by another request after it was read,
+// otherwise errVersionConflict returned
+func (s *Service) updateRefund(refund *billing.Refund) error {
+	err := s.updateVersioned(pkg.CollectionRefund, refund.Id, &refund.Version, refund)
+
+	if err != nil {
+		if err == errVersionConflict {
+			return err
+		}
+
+		s.logError("Query to update refund failed", []interface{}{"err", err.Error(), "data", refund})
+		return errors.New(orderErrorUnknown)
+	}
+
+	return nil
+}
+
 func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
 	err := p.processOrder()
 
@@ -279,6 +425,12 @@ func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
 		return nil, err
 	}
 
+	err = p.processRefundItems()
+
+	if err != nil {
+		return nil, err
+	}
+
 	err = p.processRefundsByOrder()
 
 	if err != nil {
@@ -293,7 +445,7 @@ func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
 			Id:   order.Id,
 			Uuid: order.Uuid,
 		},
-		Amount:    p.request.Amount,
+		Amount:    p.checked.amount.Float64(),
 		CreatorId: p.request.CreatorId,
 		Reason:    fmt.Sprintf(refundDefaultReasonMask, p.checked.order.Id),
 		Currency:  p.checked.order.PaymentMethodIncomeCurrency,
@@ -305,10 +457,11 @@ func (p *createRefundProcessor) processCreateRefund() (*billing.Refund, error) {
 			Zip:     order.User.Address.PostalCode,
 			State:   order.User.Address.State,
 		},
-	}
-
-	if order.Tax != nil {
-		refund.SalesTax = float32(order.Tax.Amount)
+		SalesTax:  float32(p.checked.taxAmount.Float64()),
+		TaxAmount: p.checked.taxAmount.Float64(),
+		Items:     p.checked.items,
+		// refund amounts converted with same rates as amounts of refunded order
+		CurrencyRates: order.CurrencyRates,
 	}
 
 	if p.request.Reason != "" {
@@ -352,37 +505,226 @@ func (p *createRefundProcessor) processRefundsByOrder() error {
 		return p.service.NewRefundError(err.Error(), pkg.ResponseStatusBadData)
 	}
 
-	if p.checked.order.PaymentMethodIncomeAmount < (refundedAmount + p.request.Amount) {
+	total, err := refundedAmount.Add(p.checked.amount)
+
+	if err != nil {
+		return p.service.NewRefundError(err.Error(), pkg.ResponseStatusBadData)
+	}
+
+	if r, _ := total.Compare(p.checked.order.GetPaidMoney()); r > 0 {
 		return p.service.NewRefundError(refundErrorPaymentAmountLess, pkg.ResponseStatusBadData)
 	}
 
 	return nil
 }
 
-func (p *createRefundProcessor) getRefundedAmount(order *billing.Order) (float64, error) {
-	var res struct {
-		Id     bson.ObjectId `bson:"_id"`
-		Amount float64       `bson:"amount"`
+// processRefundItems calculate refund amount by refunded order items. Amount of item line is share of item cost
+// in cost of all order items, so amounts of all lines in total equal to paid amount. Refund by amount without
+// items is also allowed, in this case refund can't be checked by items of order
+func (p *createRefundProcessor) processRefundItems() error {
+	order := p.checked.order
+	paid := order.GetPaidMoney()
+
+	if len(p.request.Items) <= 0 {
+		if p.request.Amount <= 0 {
+			return p.service.NewRefundError(refundErrorAmountEmpty, pkg.ResponseStatusBadData)
+		}
+
+		p.checked.amount = money.FromFloat(p.request.Amount, paid.Currency())
+		p.checked.taxAmount = getRefundTaxAmount(order, p.checked.amount)
+
+		return nil
+	}
+
+	ordered := make(map[string]int32)
+	items := make(map[string]*billing.OrderItem)
+	itemsAmount := float64(0)
+
+	for _, v := range order.Items {
+		quantity := v.Quantity
+
+		// items of orders created before quantity was added to order item
+		if quantity <= 0 {
+			quantity = 1
+		}
+
+		ordered[v.Id] += quantity
+		items[v.Id] = v
+		itemsAmount += v.Amount * float64(quantity)
+	}
+
+	requested := make(map[string]int32)
+	var ids []string
+
+	for _, v := range p.request.Items {
+		if _, ok := items[v.ItemId]; !ok {
+			return p.service.NewRefundError(refundErrorItemNotFound, pkg.ResponseStatusBadData)
+		}
+
+		if v.Quantity <= 0 {
+			return p.service.NewRefundError(refundErrorItemQuantity, pkg.ResponseStatusBadData)
+		}
+
+		if _, ok := requested[v.ItemId]; !ok {
+			ids = append(ids, v.ItemId)
+		}
+
+		requested[v.ItemId] += v.Quantity
+	}
+
+	refunded, err := p.getRefundedItemsQuantity(order)
+
+	if err != nil {
+		return p.service.NewRefundError(err.Error(), pkg.ResponseStatusBadData)
+	}
+
+	isLastRefund := true
+
+	for id, quantity := range ordered {
+		if requested[id]+refunded[id] > quantity {
+			return p.service.NewRefundError(refundErrorItemQuantityLess, pkg.ResponseStatusBadData)
+		}
+
+		if requested[id]+refunded[id] < quantity {
+			isLastRefund = false
+		}
+	}
+
+	amount := money.Zero(paid.Currency())
+	var amounts []money.Money
+
+	for _, id := range ids {
+		line := &billing.RefundItem{
+			ItemId:   id,
+			Sku:      items[id].Sku,
+			Quantity: requested[id],
+		}
+		lineAmount := money.Zero(paid.Currency())
+
+		if itemsAmount > 0 {
+			lineAmount = paid.Multiply(items[id].Amount * float64(requested[id]) / itemsAmount)
+		}
+
+		amount, _ = amount.Add(lineAmount)
+		amounts = append(amounts, lineAmount)
+		p.checked.items = append(p.checked.items, line)
+	}
+
+	// last refund of order take all not refunded amount to compensate rounding of amounts in previous refunds
+	if isLastRefund == true {
+		refundedAmount, err := p.getRefundedAmount(order)
+
+		if err != nil {
+			return p.service.NewRefundError(err.Error(), pkg.ResponseStatusBadData)
+		}
+
+		rest, _ := paid.Subtract(refundedAmount)
+		diff, _ := rest.Subtract(amount)
+		last := len(amounts) - 1
+		amounts[last], _ = amounts[last].Add(diff)
+		amount = rest
+	}
+
+	if !amount.IsPositive() {
+		return p.service.NewRefundError(refundErrorAmountEmpty, pkg.ResponseStatusBadData)
+	}
+
+	if p.request.Amount > 0 && !money.FromFloat(p.request.Amount, paid.Currency()).Equals(amount) {
+		return p.service.NewRefundError(refundErrorItemsAmount, pkg.ResponseStatusBadData)
+	}
+
+	taxAmount := money.Zero(paid.Currency())
+
+	for i, v := range p.checked.items {
+		tax := getRefundTaxAmount(order, amounts[i])
+		taxAmount, _ = taxAmount.Add(tax)
+
+		v.Amount = amounts[i].Float64()
+		v.TaxAmount = tax.Float64()
+	}
+
+	p.checked.amount = amount
+	p.checked.taxAmount = taxAmount
+
+	return nil
+}
+
+func (p *createRefundProcessor) getRefundedItemsQuantity(order *billing.Order) (map[string]int32, error) {
+	var res []*struct {
+		Id       string `bson:"_id"`
+		Quantity int32  `bson:"quantity"`
 	}
 
 	query := []bson.M{
 		{
 			"$match": bson.M{
-				"status":   bson.M{"$nin": []int32{pkg.RefundStatusRejected}},
+				"status":   bson.M{"$nin": refundNotCountedStatuses},
 				"order.id": bson.ObjectIdHex(order.Id),
 			},
 		},
-		{"$group": bson.M{"_id": "$order.id", "amount": bson.M{"$sum": "$amount"}}},
+		{"$unwind": "$items"},
+		{"$group": bson.M{"_id": "$items.item_id", "quantity": bson.M{"$sum": "$items.quantity"}}},
+	}
+
+	err := p.service.db.Collection(pkg.CollectionRefund).Pipe(query).All(&res)
+
+	if err != nil {
+		p.service.logError("Query to calculate refunded items by order failed", []interface{}{"err", err.Error(), "query", query})
+		return nil, errors.New(orderErrorUnknown)
+	}
+
+	refunded := make(map[string]int32)
+
+	for _, v := range res {
+		refunded[v.Id] = v.Quantity
 	}
 
-	err := p.service.db.Collection(pkg.CollectionRefund).Pipe(query).One(&res)
+	return refunded, nil
+}
+
+// getRefundedAmount return total amount of refunds of order which weren't rejected, declined or canceled. Amounts summed in minor units
+// of payment currency, so total can be compared with paid amount exactly
+func (p *createRefundProcessor) getRefundedAmount(order *billing.Order) (money.Money, error) {
+	return p.getRefundsAmount(order, bson.M{"$nin": refundNotCountedStatuses})
+}
 
-	if err != nil && !p.service.IsDbNotFoundError(err) {
+// getCompletedRefundsAmount return total amount of refunds of order which money was returned to customer
+func (p *createRefundProcessor) getCompletedRefundsAmount(order *billing.Order) (money.Money, error) {
+	return p.getRefundsAmount(order, pkg.RefundStatusCompleted)
+}
+
+func (p *createRefundProcessor) getRefundsAmount(order *billing.Order, status interface{}) (money.Money, error) {
+	var res []*struct {
+		Amount float64 `bson:"amount"`
+	}
+
+	total := money.Zero(order.GetPaidMoney().Currency())
+	query := bson.M{
+		"status":   status,
+		"order.id": bson.ObjectIdHex(order.Id),
+	}
+
+	err := p.service.db.Collection(pkg.CollectionRefund).Find(query).Select(bson.M{"amount": 1}).All(&res)
+
+	if err != nil {
 		p.service.logError("Query to calculate refunded amount by order failed", []interface{}{"err", err.Error(), "query", query})
-		return 0, errors.New(orderErrorUnknown)
+		return total, errors.New(orderErrorUnknown)
+	}
+
+	for _, v := range res {
+		total, _ = total.Add(money.FromFloat(v.Amount, total.Currency()))
+	}
+
+	return total, nil
+}
+
+// getRefundTaxAmount return share of order tax in refunded amount
+func getRefundTaxAmount(order *billing.Order, amount money.Money) money.Money {
+	if order.Tax == nil || order.TotalPaymentAmount <= 0 {
+		return money.Zero(amount.Currency())
 	}
 
-	return res.Amount, nil
+	return money.FromFloat(order.Tax.Amount, amount.Currency()).Multiply(amount.Float64() / order.TotalPaymentAmount)
 }
 
 func (s *Service) NewRefundError(text string, status int32) error {
@@ -427,6 +427,95 @@ func (suite *RefundTestSuite) TestRefund_CreateRefund_Ok() {
 	assert.Equal(suite.T(), pkg.RefundStatusInProgress, refund.Status)
 }
 
+func (suite *RefundTestSuite) TestRefund_UpdateRefund_ChangedConcurrently_Error() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "some_email@unit.com",
+			Ip:    "127.0.0.1",
+			Phone: "123456789",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.pmBankCard.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.NoError(suite.T(), err)
+
+	var order *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
+	assert.NotNil(suite.T(), order)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	order.PaymentMethod.Params.Handler = "mock_ok"
+	order.Tax = &billing.OrderTax{
+		Type:     taxTypeVat,
+		Rate:     20,
+		Amount:   10,
+		Currency: "RUB",
+	}
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+
+	req2 := &grpc.CreateRefundRequest{
+		OrderId:   rsp.Uuid,
+		Amount:    10,
+		CreatorId: bson.NewObjectId().Hex(),
+		Reason:    "unit test",
+	}
+	rsp2 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.Empty(suite.T(), rsp2.Message)
+	assert.NotNil(suite.T(), rsp2.Item)
+	assert.NotEmpty(suite.T(), rsp2.Item.Id)
+	assert.NotEmpty(suite.T(), rsp2.Item.ExternalId)
+	assert.Equal(suite.T(), pkg.RefundStatusInProgress, rsp2.Item.Status)
+
+	stale, err := suite.service.getRefundById(rsp2.Item.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rsp2.Item.Version, stale.Version)
+
+	refund, err := suite.service.getRefundById(rsp2.Item.Id)
+	assert.NoError(suite.T(), err)
+
+	refund.Status = pkg.RefundStatusCompleted
+	err = suite.service.updateRefund(refund)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stale.Version+1, refund.Version)
+
+	stale.Status = pkg.RefundStatusRejected
+	err = suite.service.updateRefund(stale)
+	assert.Equal(suite.T(), errVersionConflict, err)
+
+	refund, err = suite.service.getRefundById(rsp2.Item.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusCompleted, refund.Status)
+}
+
 func (suite *RefundTestSuite) TestRefund_CreateRefund_AmountLess_Error() {
 	req := &billing.OrderCreateRequest{
 		ProjectId:   suite.project.Id,
@@ -1130,6 +1219,10 @@ func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_Ok() {
 	err = suite.service.db.Collection(pkg.CollectionRefund).FindId(bson.ObjectIdHex(rsp2.Item.Id)).One(&refund)
 	assert.NotNil(suite.T(), refund)
 	assert.Equal(suite.T(), pkg.RefundStatusCompleted, refund.Status)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusRefundPartial, order.Status)
 }
 
 func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_UnmarshalError() {
@@ -2007,3 +2100,543 @@ func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_OrderFullyRefunde
 	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
 	assert.Equal(suite.T(), int32(constant.OrderStatusRefund), order.Status)
 }
+
+func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_Declined_OrderNotChanged_Ok() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "some_email@unit.com",
+			Ip:    "127.0.0.1",
+			Phone: "123456789",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.pmBankCard.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.NoError(suite.T(), err)
+
+	var order *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
+	assert.NotNil(suite.T(), order)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	order.PaymentMethod.Params.Handler = "mock_ok"
+	order.Tax = &billing.OrderTax{
+		Type:     taxTypeVat,
+		Rate:     20,
+		Amount:   10,
+		Currency: "RUB",
+	}
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+
+	req2 := &grpc.CreateRefundRequest{
+		OrderId:   rsp.Uuid,
+		Amount:    100,
+		CreatorId: bson.NewObjectId().Hex(),
+		Reason:    "unit test",
+	}
+	rsp2 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.Empty(suite.T(), rsp2.Message)
+
+	order.PaymentMethod.Params.Handler = pkg.PaymentSystemHandlerCardPay
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+
+	refundReq := &billing.CardPayRefundCallback{
+		MerchantOrder: &billing.CardPayMerchantOrder{
+			Id: rsp2.Item.Id,
+		},
+		PaymentMethod: order.PaymentMethod.Group,
+		PaymentData: &billing.CardPayRefundCallbackPaymentData{
+			Id:              rsp2.Item.Id,
+			RemainingAmount: 0,
+		},
+		RefundData: &billing.CardPayRefundCallbackRefundData{
+			Amount:   100,
+			Created:  time.Now().Format(cardPayDateFormat),
+			Id:       bson.NewObjectId().Hex(),
+			Currency: rsp2.Item.Currency.CodeA3,
+			Status:   pkg.CardPayPaymentResponseStatusDeclined,
+			AuthCode: bson.NewObjectId().Hex(),
+			Is_3D:    true,
+			Rrn:      bson.NewObjectId().Hex(),
+		},
+		CallbackTime: time.Now().Format(cardPayDateFormat),
+		Customer: &billing.CardPayCustomer{
+			Email: order.User.Email,
+			Id:    order.User.Email,
+		},
+	}
+
+	b, err := json.Marshal(refundReq)
+	assert.NoError(suite.T(), err)
+
+	hash := sha512.New()
+	hash.Write([]byte(string(b) + order.PaymentMethod.Params.CallbackPassword))
+
+	req3 := &grpc.CallbackRequest{
+		Handler:   pkg.PaymentSystemHandlerCardPay,
+		Body:      b,
+		Signature: hex.EncodeToString(hash.Sum(nil)),
+	}
+	rsp3 := &grpc.PaymentNotifyResponse{}
+	err = suite.service.ProcessRefundCallback(context.TODO(), req3, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp3.Status)
+	assert.Empty(suite.T(), rsp3.Error)
+
+	refund, err := suite.service.getRefundById(rsp2.Item.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusPaymentSystemDeclined, refund.Status)
+
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), order.Status)
+
+	processor := &createRefundProcessor{service: suite.service}
+	refundedAmount, err := processor.getRefundedAmount(order)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), refundedAmount.IsZero())
+}
+
+func (suite *RefundTestSuite) sendRefundCallback(
+	order *billing.Order,
+	refund *billing.Refund,
+	status string,
+) *grpc.PaymentNotifyResponse {
+	refundReq := &billing.CardPayRefundCallback{
+		MerchantOrder: &billing.CardPayMerchantOrder{Id: refund.Id},
+		PaymentMethod: order.PaymentMethod.Group,
+		PaymentData:   &billing.CardPayRefundCallbackPaymentData{Id: refund.Id},
+		RefundData: &billing.CardPayRefundCallbackRefundData{
+			Amount:   refund.Amount,
+			Created:  time.Now().Format(cardPayDateFormat),
+			Id:       bson.NewObjectId().Hex(),
+			Currency: refund.Currency.CodeA3,
+			Status:   status,
+			AuthCode: bson.NewObjectId().Hex(),
+			Is_3D:    true,
+			Rrn:      bson.NewObjectId().Hex(),
+		},
+		CallbackTime: time.Now().Format(cardPayDateFormat),
+		Customer:     &billing.CardPayCustomer{Email: order.User.Email, Id: order.User.Email},
+	}
+
+	b, err := json.Marshal(refundReq)
+	assert.NoError(suite.T(), err)
+
+	hash := sha512.New()
+	hash.Write([]byte(string(b) + order.PaymentMethod.Params.CallbackPassword))
+
+	req := &grpc.CallbackRequest{
+		Handler:   pkg.PaymentSystemHandlerCardPay,
+		Body:      b,
+		Signature: hex.EncodeToString(hash.Sum(nil)),
+	}
+	rsp := &grpc.PaymentNotifyResponse{}
+	err = suite.service.ProcessRefundCallback(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	return rsp
+}
+
+func (suite *RefundTestSuite) TestRefund_ProcessRefundCallback_SiblingDeclined_OrderRefundPartial_Ok() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "some_email@unit.com",
+			Ip:    "127.0.0.1",
+			Phone: "123456789",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.pmBankCard.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            time.Now().AddDate(1, 0, 0).Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.NoError(suite.T(), err)
+
+	var order *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
+	assert.NotNil(suite.T(), order)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	order.PaymentMethod.Params.Handler = "mock_ok"
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.NoError(suite.T(), err)
+
+	var refunds []*billing.Refund
+
+	for i := 0; i < 2; i++ {
+		req2 := &grpc.CreateRefundRequest{
+			OrderId:   rsp.Uuid,
+			Amount:    50,
+			CreatorId: bson.NewObjectId().Hex(),
+			Reason:    "unit test",
+		}
+		rsp2 := &grpc.CreateRefundResponse{}
+		err = suite.service.CreateRefund(context.TODO(), req2, rsp2)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+
+		refunds = append(refunds, rsp2.Item)
+	}
+
+	order.PaymentMethod.Params.Handler = pkg.PaymentSystemHandlerCardPay
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.NoError(suite.T(), err)
+
+	// second refund is still processed by payment system, so order isn't fully refunded
+	rsp3 := suite.sendRefundCallback(order, refunds[0], pkg.CardPayPaymentResponseStatusCompleted)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp3.Status)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusRefundPartial, order.Status)
+
+	rsp3 = suite.sendRefundCallback(order, refunds[1], pkg.CardPayPaymentResponseStatusDeclined)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp3.Status)
+
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.OrderStatusRefundPartial, order.Status)
+	assert.True(suite.T(), order.RefundAllowed())
+
+	processor := &createRefundProcessor{service: suite.service}
+	refunded, err := processor.getCompletedRefundsAmount(order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(50), refunded.Float64())
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_IdempotencyKey_Ok() {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "some_email@unit.com",
+			Ip:    "127.0.0.1",
+			Phone: "123456789",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.pmBankCard.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.NoError(suite.T(), err)
+
+	var order *billing.Order
+	err = suite.service.db.Collection(pkg.CollectionOrder).FindId(bson.ObjectIdHex(rsp.Id)).One(&order)
+	assert.NotNil(suite.T(), order)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	order.PaymentMethod.Params.Handler = "mock_ok"
+	order.Tax = &billing.OrderTax{
+		Type:     taxTypeVat,
+		Rate:     20,
+		Amount:   10,
+		Currency: "RUB",
+	}
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+
+	req2 := &grpc.CreateRefundRequest{
+		OrderId:        rsp.Uuid,
+		Amount:         10,
+		CreatorId:      bson.NewObjectId().Hex(),
+		Reason:         "unit test",
+		IdempotencyKey: bson.NewObjectId().Hex(),
+	}
+	rsp2 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp2.Status)
+	assert.NotNil(suite.T(), rsp2.Item)
+
+	rsp3 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req2, rsp3)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp3.Status)
+	assert.NotNil(suite.T(), rsp3.Item)
+	assert.Equal(suite.T(), rsp2.Item.Id, rsp3.Item.Id)
+
+	n, err := suite.service.db.Collection(pkg.CollectionRefund).Find(bson.M{"order.uuid": rsp.Uuid}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, n)
+
+	req2.Amount = 20
+	rsp4 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req2, rsp4)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusConflict, rsp4.Status)
+	assert.Equal(suite.T(), idempotencyErrorKeyConflict, rsp4.Message)
+	assert.Nil(suite.T(), rsp4.Item)
+}
+
+func (suite *RefundTestSuite) createCompletedOrderWithItems() *billing.Order {
+	req := &billing.OrderCreateRequest{
+		ProjectId:   suite.project.Id,
+		Currency:    "RUB",
+		Amount:      100,
+		Account:     "unit test",
+		Description: "unit test",
+		OrderId:     bson.NewObjectId().Hex(),
+		User: &billing.OrderUser{
+			Email: "some_email@unit.com",
+			Ip:    "127.0.0.1",
+			Phone: "123456789",
+		},
+	}
+
+	rsp := &billing.Order{}
+	err := suite.service.OrderCreateProcess(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+
+	expireYear := time.Now().AddDate(1, 0, 0)
+
+	createPaymentRequest := &grpc.PaymentCreateRequest{
+		Data: map[string]string{
+			pkg.PaymentCreateFieldOrderId:         rsp.Uuid,
+			pkg.PaymentCreateFieldPaymentMethodId: suite.pmBankCard.Id,
+			pkg.PaymentCreateFieldEmail:           "test@unit.unit",
+			pkg.PaymentCreateFieldPan:             "4000000000000002",
+			pkg.PaymentCreateFieldCvv:             "123",
+			pkg.PaymentCreateFieldMonth:           "02",
+			pkg.PaymentCreateFieldYear:            expireYear.Format("2006"),
+			pkg.PaymentCreateFieldHolder:          "Mr. Card Holder",
+		},
+	}
+
+	rsp1 := &grpc.PaymentCreateResponse{}
+	err = suite.service.PaymentCreateProcess(context.TODO(), createPaymentRequest, rsp1)
+	assert.NoError(suite.T(), err)
+
+	order, err := suite.service.getOrderById(rsp.Id)
+	assert.NoError(suite.T(), err)
+
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	order.PaymentMethod.Params.Handler = "mock_ok"
+	order.TotalPaymentAmount = 100
+	order.PaymentMethodIncomeAmount = 100
+	order.Tax = &billing.OrderTax{
+		Type:     taxTypeVat,
+		Rate:     20,
+		Amount:   10,
+		Currency: "RUB",
+	}
+	order.Items = []*billing.OrderItem{
+		{Id: bson.NewObjectId().Hex(), Sku: "sku_1", Name: "item 1", Amount: 30, Currency: "RUB", Quantity: 2},
+		{Id: bson.NewObjectId().Hex(), Sku: "sku_2", Name: "item 2", Amount: 40, Currency: "RUB", Quantity: 1},
+	}
+	err = suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.NoError(suite.T(), err)
+
+	return order
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_ByItems_Ok() {
+	order := suite.createCompletedOrderWithItems()
+
+	req := &grpc.CreateRefundRequest{
+		OrderId:   order.Uuid,
+		CreatorId: bson.NewObjectId().Hex(),
+		Items:     []*grpc.CreateRefundItem{{ItemId: order.Items[0].Id, Quantity: 1}},
+	}
+	rsp := &grpc.CreateRefundResponse{}
+	err := suite.service.CreateRefund(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), float64(30), rsp.Item.Amount)
+	assert.Equal(suite.T(), float64(3), rsp.Item.TaxAmount)
+	assert.Len(suite.T(), rsp.Item.Items, 1)
+	assert.Equal(suite.T(), "sku_1", rsp.Item.Items[0].Sku)
+	assert.Equal(suite.T(), int32(1), rsp.Item.Items[0].Quantity)
+	assert.Equal(suite.T(), float64(3), rsp.Item.Items[0].TaxAmount)
+
+	req1 := &grpc.CreateRefundRequest{
+		OrderId:   order.Uuid,
+		Amount:    70,
+		CreatorId: bson.NewObjectId().Hex(),
+		Items: []*grpc.CreateRefundItem{
+			{ItemId: order.Items[0].Id, Quantity: 1},
+			{ItemId: order.Items[1].Id, Quantity: 1},
+		},
+	}
+	rsp1 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req1, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), float64(70), rsp1.Item.Amount)
+	assert.Equal(suite.T(), float64(7), rsp1.Item.TaxAmount)
+	assert.Len(suite.T(), rsp1.Item.Items, 2)
+
+	req2 := &grpc.CreateRefundRequest{
+		OrderId:   order.Uuid,
+		CreatorId: bson.NewObjectId().Hex(),
+		Items:     []*grpc.CreateRefundItem{{ItemId: order.Items[0].Id, Quantity: 1}},
+	}
+	rsp2 := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req2, rsp2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp2.Status)
+	assert.Equal(suite.T(), refundErrorItemQuantityLess, rsp2.Message)
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_ByItems_LastRefundTakeRest_Ok() {
+	order := suite.createCompletedOrderWithItems()
+	order.Items = []*billing.OrderItem{
+		{Id: bson.NewObjectId().Hex(), Sku: "sku_1", Name: "item 1", Amount: 10, Currency: "RUB", Quantity: 1},
+		{Id: bson.NewObjectId().Hex(), Sku: "sku_2", Name: "item 2", Amount: 10, Currency: "RUB", Quantity: 1},
+		{Id: bson.NewObjectId().Hex(), Sku: "sku_3", Name: "item 3", Amount: 10, Currency: "RUB", Quantity: 1},
+	}
+	err := suite.service.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(order.Id), order)
+	assert.NoError(suite.T(), err)
+
+	expected := []float64{33.33, 33.33, 33.34}
+
+	for i, item := range order.Items {
+		req := &grpc.CreateRefundRequest{
+			OrderId:   order.Uuid,
+			CreatorId: bson.NewObjectId().Hex(),
+			Items:     []*grpc.CreateRefundItem{{ItemId: item.Id, Quantity: 1}},
+		}
+		rsp := &grpc.CreateRefundResponse{}
+		err = suite.service.CreateRefund(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+		assert.Equal(suite.T(), expected[i], rsp.Item.Amount)
+	}
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_RefundedTotalExact_Ok() {
+	order := suite.createCompletedOrderWithItems()
+
+	for _, amount := range []float64{33.33, 33.33, 33.34} {
+		req := &grpc.CreateRefundRequest{OrderId: order.Uuid, Amount: amount, CreatorId: bson.NewObjectId().Hex()}
+		rsp := &grpc.CreateRefundResponse{}
+		err := suite.service.CreateRefund(context.TODO(), req, rsp)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	}
+
+	processor := &createRefundProcessor{service: suite.service}
+	refunded, err := processor.getRefundedAmount(order)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), refunded.Equals(order.GetPaidMoney()))
+	assert.Equal(suite.T(), int64(10000), refunded.Amount())
+
+	req := &grpc.CreateRefundRequest{OrderId: order.Uuid, Amount: 0.01, CreatorId: bson.NewObjectId().Hex()}
+	rsp := &grpc.CreateRefundResponse{}
+	err = suite.service.CreateRefund(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), refundErrorPaymentAmountLess, rsp.Message)
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_ItemNotFound_Error() {
+	order := suite.createCompletedOrderWithItems()
+
+	req := &grpc.CreateRefundRequest{
+		OrderId:   order.Uuid,
+		CreatorId: bson.NewObjectId().Hex(),
+		Items:     []*grpc.CreateRefundItem{{ItemId: bson.NewObjectId().Hex(), Quantity: 1}},
+	}
+	rsp := &grpc.CreateRefundResponse{}
+	err := suite.service.CreateRefund(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), refundErrorItemNotFound, rsp.Message)
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_ItemsAmountMismatch_Error() {
+	order := suite.createCompletedOrderWithItems()
+
+	req := &grpc.CreateRefundRequest{
+		OrderId:   order.Uuid,
+		Amount:    50,
+		CreatorId: bson.NewObjectId().Hex(),
+		Items:     []*grpc.CreateRefundItem{{ItemId: order.Items[1].Id, Quantity: 1}},
+	}
+	rsp := &grpc.CreateRefundResponse{}
+	err := suite.service.CreateRefund(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), refundErrorItemsAmount, rsp.Message)
+}
+
+func (suite *RefundTestSuite) TestRefund_CreateRefund_AmountEmpty_Error() {
+	order := suite.createCompletedOrderWithItems()
+
+	req := &grpc.CreateRefundRequest{OrderId: order.Uuid, CreatorId: bson.NewObjectId().Hex()}
+	rsp := &grpc.CreateRefundResponse{}
+	err := suite.service.CreateRefund(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), refundErrorAmountEmpty, rsp.Message)
+}
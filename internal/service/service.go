@@ -8,10 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/ProtocolONE/geoip-service/pkg/proto"
-	"github.com/ProtocolONE/rabbitmq/pkg"
 	"github.com/centrifugal/gocent"
 	"github.com/globalsign/mgo/bson"
 	"github.com/go-redis/redis"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/internal/config"
 	"github.com/paysuper/paysuper-billing-server/internal/database"
 	"github.com/paysuper/paysuper-billing-server/pkg"
@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -30,6 +31,7 @@ const (
 	errorNotFound                   = "[PAYONE_BILLING] %s not found"
 	errorQueryMask                  = "[PAYONE_BILLING] Query from collection \"%s\" failed"
 	errorAccountingCurrencyNotFound = "[PAYONE_BILLING] Accounting currency not found"
+	errorCurrencyRateBaseNotFound   = "[PAYONE_BILLING] Base currency of cross rates not found"
 
 	errorBbNotFoundMessage = "not found"
 
@@ -57,11 +59,8 @@ var (
 	handlers = map[string]func(*Service) Cacher{
 		pkg.CollectionCurrency:      newCurrencyHandler,
 		pkg.CollectionCountry:       newCountryHandler,
-		pkg.CollectionProject:       newProjectHandler,
 		pkg.CollectionCurrencyRate:  newCurrencyRateHandler,
 		pkg.CollectionPaymentMethod: newPaymentMethodHandler,
-		pkg.CollectionCommission:    newCommissionHandler,
-		pkg.CollectionMerchant:      newMerchantHandler,
 		pkg.CollectionSystemFees:    newSystemFeeHandler,
 	}
 )
@@ -75,32 +74,34 @@ type Service struct {
 	geo              proto.GeoIpService
 	rep              repository.RepositoryService
 	tax              tax_service.TaxService
-	broker           *rabbitmq.Broker
+	broker           Broker
 	centrifugoClient *gocent.Client
 	redis            *redis.Client
+	outboxExit       chan bool
+	payoutExit       chan bool
+	subscriptionExit chan bool
+	cacheEventsExit  chan bool
+	webhookExit      chan bool
+	orderExpireExit  chan bool
 
-	accountingCurrency *billing.Currency
+	ledgerPostingExit chan bool
 
-	currencyCache        map[string]*billing.Currency
-	countryCache         map[string]*billing.Country
-	projectCache         map[string]*billing.Project
-	currencyRateCache    map[int32]map[int32]*billing.CurrencyRate
-	paymentMethodCache   map[string]map[int32]*billing.PaymentMethod
-	paymentMethodIdCache map[string]*billing.PaymentMethod
+	accountingCurrency *billing.Currency
+	currencyRateBase   *billing.Currency
 
-	merchantCache          map[string]*billing.Merchant
-	merchantPaymentMethods map[string]map[string]*billing.MerchantPaymentMethod
+	// snapshot is current *cacheSnapshot of cached collections
+	snapshot atomic.Value
 
-	commissionCache map[string]map[string]*billing.MerchantPaymentMethodCommissions
-	systemFeesCache map[string]map[string]map[string]*billing.SystemFees
+	// projects and merchants are loaded on demand, because count of them isn't limited
+	projects  *lazyCache
+	merchants *lazyCache
 
-	rebuild      bool
-	rebuildError error
+	cacheHealth *cacheHealth
 }
 
 type Cacher interface {
 	getAll() ([]interface{}, error)
-	setCache([]interface{})
+	setCache(*cacheSnapshot, []interface{})
 }
 
 func NewBillingService(
@@ -110,28 +111,50 @@ func NewBillingService(
 	geo proto.GeoIpService,
 	rep repository.RepositoryService,
 	tax tax_service.TaxService,
-	broker *rabbitmq.Broker,
+	broker Broker,
 	redis *redis.Client,
 ) *Service {
-	return &Service{
-		db:     db,
-		cfg:    cfg,
-		exitCh: exitCh,
-		geo:    geo,
-		rep:    rep,
-		tax:    tax,
-		broker: broker,
-		redis:  redis,
+	s := &Service{
+		db:               db,
+		cfg:              cfg,
+		exitCh:           exitCh,
+		geo:              geo,
+		rep:              rep,
+		tax:              tax,
+		broker:           broker,
+		redis:            redis,
+		outboxExit:       make(chan bool, 1),
+		payoutExit:       make(chan bool, 1),
+		subscriptionExit: make(chan bool, 1),
+		cacheEventsExit:  make(chan bool, 1),
+		webhookExit:      make(chan bool, 1),
+		orderExpireExit:  make(chan bool, 1),
+		cacheHealth:      newCacheHealth(),
+
+		ledgerPostingExit: make(chan bool, 1),
 	}
+
+	s.projects = newLazyCache(pkg.CollectionProject, cfg.ProjectCacheSize, newProjectHandler(s))
+	s.merchants = newLazyCache(pkg.CollectionMerchant, cfg.MerchantCacheSize, newMerchantHandler(s))
+
+	return s
 }
 
 func (s *Service) Init() (err error) {
+	// version is read before cache loading, so changes made while cache is loading lead to rebuild
+	cacheVersion := s.getCacheVersion()
 	err = s.initCache()
 
 	if err != nil {
 		return
 	}
 
+	err = s.ensureIndexes()
+
+	if err != nil {
+		return
+	}
+
 	s.centrifugoClient = gocent.New(
 		gocent.Config{
 			Addr:       s.cfg.CentrifugoURL,
@@ -146,11 +169,41 @@ func (s *Service) Init() (err error) {
 		return errors.New(errorAccountingCurrencyNotFound)
 	}
 
+	s.currencyRateBase, err = s.GetCurrencyByCodeA3(s.cfg.GetCurrencyRateBase())
+
+	if err != nil {
+		return errors.New(errorCurrencyRateBaseNotFound)
+	}
+
 	go s.reBuildCache()
+	go s.dispatchOutbox()
+	go s.dispatchWebhooks()
+	go s.schedulePayouts()
+	go s.scheduleSubscriptions()
+	go s.sweepExpiredOrders()
+	go s.dispatchLedger()
+
+	if s.redis != nil {
+		go s.listenCacheInvalidation(s.redis.Subscribe(cacheInvalidationChannel), cacheVersion)
+	}
 
 	return
 }
 
+// Stop stop background workers of service
+func (s *Service) Stop() {
+	s.outboxExit <- true
+	s.webhookExit <- true
+	s.payoutExit <- true
+	s.subscriptionExit <- true
+	s.orderExpireExit <- true
+	s.ledgerPostingExit <- true
+
+	if s.redis != nil {
+		s.cacheEventsExit <- true
+	}
+}
+
 func (s *Service) reBuildCache() {
 	var err error
 	var key string
@@ -160,10 +213,10 @@ func (s *Service) reBuildCache() {
 	projectTicker := time.NewTicker(time.Second * time.Duration(s.cfg.ProjectTimeout))
 	currencyRateTicker := time.NewTicker(time.Second * time.Duration(s.cfg.CurrencyRateTimeout))
 	paymentMethodTicker := time.NewTicker(time.Second * time.Duration(s.cfg.PaymentMethodTimeout))
-	commissionTicker := time.NewTicker(time.Second * time.Duration(s.cfg.CommissionTimeout))
 	systemFeesTimer := time.NewTicker(time.Second * time.Duration(s.cfg.SystemFeesTimeout))
 
-	s.rebuild = true
+	s.cacheHealth.setRebuild(true)
+	defer s.cacheHealth.setRebuild(false)
 
 	for {
 		select {
@@ -174,51 +227,48 @@ func (s *Service) reBuildCache() {
 			err = s.cache(pkg.CollectionCountry, handlers[pkg.CollectionCountry](s))
 			key = pkg.CollectionCountry
 		case <-projectTicker.C:
-			err = s.cache(pkg.CollectionProject, handlers[pkg.CollectionProject](s))
-			key = pkg.CollectionProject
+			s.projects.invalidate("")
+			err, key = nil, pkg.CollectionProject
 		case <-currencyRateTicker.C:
 			err = s.cache(pkg.CollectionCurrencyRate, handlers[pkg.CollectionCurrencyRate](s))
 			key = pkg.CollectionCurrencyRate
 		case <-paymentMethodTicker.C:
 			err = s.cache(pkg.CollectionPaymentMethod, handlers[pkg.CollectionPaymentMethod](s))
 			key = pkg.CollectionPaymentMethod
-		case <-commissionTicker.C:
-			err = s.cache(pkg.CollectionCommission, handlers[pkg.CollectionCommission](s))
-			key = pkg.CollectionCommission
 		case <-systemFeesTimer.C:
-			s.mx.Lock()
-			s.systemFeesCache = make(map[string]map[string]map[string]*billing.SystemFees)
-			s.mx.Unlock()
+			err = s.cache(pkg.CollectionSystemFees, handlers[pkg.CollectionSystemFees](s))
+			key = pkg.CollectionSystemFees
 		case <-s.exitCh:
-			s.rebuild = false
 			return
 		}
 
 		if err != nil {
-			s.rebuild = false
-			s.rebuildError = err
-
 			zap.S().Errorw("Rebuild cache failed", "error", err, "cached_collection", key)
 		}
 	}
 }
 
 func (s *Service) cache(key string, handler Cacher) error {
+	start := time.Now()
 	rec, err := handler.getAll()
+	cacheLoadDuration.WithLabelValues(key).Observe(time.Since(start).Seconds())
+	s.cacheHealth.setLoaded(key, err)
 
 	if err != nil {
 		return err
 	}
 
-	s.mx.Lock()
-	defer s.mx.Unlock()
-
-	handler.setCache(rec)
+	s.updateCache(func(c *cacheSnapshot) {
+		handler.setCache(c, rec)
+	})
 
 	return nil
 }
 
 func (s *Service) initCache() error {
+	s.projects.invalidate("")
+	s.merchants.invalidate("")
+
 	for k, handler := range handlers {
 		err := s.cache(k, handler(s))
 
@@ -238,32 +288,63 @@ func (s *Service) logError(msg string, data []interface{}) {
 	zap.S().Errorw(fmt.Sprintf("[PAYSUPER_BILLING] %s", msg), data...)
 }
 
+// RebuildCache rebuild all caches on all instances of service
 func (s *Service) RebuildCache(ctx context.Context, req *grpc.EmptyRequest, res *grpc.EmptyResponse) error {
+	if err := s.initCache(); err != nil {
+		s.logError("Rebuild cache failed", []interface{}{"err", err.Error()})
+		return err
+	}
+
+	s.publishCacheInvalidation("", "")
+
 	return nil
 }
 
+// UpdateOrder replace order data. Status of order can be changed only by allowed transition and history
+// of status changes can't be overwritten
 func (s *Service) UpdateOrder(ctx context.Context, req *billing.Order, rsp *grpc.EmptyResponse) error {
-	err := s.db.Collection(pkg.CollectionOrder).UpdateId(bson.ObjectIdHex(req.Id), req)
+	order, err := s.getOrderById(req.Id)
 
 	if err != nil {
+		return err
+	}
+
+	status := req.Status
+	req.Status = order.Status
+	req.StatusHistory = order.StatusHistory
+
+	if err = changeOrderStatus(req, status, pkg.OrderStatusChangeSourceAdmin, ""); err != nil {
 		s.logError("Update order failed", []interface{}{"error", err.Error(), "order", req})
+		return err
+	}
+
+	// order saved only if it wasn't changed after version passed in request was read
+	if err = s.updateOrder(req); err == errVersionConflict {
+		return err
 	}
 
 	return nil
 }
 
 func (s *Service) UpdateMerchant(ctx context.Context, req *billing.Merchant, rsp *grpc.EmptyResponse) error {
-	err := s.db.Collection(pkg.CollectionMerchant).UpdateId(bson.ObjectIdHex(req.Id), req)
-
-	if err != nil {
-		s.logError("Update merchant failed", []interface{}{"error", err.Error(), "order", req})
+	// merchant saved only if it wasn't changed after version passed in request was read
+	if err := s.updateMerchant(req); err == errVersionConflict {
+		return err
 	}
 
 	return nil
 }
 
 func (s *Service) GetConvertRate(ctx context.Context, req *grpc.ConvertRateRequest, rsp *grpc.ConvertRateResponse) error {
-	rate, err := s.Convert(req.From, req.To, 1)
+	date := time.Now()
+
+	if req.Date != nil {
+		if t, err := ptypes.Timestamp(req.Date); err == nil {
+			date = t
+		}
+	}
+
+	rate, err := s.Convert(req.From, req.To, 1, date)
 
 	if err != nil {
 		s.logError("Get convert rate failed", []interface{}{"error", err.Error(), "from", req.From, "to", req.To})
@@ -38,7 +38,7 @@ func newGetAllErrorTest(svc *Service) Cacher {
 	return &getAllErrorTest{svc: svc}
 }
 
-func (h *getAllErrorTest) setCache(recs []interface{}) {
+func (h *getAllErrorTest) setCache(c *cacheSnapshot, recs []interface{}) {
 	return
 }
 
@@ -48,6 +48,15 @@ func (h *getAllErrorTest) getAll() (recs []interface{}, err error) {
 	return
 }
 
+// mock adapters accept callbacks without signature, so they registered for tests only
+func init() {
+	for _, adapter := range []*paymentSystemAdapter{paymentSystemMockOkAdapter, paymentSystemMockErrorAdapter} {
+		if err := registerPaymentSystemAdapter(adapter); err != nil {
+			panic(err)
+		}
+	}
+}
+
 func Test_BillingService(t *testing.T) {
 	suite.Run(t, new(BillingServiceTestSuite))
 }
@@ -506,11 +515,13 @@ func (suite *BillingServiceTestSuite) TestNewBillingService() {
 	err := service.Init()
 
 	assert.Nil(suite.T(), err)
-	assert.True(suite.T(), len(service.currencyCache) > 0)
-	assert.True(suite.T(), len(service.projectCache) > 0)
-	assert.True(suite.T(), len(service.currencyRateCache) > 0)
-	assert.True(suite.T(), len(service.paymentMethodCache) > 0)
-	assert.True(suite.T(), len(service.commissionCache) > 0)
+	assert.True(suite.T(), len(service.getCache().currency) > 0)
+	assert.True(suite.T(), len(service.getCache().currencyRate) > 0)
+	assert.True(suite.T(), len(service.getCache().paymentMethod) > 0)
+
+	// projects and merchants are loaded to cache on demand
+	assert.Equal(suite.T(), 0, service.projects.entries.len())
+	assert.Equal(suite.T(), 0, service.merchants.entries.len())
 }
 
 func (suite *BillingServiceTestSuite) TestBillingService_GetAllError() {
@@ -546,7 +557,8 @@ func (suite *BillingServiceTestSuite) TestBillingService_RebuildCacheExit() {
 
 	assert.Nil(suite.T(), err)
 	time.Sleep(time.Second * 1)
-	assert.True(suite.T(), service.rebuild)
+	_, err = service.CheckCacheRebuild()
+	assert.NoError(suite.T(), err)
 
 	tp := time.NewTimer(time.Second * 2)
 	exit := make(chan bool, 1)
@@ -559,8 +571,10 @@ func (suite *BillingServiceTestSuite) TestBillingService_RebuildCacheExit() {
 	<-exit
 
 	time.Sleep(time.Second * 1)
-	assert.False(suite.T(), service.rebuild)
-	assert.Nil(suite.T(), service.rebuildError)
+	_, err = service.CheckCacheRebuild()
+	assert.EqualError(suite.T(), err, healthErrorCacheRebuildStopped)
+	_, err = service.CheckCacheFreshness()
+	assert.NoError(suite.T(), err)
 }
 
 func (suite *BillingServiceTestSuite) TestBillingService_RebuildCacheByTimer() {
@@ -586,15 +600,17 @@ func (suite *BillingServiceTestSuite) TestBillingService_RebuildCacheByTimer() {
 	err = suite.db.Collection(pkg.CollectionCurrency).Insert(c)
 	assert.Nil(suite.T(), err)
 
-	_, ok := service.currencyCache[c.CodeA3]
+	_, ok := service.getCache().currency[c.CodeA3]
 	assert.False(suite.T(), ok)
 
 	time.Sleep(time.Second * time.Duration(cfg.CurrencyTimeout+1))
 
-	_, ok = service.currencyCache[c.CodeA3]
+	_, ok = service.getCache().currency[c.CodeA3]
 	assert.True(suite.T(), ok)
-	assert.True(suite.T(), service.rebuild)
-	assert.Nil(suite.T(), service.rebuildError)
+	_, err = service.CheckCacheRebuild()
+	assert.NoError(suite.T(), err)
+	_, err = service.CheckCacheFreshness()
+	assert.NoError(suite.T(), err)
 }
 
 func (suite *BillingServiceTestSuite) TestBillingService_AccountingCurrencyInitError() {
@@ -677,3 +693,71 @@ func (suite *BillingServiceTestSuite) TestBillingService_CheckProjectRequestSign
 	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
 	assert.Equal(suite.T(), orderErrorSignatureInvalid, rsp.Message)
 }
+
+func (suite *BillingServiceTestSuite) TestBillingService_CacheInvalidation_ReloadEntity() {
+	project, err := suite.service.GetProjectById(suite.project.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ProjectStatusInProduction, project.Status)
+
+	// project changed by other instance of service
+	err = suite.db.Collection(pkg.CollectionProject).UpdateId(
+		bson.ObjectIdHex(suite.project.Id),
+		bson.M{"$set": bson.M{"status": pkg.ProjectStatusDraft}},
+	)
+	assert.NoError(suite.T(), err)
+
+	e := &cacheInvalidationEvent{Collection: pkg.CollectionProject, Id: suite.project.Id, Version: 1}
+	version := suite.service.processCacheInvalidationEvent(e, 0)
+	assert.Equal(suite.T(), int64(1), version)
+
+	project, err = suite.service.GetProjectById(suite.project.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ProjectStatusDraft, project.Status)
+
+	err = suite.db.Collection(pkg.CollectionProject).RemoveId(bson.ObjectIdHex(suite.project.Id))
+	assert.NoError(suite.T(), err)
+
+	e = &cacheInvalidationEvent{Collection: pkg.CollectionProject, Id: suite.project.Id, Version: 2}
+	version = suite.service.processCacheInvalidationEvent(e, version)
+	assert.Equal(suite.T(), int64(2), version)
+
+	_, err = suite.service.GetProjectById(suite.project.Id)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *BillingServiceTestSuite) TestBillingService_CacheInvalidation_MissedEvents_Rebuild() {
+	c := &billing.Currency{
+		CodeInt:  826,
+		CodeA3:   "GBP",
+		Name:     &billing.Name{Ru: "Фунт стерлингов Соединенного королевства", En: "British Pound Sterling"},
+		IsActive: true,
+	}
+
+	err := suite.db.Collection(pkg.CollectionCurrency).Insert(c)
+	assert.NoError(suite.T(), err)
+
+	e := &cacheInvalidationEvent{Collection: pkg.CollectionProject, Id: suite.project.Id, Version: 5}
+	version := suite.service.processCacheInvalidationEvent(e, 3)
+	assert.Equal(suite.T(), int64(5), version)
+
+	_, err = suite.service.GetCurrencyByCodeA3(c.CodeA3)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *BillingServiceTestSuite) TestBillingService_CacheInvalidation_Collection() {
+	c := &billing.Currency{
+		CodeInt:  826,
+		CodeA3:   "GBP",
+		Name:     &billing.Name{Ru: "Фунт стерлингов Соединенного королевства", En: "British Pound Sterling"},
+		IsActive: true,
+	}
+
+	err := suite.db.Collection(pkg.CollectionCurrency).Insert(c)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.applyCacheInvalidation(pkg.CollectionCurrency, "")
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.service.GetCurrencyByCodeA3(c.CodeA3)
+	assert.NoError(suite.T(), err)
+}
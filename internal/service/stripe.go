@@ -0,0 +1,674 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"go.uber.org/zap"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	stripeActionCreatePayment = "create_payment"
+	stripeActionRefund        = "refund"
+	stripeActionCapture       = "capture"
+	stripeActionCancel        = "cancel"
+
+	stripeHeaderIdempotencyKey = "Idempotency-Key"
+
+	stripeMetadataOrderId  = "order_id"
+	stripeMetadataRefundId = "refund_id"
+
+	stripeSignatureTimestampKey = "t"
+	stripeSignatureSchemeKey    = "v1"
+	stripeSignatureTolerance    = 300
+
+	stripeNextActionRedirectToUrl = "redirect_to_url"
+	stripePaymentMethodTypeCard   = "card"
+	stripeCaptureMethodManual     = "manual"
+
+	stripeEventPaymentIntentSucceeded     = "payment_intent.succeeded"
+	stripeEventPaymentIntentPaymentFailed = "payment_intent.payment_failed"
+	stripeEventPaymentIntentCanceled      = "payment_intent.canceled"
+	stripeEventPaymentIntentProcessing    = "payment_intent.processing"
+	stripeEventPaymentIntentCapturable    = "payment_intent.amount_capturable_updated"
+	stripeEventChargeRefundUpdated        = "charge.refund.updated"
+	stripeEventChargeRefunded             = "charge.refunded"
+
+	stripeMaskedPanPrefix = "************"
+
+	paymentSystemErrorRequestOrderIdIsInvalid = "order id from request not match with value in order"
+)
+
+var (
+	stripeAdapter = &paymentSystemAdapter{
+		handler:         pkg.PaymentSystemHandlerStripe,
+		new:             newStripeHandler,
+		paymentCallback: func() proto.Message { return &billing.StripePaymentCallback{} },
+		refundCallback:  func() proto.Message { return &billing.StripeRefundCallback{} },
+		refundId:        stripeRefundId,
+		verifySignature: stripeVerifyCallbackSignature,
+	}
+
+	stripePaths = map[string]*Path{
+		stripeActionCreatePayment: {
+			path:   "/v1/payment_intents",
+			method: http.MethodPost,
+		},
+		stripeActionRefund: {
+			path:   "/v1/refunds",
+			method: http.MethodPost,
+		},
+		stripeActionCapture: {
+			path:   "/v1/payment_intents/%s/capture",
+			method: http.MethodPost,
+		},
+		stripeActionCancel: {
+			path:   "/v1/payment_intents/%s/cancel",
+			method: http.MethodPost,
+		},
+	}
+
+	stripePaymentCallbackAllowedTypes = map[string]bool{
+		stripeEventPaymentIntentSucceeded:     true,
+		stripeEventPaymentIntentPaymentFailed: true,
+		stripeEventPaymentIntentCanceled:      true,
+		stripeEventPaymentIntentProcessing:    true,
+		stripeEventPaymentIntentCapturable:    true,
+	}
+
+	stripeRefundCallbackAllowedTypes = map[string]bool{
+		stripeEventChargeRefundUpdated: true,
+		stripeEventChargeRefunded:      true,
+	}
+)
+
+type stripe struct {
+	processor *paymentProcessor
+}
+
+type StripeRedirectToUrl struct {
+	Url       string `json:"url"`
+	ReturnUrl string `json:"return_url"`
+}
+
+type StripeNextAction struct {
+	Type          string               `json:"type"`
+	RedirectToUrl *StripeRedirectToUrl `json:"redirect_to_url"`
+}
+
+type StripePaymentIntentResponse struct {
+	Id         string            `json:"id"`
+	Status     string            `json:"status"`
+	Amount     int64             `json:"amount"`
+	Currency   string            `json:"currency"`
+	NextAction *StripeNextAction `json:"next_action"`
+}
+
+func newStripeHandler(processor *paymentProcessor) PaymentSystem {
+	return &stripe{processor: processor}
+}
+
+func (h *stripe) CreatePayment(requisites map[string]string) (redirectUrl string, err error) {
+	order := h.processor.order
+
+	if !order.PaymentMethod.IsBankCard() {
+		return "", errors.New(paymentSystemErrorUnknownPaymentMethod)
+	}
+
+	qUrl, err := h.getUrl(stripeActionCreatePayment)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		err = h.processor.finishCreatePayment(err)
+	}()
+
+	data := url.Values{
+		"amount":                                      []string{strconv.FormatInt(money.FromFloat(order.TotalPaymentAmount, order.PaymentMethodOutcomeCurrency.CodeA3).Amount(), 10)},
+		"currency":                                    []string{strings.ToLower(order.PaymentMethodOutcomeCurrency.CodeA3)},
+		"confirm":                                     []string{"true"},
+		"description":                                 []string{order.Description},
+		"payment_method_data[type]":                   []string{stripePaymentMethodTypeCard},
+		"payment_method_data[card][number]":           []string{requisites[pkg.PaymentCreateFieldPan]},
+		"payment_method_data[card][exp_month]":        []string{requisites[pkg.PaymentCreateFieldMonth]},
+		"payment_method_data[card][exp_year]":         []string{requisites[pkg.PaymentCreateFieldYear]},
+		"payment_method_data[card][cvc]":              []string{requisites[pkg.PaymentCreateFieldCvv]},
+		"payment_method_data[billing_details][name]":  []string{strings.ToUpper(requisites[pkg.PaymentCreateFieldHolder])},
+		"payment_method_data[billing_details][email]": []string{order.User.TechEmail},
+		"metadata[" + stripeMetadataOrderId + "]":     []string{order.Id},
+	}
+
+	if order.Project.UrlSuccess != "" {
+		data.Set("return_url", order.Project.UrlSuccess)
+	}
+
+	if order.AuthorizeOnly == true {
+		data.Set("capture_method", stripeCaptureMethodManual)
+	}
+
+	client := &http.Client{Timeout: time.Duration(defaultHttpClientTimeout * time.Second)}
+	req, err := http.NewRequest(stripePaths[stripeActionCreatePayment].method, qUrl, strings.NewReader(data.Encode()))
+
+	if err != nil {
+		return "", err
+	}
+
+	h.addHeaders(req, order.Id)
+
+	resp, err := client.Do(req)
+
+	if err != nil || resp.StatusCode != http.StatusOK {
+		if err != nil {
+			zap.L().Error("[PAYSUPER_BILLING] Stripe create payment failed", zap.Error(err), zap.String("order_id", order.Id))
+		}
+
+		return "", errors.New(paymentSystemErrorCreateRequestFailed)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	b, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return "", err
+	}
+
+	intent := &StripePaymentIntentResponse{}
+
+	if err = json.Unmarshal(b, intent); err != nil {
+		return "", err
+	}
+
+	switch intent.Status {
+	case pkg.StripePaymentIntentStatusRequiresAction:
+		if intent.NextAction == nil || intent.NextAction.Type != stripeNextActionRedirectToUrl ||
+			intent.NextAction.RedirectToUrl == nil {
+			return "", errors.New(paymentSystemErrorCreateRequestFailed)
+		}
+
+		redirectUrl = intent.NextAction.RedirectToUrl.Url
+		break
+	case pkg.StripePaymentIntentStatusSucceeded,
+		pkg.StripePaymentIntentStatusProcessing,
+		pkg.StripePaymentIntentStatusRequiresCapture:
+		redirectUrl = order.Project.UrlSuccess
+		break
+	default:
+		return "", errors.New(paymentSystemErrorCreateRequestFailed)
+	}
+
+	order.PaymentMethodOrderId = intent.Id
+
+	return redirectUrl, nil
+}
+
+func (h *stripe) ProcessPayment(message proto.Message, raw, signature string) (err error) {
+	req := message.(*billing.StripePaymentCallback)
+	order := h.processor.order
+	prevStatus := order.Status
+
+	defer func() {
+		h.processor.rejectPaymentCallback(err)
+	}()
+
+	err = h.processor.checkCallbackSignature(raw, signature)
+
+	if err != nil {
+		return
+	}
+
+	if !stripePaymentCallbackAllowedTypes[req.Type] || req.Data == nil || req.Data.Object == nil {
+		return NewError(paymentSystemErrorRequestStatusIsInvalid, pkg.StatusErrorValidation)
+	}
+
+	intent := req.Data.Object
+
+	if intent.Metadata[stripeMetadataOrderId] != order.Id {
+		return NewError(paymentSystemErrorRequestOrderIdIsInvalid, pkg.StatusErrorValidation)
+	}
+
+	ts, err := ptypes.TimestampProto(time.Unix(req.Created, 0))
+
+	if err != nil || req.Created <= 0 {
+		return NewError(paymentSystemErrorRequestTimeFieldIsInvalid, pkg.StatusErrorValidation)
+	}
+
+	amount := intent.Amount
+
+	// for partially captured payment intent amount in notification is authorized amount,
+	// really charged amount contains in amount_received field
+	if order.CapturedAmount > 0 && intent.AmountReceived > 0 {
+		amount = intent.AmountReceived
+	}
+
+	if amount != order.GetChargeMoney().Amount() ||
+		strings.ToUpper(intent.Currency) != order.PaymentMethodOutcomeCurrency.CodeA3 {
+		return NewError(paymentSystemErrorRequestAmountOrCurrencyIsInvalid, pkg.StatusErrorValidation)
+	}
+
+	params := make(map[string]string)
+
+	if card := stripeCardDetails(intent); card != nil {
+		order.PaymentMethodPayerAccount = stripeMaskedPanPrefix + card.Last4
+		params[pkg.PaymentCreateFieldPan] = order.PaymentMethodPayerAccount
+		params[pkg.TxnParamsFieldBankCardEmissionCountry] = card.Country
+	}
+
+	if intent.LastPaymentError != nil {
+		params[pkg.TxnParamsFieldDeclineCode] = intent.LastPaymentError.DeclineCode
+		params[pkg.TxnParamsFieldDeclineReason] = intent.LastPaymentError.Message
+	}
+
+	order.PaymentMethodTxnParams = params
+
+	var status int32
+
+	switch intent.Status {
+	case pkg.StripePaymentIntentStatusRequiresPaymentMethod:
+		status = constant.OrderStatusPaymentSystemDeclined
+		break
+	case pkg.StripePaymentIntentStatusCanceled:
+		status = constant.OrderStatusPaymentSystemCanceled
+
+		if order.AuthorizeOnly == true {
+			status = pkg.OrderStatusPaymentSystemVoided
+		}
+		break
+	case pkg.StripePaymentIntentStatusSucceeded:
+		status = constant.OrderStatusPaymentSystemComplete
+		break
+	case pkg.StripePaymentIntentStatusRequiresCapture:
+		if order.AuthorizeOnly == false || prevStatus == pkg.OrderStatusPaymentSystemCaptured ||
+			prevStatus == pkg.OrderStatusPaymentSystemVoided {
+			return NewError(paymentSystemErrorRequestTemporarySkipped, pkg.StatusTemporary)
+		}
+
+		status = pkg.OrderStatusPaymentSystemAuthorized
+		break
+	default:
+		return NewError(paymentSystemErrorRequestTemporarySkipped, pkg.StatusTemporary)
+	}
+
+	if err = h.processor.setPaymentCallbackStatus(status, intent.Status); err != nil {
+		return
+	}
+
+	order.PaymentMethodOrderId = intent.Id
+	order.PaymentMethodOrderClosedAt = ts
+	order.PaymentMethodIncomeAmount = money.New(amount, order.PaymentMethodOutcomeCurrency.CodeA3).Float64()
+	order.PaymentMethodIncomeCurrency = order.PaymentMethodOutcomeCurrency
+
+	return
+}
+
+func (h *stripe) IsRecurringCallback(request proto.Message) bool {
+	return false
+}
+
+func (h *stripe) GetRecurringId(request proto.Message) string {
+	return ""
+}
+
+func (h *stripe) CreateRefund(refund *billing.Refund) error {
+	qUrl, err := h.getUrl(stripeActionRefund)
+
+	if err != nil {
+		return err
+	}
+
+	data := url.Values{
+		"payment_intent": []string{h.processor.order.PaymentMethodOrderId},
+		"amount":         []string{strconv.FormatInt(refund.GetMoney().Amount(), 10)},
+		"metadata[" + stripeMetadataRefundId + "]": []string{refund.Id},
+		"metadata[" + stripeMetadataOrderId + "]":  []string{h.processor.order.Id},
+	}
+
+	client := tools.NewLoggedHttpClient(zap.S())
+	req, err := http.NewRequest(stripePaths[stripeActionRefund].method, qUrl, strings.NewReader(data.Encode()))
+
+	if err != nil {
+		h.processor.service.logError(
+			"Refund request building failed",
+			[]interface{}{
+				"error", err.Error(),
+				"handler", pkg.PaymentSystemHandlerStripe,
+				"req", data,
+			},
+		)
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	h.addHeaders(req, refund.Id)
+
+	refund.Status = pkg.RefundStatusRejected
+	resp, err := client.Do(req)
+
+	if err != nil || resp.StatusCode != http.StatusOK {
+		if err != nil {
+			h.processor.service.logError(
+				"Refund request failed",
+				[]interface{}{
+					"error", err.Error(),
+					"handler", pkg.PaymentSystemHandlerStripe,
+					"req", data,
+				},
+			)
+		}
+
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	b, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	rsp := &billing.StripeRefund{}
+	err = json.Unmarshal(b, rsp)
+
+	if err != nil {
+		h.processor.service.logError(
+			"Refund response can't be unmarshal",
+			[]interface{}{
+				"error", err.Error(),
+				"handler", pkg.PaymentSystemHandlerStripe,
+				"req", string(b),
+			},
+		)
+
+		return errors.New(pkg.PaymentSystemErrorCreateRefundFailed)
+	}
+
+	if rsp.Status != pkg.StripeRefundStatusPending && rsp.Status != pkg.StripeRefundStatusSucceeded {
+		return errors.New(pkg.PaymentSystemErrorCreateRefundRejected)
+	}
+
+	refund.Status = pkg.RefundStatusInProgress
+	refund.ExternalId = rsp.Id
+
+	return nil
+}
+
+func (h *stripe) ProcessRefund(refund *billing.Refund, message proto.Message, raw, signature string) (err error) {
+	req := message.(*billing.StripeRefundCallback)
+	refund.Status = pkg.RefundStatusRejected
+
+	err = h.processor.checkCallbackSignature(raw, signature)
+
+	if err != nil {
+		return NewError(err.Error(), pkg.ResponseStatusBadData)
+	}
+
+	if !stripeRefundCallbackAllowedTypes[req.Type] || req.Data == nil || req.Data.Object == nil {
+		return NewError(paymentSystemErrorRequestStatusIsInvalid, pkg.ResponseStatusBadData)
+	}
+
+	obj := req.Data.Object
+
+	if obj.PaymentIntent != h.processor.order.PaymentMethodOrderId {
+		return NewError(paymentSystemErrorRequestOrderIdIsInvalid, pkg.ResponseStatusBadData)
+	}
+
+	if obj.Amount != refund.GetMoney().Amount() || strings.ToUpper(obj.Currency) != refund.Currency.CodeA3 {
+		return NewError(paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid, pkg.ResponseStatusBadData)
+	}
+
+	switch obj.Status {
+	case pkg.StripeRefundStatusFailed:
+		refund.Status = pkg.RefundStatusPaymentSystemDeclined
+		break
+	case pkg.StripeRefundStatusCanceled:
+		refund.Status = pkg.RefundStatusPaymentSystemCanceled
+		break
+	case pkg.StripeRefundStatusSucceeded:
+		refund.Status = pkg.RefundStatusCompleted
+		break
+	default:
+		return NewError(paymentSystemErrorRequestTemporarySkipped, pkg.ResponseStatusTemporary)
+	}
+
+	refund.ExternalId = obj.Id
+	refund.UpdatedAt = ptypes.TimestampNow()
+
+	return
+}
+
+func (h *stripe) Capture(order *billing.Order, amount float64) error {
+	data := url.Values{
+		"amount_to_capture": []string{strconv.FormatInt(money.FromFloat(amount, order.PaymentMethodOutcomeCurrency.CodeA3).Amount(), 10)},
+	}
+
+	intent, err := h.changePaymentIntent(order, stripeActionCapture, data)
+
+	if err != nil {
+		return errors.New(paymentSystemErrorCaptureFailed)
+	}
+
+	if intent.Status != pkg.StripePaymentIntentStatusSucceeded &&
+		intent.Status != pkg.StripePaymentIntentStatusProcessing {
+		return errors.New(paymentSystemErrorCaptureRejected)
+	}
+
+	if err = changeOrderStatus(order, pkg.OrderStatusPaymentSystemCaptured, pkg.OrderStatusChangeSourceApi, ""); err != nil {
+		return err
+	}
+
+	order.CapturedAmount = amount
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	return nil
+}
+
+func (h *stripe) Void(order *billing.Order) error {
+	intent, err := h.changePaymentIntent(order, stripeActionCancel, url.Values{})
+
+	if err != nil {
+		return errors.New(paymentSystemErrorVoidFailed)
+	}
+
+	if intent.Status != pkg.StripePaymentIntentStatusCanceled {
+		return errors.New(paymentSystemErrorVoidRejected)
+	}
+
+	if err = changeOrderStatus(order, pkg.OrderStatusPaymentSystemVoided, pkg.OrderStatusChangeSourceApi, ""); err != nil {
+		return err
+	}
+
+	order.UpdatedAt = ptypes.TimestampNow()
+
+	return nil
+}
+
+func (h *stripe) ProcessChargeback(dispute *billing.Dispute, message proto.Message, raw, signature string) error {
+	return NewError(paymentSystemErrorChargebackNotSupported, pkg.ResponseStatusBadData)
+}
+
+func (h *stripe) changePaymentIntent(
+	order *billing.Order,
+	action string,
+	data url.Values,
+) (*StripePaymentIntentResponse, error) {
+	qUrl, err := h.getUrl(action, order.PaymentMethodOrderId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	client := tools.NewLoggedHttpClient(zap.S())
+	req, err := http.NewRequest(stripePaths[action].method, qUrl, strings.NewReader(data.Encode()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	h.addHeaders(req, order.Id+"-"+action)
+
+	resp, err := client.Do(req)
+
+	if err != nil || resp.StatusCode != http.StatusOK {
+		if err != nil {
+			h.processor.service.logError(
+				"Payment intent request failed",
+				[]interface{}{
+					"error", err.Error(),
+					"handler", pkg.PaymentSystemHandlerStripe,
+					"action", action,
+					"order_id", order.Id,
+				},
+			)
+		} else {
+			err = errors.New(paymentSystemErrorCreateRequestFailed)
+		}
+
+		return nil, err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	b, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	intent := &StripePaymentIntentResponse{}
+
+	if err = json.Unmarshal(b, intent); err != nil {
+		return nil, err
+	}
+
+	return intent, nil
+}
+
+func (h *stripe) getUrl(action string, params ...interface{}) (string, error) {
+	u, err := url.ParseRequestURI(h.processor.cfg.StripeApiUrl)
+
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = stripePaths[action].path
+
+	if len(params) > 0 {
+		u.Path = fmt.Sprintf(u.Path, params...)
+	}
+
+	return u.String(), nil
+}
+
+func (h *stripe) addHeaders(req *http.Request, idempotencyKey string) {
+	req.Header.Add(HeaderContentType, MIMEApplicationForm)
+	req.Header.Add(HeaderAuthorization, "Bearer "+h.processor.order.PaymentMethod.Params.Password)
+	req.Header.Add(stripeHeaderIdempotencyKey, idempotencyKey)
+}
+
+// stripeVerifyCallbackSignature check value of Stripe-Signature header: header contain timestamp of request
+// and one or more HMAC-SHA256 signatures of string "timestamp.raw" signed with webhook secret
+func stripeVerifyCallbackSignature(params *billing.PaymentMethodParams, raw, signature string) error {
+	var timestamp string
+	var signatures []string
+
+	for _, item := range strings.Split(signature, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case stripeSignatureTimestampKey:
+			timestamp = kv[1]
+			break
+		case stripeSignatureSchemeKey:
+			signatures = append(signatures, kv[1])
+			break
+		}
+	}
+
+	t, err := strconv.ParseInt(timestamp, 10, 64)
+
+	if err != nil || len(signatures) <= 0 {
+		return NewError(paymentSystemErrorRequestSignatureIsInvalid, pkg.StatusErrorValidation)
+	}
+
+	if math.Abs(float64(time.Now().Unix()-t)) > stripeSignatureTolerance {
+		return NewError(paymentSystemErrorRequestSignatureIsInvalid, pkg.StatusErrorValidation)
+	}
+
+	expected := stripeSignature(params.CallbackPassword, timestamp, raw)
+
+	for _, v := range signatures {
+		if hmac.Equal([]byte(v), []byte(expected)) {
+			return nil
+		}
+	}
+
+	return NewError(paymentSystemErrorRequestSignatureIsInvalid, pkg.StatusErrorValidation)
+}
+
+func stripeSignature(secret, timestamp, raw string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + raw))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func stripeRefundId(message proto.Message) (string, bool) {
+	req, ok := message.(*billing.StripeRefundCallback)
+
+	if !ok || req.Data == nil || req.Data.Object == nil {
+		return "", false
+	}
+
+	id, ok := req.Data.Object.Metadata[stripeMetadataRefundId]
+
+	return id, ok
+}
+
+func stripeCardDetails(intent *billing.StripePaymentIntent) *billing.StripeCardDetails {
+	if intent.Charges == nil || len(intent.Charges.Data) <= 0 {
+		return nil
+	}
+
+	details := intent.Charges.Data[len(intent.Charges.Data)-1].PaymentMethodDetails
+
+	if details == nil {
+		return nil
+	}
+
+	return details.Card
+}
@@ -0,0 +1,555 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const (
+	stripeTestSecretKey     = "sk_test_secret"
+	stripeTestWebhookSecret = "whsec_test_secret"
+	stripeTestIntentId      = "pi_1EUmy5285d61s2cIUDDd7XEQ"
+	stripeTestRefundId      = "re_1EUn0D285d61s2cI3nVKoCEP"
+	stripeTestRedirectUrl   = "https://hooks.stripe.com/redirect/authenticate/src_1EUmy6285d61s2cI"
+)
+
+type StripeTestSuite struct {
+	suite.Suite
+
+	server  *httptest.Server
+	order   *billing.Order
+	handler PaymentSystem
+
+	createPaymentStatus int
+	createPaymentBody   string
+	refundStatus        int
+	refundBody          string
+	changeStatus        int
+	changeBody          string
+	lastRequest         *http.Request
+}
+
+func Test_Stripe(t *testing.T) {
+	suite.Run(t, new(StripeTestSuite))
+}
+
+func (suite *StripeTestSuite) SetupTest() {
+	suite.lastRequest = nil
+	suite.createPaymentStatus = http.StatusOK
+	suite.createPaymentBody = `{"id":"` + stripeTestIntentId + `","status":"requires_action","amount":10050,` +
+		`"currency":"usd","next_action":{"type":"redirect_to_url","redirect_to_url":{"url":"` +
+		stripeTestRedirectUrl + `"}}}`
+	suite.refundStatus = http.StatusOK
+	suite.refundBody = `{"id":"` + stripeTestRefundId + `","status":"pending","amount":5025,"currency":"usd",` +
+		`"payment_intent":"` + stripeTestIntentId + `"}`
+	suite.changeStatus = http.StatusOK
+	suite.changeBody = `{"id":"` + stripeTestIntentId + `","status":"succeeded","amount":10050,"currency":"usd"}`
+
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(suite.T(), r.ParseForm())
+		suite.lastRequest = r
+
+		switch r.URL.Path {
+		case stripePaths[stripeActionCreatePayment].path:
+			w.WriteHeader(suite.createPaymentStatus)
+			_, _ = w.Write([]byte(suite.createPaymentBody))
+			break
+		case stripePaths[stripeActionRefund].path:
+			w.WriteHeader(suite.refundStatus)
+			_, _ = w.Write([]byte(suite.refundBody))
+			break
+		case fmt.Sprintf(stripePaths[stripeActionCapture].path, stripeTestIntentId),
+			fmt.Sprintf(stripePaths[stripeActionCancel].path, stripeTestIntentId):
+			w.WriteHeader(suite.changeStatus)
+			_, _ = w.Write([]byte(suite.changeBody))
+			break
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	suite.order = &billing.Order{
+		Id:                 bson.NewObjectId().Hex(),
+		Description:        "unit test",
+		TotalPaymentAmount: 100.5,
+		PaymentMethodOutcomeCurrency: &billing.Currency{
+			CodeInt: 840,
+			CodeA3:  "USD",
+		},
+		Project: &billing.ProjectOrder{
+			Id:         bson.NewObjectId().Hex(),
+			UrlSuccess: "https://unit.test/success",
+		},
+		User: &billing.OrderUser{
+			TechEmail: "unit@test.unit",
+		},
+		PaymentMethod: &billing.PaymentMethodOrder{
+			Id:    bson.NewObjectId().Hex(),
+			Group: constant.PaymentSystemGroupAliasBankCard,
+			Params: &billing.PaymentMethodParams{
+				Handler:          pkg.PaymentSystemHandlerStripe,
+				Password:         stripeTestSecretKey,
+				CallbackPassword: stripeTestWebhookSecret,
+				ExternalId:       constant.PaymentSystemGroupAliasBankCard,
+			},
+		},
+	}
+
+	service := &Service{}
+	handler, err := service.NewPaymentSystem(&config.PaymentSystemConfig{StripeApiUrl: suite.server.URL}, suite.order)
+	assert.NoError(suite.T(), err)
+	assert.IsType(suite.T(), &stripe{}, handler)
+
+	suite.handler = handler
+}
+
+func (suite *StripeTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *StripeTestSuite) getPaymentCallback(status string) (*billing.StripePaymentCallback, []byte) {
+	eventType := stripeEventPaymentIntentSucceeded
+
+	if status == pkg.StripePaymentIntentStatusRequiresPaymentMethod {
+		eventType = stripeEventPaymentIntentPaymentFailed
+	}
+
+	req := &billing.StripePaymentCallback{
+		Id:      "evt_1EUmyY285d61s2cIZWrvL7SM",
+		Type:    eventType,
+		Created: time.Now().Unix(),
+		Data: &billing.StripePaymentEventData{
+			Object: &billing.StripePaymentIntent{
+				Id:       stripeTestIntentId,
+				Amount:   10050,
+				Currency: "usd",
+				Status:   status,
+				Metadata: map[string]string{stripeMetadataOrderId: suite.order.Id},
+				Charges: &billing.StripeChargeList{
+					Data: []*billing.StripeCharge{
+						{
+							Id:       "ch_1EUmyY285d61s2cIIz3NaJpa",
+							Amount:   10050,
+							Currency: "usd",
+							Status:   status,
+							PaymentMethodDetails: &billing.StripePaymentMethodDetails{
+								Type: stripePaymentMethodTypeCard,
+								Card: &billing.StripeCardDetails{
+									Brand:   "visa",
+									Country: "US",
+									Last4:   "4242",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	b, err := json.Marshal(req)
+	assert.NoError(suite.T(), err)
+
+	return req, b
+}
+
+func (suite *StripeTestSuite) getSignature(raw []byte) string {
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, stripeSignature(stripeTestWebhookSecret, ts, string(raw)))
+}
+
+func (suite *StripeTestSuite) getRefund() *billing.Refund {
+	return &billing.Refund{
+		Id:       bson.NewObjectId().Hex(),
+		Order:    &billing.RefundOrder{Id: suite.order.Id, Uuid: suite.order.Uuid},
+		Amount:   50.25,
+		Currency: suite.order.PaymentMethodOutcomeCurrency,
+		Status:   pkg.RefundStatusCreated,
+	}
+}
+
+func (suite *StripeTestSuite) TestStripe_Adapter_Registered() {
+	adapter, err := getPaymentSystemAdapter(pkg.PaymentSystemHandlerStripe)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stripeAdapter, adapter)
+	assert.IsType(suite.T(), &billing.StripePaymentCallback{}, adapter.paymentCallback())
+	assert.IsType(suite.T(), &billing.StripeRefundCallback{}, adapter.refundCallback())
+
+	err = registerPaymentSystemAdapter(stripeAdapter)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorAdapterAlreadyRegistered, err.Error())
+
+	err = registerPaymentSystemAdapter(&paymentSystemAdapter{handler: "unit_test"})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorAdapterIsInvalid, err.Error())
+
+	_, err = getPaymentSystemAdapter("unknown_handler")
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorHandlerNotFound, err.Error())
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_Ok() {
+	requisites := map[string]string{
+		pkg.PaymentCreateFieldPan:    "4000000000003063",
+		pkg.PaymentCreateFieldCvv:    "123",
+		pkg.PaymentCreateFieldMonth:  "02",
+		pkg.PaymentCreateFieldYear:   "2030",
+		pkg.PaymentCreateFieldHolder: "Mr. Card Holder",
+	}
+
+	url, err := suite.handler.CreatePayment(requisites)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), stripeTestRedirectUrl, url)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemCreate), suite.order.Status)
+	assert.Equal(suite.T(), stripeTestIntentId, suite.order.PaymentMethodOrderId)
+
+	assert.NotNil(suite.T(), suite.lastRequest)
+	assert.Equal(suite.T(), "Bearer "+stripeTestSecretKey, suite.lastRequest.Header.Get(HeaderAuthorization))
+	assert.Equal(suite.T(), suite.order.Id, suite.lastRequest.Header.Get(stripeHeaderIdempotencyKey))
+	assert.Equal(suite.T(), "10050", suite.lastRequest.PostForm.Get("amount"))
+	assert.Equal(suite.T(), "usd", suite.lastRequest.PostForm.Get("currency"))
+	assert.Equal(suite.T(), "4000000000003063", suite.lastRequest.PostForm.Get("payment_method_data[card][number]"))
+	assert.Equal(suite.T(), suite.order.Id, suite.lastRequest.PostForm.Get("metadata[order_id]"))
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_Succeeded_Ok() {
+	suite.createPaymentBody = `{"id":"` + stripeTestIntentId + `","status":"succeeded","amount":10050,"currency":"usd"}`
+
+	url, err := suite.handler.CreatePayment(map[string]string{})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.order.Project.UrlSuccess, url)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemCreate), suite.order.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_CardDeclined_Error() {
+	suite.createPaymentStatus = http.StatusPaymentRequired
+	suite.createPaymentBody = `{"error":{"code":"card_declined","decline_code":"generic_decline","type":"card_error"}}`
+
+	url, err := suite.handler.CreatePayment(map[string]string{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorCreateRequestFailed, err.Error())
+	assert.Empty(suite.T(), url)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemRejectOnCreate), suite.order.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_NotBankCard_Error() {
+	suite.order.PaymentMethod.Group = constant.PaymentSystemGroupAliasQiwi
+
+	_, err := suite.handler.CreatePayment(map[string]string{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorUnknownPaymentMethod, err.Error())
+	assert.Nil(suite.T(), suite.lastRequest)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Ok() {
+	adapter, err := getPaymentSystemAdapter(suite.order.PaymentMethod.Params.Handler)
+	assert.NoError(suite.T(), err)
+
+	_, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusSucceeded)
+	req, err := adapter.unmarshalPaymentCallback(raw)
+	assert.NoError(suite.T(), err)
+
+	err = suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), suite.order.Status)
+	assert.Equal(suite.T(), stripeTestIntentId, suite.order.PaymentMethodOrderId)
+	assert.Equal(suite.T(), suite.order.TotalPaymentAmount, suite.order.PaymentMethodIncomeAmount)
+	assert.Equal(suite.T(), stripeMaskedPanPrefix+"4242", suite.order.PaymentMethodPayerAccount)
+	assert.Equal(suite.T(), "US", suite.order.PaymentMethodTxnParams[pkg.TxnParamsFieldBankCardEmissionCountry])
+	assert.NotNil(suite.T(), suite.order.PaymentMethodOrderClosedAt)
+	assert.False(suite.T(), suite.handler.IsRecurringCallback(req))
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Declined_Ok() {
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusRequiresPaymentMethod)
+	req.Data.Object.LastPaymentError = &billing.StripeLastPaymentError{
+		Code:        "card_declined",
+		DeclineCode: "insufficient_funds",
+		Message:     "Your card has insufficient funds.",
+	}
+	raw, _ = json.Marshal(req)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemDeclined), suite.order.Status)
+	assert.Equal(suite.T(), "insufficient_funds", suite.order.PaymentMethodTxnParams[pkg.TxnParamsFieldDeclineCode])
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_LateCallback_Error() {
+	suite.order.Status = constant.OrderStatusPaymentSystemComplete
+
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusRequiresPaymentMethod)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusErrorValidation, err.(*Error).Status())
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), suite.order.Status)
+	assert.Empty(suite.T(), suite.order.StatusHistory)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_StatusHistory_Ok() {
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusSucceeded)
+
+	err := suite.handler.ProcessPayment(req, string(raw), "")
+	assert.Error(suite.T(), err)
+
+	err = suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.NoError(suite.T(), err)
+
+	assert.Len(suite.T(), suite.order.StatusHistory, 2)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemReject), suite.order.StatusHistory[0].To)
+	assert.Equal(suite.T(), paymentSystemErrorRequestSignatureIsInvalid, suite.order.StatusHistory[0].Reason)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemReject), suite.order.StatusHistory[1].From)
+	assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemComplete), suite.order.StatusHistory[1].To)
+	assert.Equal(suite.T(), pkg.OrderStatusChangeSourceCallback, suite.order.StatusHistory[1].Source)
+	assert.Equal(suite.T(), pkg.StripePaymentIntentStatusSucceeded, suite.order.StatusHistory[1].Reason)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Processing_Temporary() {
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusProcessing)
+	req.Type = stripeEventPaymentIntentProcessing
+	raw, _ = json.Marshal(req)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusTemporary, err.(*Error).Status())
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_SignatureInvalid_Error() {
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusSucceeded)
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+
+	signatures := []string{
+		"",
+		"v1=" + stripeSignature(stripeTestWebhookSecret, ts, string(raw)),
+		"t=" + ts + ",v1=" + stripeSignature("wrong_secret", ts, string(raw)),
+		"t=" + ts + ",v1=" + stripeSignature(stripeTestWebhookSecret, ts, string(raw)+" "),
+		suite.getSignature(raw)[2:],
+	}
+
+	past := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	signatures = append(signatures, "t="+past+",v1="+stripeSignature(stripeTestWebhookSecret, past, string(raw)))
+
+	for _, signature := range signatures {
+		err := suite.handler.ProcessPayment(req, string(raw), signature)
+		assert.Error(suite.T(), err, signature)
+		assert.Equal(suite.T(), paymentSystemErrorRequestSignatureIsInvalid, err.Error())
+		assert.Equal(suite.T(), int32(constant.OrderStatusPaymentSystemReject), suite.order.Status)
+	}
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_AmountMismatch_Error() {
+	req, _ := suite.getPaymentCallback(pkg.StripePaymentIntentStatusSucceeded)
+	req.Data.Object.Amount = 10049
+	raw, _ := json.Marshal(req)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestAmountOrCurrencyIsInvalid, err.Error())
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_OrderMismatch_Error() {
+	req, _ := suite.getPaymentCallback(pkg.StripePaymentIntentStatusSucceeded)
+	req.Data.Object.Metadata[stripeMetadataOrderId] = bson.NewObjectId().Hex()
+	raw, _ := json.Marshal(req)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRequestOrderIdIsInvalid, err.Error())
+}
+
+func (suite *StripeTestSuite) TestStripe_CreateRefund_Ok() {
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+	refund := suite.getRefund()
+
+	err := suite.handler.CreateRefund(refund)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusInProgress, refund.Status)
+	assert.Equal(suite.T(), stripeTestRefundId, refund.ExternalId)
+
+	assert.Equal(suite.T(), refund.Id, suite.lastRequest.Header.Get(stripeHeaderIdempotencyKey))
+	assert.Equal(suite.T(), stripeTestIntentId, suite.lastRequest.PostForm.Get("payment_intent"))
+	assert.Equal(suite.T(), "5025", suite.lastRequest.PostForm.Get("amount"))
+	assert.Equal(suite.T(), refund.Id, suite.lastRequest.PostForm.Get("metadata[refund_id]"))
+}
+
+func (suite *StripeTestSuite) TestStripe_CreateRefund_Rejected_Error() {
+	suite.refundBody = `{"id":"` + stripeTestRefundId + `","status":"failed","amount":5025,"currency":"usd"}`
+	refund := suite.getRefund()
+
+	err := suite.handler.CreateRefund(refund)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PaymentSystemErrorCreateRefundRejected, err.Error())
+	assert.Equal(suite.T(), pkg.RefundStatusRejected, refund.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreateRefund_RequestFailed_Error() {
+	suite.refundStatus = http.StatusBadRequest
+	refund := suite.getRefund()
+
+	err := suite.handler.CreateRefund(refund)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.PaymentSystemErrorCreateRefundFailed, err.Error())
+	assert.Equal(suite.T(), pkg.RefundStatusRejected, refund.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessRefund_Ok() {
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+	refund := suite.getRefund()
+
+	req := &billing.StripeRefundCallback{
+		Id:      "evt_1EUn0E285d61s2cIRSIjW5GP",
+		Type:    stripeEventChargeRefundUpdated,
+		Created: time.Now().Unix(),
+		Data: &billing.StripeRefundEventData{
+			Object: &billing.StripeRefund{
+				Id:            stripeTestRefundId,
+				Amount:        5025,
+				Currency:      "usd",
+				Status:        pkg.StripeRefundStatusSucceeded,
+				PaymentIntent: stripeTestIntentId,
+				Metadata:      map[string]string{stripeMetadataRefundId: refund.Id},
+			},
+		},
+	}
+	raw, err := json.Marshal(req)
+	assert.NoError(suite.T(), err)
+
+	message, err := stripeAdapter.unmarshalRefundCallback(raw)
+	assert.NoError(suite.T(), err)
+
+	refundId, ok := stripeAdapter.refundId(message)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), refund.Id, refundId)
+
+	err = suite.handler.ProcessRefund(refund, message, string(raw), suite.getSignature(raw))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.RefundStatusCompleted, refund.Status)
+	assert.Equal(suite.T(), stripeTestRefundId, refund.ExternalId)
+
+	req.Data.Object.Amount = 5024
+	raw, _ = json.Marshal(req)
+
+	err = suite.handler.ProcessRefund(refund, req, string(raw), suite.getSignature(raw))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorRefundRequestAmountOrCurrencyIsInvalid, err.Error())
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, err.(*Error).Status())
+	assert.Equal(suite.T(), pkg.RefundStatusRejected, refund.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_RefundId_Error() {
+	_, ok := stripeRefundId(&billing.StripeRefundCallback{})
+	assert.False(suite.T(), ok)
+
+	_, ok = stripeRefundId(&billing.CardPayRefundCallback{})
+	assert.False(suite.T(), ok)
+
+	var message proto.Message = &billing.StripeRefundCallback{
+		Data: &billing.StripeRefundEventData{Object: &billing.StripeRefund{}},
+	}
+	_, ok = stripeRefundId(message)
+	assert.False(suite.T(), ok)
+}
+
+func (suite *StripeTestSuite) TestStripe_CreatePayment_AuthorizeOnly_Ok() {
+	suite.order.AuthorizeOnly = true
+
+	_, err := suite.handler.CreatePayment(map[string]string{pkg.PaymentCreateFieldPan: "4000000000003063"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "manual", suite.lastRequest.PostForm.Get("capture_method"))
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_Authorized_Ok() {
+	suite.order.AuthorizeOnly = true
+
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusRequiresCapture)
+	req.Type = stripeEventPaymentIntentCapturable
+	raw, _ = json.Marshal(req)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemAuthorized), suite.order.Status)
+	assert.True(suite.T(), suite.order.CanBeCaptured())
+	assert.True(suite.T(), suite.order.CanBeVoided())
+}
+
+func (suite *StripeTestSuite) TestStripe_ProcessPayment_AuthorizedWithoutAuthorizeOnly_Temporary() {
+	req, raw := suite.getPaymentCallback(pkg.StripePaymentIntentStatusRequiresCapture)
+	req.Type = stripeEventPaymentIntentCapturable
+	raw, _ = json.Marshal(req)
+
+	err := suite.handler.ProcessPayment(req, string(raw), suite.getSignature(raw))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), pkg.StatusTemporary, err.(*Error).Status())
+}
+
+func (suite *StripeTestSuite) TestStripe_Capture_Ok() {
+	suite.order.AuthorizeOnly = true
+	suite.order.Status = pkg.OrderStatusPaymentSystemAuthorized
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+
+	err := suite.handler.Capture(suite.order, 50.25)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemCaptured), suite.order.Status)
+	assert.Equal(suite.T(), 50.25, suite.order.CapturedAmount)
+	assert.Equal(suite.T(), 50.25, suite.order.GetChargeAmount())
+	assert.Equal(suite.T(), "5025", suite.lastRequest.PostForm.Get("amount_to_capture"))
+	assert.Equal(
+		suite.T(),
+		suite.order.Id+"-"+stripeActionCapture,
+		suite.lastRequest.Header.Get(stripeHeaderIdempotencyKey),
+	)
+}
+
+func (suite *StripeTestSuite) TestStripe_Capture_Rejected_Error() {
+	suite.order.Status = pkg.OrderStatusPaymentSystemAuthorized
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+	suite.changeBody = `{"id":"` + stripeTestIntentId + `","status":"requires_capture"}`
+
+	err := suite.handler.Capture(suite.order, suite.order.TotalPaymentAmount)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorCaptureRejected, err.Error())
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemAuthorized), suite.order.Status)
+}
+
+func (suite *StripeTestSuite) TestStripe_Capture_RequestFailed_Error() {
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+	suite.changeStatus = http.StatusBadRequest
+
+	err := suite.handler.Capture(suite.order, suite.order.TotalPaymentAmount)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorCaptureFailed, err.Error())
+}
+
+func (suite *StripeTestSuite) TestStripe_Void_Ok() {
+	suite.order.AuthorizeOnly = true
+	suite.order.Status = pkg.OrderStatusPaymentSystemAuthorized
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+	suite.changeBody = `{"id":"` + stripeTestIntentId + `","status":"canceled"}`
+
+	err := suite.handler.Void(suite.order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(pkg.OrderStatusPaymentSystemVoided), suite.order.Status)
+	assert.True(suite.T(), suite.order.HasEndedStatus())
+}
+
+func (suite *StripeTestSuite) TestStripe_Void_Rejected_Error() {
+	suite.order.PaymentMethodOrderId = stripeTestIntentId
+
+	err := suite.handler.Void(suite.order)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), paymentSystemErrorVoidRejected, err.Error())
+}
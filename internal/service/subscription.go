@@ -0,0 +1,848 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	repo "github.com/paysuper/paysuper-recurring-repository/pkg/proto/repository"
+	"time"
+)
+
+const (
+	subscriptionErrorNotFound              = "subscription with specified identifier not found"
+	subscriptionErrorIdIncorrect           = "subscription identifier is incorrect"
+	subscriptionErrorQueryFailed           = "subscriptions query failed"
+	subscriptionErrorProductNotFound       = "product of subscription not found"
+	subscriptionErrorPlanNotFound          = "subscription plan not found in product"
+	subscriptionErrorPlanDisabled          = "subscription plan disabled"
+	subscriptionErrorPlanPriceNotFound     = "subscription plan hasn't price in requested currency"
+	subscriptionErrorCurrencyNotFound      = "currency of subscription not found"
+	subscriptionErrorCardNotFound          = "saved card of subscription not found"
+	subscriptionErrorCardNotOwnToCustomer  = "saved card not own to customer"
+	subscriptionErrorPaymentMethodNotFound = "bank card payment method not found for currency of subscription"
+	subscriptionErrorStatusNotAllowed      = "action not allowed for subscription with current status"
+	subscriptionErrorChangedConcurrently   = "subscription was changed by another request. try request later"
+	subscriptionErrorIntervalUnknown       = "interval of subscription plan is unknown"
+	subscriptionErrorPaymentFailed         = "renewal payment of subscription failed"
+	subscriptionErrorPaymentTimeout        = "result of renewal payment wasn't received in time"
+	subscriptionErrorFilterIncorrect       = "value of filter field %s is incorrect"
+)
+
+var (
+	subscriptionErrNotFound = errors.New(subscriptionErrorNotFound)
+)
+
+func (s *Service) scheduleSubscriptions() {
+	ticker := time.NewTicker(s.cfg.GetSubscriptionScheduleInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.renewSubscriptions(time.Now())
+		case <-s.subscriptionExit:
+			return
+		}
+	}
+}
+
+// CreateSubscription subscribe customer to plan of product. Renewal payments are charged from saved recurring
+// card of customer without customer presence
+func (s *Service) CreateSubscription(
+	ctx context.Context,
+	req *grpc.CreateSubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	product, err := s.getSubscriptionProduct(req.ProductId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	plan, err := product.GetPlan(req.PlanId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorPlanNotFound
+
+		return nil
+	}
+
+	if plan.Enabled == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPlanDisabled
+
+		return nil
+	}
+
+	amount, err := plan.GetPriceInCurrency(req.Currency)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPlanPriceNotFound
+
+		return nil
+	}
+
+	currency, err := s.GetCurrencyByCodeA3(req.Currency)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorCurrencyNotFound
+
+		return nil
+	}
+
+	pm, err := s.GetPaymentMethodByGroupAndCurrency(constant.PaymentSystemGroupAliasBankCard, currency.CodeInt)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorPaymentMethodNotFound
+
+		return nil
+	}
+
+	customer, err := s.getCustomerById(req.CustomerId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	card, err := s.rep.FindSavedCardById(ctx, &repo.FindByStringValue{Value: req.StoredCardId})
+
+	if err != nil || card == nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = subscriptionErrorCardNotFound
+
+		return nil
+	}
+
+	if card.Token != customer.Id {
+		s.logError(
+			"Alarm: customer try subscribe with not own bank card",
+			[]interface{}{"customer_id", customer.Id, "card_id", req.StoredCardId},
+		)
+
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorCardNotOwnToCustomer
+
+		return nil
+	}
+
+	now := time.Now()
+	subscription := &billing.Subscription{
+		Id:              bson.NewObjectId().Hex(),
+		ProjectId:       product.ProjectId,
+		MerchantId:      product.MerchantId,
+		ProductId:       product.Id,
+		PlanId:          plan.Id,
+		CustomerId:      customer.Id,
+		StoredCardId:    req.StoredCardId,
+		PaymentMethodId: pm.Id,
+		Email:           req.Email,
+		Amount:          amount,
+		Currency:        currency.CodeA3,
+		Interval:        plan.Interval,
+		IntervalCount:   plan.IntervalCount,
+		Status:          pkg.SubscriptionStatusActive,
+		CreatedAt:       ptypes.TimestampNow(),
+		UpdatedAt:       ptypes.TimestampNow(),
+	}
+
+	if subscription.Email == "" {
+		subscription.Email = customer.Email
+	}
+
+	// subscription without trial charged by scheduler on nearest run
+	subscription.NextPaymentAt, _ = ptypes.TimestampProto(now)
+
+	if plan.TrialDays > 0 {
+		trialEnd, _ := ptypes.TimestampProto(now.AddDate(0, 0, int(plan.TrialDays)))
+
+		subscription.Status = pkg.SubscriptionStatusTrial
+		subscription.TrialEndAt = trialEnd
+		subscription.CurrentPeriodStart = subscription.CreatedAt
+		subscription.CurrentPeriodEnd = trialEnd
+		subscription.NextPaymentAt = trialEnd
+	}
+
+	err = s.db.Collection(pkg.CollectionSubscription).Insert(subscription)
+
+	if err != nil {
+		s.logError("Query to insert subscription failed", []interface{}{"err", err.Error(), "data", subscription})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+func (s *Service) GetSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getMerchantSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+
+	return nil
+}
+
+func (s *Service) ListSubscriptions(
+	ctx context.Context,
+	req *grpc.ListSubscriptionsRequest,
+	rsp *grpc.ListSubscriptionsResponse,
+) error {
+	query := make(bson.M)
+	ids := map[string]string{
+		"merchant_id": req.MerchantId,
+		"customer_id": req.CustomerId,
+		"product_id":  req.ProductId,
+	}
+
+	for field, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		if bson.IsObjectIdHex(id) == false {
+			rsp.Status = pkg.ResponseStatusBadData
+			rsp.Message = fmt.Sprintf(subscriptionErrorFilterIncorrect, field)
+
+			return nil
+		}
+
+		query[field] = bson.ObjectIdHex(id)
+	}
+
+	if len(req.Status) > 0 {
+		query["status"] = bson.M{"$in": req.Status}
+	}
+
+	var subscriptions []*billing.Subscription
+	err := s.db.Collection(pkg.CollectionSubscription).Find(query).Sort("-_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&subscriptions)
+
+	if err != nil {
+		s.logError("Query to find subscriptions failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionSubscription).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count subscriptions failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = subscriptionErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = subscriptions
+
+	return nil
+}
+
+// CancelSubscription stop renewals of subscription. If cancellation requested at period end then subscription
+// stay active until end of paid period and will be canceled by scheduler instead of renewal
+func (s *Service) CancelSubscription(
+	ctx context.Context,
+	req *grpc.CancelSubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getMerchantSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if subscription.IsClosed() {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorStatusNotAllowed
+
+		return nil
+	}
+
+	prevStatus, prevPendingOrderId := subscription.Status, subscription.PendingOrderId
+
+	if req.AtPeriodEnd == true && subscription.Status != pkg.SubscriptionStatusPaused {
+		subscription.CancelAtPeriodEnd = true
+	} else {
+		subscription.Status = pkg.SubscriptionStatusCanceled
+		subscription.CanceledAt = ptypes.TimestampNow()
+		subscription.NextPaymentAt = nil
+	}
+
+	subscription.UpdatedAt = ptypes.TimestampNow()
+
+	s.saveSubscriptionResponse(subscription, prevStatus, prevPendingOrderId, rsp)
+
+	return nil
+}
+
+// PauseSubscription temporary stop renewals of subscription, paid period isn't changed
+func (s *Service) PauseSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getMerchantSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if subscription.IsRenewable() == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorStatusNotAllowed
+
+		return nil
+	}
+
+	prevStatus, prevPendingOrderId := subscription.Status, subscription.PendingOrderId
+
+	subscription.Status = pkg.SubscriptionStatusPaused
+	subscription.PausedAt = ptypes.TimestampNow()
+	subscription.UpdatedAt = ptypes.TimestampNow()
+
+	s.saveSubscriptionResponse(subscription, prevStatus, prevPendingOrderId, rsp)
+
+	return nil
+}
+
+// ResumeSubscription restore renewals of paused subscription. If payment date passed while subscription
+// was paused then subscription charged by scheduler on nearest run
+func (s *Service) ResumeSubscription(
+	ctx context.Context,
+	req *grpc.SubscriptionRequest,
+	rsp *grpc.SubscriptionResponse,
+) error {
+	subscription, err := s.getMerchantSubscription(req.SubscriptionId, req.MerchantId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = err.Error()
+
+		return nil
+	}
+
+	if subscription.Status != pkg.SubscriptionStatusPaused {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = subscriptionErrorStatusNotAllowed
+
+		return nil
+	}
+
+	prevStatus, prevPendingOrderId := subscription.Status, subscription.PendingOrderId
+	now := ptypes.TimestampNow()
+
+	switch {
+	case subscription.TrialEndAt != nil && subscription.TrialEndAt.Seconds > now.Seconds:
+		subscription.Status = pkg.SubscriptionStatusTrial
+	case subscription.RetryAttempts > 0:
+		subscription.Status = pkg.SubscriptionStatusPastDue
+	default:
+		subscription.Status = pkg.SubscriptionStatusActive
+	}
+
+	if subscription.NextPaymentAt == nil || subscription.NextPaymentAt.Seconds < now.Seconds {
+		subscription.NextPaymentAt = now
+	}
+
+	subscription.PausedAt = nil
+	subscription.UpdatedAt = now
+
+	s.saveSubscriptionResponse(subscription, prevStatus, prevPendingOrderId, rsp)
+
+	return nil
+}
+
+// renewSubscriptions create renewal orders for all subscriptions which payment date is come and return
+// count of created orders
+func (s *Service) renewSubscriptions(now time.Time) int {
+	s.releaseStaleSubscriptions(now)
+
+	query := bson.M{
+		"status": bson.M{
+			"$in": []int32{
+				pkg.SubscriptionStatusTrial,
+				pkg.SubscriptionStatusActive,
+				pkg.SubscriptionStatusPastDue,
+			},
+		},
+		"next_payment_at":  bson.M{"$lte": now},
+		"pending_order_id": "",
+	}
+
+	var subscriptions []*billing.Subscription
+	err := s.db.Collection(pkg.CollectionSubscription).Find(query).All(&subscriptions)
+
+	if err != nil {
+		s.logError("Query to find subscriptions for renewal failed", []interface{}{"err", err.Error(), "query", query})
+		return 0
+	}
+
+	count := 0
+
+	for _, subscription := range subscriptions {
+		if subscription.CancelAtPeriodEnd == true {
+			prevStatus := subscription.Status
+
+			subscription.Status = pkg.SubscriptionStatusCanceled
+			subscription.CanceledAt = ptypes.TimestampNow()
+			subscription.NextPaymentAt = nil
+			subscription.UpdatedAt = ptypes.TimestampNow()
+
+			_ = s.saveSubscription(subscription, prevStatus, "")
+			continue
+		}
+
+		if err := s.renewSubscription(subscription); err != nil {
+			s.logError(
+				"Subscription renewal failed",
+				[]interface{}{"err", err.Error(), "subscription_id", subscription.Id},
+			)
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+// releaseStaleSubscriptions unlock subscriptions which renewal order hasn't result after timeout. Result of
+// renewal order may be not received if payment system didn't send callback, callback was rejected or
+// service was stopped before renewal order was created
+func (s *Service) releaseStaleSubscriptions(now time.Time) {
+	query := bson.M{
+		"pending_order_id": bson.M{"$ne": ""},
+		"pending_order_at": bson.M{"$lt": now.Add(-s.cfg.GetSubscriptionPendingTimeout())},
+	}
+
+	var subscriptions []*billing.Subscription
+	err := s.db.Collection(pkg.CollectionSubscription).Find(query).All(&subscriptions)
+
+	if err != nil {
+		s.logError("Query to find stale subscriptions failed", []interface{}{"err", err.Error(), "query", query})
+		return
+	}
+
+	for _, subscription := range subscriptions {
+		order, err := s.getOrderById(subscription.PendingOrderId)
+
+		if err == nil && order.Status == constant.OrderStatusPaymentSystemComplete {
+			s.completeSubscriptionPayment(subscription, order)
+			continue
+		}
+
+		s.failSubscriptionPayment(subscription, subscriptionErrorPaymentTimeout)
+	}
+}
+
+// renewSubscription lock subscription by identifier of renewal order and charge saved card of customer.
+// Result of payment will be applied to subscription on payment system callback
+func (s *Service) renewSubscription(subscription *billing.Subscription) error {
+	prevStatus := subscription.Status
+
+	subscription.PendingOrderId = bson.NewObjectId().Hex()
+	subscription.PendingOrderAt = ptypes.TimestampNow()
+	subscription.UpdatedAt = subscription.PendingOrderAt
+
+	// subscription may be locked by another instance of service
+	if err := s.saveSubscription(subscription, prevStatus, ""); err != nil {
+		return err
+	}
+
+	err := s.createSubscriptionOrder(subscription)
+
+	if err != nil {
+		s.failSubscriptionPayment(subscription, err.Error())
+	}
+
+	return err
+}
+
+// createSubscriptionOrder create renewal order of subscription and send merchant initiated payment request
+// with recurring identifier of saved card to payment system
+func (s *Service) createSubscriptionOrder(subscription *billing.Subscription) error {
+	processor := &OrderCreateRequestProcessor{
+		Service: s,
+		request: &billing.OrderCreateRequest{
+			ProjectId: subscription.ProjectId,
+			Currency:  subscription.Currency,
+			Amount:    subscription.Amount,
+			PrivateMetadata: map[string]string{
+				pkg.OrderPrivateMetadataSubscriptionId: subscription.Id,
+			},
+		},
+		checked: &orderCreateRequestProcessorChecked{},
+	}
+
+	if err := processor.processProject(); err != nil {
+		return err
+	}
+
+	if err := processor.processCurrency(); err != nil {
+		return err
+	}
+
+	processor.processAmount()
+
+	pm, err := s.GetPaymentMethodById(subscription.PaymentMethodId)
+
+	if err != nil {
+		return errors.New(orderErrorPaymentMethodNotFound)
+	}
+
+	if err := processor.processPaymentMethod(pm); err != nil {
+		return err
+	}
+
+	if err := processor.processLimitAmounts(); err != nil {
+		return err
+	}
+
+	processor.processPrivateMetadata()
+
+	customer, err := s.getCustomerById(subscription.CustomerId)
+
+	if err != nil {
+		return err
+	}
+
+	processor.checked.user = &billing.OrderUser{
+		Id:         customer.Id,
+		Object:     pkg.ObjectTypeUser,
+		ExternalId: customer.ExternalId,
+		Email:      subscription.Email,
+		TechEmail:  customer.TechEmail,
+		Locale:     customer.Locale,
+		Address:    customer.Address,
+	}
+
+	card, err := s.rep.FindSavedCardById(context.TODO(), &repo.FindByStringValue{Value: subscription.StoredCardId})
+
+	if err != nil || card == nil {
+		return errors.New(subscriptionErrorCardNotFound)
+	}
+
+	order, err := processor.prepareOrder()
+
+	if err != nil {
+		return err
+	}
+
+	order.Id = subscription.PendingOrderId
+	order.Description = fmt.Sprintf(orderDefaultDescription, order.Id)
+	order.PaymentRequisites = map[string]string{
+		pkg.PaymentCreateFieldPan:   card.MaskedPan,
+		pkg.PaymentCreateFieldMonth: card.Expire.Month,
+		pkg.PaymentCreateFieldYear:  card.Expire.Year,
+	}
+
+	// tax calculated only for customers with known address
+	if order.TotalPaymentAmount <= 0 {
+		order.TotalPaymentAmount = order.PaymentMethodOutcomeAmount
+	}
+
+	paymentProcessor := &PaymentCreateProcessor{service: s}
+	paymentProcessor.checked.order = order
+
+	if err := paymentProcessor.processPaymentAmounts(); err != nil {
+		return errors.New(orderCurrencyConvertationError)
+	}
+
+	err = s.db.Collection(pkg.CollectionOrder).Insert(order)
+
+	if err != nil {
+		s.logError("Query to insert subscription order failed", []interface{}{"err", err.Error(), "order", order})
+		return errors.New(orderErrorCanNotCreate)
+	}
+
+	if processor.checked.project.IsProduction() == true {
+		merchantId := processor.checked.merchant.Id
+
+		order.PaymentMethod.Params.Terminal, _ = s.getMerchantPaymentMethodTerminalId(merchantId, pm.Id)
+		order.PaymentMethod.Params.Password, _ = s.getMerchantPaymentMethodTerminalPassword(merchantId, pm.Id)
+		order.PaymentMethod.Params.CallbackPassword, _ = s.getMerchantPaymentMethodTerminalCallbackPassword(merchantId, pm.Id)
+	}
+
+	h, err := s.NewPaymentSystem(s.cfg.PaymentSystemConfig, order)
+
+	if err != nil {
+		return err
+	}
+
+	historyLen := len(order.StatusHistory)
+	_, err = h.CreatePayment(map[string]string{
+		pkg.PaymentCreateFieldRecurringId:       card.RecurringId,
+		pkg.PaymentCreateFieldMerchantInitiated: "1",
+	})
+
+	// status set by callback of payment system processed before result of payment creation saved is kept
+	errDb := s.saveOrderChanges(order, historyLen, s.updateOrder, func(actual *billing.Order) {
+		if actual.PaymentMethodOrderId == "" {
+			actual.PaymentMethodOrderId = order.PaymentMethodOrderId
+		}
+	})
+
+	if errDb != nil {
+		return errDb
+	}
+
+	return err
+}
+
+// processSubscriptionOrder apply result of renewal payment to subscription of order. Orders which not
+// created by subscription scheduler are ignored
+func (s *Service) processSubscriptionOrder(order *billing.Order) {
+	id, ok := order.PrivateMetadata[pkg.OrderPrivateMetadataSubscriptionId]
+
+	if !ok || bson.IsObjectIdHex(id) == false {
+		return
+	}
+
+	query := bson.M{"_id": bson.ObjectIdHex(id), "pending_order_id": order.Id}
+
+	// renewal failed by timeout is completed by late result of payment
+	if order.Status == constant.OrderStatusPaymentSystemComplete {
+		query = bson.M{
+			"_id": bson.ObjectIdHex(id),
+			"$or": []bson.M{
+				{"pending_order_id": order.Id},
+				{"pending_order_id": "", "last_order_id": order.Id, "failure_reason": bson.M{"$ne": ""}},
+			},
+		}
+	}
+
+	subscription, err := s.getSubscriptionBy(query)
+
+	if err != nil {
+		return
+	}
+
+	switch order.Status {
+	case constant.OrderStatusPaymentSystemComplete:
+		s.completeSubscriptionPayment(subscription, order)
+	case constant.OrderStatusPaymentSystemDeclined,
+		constant.OrderStatusPaymentSystemCanceled,
+		constant.OrderStatusPaymentSystemReject,
+		pkg.OrderStatusPaymentSystemStatusUnknown:
+		s.failSubscriptionPayment(subscription, subscriptionErrorPaymentFailed)
+	}
+}
+
+// completeSubscriptionPayment start new paid period of subscription. New period starts at end of previous
+// period, so retries of failed payment don't shift billing date of subscription
+func (s *Service) completeSubscriptionPayment(subscription *billing.Subscription, order *billing.Order) {
+	prevStatus, prevPendingOrderId := subscription.Status, subscription.PendingOrderId
+	start := time.Now()
+
+	if subscription.CurrentPeriodEnd != nil {
+		if t, err := ptypes.Timestamp(subscription.CurrentPeriodEnd); err == nil {
+			start = t
+		}
+	}
+
+	end, err := getSubscriptionPeriodEnd(start, subscription.Interval, subscription.IntervalCount)
+
+	if err != nil {
+		s.logError("Calculate period of subscription failed", []interface{}{"err", err.Error(), "data", subscription})
+		return
+	}
+
+	subscription.CurrentPeriodStart, _ = ptypes.TimestampProto(start)
+	subscription.CurrentPeriodEnd, _ = ptypes.TimestampProto(end)
+	subscription.NextPaymentAt = subscription.CurrentPeriodEnd
+	subscription.RetryAttempts = 0
+	subscription.FailureReason = ""
+	subscription.LastOrderId = order.Id
+	subscription.PendingOrderId = ""
+	subscription.PendingOrderAt = nil
+	subscription.UpdatedAt = ptypes.TimestampNow()
+
+	if subscription.IsRenewable() {
+		subscription.Status = pkg.SubscriptionStatusActive
+	}
+
+	_ = s.saveSubscription(subscription, prevStatus, prevPendingOrderId)
+}
+
+// failSubscriptionPayment schedule retry of failed renewal payment. After max count of retries subscription
+// marked as unpaid and not renewed anymore
+func (s *Service) failSubscriptionPayment(subscription *billing.Subscription, reason string) {
+	prevStatus, prevPendingOrderId := subscription.Status, subscription.PendingOrderId
+
+	subscription.RetryAttempts++
+	subscription.FailureReason = reason
+	subscription.LastOrderId = subscription.PendingOrderId
+	subscription.PendingOrderId = ""
+	subscription.PendingOrderAt = nil
+	subscription.UpdatedAt = ptypes.TimestampNow()
+
+	if subscription.IsRenewable() {
+		if subscription.RetryAttempts >= s.cfg.SubscriptionRetryAttempts {
+			subscription.Status = pkg.SubscriptionStatusUnpaid
+			subscription.NextPaymentAt = nil
+		} else {
+			subscription.Status = pkg.SubscriptionStatusPastDue
+			subscription.NextPaymentAt, _ = ptypes.TimestampProto(time.Now().Add(s.cfg.GetSubscriptionRetryInterval()))
+		}
+	}
+
+	_ = s.saveSubscription(subscription, prevStatus, prevPendingOrderId)
+}
+
+func (s *Service) getSubscriptionProduct(id string) (*grpc.Product, error) {
+	product := &grpc.Product{}
+	query := bson.M{"_id": bson.ObjectIdHex(id), "enabled": true, "deleted": false}
+	err := s.db.Collection(pkg.CollectionProduct).Find(query).One(product)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			s.logError("Query to find product failed", []interface{}{"err", err.Error(), "query", query})
+		}
+
+		return nil, errors.New(subscriptionErrorProductNotFound)
+	}
+
+	return product, nil
+}
+
+func (s *Service) getSubscriptionBy(query bson.M) (*billing.Subscription, error) {
+	subscription := &billing.Subscription{}
+	err := s.db.Collection(pkg.CollectionSubscription).Find(query).One(subscription)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return nil, subscriptionErrNotFound
+		}
+
+		s.logError("Query to find subscription failed", []interface{}{"err", err.Error(), "query", query})
+		return nil, errors.New(subscriptionErrorQueryFailed)
+	}
+
+	return subscription, nil
+}
+
+// getMerchantSubscription return subscription by identifier. If merchant identifier is set then subscription
+// of other merchant processed as not found
+func (s *Service) getMerchantSubscription(id, merchantId string) (*billing.Subscription, error) {
+	if bson.IsObjectIdHex(id) == false {
+		return nil, errors.New(subscriptionErrorIdIncorrect)
+	}
+
+	subscription, err := s.getSubscriptionBy(bson.M{"_id": bson.ObjectIdHex(id)})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if merchantId != "" && subscription.MerchantId != merchantId {
+		return nil, subscriptionErrNotFound
+	}
+
+	return subscription, nil
+}
+
+// saveSubscription update subscription only if status and pending renewal order of subscription wasn't
+// changed by another request or scheduler
+func (s *Service) saveSubscription(subscription *billing.Subscription, prevStatus int32, prevPendingOrderId string) error {
+	query := bson.M{
+		"_id":              bson.ObjectIdHex(subscription.Id),
+		"status":           prevStatus,
+		"pending_order_id": prevPendingOrderId,
+	}
+	err := s.db.Collection(pkg.CollectionSubscription).Update(query, subscription)
+
+	if err != nil {
+		if err == mgo.ErrNotFound {
+			return errors.New(subscriptionErrorChangedConcurrently)
+		}
+
+		s.logError("Query to update subscription failed", []interface{}{"err", err.Error(), "data", subscription})
+		return errors.New(subscriptionErrorQueryFailed)
+	}
+
+	return nil
+}
+
+func (s *Service) saveSubscriptionResponse(
+	subscription *billing.Subscription,
+	prevStatus int32,
+	prevPendingOrderId string,
+	rsp *grpc.SubscriptionResponse,
+) {
+	err := s.saveSubscription(subscription, prevStatus, prevPendingOrderId)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.Error()
+
+		if err.Error() == subscriptionErrorChangedConcurrently {
+			rsp.Status = pkg.ResponseStatusConflict
+		}
+
+		return
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = subscription
+}
+
+func getSubscriptionPeriodEnd(start time.Time, interval string, count int32) (time.Time, error) {
+	switch interval {
+	case pkg.SubscriptionIntervalDay:
+		return start.AddDate(0, 0, int(count)), nil
+	case pkg.SubscriptionIntervalWeek:
+		return start.AddDate(0, 0, 7*int(count)), nil
+	case pkg.SubscriptionIntervalMonth:
+		return start.AddDate(0, int(count), 0), nil
+	case pkg.SubscriptionIntervalYear:
+		return start.AddDate(int(count), 0, 0), nil
+	}
+
+	return start, errors.New(subscriptionErrorIntervalUnknown)
+}
@@ -0,0 +1,508 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/micro/go-micro/client"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/proto/entity"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/proto/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type subscriptionRepositoryMock struct {
+	repository.RepositoryService
+	card *entity.SavedCard
+}
+
+func (r *subscriptionRepositoryMock) FindSavedCardById(
+	ctx context.Context,
+	in *repository.FindByStringValue,
+	opts ...client.CallOption,
+) (*entity.SavedCard, error) {
+	return r.card, nil
+}
+
+type SubscriptionTestSuite struct {
+	suite.Suite
+	service  *Service
+	product  *grpc.Product
+	customer *billing.Customer
+	card     *entity.SavedCard
+	rub      *billing.Currency
+}
+
+func Test_Subscription(t *testing.T) {
+	suite.Run(t, new(SubscriptionTestSuite))
+}
+
+func (suite *SubscriptionTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+
+	settings := database.Connection{
+		Host:     cfg.MongoHost,
+		Database: cfg.MongoDatabase,
+		User:     cfg.MongoUser,
+		Password: cfg.MongoPassword,
+	}
+
+	db, err := database.NewDatabase(settings)
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.rub = &billing.Currency{CodeInt: 643, CodeA3: "RUB", Name: &billing.Name{Ru: "Российский рубль", En: "Russian ruble"}}
+
+	suite.product = &grpc.Product{
+		Id:              bson.NewObjectId().Hex(),
+		MerchantId:      bson.NewObjectId().Hex(),
+		ProjectId:       bson.NewObjectId().Hex(),
+		Object:          "product",
+		Type:            "simple_product",
+		Sku:             "subscription_sku",
+		Name:            map[string]string{"en": "Subscription"},
+		DefaultCurrency: suite.rub.CodeA3,
+		Enabled:         true,
+		Prices:          []*grpc.ProductPrice{{Amount: 100, Currency: suite.rub.CodeA3}},
+		Description:     map[string]string{"en": "Subscription"},
+		Plans: []*grpc.SubscriptionPlan{
+			{
+				Id:            bson.NewObjectId().Hex(),
+				Name:          "Monthly",
+				Interval:      pkg.SubscriptionIntervalMonth,
+				IntervalCount: 1,
+				Prices:        []*grpc.ProductPrice{{Amount: 300, Currency: suite.rub.CodeA3}},
+				Enabled:       true,
+			},
+			{
+				Id:            bson.NewObjectId().Hex(),
+				Name:          "Weekly with trial",
+				Interval:      pkg.SubscriptionIntervalWeek,
+				IntervalCount: 1,
+				TrialDays:     14,
+				Prices:        []*grpc.ProductPrice{{Amount: 100, Currency: suite.rub.CodeA3}},
+				Enabled:       true,
+			},
+			{
+				Id:            bson.NewObjectId().Hex(),
+				Name:          "Disabled",
+				Interval:      pkg.SubscriptionIntervalYear,
+				IntervalCount: 1,
+				Prices:        []*grpc.ProductPrice{{Amount: 1000, Currency: suite.rub.CodeA3}},
+				Enabled:       false,
+			},
+		},
+	}
+
+	err = db.Collection(pkg.CollectionProduct).Insert(suite.product)
+	assert.NoError(suite.T(), err, "Insert product test data failed")
+
+	suite.customer = &billing.Customer{
+		Id:         bson.NewObjectId().Hex(),
+		TechEmail:  "customer@unit.test",
+		ExternalId: "customer_1",
+		Email:      "customer@unit.test",
+		CreatedAt:  ptypes.TimestampNow(),
+		UpdatedAt:  ptypes.TimestampNow(),
+	}
+
+	err = db.Collection(pkg.CollectionCustomer).Insert(suite.customer)
+	assert.NoError(suite.T(), err, "Insert customer test data failed")
+
+	suite.card = &entity.SavedCard{
+		Id:          bson.NewObjectId().Hex(),
+		Token:       suite.customer.Id,
+		ProjectId:   suite.product.ProjectId,
+		MerchantId:  suite.product.MerchantId,
+		MaskedPan:   "400000******0002",
+		RecurringId: bson.NewObjectId().Hex(),
+		Expire:      &entity.CardExpire{Month: "12", Year: "2030"},
+		IsActive:    true,
+	}
+
+	pm := &billing.PaymentMethod{
+		Id:       bson.NewObjectId().Hex(),
+		Name:     "Bank card",
+		Group:    constant.PaymentSystemGroupAliasBankCard,
+		IsActive: true,
+		Params: &billing.PaymentMethodParams{
+			Handler:    paymentSystemHandlerMockOk,
+			ExternalId: "BANKCARD",
+		},
+	}
+
+	rep := &subscriptionRepositoryMock{RepositoryService: mock.NewRepositoryServiceOk(), card: suite.card}
+	suite.service = NewBillingService(db, cfg, make(chan bool, 1), nil, rep, nil, mock.NewBrokerMockOk(), nil)
+	suite.service.updateCache(func(c *cacheSnapshot) {
+		c.currency = map[string]*billing.Currency{suite.rub.CodeA3: suite.rub}
+		c.paymentMethod = map[string]map[int32]*billing.PaymentMethod{
+			pm.Group: {suite.rub.CodeInt: pm},
+		}
+		c.paymentMethodId = map[string]*billing.PaymentMethod{pm.Id: pm}
+	})
+}
+
+func (suite *SubscriptionTestSuite) TearDownTest() {
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *SubscriptionTestSuite) createSubscription(planId string) *billing.Subscription {
+	req := &grpc.CreateSubscriptionRequest{
+		ProductId:    suite.product.Id,
+		PlanId:       planId,
+		CustomerId:   suite.customer.Id,
+		StoredCardId: suite.card.Id,
+		Currency:     suite.rub.CodeA3,
+	}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.NotNil(suite.T(), rsp.Item)
+
+	return rsp.Item
+}
+
+func (suite *SubscriptionTestSuite) getSubscription(id string) *billing.Subscription {
+	subscription, err := suite.service.getSubscriptionBy(bson.M{"_id": bson.ObjectIdHex(id)})
+	assert.NoError(suite.T(), err)
+
+	return subscription
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CreateSubscription_Ok() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, subscription.Status)
+	assert.Equal(suite.T(), float64(300), subscription.Amount)
+	assert.Equal(suite.T(), suite.rub.CodeA3, subscription.Currency)
+	assert.Equal(suite.T(), suite.product.MerchantId, subscription.MerchantId)
+	assert.Equal(suite.T(), suite.customer.Email, subscription.Email)
+	assert.Nil(suite.T(), subscription.TrialEndAt)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), subscription.PlanId, saved.PlanId)
+	assert.Equal(suite.T(), suite.card.Id, saved.StoredCardId)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+	assert.True(suite.T(), saved.NextPaymentAt.Seconds <= time.Now().Unix())
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CreateSubscription_Trial_Ok() {
+	subscription := suite.createSubscription(suite.product.Plans[1].Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusTrial, subscription.Status)
+	assert.NotNil(suite.T(), subscription.TrialEndAt)
+	assert.Equal(suite.T(), subscription.TrialEndAt.Seconds, subscription.NextPaymentAt.Seconds)
+
+	count := suite.service.renewSubscriptions(time.Now())
+	assert.Equal(suite.T(), 0, count)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusTrial, saved.Status)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CreateSubscription_PlanNotFound_Error() {
+	req := &grpc.CreateSubscriptionRequest{
+		ProductId:    suite.product.Id,
+		PlanId:       bson.NewObjectId().Hex(),
+		CustomerId:   suite.customer.Id,
+		StoredCardId: suite.card.Id,
+		Currency:     suite.rub.CodeA3,
+	}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorPlanNotFound, rsp.Message)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CreateSubscription_PlanDisabled_Error() {
+	req := &grpc.CreateSubscriptionRequest{
+		ProductId:    suite.product.Id,
+		PlanId:       suite.product.Plans[2].Id,
+		CustomerId:   suite.customer.Id,
+		StoredCardId: suite.card.Id,
+		Currency:     suite.rub.CodeA3,
+	}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorPlanDisabled, rsp.Message)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CreateSubscription_CardNotOwnToCustomer_Error() {
+	suite.card.Token = bson.NewObjectId().Hex()
+
+	req := &grpc.CreateSubscriptionRequest{
+		ProductId:    suite.product.Id,
+		PlanId:       suite.product.Plans[0].Id,
+		CustomerId:   suite.customer.Id,
+		StoredCardId: suite.card.Id,
+		Currency:     suite.rub.CodeA3,
+	}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CreateSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorCardNotOwnToCustomer, rsp.Message)
+
+	count, err := suite.service.db.Collection(pkg.CollectionSubscription).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, count)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_RenewSubscriptions_PaymentFailed_Dunning() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+
+	// project of subscription absent in cache, so renewal order can't be created
+	count := suite.service.renewSubscriptions(time.Now())
+	assert.Equal(suite.T(), 0, count)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPastDue, saved.Status)
+	assert.Equal(suite.T(), int32(1), saved.RetryAttempts)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+	assert.NotEmpty(suite.T(), saved.LastOrderId)
+	assert.NotEmpty(suite.T(), saved.FailureReason)
+	assert.True(suite.T(), saved.NextPaymentAt.Seconds > time.Now().Unix())
+
+	// retry not executed before retry interval is over
+	count = suite.service.renewSubscriptions(time.Now())
+	assert.Equal(suite.T(), 0, count)
+	assert.Equal(suite.T(), int32(1), suite.getSubscription(subscription.Id).RetryAttempts)
+
+	for i := int32(1); i < suite.service.cfg.SubscriptionRetryAttempts; i++ {
+		suite.service.renewSubscriptions(time.Now().Add(suite.service.cfg.GetSubscriptionRetryInterval() * time.Duration(i+1)))
+	}
+
+	saved = suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusUnpaid, saved.Status)
+	assert.Equal(suite.T(), suite.service.cfg.SubscriptionRetryAttempts, saved.RetryAttempts)
+	assert.Nil(suite.T(), saved.NextPaymentAt)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_ProcessSubscriptionOrder_Complete_Ok() {
+	subscription := suite.createSubscription(suite.product.Plans[1].Id)
+	periodEnd, err := ptypes.Timestamp(subscription.CurrentPeriodEnd)
+	assert.NoError(suite.T(), err)
+
+	subscription.PendingOrderId = bson.NewObjectId().Hex()
+	err = suite.service.saveSubscription(subscription, subscription.Status, "")
+	assert.NoError(suite.T(), err)
+
+	order := &billing.Order{
+		Id:              subscription.PendingOrderId,
+		Status:          constant.OrderStatusPaymentSystemComplete,
+		Project:         &billing.ProjectOrder{Id: subscription.ProjectId, MerchantId: subscription.MerchantId},
+		PrivateMetadata: map[string]string{pkg.OrderPrivateMetadataSubscriptionId: subscription.Id},
+	}
+	err = suite.service.db.Collection(pkg.CollectionOrder).Insert(order)
+	assert.NoError(suite.T(), err)
+
+	// subscription of order must be resolved by order loaded from database as in payment callback
+	order, err = suite.service.getOrderById(order.Id)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), subscription.Id, order.PrivateMetadata[pkg.OrderPrivateMetadataSubscriptionId])
+
+	suite.service.processSubscriptionOrder(order)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, saved.Status)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+	assert.Equal(suite.T(), order.Id, saved.LastOrderId)
+	assert.Equal(suite.T(), periodEnd.Unix(), saved.CurrentPeriodStart.Seconds)
+	assert.Equal(suite.T(), periodEnd.AddDate(0, 0, 7).Unix(), saved.CurrentPeriodEnd.Seconds)
+	assert.Equal(suite.T(), saved.CurrentPeriodEnd.Seconds, saved.NextPaymentAt.Seconds)
+
+	// repeated callback of same order doesn't prolong subscription twice
+	suite.service.processSubscriptionOrder(order)
+	assert.Equal(suite.T(), saved.CurrentPeriodEnd.Seconds, suite.getSubscription(subscription.Id).CurrentPeriodEnd.Seconds)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_ProcessSubscriptionOrder_Declined() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+	subscription.PendingOrderId = bson.NewObjectId().Hex()
+	err := suite.service.saveSubscription(subscription, subscription.Status, "")
+	assert.NoError(suite.T(), err)
+
+	order := &billing.Order{
+		Id:              subscription.PendingOrderId,
+		Status:          constant.OrderStatusPaymentSystemDeclined,
+		PrivateMetadata: map[string]string{pkg.OrderPrivateMetadataSubscriptionId: subscription.Id},
+	}
+	suite.service.processSubscriptionOrder(order)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPastDue, saved.Status)
+	assert.Equal(suite.T(), int32(1), saved.RetryAttempts)
+	assert.Equal(suite.T(), subscriptionErrorPaymentFailed, saved.FailureReason)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_ProcessSubscriptionOrder_StatusUnknown() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+	subscription.PendingOrderId = bson.NewObjectId().Hex()
+	err := suite.service.saveSubscription(subscription, subscription.Status, "")
+	assert.NoError(suite.T(), err)
+
+	order := &billing.Order{
+		Id:              subscription.PendingOrderId,
+		Status:          pkg.OrderStatusPaymentSystemStatusUnknown,
+		PrivateMetadata: map[string]string{pkg.OrderPrivateMetadataSubscriptionId: subscription.Id},
+	}
+	suite.service.processSubscriptionOrder(order)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPastDue, saved.Status)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+	assert.Equal(suite.T(), order.Id, saved.LastOrderId)
+
+	// late result of payment completes renewal
+	order.Status = constant.OrderStatusPaymentSystemComplete
+	suite.service.processSubscriptionOrder(order)
+
+	saved = suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, saved.Status)
+	assert.Empty(suite.T(), saved.FailureReason)
+	assert.Equal(suite.T(), int32(0), saved.RetryAttempts)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_RenewSubscriptions_StaleLock_Released() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+	subscription.PendingOrderId = bson.NewObjectId().Hex()
+	subscription.PendingOrderAt, _ = ptypes.TimestampProto(
+		time.Now().Add(-suite.service.cfg.GetSubscriptionPendingTimeout() - time.Minute),
+	)
+	err := suite.service.saveSubscription(subscription, subscription.Status, "")
+	assert.NoError(suite.T(), err)
+
+	// renewal order wasn't created, because service was stopped
+	suite.service.renewSubscriptions(time.Now())
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPastDue, saved.Status)
+	assert.Equal(suite.T(), subscriptionErrorPaymentTimeout, saved.FailureReason)
+	assert.Empty(suite.T(), saved.PendingOrderId)
+	assert.Nil(suite.T(), saved.PendingOrderAt)
+	assert.NotNil(suite.T(), saved.NextPaymentAt)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_RenewSubscriptions_FreshLock_Kept() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+	subscription.PendingOrderId = bson.NewObjectId().Hex()
+	subscription.PendingOrderAt = ptypes.TimestampNow()
+	err := suite.service.saveSubscription(subscription, subscription.Status, "")
+	assert.NoError(suite.T(), err)
+
+	suite.service.renewSubscriptions(time.Now())
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), subscription.PendingOrderId, saved.PendingOrderId)
+	assert.Equal(suite.T(), subscription.Status, saved.Status)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CancelSubscription_AtPeriodEnd_Ok() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+
+	req := &grpc.CancelSubscriptionRequest{SubscriptionId: subscription.Id, AtPeriodEnd: true}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CancelSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, rsp.Item.Status)
+	assert.True(suite.T(), rsp.Item.CancelAtPeriodEnd)
+
+	count := suite.service.renewSubscriptions(time.Now())
+	assert.Equal(suite.T(), 0, count)
+
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusCanceled, saved.Status)
+	assert.NotNil(suite.T(), saved.CanceledAt)
+	assert.Empty(suite.T(), saved.LastOrderId)
+
+	err = suite.service.CancelSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), subscriptionErrorStatusNotAllowed, rsp.Message)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_CancelSubscription_OtherMerchant_NotFound() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+
+	req := &grpc.CancelSubscriptionRequest{SubscriptionId: subscription.Id, MerchantId: bson.NewObjectId().Hex()}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.CancelSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, suite.getSubscription(subscription.Id).Status)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_PauseResumeSubscription_Ok() {
+	subscription := suite.createSubscription(suite.product.Plans[0].Id)
+
+	req := &grpc.SubscriptionRequest{SubscriptionId: subscription.Id, MerchantId: suite.product.MerchantId}
+	rsp := &grpc.SubscriptionResponse{}
+	err := suite.service.PauseSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPaused, rsp.Item.Status)
+	assert.NotNil(suite.T(), rsp.Item.PausedAt)
+
+	// paused subscription isn't renewed by scheduler
+	suite.service.renewSubscriptions(time.Now())
+	saved := suite.getSubscription(subscription.Id)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusPaused, saved.Status)
+	assert.Equal(suite.T(), int32(0), saved.RetryAttempts)
+
+	err = suite.service.PauseSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+
+	rsp = &grpc.SubscriptionResponse{}
+	err = suite.service.ResumeSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), pkg.SubscriptionStatusActive, rsp.Item.Status)
+	assert.Nil(suite.T(), rsp.Item.PausedAt)
+
+	err = suite.service.ResumeSubscription(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+}
+
+func (suite *SubscriptionTestSuite) TestSubscription_ListSubscriptions_Ok() {
+	suite.createSubscription(suite.product.Plans[0].Id)
+	suite.createSubscription(suite.product.Plans[1].Id)
+
+	req := &grpc.ListSubscriptionsRequest{MerchantId: suite.product.MerchantId, Limit: 10}
+	rsp := &grpc.ListSubscriptionsResponse{}
+	err := suite.service.ListSubscriptions(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(2), rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 2)
+
+	req.Status = []int32{pkg.SubscriptionStatusTrial}
+	err = suite.service.ListSubscriptions(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int32(1), rsp.Count)
+
+	req = &grpc.ListSubscriptionsRequest{CustomerId: "invalid", Limit: 10}
+	rsp = &grpc.ListSubscriptionsResponse{}
+	err = suite.service.ListSubscriptions(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+}
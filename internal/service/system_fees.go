@@ -7,10 +7,12 @@ import (
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
 	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
-    "github.com/paysuper/paysuper-recurring-repository/tools"
-    "sort"
+	"sort"
+	"strings"
+	"time"
 )
 
 type kv struct {
@@ -19,6 +21,14 @@ type kv struct {
 }
 
 const (
+	systemFeesRegionEu = "EU"
+
+	// count of digits after decimal separator of fee percents
+	systemFeesPercentExponent = 2
+
+	// max attempts to save version of system fees which version number was taken by concurrent request
+	systemFeesSaveMaxAttempts = 5
+
 	errorSystemFeeCardBrandRequired        = "card brand required for this method"
 	errorSystemFeeCardBrandNotAllowed      = "card brand not allowed for this method"
 	errorSystemFeeCardBrandInvalid         = "card brand invalid or not supported"
@@ -27,8 +37,16 @@ const (
 	errorSystemFeeDuplicatedActive         = "duplicated active system fee"
 	errorSystemFeeRegionInvalid            = "system fee region invalid"
 	errorSystemFeeRequiredFeeset           = "system fees require alt least one fee set in request"
+	errorSystemFeeEffectivePeriodInvalid   = "system fees effective period invalid"
+	errorSystemFeeQueryFailed              = "query to system fees failed"
 )
 
+// countries of European Union, system fees of region EU applied to payments by bank cards issued in these countries
+var euCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
 var CardBrands = []string{
 	"JCB",
 	"MASTERCARD",
@@ -52,7 +70,7 @@ func (s *Service) AddSystemFees(
 	res *grpc.EmptyResponse,
 ) error {
 
-	if req.Region != "" && req.Region != "EU" {
+	if req.Region != "" && req.Region != systemFeesRegionEu {
 		_, err := s.GetCountryByCodeA2(req.Region)
 		if err != nil {
 			s.logError(errorSystemFeeRegionInvalid, []interface{}{"data", req})
@@ -87,106 +105,301 @@ func (s *Service) AddSystemFees(
 		return errors.New(errorSystemFeeRequiredFeeset)
 	}
 
-    // formatting values
+	// amounts rounded to minor units of their currencies
 	for _, f := range req.Fees {
-        f.TransactionCost.Percent = tools.FormatAmount(f.TransactionCost.Percent)
-        f.TransactionCost.FixAmount = tools.FormatAmount(f.TransactionCost.FixAmount)
-        f.AuthorizationFee.Percent = tools.FormatAmount(f.AuthorizationFee.Percent)
-        f.AuthorizationFee.FixAmount = tools.FormatAmount(f.AuthorizationFee.FixAmount)
+		for _, fee := range []*billing.SystemFee{f.TransactionCost, f.AuthorizationFee} {
+			fee.Percent = money.RoundDecimal(fee.Percent, systemFeesPercentExponent)
+			fee.FixAmount = money.Round(fee.FixAmount, fee.FixCurrency)
+		}
 
-        for c, v := range f.MinAmounts {
-            f.MinAmounts[c] = tools.FormatAmount(v)
-        }
-    }
+		for c, v := range f.MinAmounts {
+			f.MinAmounts[c] = money.Round(v, c)
+		}
+	}
 
 	fees := &billing.SystemFees{
-		Id:        bson.NewObjectId().Hex(),
-		MethodId:  req.MethodId,
-		Region:    req.Region,
-		CardBrand: req.CardBrand,
-		Fees:      req.Fees,
-		UserId:    req.UserId,
-		CreatedAt: ptypes.TimestampNow(),
-		IsActive:  true,
+		Id:            bson.NewObjectId().Hex(),
+		MethodId:      req.MethodId,
+		Region:        req.Region,
+		CardBrand:     req.CardBrand,
+		Fees:          req.Fees,
+		UserId:        req.UserId,
+		CreatedAt:     ptypes.TimestampNow(),
+		IsActive:      true,
+		EffectiveFrom: req.EffectiveFrom,
+		EffectiveTo:   req.EffectiveTo,
 	}
 
-	query := bson.M{"method_id": bson.ObjectIdHex(req.MethodId), "region": req.Region, "card_brand": req.CardBrand, "is_active": true}
-	err = s.db.Collection(pkg.CollectionSystemFees).Update(query, bson.M{"$set": bson.M{"is_active": false}})
+	return s.saveSystemFeesVersion(fees)
+}
 
-	if err != nil && err != mgo.ErrNotFound {
-		s.logError("Query to disable old fees failed", []interface{}{"err", err.Error(), "query", query, "req", req})
-		return err
+// ReactivateSystemFees create new version of system fees with fee sets of previous version
+func (s *Service) ReactivateSystemFees(
+	ctx context.Context,
+	req *grpc.ReactivateSystemFeesRequest,
+	rsp *grpc.SystemFeesResponse,
+) error {
+	if bson.IsObjectIdHex(req.Id) == false || bson.IsObjectIdHex(req.UserId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = errorSystemFeeNotFound
+
+		return nil
 	}
 
-	err = s.db.Collection(pkg.CollectionSystemFees).Insert(fees)
+	prev := &billing.SystemFees{}
+	err := s.db.Collection(pkg.CollectionSystemFees).FindId(bson.ObjectIdHex(req.Id)).One(prev)
 
 	if err != nil {
-		s.logError("Query to add fees failed", []interface{}{"err", err.Error(), "data", req})
-		return err
-	}
+		if err == mgo.ErrNotFound {
+			rsp.Status = pkg.ResponseStatusNotFound
+			rsp.Message = errorSystemFeeNotFound
 
-	// updating a cache
-	s.mx.Lock()
-	defer s.mx.Unlock()
+			return nil
+		}
+
+		s.logError("Query to find system fees failed", []interface{}{"err", err.Error(), "id", req.Id})
 
-	if _, ok := s.systemFeesCache[fees.MethodId]; !ok {
-		s.systemFeesCache[fees.MethodId] = make(map[string]map[string]*billing.SystemFees)
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorSystemFeeQueryFailed
+
+		return nil
 	}
-	if _, ok := s.systemFeesCache[fees.MethodId][fees.Region]; !ok {
-		s.systemFeesCache[fees.MethodId][fees.Region] = make(map[string]*billing.SystemFees)
+
+	fees := &billing.SystemFees{
+		Id:                bson.NewObjectId().Hex(),
+		MethodId:          prev.MethodId,
+		Region:            prev.Region,
+		CardBrand:         prev.CardBrand,
+		Fees:              prev.Fees,
+		UserId:            req.UserId,
+		CreatedAt:         ptypes.TimestampNow(),
+		IsActive:          true,
+		EffectiveFrom:     req.EffectiveFrom,
+		ReactivatedFromId: prev.Id,
 	}
-	s.systemFeesCache[fees.MethodId][fees.Region][fees.CardBrand] = fees
+
+	err = s.saveSystemFeesVersion(fees)
+
+	if err != nil {
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = err.Error()
+
+		if err.Error() == errorSystemFeeEffectivePeriodInvalid {
+			rsp.Status = pkg.ResponseStatusBadData
+		}
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = fees
 
 	return nil
 }
 
-func (s *Service) GetSystemFeesForPayment(
+// ListSystemFeesHistory return versions of system fees of payment method from latest to oldest
+func (s *Service) ListSystemFeesHistory(
 	ctx context.Context,
-	req *billing.GetSystemFeesRequest,
-	res *billing.FeeSet,
+	req *grpc.ListSystemFeesHistoryRequest,
+	rsp *grpc.ListSystemFeesHistoryResponse,
 ) error {
-	sf, ok := s.systemFeesCache[req.MethodId]
-	if !ok {
-		return errors.New(errorSystemFeeNotFound)
+	if bson.IsObjectIdHex(req.MethodId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = orderErrorPaymentMethodNotFound
+
+		return nil
 	}
 
-	sfr, ok := sf[req.Region]
-	if !ok {
-		return errors.New(errorSystemFeeNotFound)
+	query := bson.M{"method_id": bson.ObjectIdHex(req.MethodId)}
+
+	if req.Region != "" {
+		query["region"] = req.Region
 	}
 
-	systemFees, ok := sfr[req.CardBrand]
-	if !ok {
-		return errors.New(errorSystemFeeNotFound)
+	if req.CardBrand != "" {
+		query["card_brand"] = req.CardBrand
 	}
 
-	var matchedAmounts []*kv
+	var fees []*billing.SystemFees
+	err := s.db.Collection(pkg.CollectionSystemFees).Find(query).Sort("-version", "-_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&fees)
 
-	for k, f := range systemFees.Fees {
-		minA, ok := f.MinAmounts[req.Currency]
-		if !ok {
+	if err != nil {
+		s.logError("Query to find system fees history failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorSystemFeeQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionSystemFees).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count system fees history failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = errorSystemFeeQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = fees
+
+	return nil
+}
+
+// saveSystemFeesVersion save system fees as next version for method, region and card brand. Effective periods
+// of previous versions are closed by start of new version, versions scheduled after it are cancelled
+func (s *Service) saveSystemFeesVersion(fees *billing.SystemFees) error {
+	if fees.EffectiveFrom == nil {
+		fees.EffectiveFrom = fees.CreatedAt
+	}
+
+	from, err := ptypes.Timestamp(fees.EffectiveFrom)
+
+	if err != nil {
+		return errors.New(errorSystemFeeEffectivePeriodInvalid)
+	}
+
+	if fees.EffectiveTo != nil {
+		to, err := ptypes.Timestamp(fees.EffectiveTo)
+
+		if err != nil || !to.After(from) {
+			s.logError(errorSystemFeeEffectivePeriodInvalid, []interface{}{"data", fees})
+			return errors.New(errorSystemFeeEffectivePeriodInvalid)
+		}
+	}
+
+	// version of system fees is unique, so version taken by concurrent request leads to insert failure
+	for i := 0; i < systemFeesSaveMaxAttempts; i++ {
+		if err = s.insertSystemFeesVersion(fees, from); !mgo.IsDup(err) {
+			break
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = s.cache(pkg.CollectionSystemFees, handlers[pkg.CollectionSystemFees](s))
+
+	if err != nil {
+		return err
+	}
+
+	s.publishCacheInvalidation(pkg.CollectionSystemFees, "")
+
+	return nil
+}
+
+// insertSystemFeesVersion close effective periods of previous versions and insert system fees as next version
+func (s *Service) insertSystemFeesVersion(fees *billing.SystemFees, from time.Time) error {
+	var versions []*billing.SystemFees
+	query := bson.M{"method_id": bson.ObjectIdHex(fees.MethodId), "region": fees.Region, "card_brand": fees.CardBrand}
+	err := s.db.Collection(pkg.CollectionSystemFees).Find(query).Sort("-version").All(&versions)
+
+	if err != nil {
+		s.logError("Query to find system fees versions failed", []interface{}{"err", err.Error(), "query", query})
+		return err
+	}
+
+	fees.Version = 1
+
+	if len(versions) > 0 {
+		fees.Version = versions[0].Version + 1
+	}
+
+	for _, v := range versions {
+		// versions replaced before effective periods were introduced are history only
+		if !v.IsActive && v.EffectiveTo == nil {
 			continue
 		}
-		if req.Amount >= minA {
-			matchedAmounts = append(matchedAmounts, &kv{k, minA})
+
+		closeAt := from
+
+		if v.EffectiveFrom != nil {
+			if vFrom, err := ptypes.Timestamp(v.EffectiveFrom); err == nil && vFrom.After(from) {
+				closeAt = vFrom
+			}
+		}
+
+		if v.EffectiveTo != nil {
+			if vTo, err := ptypes.Timestamp(v.EffectiveTo); err == nil && !vTo.After(closeAt) {
+				continue
+			}
+		}
+
+		v.EffectiveTo, _ = ptypes.TimestampProto(closeAt)
+		v.IsActive = false
+		v.ClosedByUserId = fees.UserId
+		v.ClosedAt = ptypes.TimestampNow()
+
+		err = s.db.Collection(pkg.CollectionSystemFees).UpdateId(bson.ObjectIdHex(v.Id), v)
+
+		if err != nil {
+			s.logError("Query to close system fees version failed", []interface{}{"err", err.Error(), "data", v})
+			return err
 		}
 	}
 
-	if len(matchedAmounts) == 0 {
-		return errors.New(errorSystemFeeMatchedMinAmountNotFound)
+	err = s.db.Collection(pkg.CollectionSystemFees).Insert(fees)
+
+	if err != nil {
+		if !mgo.IsDup(err) {
+			s.logError("Query to add fees failed", []interface{}{"err", err.Error(), "data", fees})
+		}
+
+		return err
 	}
 
-	sort.Slice(matchedAmounts, func(i, j int) bool {
-		return matchedAmounts[i].Value > matchedAmounts[j].Value
-	})
+	return nil
+}
+
+// getEffectiveSystemFees return version of system fees which is effective on date
+func (s *Service) getEffectiveSystemFees(methodId, region, cardBrand string, date time.Time) *billing.SystemFees {
+	for _, v := range s.getCachedSystemFees(methodId, region, cardBrand) {
+		if v.IsEffective(date) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) GetSystemFeesForPayment(
+	ctx context.Context,
+	req *billing.GetSystemFeesRequest,
+	res *billing.FeeSet,
+) error {
+	date := time.Now()
+
+	if req.Date != nil {
+		if t, err := ptypes.Timestamp(req.Date); err == nil {
+			date = t
+		}
+	}
+
+	systemFees := s.getEffectiveSystemFees(req.MethodId, req.Region, req.CardBrand, date)
+	if systemFees == nil {
+		return errors.New(errorSystemFeeNotFound)
+	}
+
+	f, err := getSystemFeeSet(systemFees, money.FromFloat(req.Amount, req.Currency))
+	if err != nil {
+		return err
+	}
 
-	f := systemFees.Fees[matchedAmounts[0].Key]
 	res.MinAmounts = f.MinAmounts
 	res.TransactionCost = f.TransactionCost
 	res.AuthorizationFee = f.AuthorizationFee
 	return nil
 }
 
+// GetActualSystemFeesList return versions of system fees which are effective now. Version closed by
+// scheduled version stay effective until start of scheduled version
 func (s *Service) GetActualSystemFeesList(
 	ctx context.Context,
 	req *grpc.EmptyRequest,
@@ -194,13 +407,227 @@ func (s *Service) GetActualSystemFeesList(
 ) error {
 	var (
 		fees  []*billing.SystemFees
-		query = bson.M{"is_active": true}
+		now   = time.Now()
+		query = bson.M{"$or": []bson.M{{"effective_to": nil}, {"effective_to": bson.M{"$gt": now}}}}
 	)
 	e := s.db.Collection(pkg.CollectionSystemFees).Find(query).All(&fees)
 	if e != nil {
 		s.logError("Get System fees failed", []interface{}{"err", e.Error(), "query", query})
 		return e
 	}
-	res.SystemFees = fees
+
+	for _, v := range fees {
+		if v.IsEffective(now) {
+			res.SystemFees = append(res.SystemFees, v)
+		}
+	}
+
 	return nil
 }
+
+// getSystemFeeSet return fee set with greatest min amount in currency which isn't greater than amount
+func getSystemFeeSet(systemFees *billing.SystemFees, amount money.Money) (*billing.FeeSet, error) {
+	var matchedAmounts []*kv
+
+	for k, f := range systemFees.Fees {
+		minA, ok := f.MinAmounts[amount.Currency()]
+		if !ok {
+			continue
+		}
+		if r, _ := amount.Compare(money.FromFloat(minA, amount.Currency())); r >= 0 {
+			matchedAmounts = append(matchedAmounts, &kv{k, minA})
+		}
+	}
+
+	if len(matchedAmounts) == 0 {
+		return nil, errors.New(errorSystemFeeMatchedMinAmountNotFound)
+	}
+
+	sort.Slice(matchedAmounts, func(i, j int) bool {
+		return matchedAmounts[i].Value > matchedAmounts[j].Value
+	})
+
+	return systemFees.Fees[matchedAmounts[0].Key], nil
+}
+
+// getSystemFeesRegions return regions of system fees for country from most specific to worldwide
+func getSystemFeesRegions(country string) []string {
+	var regions []string
+
+	if country != "" {
+		regions = append(regions, country)
+	}
+
+	if contains(euCountries, country) {
+		regions = append(regions, systemFeesRegionEu)
+	}
+
+	return append(regions, "")
+}
+
+// getSystemFeesForCountry return system fees of payment method for card brand in most specific region
+// of payer country which are effective on date
+func (s *Service) getSystemFeesForCountry(
+	methodId, country, cardBrand string,
+	date time.Time,
+) (*billing.SystemFees, error) {
+	for _, region := range getSystemFeesRegions(country) {
+		if fees := s.getEffectiveSystemFees(methodId, region, cardBrand, date); fees != nil {
+			return fees, nil
+		}
+	}
+
+	return nil, errors.New(errorSystemFeeNotFound)
+}
+
+// processOrderSystemFees calculate transaction cost and authorization fee of payment by system fees of payment
+// method for card brand and issuer country of bank card or country of payer. Fees saved to order in payment
+// currency and in accounting currencies of merchant, PSP and payment system, total of fees saved as PSP fee.
+// Order without system fees for its payment method is left without fees
+func (s *Service) processOrderSystemFees(order *billing.Order) error {
+	country := order.PaymentRequisites[paymentCreateBankCardFieldIssuerCountryCode]
+
+	if country == "" && order.User != nil && order.User.Address != nil {
+		country = order.User.Address.Country
+	}
+
+	cardBrand := strings.ToUpper(order.PaymentRequisites[paymentCreateBankCardFieldBrand])
+	currency := order.PaymentMethodOutcomeCurrency.CodeA3
+	amount := money.FromFloat(order.PaymentMethodOutcomeAmount, currency)
+
+	systemFees, err := s.getSystemFeesForCountry(
+		order.PaymentMethod.Id,
+		strings.ToUpper(country),
+		cardBrand,
+		order.GetRateDate(),
+	)
+
+	if err != nil {
+		return nil
+	}
+
+	feeSet, err := getSystemFeeSet(systemFees, amount)
+
+	if err != nil {
+		s.logError(
+			"System fees for payment amount not found",
+			[]interface{}{"err", err.Error(), "order_id", order.Id, "system_fees_id", systemFees.Id},
+		)
+		return nil
+	}
+
+	transactionCost, err := s.calculateOrderSystemFee(order, feeSet.TransactionCost, amount)
+
+	if err != nil {
+		return err
+	}
+
+	authorizationFee, err := s.calculateOrderSystemFee(order, feeSet.AuthorizationFee, amount)
+
+	if err != nil {
+		return err
+	}
+
+	order.SystemFees = &billing.OrderSystemFees{
+		Id:               systemFees.Id,
+		Region:           systemFees.Region,
+		CardBrand:        systemFees.CardBrand,
+		TransactionCost:  transactionCost,
+		AuthorizationFee: authorizationFee,
+	}
+
+	merchantCurrency := ""
+
+	if merchant, ok := s.getCachedMerchant(order.Project.MerchantId); ok && merchant.GetPayoutCurrency() != nil {
+		merchantCurrency = merchant.GetPayoutCurrency().CodeA3
+	}
+
+	tc, af := transactionCost, authorizationFee
+	order.PspFeeAmount = &billing.OrderFeePsp{
+		AmountPaymentMethodCurrency: sumSystemFees(currency, tc.AmountPaymentMethodCurrency, af.AmountPaymentMethodCurrency),
+		AmountMerchantCurrency:      sumSystemFees(merchantCurrency, tc.AmountMerchantCurrency, af.AmountMerchantCurrency),
+		AmountPspCurrency:           sumSystemFees(s.accountingCurrency.CodeA3, tc.AmountPspCurrency, af.AmountPspCurrency),
+	}
+
+	return nil
+}
+
+// sumSystemFees return exact sum of fee amounts in currency
+func sumSystemFees(currency string, amounts ...float64) float64 {
+	sum := money.Zero(currency)
+
+	for _, v := range amounts {
+		sum, _ = sum.Add(money.FromFloat(v, currency))
+	}
+
+	return sum.Float64()
+}
+
+// calculateOrderSystemFee calculate system fee for payment amount in payment currency and convert it to
+// accounting currencies of merchant, PSP and payment system
+func (s *Service) calculateOrderSystemFee(
+	order *billing.Order,
+	fee *billing.SystemFee,
+	amount money.Money,
+) (*billing.OrderSystemFee, error) {
+	currency := order.PaymentMethodOutcomeCurrency
+	res := &billing.OrderSystemFee{}
+
+	if fee == nil {
+		return res, nil
+	}
+
+	fix := money.FromFloat(fee.FixAmount, currency.CodeA3)
+
+	if fee.FixAmount > 0 && fee.FixCurrency != "" && fee.FixCurrency != currency.CodeA3 {
+		fixCurrency, err := s.GetCurrencyByCodeA3(fee.FixCurrency)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fix, err = s.convertOrderAmount(order, fixCurrency, currency, money.FromFloat(fee.FixAmount, fixCurrency.CodeA3))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	total, err := amount.Multiply(fee.Percent / 100).Add(fix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.AmountPaymentMethodCurrency = total.Float64()
+
+	converted, err := s.convertOrderAmount(order, currency, s.accountingCurrency, total)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.AmountPspCurrency = converted.Float64()
+
+	if merchant, ok := s.getCachedMerchant(order.Project.MerchantId); ok && merchant.GetPayoutCurrency() != nil {
+		converted, err = s.convertOrderAmount(order, currency, merchant.GetPayoutCurrency(), total)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.AmountMerchantCurrency = converted.Float64()
+	}
+
+	if ps := order.PaymentMethod.PaymentSystem; ps != nil && ps.AccountingCurrency != nil {
+		converted, err = s.convertOrderAmount(order, currency, ps.AccountingCurrency, total)
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.AmountPaymentSystemCurrency = converted.Float64()
+	}
+
+	return res, nil
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
+	"sync"
 	"testing"
+	"time"
 )
 
 type SystemFeesTestSuite struct {
@@ -501,3 +503,357 @@ func (suite *SystemFeesTestSuite) TestSystemFees_GetSystemFeesForPayment() {
 	err = suite.service.GetSystemFeesForPayment(context.TODO(), req, &sf)
 	assert.EqualError(suite.T(), err, errorSystemFeeMatchedMinAmountNotFound)
 }
+
+func (suite *SystemFeesTestSuite) TestSystemFees_GetSystemFeesForCountry_Ok() {
+	req := &billing.AddSystemFeesRequest{
+		MethodId:  suite.paymentMethod.Id,
+		Region:    "US",
+		CardBrand: "VISA",
+		Fees: []*billing.FeeSet{
+			{
+				MinAmounts:       map[string]float64{"RUB": 0},
+				TransactionCost:  systemFeeExample,
+				AuthorizationFee: systemFeeExample,
+			},
+		},
+		UserId: suite.AdminUserId,
+	}
+
+	err := suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	fees, err := suite.service.getSystemFeesForCountry(suite.paymentMethod.Id, "US", "VISA", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "US", fees.Region)
+
+	fees, err = suite.service.getSystemFeesForCountry(suite.paymentMethod.Id, "FR", "VISA", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), systemFeesRegionEu, fees.Region)
+
+	fees, err = suite.service.getSystemFeesForCountry(suite.paymentMethod.Id, "RU", "MASTERCARD", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "", fees.Region)
+
+	_, err = suite.service.getSystemFeesForCountry(suite.paymentMethod.Id, "US", "JCB", time.Now())
+	assert.EqualError(suite.T(), err, errorSystemFeeNotFound)
+
+	_, err = suite.service.getSystemFeesForCountry(bson.NewObjectId().Hex(), "US", "VISA", time.Now())
+	assert.EqualError(suite.T(), err, errorSystemFeeNotFound)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_ProcessOrderSystemFees_Ok() {
+	req := &billing.AddSystemFeesRequest{
+		MethodId:  suite.paymentMethod.Id,
+		Region:    "US",
+		CardBrand: "VISA",
+		Fees: []*billing.FeeSet{
+			{
+				MinAmounts: map[string]float64{"RUB": 0},
+				TransactionCost: &billing.SystemFee{
+					Percent:         2,
+					PercentCurrency: "RUB",
+					FixAmount:       10,
+					FixCurrency:     "RUB",
+				},
+				AuthorizationFee: &billing.SystemFee{
+					FixAmount:   5,
+					FixCurrency: "RUB",
+				},
+			},
+			{
+				MinAmounts: map[string]float64{"RUB": 100000},
+				TransactionCost: &billing.SystemFee{
+					Percent:         1,
+					PercentCurrency: "RUB",
+					FixCurrency:     "RUB",
+				},
+				AuthorizationFee: &billing.SystemFee{
+					FixCurrency: "RUB",
+				},
+			},
+		},
+		UserId: suite.AdminUserId,
+	}
+
+	err := suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rub, err := suite.service.GetCurrencyByCodeA3("RUB")
+	assert.NoError(suite.T(), err)
+
+	order := &billing.Order{
+		Id:                           bson.NewObjectId().Hex(),
+		Project:                      &billing.ProjectOrder{Id: bson.NewObjectId().Hex(), MerchantId: bson.NewObjectId().Hex()},
+		PaymentMethod:                &billing.PaymentMethodOrder{Id: suite.paymentMethod.Id, Group: suite.paymentMethod.Group},
+		PaymentMethodOutcomeAmount:   1000,
+		PaymentMethodOutcomeCurrency: rub,
+		PaymentRequisites: map[string]string{
+			paymentCreateBankCardFieldBrand:             "Visa",
+			paymentCreateBankCardFieldIssuerCountryCode: "US",
+		},
+		CreatedAt: ptypes.TimestampNow(),
+	}
+
+	err = suite.service.processOrderSystemFees(order)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), order.SystemFees)
+	assert.Equal(suite.T(), "US", order.SystemFees.Region)
+	assert.Equal(suite.T(), "VISA", order.SystemFees.CardBrand)
+	assert.Equal(suite.T(), float64(30), order.SystemFees.TransactionCost.AmountPaymentMethodCurrency)
+	assert.Equal(suite.T(), float64(30), order.SystemFees.TransactionCost.AmountPspCurrency)
+	assert.Equal(suite.T(), float64(5), order.SystemFees.AuthorizationFee.AmountPaymentMethodCurrency)
+	assert.NotNil(suite.T(), order.PspFeeAmount)
+	assert.Equal(suite.T(), float64(35), order.PspFeeAmount.AmountPaymentMethodCurrency)
+	assert.Equal(suite.T(), float64(35), order.PspFeeAmount.AmountPspCurrency)
+
+	order.PaymentMethodOutcomeAmount = 200000
+	order.SystemFees, order.PspFeeAmount = nil, nil
+
+	err = suite.service.processOrderSystemFees(order)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(2000), order.PspFeeAmount.AmountPaymentMethodCurrency)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_ProcessOrderSystemFees_NotFound_Ok() {
+	rub, err := suite.service.GetCurrencyByCodeA3("RUB")
+	assert.NoError(suite.T(), err)
+
+	order := &billing.Order{
+		Id:                           bson.NewObjectId().Hex(),
+		Project:                      &billing.ProjectOrder{Id: bson.NewObjectId().Hex(), MerchantId: bson.NewObjectId().Hex()},
+		PaymentMethod:                &billing.PaymentMethodOrder{Id: bson.NewObjectId().Hex()},
+		PaymentMethodOutcomeAmount:   1000,
+		PaymentMethodOutcomeCurrency: rub,
+	}
+
+	err = suite.service.processOrderSystemFees(order)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), order.SystemFees)
+	assert.Nil(suite.T(), order.PspFeeAmount)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_AddSystemFees_Scheduled_Ok() {
+	effectiveFrom := time.Now().Add(time.Hour * 24)
+	from, _ := ptypes.TimestampProto(effectiveFrom)
+
+	req := &billing.AddSystemFeesRequest{
+		MethodId:  suite.paymentMethod.Id,
+		CardBrand: "MASTERCARD",
+		Fees: []*billing.FeeSet{
+			{
+				MinAmounts: map[string]float64{"EUR": 0, "USD": 0},
+				TransactionCost: &billing.SystemFee{
+					Percent:         2.35,
+					PercentCurrency: "EUR",
+				},
+				AuthorizationFee: systemFeeExample,
+			},
+		},
+		UserId:        suite.AdminUserId,
+		EffectiveFrom: from,
+	}
+
+	err := suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	fees := suite.service.getEffectiveSystemFees(suite.paymentMethod.Id, "", "MASTERCARD", time.Now())
+	assert.NotNil(suite.T(), fees)
+	assert.Equal(suite.T(), float64(1.15), fees.Fees[0].TransactionCost.Percent)
+	assert.Equal(suite.T(), suite.AdminUserId, fees.ClosedByUserId)
+	assert.NotNil(suite.T(), fees.EffectiveTo)
+
+	fees = suite.service.getEffectiveSystemFees(suite.paymentMethod.Id, "", "MASTERCARD", effectiveFrom)
+	assert.NotNil(suite.T(), fees)
+	assert.Equal(suite.T(), float64(2.35), fees.Fees[0].TransactionCost.Percent)
+	assert.Equal(suite.T(), int32(1), fees.Version)
+
+	sf := billing.FeeSet{}
+	date, _ := ptypes.TimestampProto(effectiveFrom.Add(time.Hour))
+	err = suite.service.GetSystemFeesForPayment(
+		context.TODO(),
+		&billing.GetSystemFeesRequest{
+			MethodId:  suite.paymentMethod.Id,
+			CardBrand: "MASTERCARD",
+			Amount:    100,
+			Currency:  "USD",
+			Date:      date,
+		},
+		&sf,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), float64(2.35), sf.TransactionCost.Percent)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_GetActualSystemFeesList_Scheduled_Ok() {
+	from, _ := ptypes.TimestampProto(time.Now().Add(time.Hour * 24))
+
+	req := &billing.AddSystemFeesRequest{
+		MethodId:  suite.paymentMethod.Id,
+		CardBrand: "MASTERCARD",
+		Fees: []*billing.FeeSet{
+			{
+				MinAmounts: map[string]float64{"EUR": 0, "USD": 0},
+				TransactionCost: &billing.SystemFee{
+					Percent:         2.35,
+					PercentCurrency: "EUR",
+				},
+				AuthorizationFee: systemFeeExample,
+			},
+		},
+		UserId:        suite.AdminUserId,
+		EffectiveFrom: from,
+	}
+
+	err := suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rsp := &billing.SystemFeesList{}
+	err = suite.service.GetActualSystemFeesList(context.TODO(), &grpc.EmptyRequest{}, rsp)
+	assert.NoError(suite.T(), err)
+
+	var actual []*billing.SystemFees
+
+	for _, v := range rsp.SystemFees {
+		if v.MethodId == suite.paymentMethod.Id && v.Region == "" && v.CardBrand == "MASTERCARD" {
+			actual = append(actual, v)
+		}
+	}
+
+	assert.Len(suite.T(), actual, 1)
+	assert.Equal(suite.T(), float64(1.15), actual[0].Fees[0].TransactionCost.Percent)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_AddSystemFees_Concurrent_UniqueVersions() {
+	var wg sync.WaitGroup
+
+	count := 5
+	errs := make(chan error, count)
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			req := &billing.AddSystemFeesRequest{
+				MethodId:  suite.paymentMethod.Id,
+				Region:    "US",
+				CardBrand: "VISA",
+				Fees: []*billing.FeeSet{
+					{
+						MinAmounts:       map[string]float64{"EUR": 0, "USD": 0},
+						TransactionCost:  systemFeeExample,
+						AuthorizationFee: systemFeeExample,
+					},
+				},
+				UserId: suite.AdminUserId,
+			}
+			errs <- suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(suite.T(), err)
+	}
+
+	var fees []*billing.SystemFees
+	query := bson.M{"method_id": bson.ObjectIdHex(suite.paymentMethod.Id), "region": "US", "card_brand": "VISA"}
+	err := suite.service.db.Collection(pkg.CollectionSystemFees).Find(query).All(&fees)
+	assert.NoError(suite.T(), err)
+
+	versions := make(map[int32]bool)
+
+	for _, v := range fees {
+		assert.False(suite.T(), versions[v.Version], "version %d is duplicated", v.Version)
+		versions[v.Version] = true
+	}
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_AddSystemFees_EffectivePeriodInvalid_Error() {
+	from, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	to, _ := ptypes.TimestampProto(time.Now())
+
+	req := &billing.AddSystemFeesRequest{
+		MethodId:  suite.paymentMethod.Id,
+		CardBrand: "MASTERCARD",
+		Fees: []*billing.FeeSet{
+			{
+				MinAmounts:       map[string]float64{"EUR": 0, "USD": 0},
+				TransactionCost:  systemFeeExample,
+				AuthorizationFee: systemFeeExample,
+			},
+		},
+		UserId:        suite.AdminUserId,
+		EffectiveFrom: from,
+		EffectiveTo:   to,
+	}
+
+	err := suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.EqualError(suite.T(), err, errorSystemFeeEffectivePeriodInvalid)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_ReactivateSystemFees_Ok() {
+	prev := suite.service.getEffectiveSystemFees(suite.paymentMethod.Id, "", "MASTERCARD", time.Now())
+	assert.NotNil(suite.T(), prev)
+
+	req := &billing.AddSystemFeesRequest{
+		MethodId:  suite.paymentMethod.Id,
+		CardBrand: "MASTERCARD",
+		Fees: []*billing.FeeSet{
+			{
+				MinAmounts:       map[string]float64{"EUR": 0, "USD": 0},
+				TransactionCost:  systemFeeExample,
+				AuthorizationFee: systemFeeExample,
+			},
+		},
+		UserId: suite.AdminUserId,
+	}
+
+	err := suite.service.AddSystemFees(context.TODO(), req, &grpc.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rsp := &grpc.SystemFeesResponse{}
+	err = suite.service.ReactivateSystemFees(
+		context.TODO(),
+		&grpc.ReactivateSystemFeesRequest{Id: prev.Id, UserId: suite.AdminUserId},
+		rsp,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), prev.Id, rsp.Item.ReactivatedFromId)
+	assert.Equal(suite.T(), int32(2), rsp.Item.Version)
+
+	fees := suite.service.getEffectiveSystemFees(suite.paymentMethod.Id, "", "MASTERCARD", time.Now())
+	assert.NotNil(suite.T(), fees)
+	assert.Equal(suite.T(), rsp.Item.Id, fees.Id)
+	assert.Equal(suite.T(), prev.Fees[0].TransactionCost.Percent, fees.Fees[0].TransactionCost.Percent)
+
+	history := &grpc.ListSystemFeesHistoryResponse{}
+	err = suite.service.ListSystemFeesHistory(
+		context.TODO(),
+		&grpc.ListSystemFeesHistoryRequest{MethodId: suite.paymentMethod.Id, CardBrand: "MASTERCARD"},
+		history,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, history.Status)
+	assert.Equal(suite.T(), int32(3), history.Count)
+	assert.Equal(suite.T(), rsp.Item.Id, history.Items[0].Id)
+	assert.True(suite.T(), history.Items[0].IsActive)
+	assert.False(suite.T(), history.Items[1].IsActive)
+	assert.Equal(suite.T(), suite.AdminUserId, history.Items[1].ClosedByUserId)
+}
+
+func (suite *SystemFeesTestSuite) TestSystemFees_ReactivateSystemFees_NotFound_Error() {
+	rsp := &grpc.SystemFeesResponse{}
+	err := suite.service.ReactivateSystemFees(
+		context.TODO(),
+		&grpc.ReactivateSystemFeesRequest{Id: bson.NewObjectId().Hex(), UserId: suite.AdminUserId},
+		rsp,
+	)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), errorSystemFeeNotFound, rsp.Message)
+}
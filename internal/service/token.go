@@ -77,7 +77,7 @@ func (s *Service) CreateToken(
 		return nil
 	}
 
-	project, ok := s.projectCache[req.Settings.ProjectId]
+	project, ok := s.getCachedProject(req.Settings.ProjectId)
 
 	if !ok {
 		rsp.Status = pkg.ResponseStatusBadData
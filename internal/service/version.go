@@ -0,0 +1,64 @@
+package service
+
+import (
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+)
+
+const (
+	// max attempts to save document changed concurrently by another request
+	versionConflictMaxAttempts = 5
+)
+
+var (
+	errVersionConflict = errors.New("document was changed by another request. try request later")
+)
+
+// versionQuery return query to find document with expected version. Documents saved before versioning
+// haven't version field and match to zero version
+func versionQuery(id string, version int64) bson.M {
+	query := bson.M{"_id": bson.ObjectIdHex(id), "version": version}
+
+	if version == 0 {
+		query["version"] = bson.M{"$in": []interface{}{0, nil}}
+	}
+
+	return query
+}
+
+// updateVersioned save document only if it version in database equal to version of document and increment
+// version of document. If document was changed by another request then errVersionConflict returned and
+// version of document stay unchanged
+func (s *Service) updateVersioned(collection, id string, version *int64, doc interface{}) error {
+	expected := *version
+	*version++
+
+	err := s.db.Collection(collection).Update(versionQuery(id, expected), doc)
+
+	if err != nil {
+		*version = expected
+
+		if err == mgo.ErrNotFound {
+			return errVersionConflict
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// retryOnVersionConflict call fn again while it fails with version conflict, but not more than
+// versionConflictMaxAttempts times. fn must re-read documents on every call
+func retryOnVersionConflict(fn func() error) error {
+	var err error
+
+	for i := 0; i < versionConflictMaxAttempts; i++ {
+		if err = fn(); err != errVersionConflict {
+			return err
+		}
+	}
+
+	return err
+}
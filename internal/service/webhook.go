@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"go.uber.org/zap"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	webhookErrorQueryFailed = "webhook deliveries query failed"
+	webhookErrorNotFound    = "webhook delivery not found"
+	webhookErrorProject     = "project of webhook delivery not found or webhooks are disabled for it"
+
+	webhookEventOrderChanged  = "order.changed"
+	webhookEventRefundChanged = "refund.changed"
+
+	// header of request contains time of signing and signature of request: t=<unix time>,v1=<signature>
+	webhookSignatureHeader = "X-Paysuper-Signature"
+	webhookSignatureFormat = "t=%d,v1=%s"
+
+	// time while delivery is reserved by one dispatcher and can't be taken by another
+	webhookClaimLifeTime = time.Minute
+	webhookBackoffBase   = 10 * time.Second
+	webhookBackoffMax    = 6 * time.Hour
+	webhookBatchSize     = 100
+
+	// max length of response body saved to history of delivery
+	webhookResponseBodyLength = 1024
+)
+
+// webhookPayload is body of webhook request, id of delivery allows merchant to skip repeated deliveries
+type webhookPayload struct {
+	Id        string      `json:"id"`
+	Event     string      `json:"event"`
+	CreatedAt int64       `json:"created_at"`
+	Object    interface{} `json:"object"`
+}
+
+// sendOrderWebhook schedule delivery of order changes to url of project
+func (s *Service) sendOrderWebhook(order *billing.Order) {
+	s.createWebhookDelivery(order.Project.Id, order.Id, "", webhookEventOrderChanged, getWebhookOrder(order))
+}
+
+// sendRefundWebhook schedule delivery of refund changes to url of project
+func (s *Service) sendRefundWebhook(refund *billing.Refund, order *billing.Order) {
+	s.createWebhookDelivery(order.Project.Id, order.Id, refund.Id, webhookEventRefundChanged, getWebhookRefund(refund))
+}
+
+// getWebhookOrder return copy of order without secret key of project, credentials of payment system and
+// fees of payment system and psp, which mustn't be sent to merchant, saved to deliveries or logged
+func getWebhookOrder(order *billing.Order) *billing.Order {
+	o := *order
+
+	if order.Project != nil {
+		project := *order.Project
+		project.SecretKey = ""
+		o.Project = &project
+	}
+
+	if order.PaymentMethod != nil {
+		o.PaymentMethod = &billing.PaymentMethodOrder{
+			Id:    order.PaymentMethod.Id,
+			Name:  order.PaymentMethod.Name,
+			Group: order.PaymentMethod.Group,
+		}
+	}
+
+	o.PspFeeAmount = nil
+	o.PaymentSystemFeeAmount = nil
+	o.AmountInPspAccountingCurrency = 0
+	o.AmountInPaymentSystemAccountingCurrency = 0
+
+	return &o
+}
+
+// getWebhookRefund return copy of refund without internal currency rates
+func getWebhookRefund(refund *billing.Refund) *billing.Refund {
+	r := *refund
+	r.CurrencyRates = nil
+
+	return &r
+}
+
+// createWebhookDelivery save webhook delivery which will be sent by dispatcher. Delivery isn't created
+// if project hasn't url to send notifications or notifications are disabled by callback protocol of project
+func (s *Service) createWebhookDelivery(projectId, orderId, refundId, event string, object interface{}) {
+	project, ok := s.getCachedProject(projectId)
+
+	if !ok || !isWebhookEnabled(project) {
+		return
+	}
+
+	now := time.Now()
+	delivery := &billing.WebhookDelivery{
+		Id:        bson.NewObjectId().Hex(),
+		ProjectId: projectId,
+		OrderId:   orderId,
+		RefundId:  refundId,
+		Event:     event,
+		Url:       project.UrlProcessPayment,
+		Status:    pkg.WebhookDeliveryStatusPending,
+	}
+
+	b, err := json.Marshal(&webhookPayload{Id: delivery.Id, Event: event, CreatedAt: now.Unix(), Object: object})
+
+	if err != nil {
+		s.logError("Marshal webhook payload failed", []interface{}{"error", err.Error(), "order_id", orderId, "event", event})
+		return
+	}
+
+	delivery.Payload = string(b)
+	delivery.NextAttemptAt, _ = ptypes.TimestampProto(now)
+	delivery.CreatedAt, _ = ptypes.TimestampProto(now)
+	delivery.UpdatedAt = delivery.CreatedAt
+
+	if err = s.db.Collection(pkg.CollectionWebhookDelivery).Insert(delivery); err != nil {
+		s.logError("Insert webhook delivery failed", []interface{}{"error", err.Error(), "order_id", orderId, "event", event})
+	}
+}
+
+func isWebhookEnabled(project *billing.Project) bool {
+	return project.UrlProcessPayment != "" && project.CallbackProtocol != pkg.ProjectCallbackProtocolEmpty
+}
+
+// sendWebhookDelivery send signed payload to url of project and save attempt to history of delivery.
+// If request failed then next attempt will be scheduled with exponential backoff, after max attempts count
+// delivery marks as failed
+func (s *Service) sendWebhookDelivery(delivery *billing.WebhookDelivery) error {
+	project, ok := s.getCachedProject(delivery.ProjectId)
+
+	if !ok {
+		return fmt.Errorf(errorNotFound, pkg.CollectionProject)
+	}
+
+	start := time.Now()
+	attempt := &billing.WebhookDeliveryAttempt{}
+	attempt.CreatedAt, _ = ptypes.TimestampProto(start)
+
+	code, body, sErr := s.postWebhook(delivery, project.SecretKey, start)
+	now := time.Now()
+
+	attempt.ResponseCode = int32(code)
+	attempt.ResponseBody = body
+	attempt.Duration = now.Sub(start).Nanoseconds() / int64(time.Millisecond)
+
+	if sErr == nil && (code < http.StatusOK || code >= http.StatusMultipleChoices) {
+		sErr = fmt.Errorf("unexpected response status %d", code)
+	}
+
+	if sErr == nil {
+		delivery.Status = pkg.WebhookDeliveryStatusDelivered
+		delivery.DeliveredAt, _ = ptypes.TimestampProto(now)
+	} else {
+		attempt.Error = sErr.Error()
+		delivery.Attempts++
+
+		if delivery.Attempts >= s.cfg.WebhookMaxAttempts {
+			delivery.Status = pkg.WebhookDeliveryStatusFailed
+		}
+
+		delivery.NextAttemptAt, _ = ptypes.TimestampProto(now.Add(getWebhookBackoff(delivery.Attempts)))
+	}
+
+	delivery.History = append(delivery.History, attempt)
+	delivery.UpdatedAt, _ = ptypes.TimestampProto(now)
+
+	err := s.db.Collection(pkg.CollectionWebhookDelivery).UpdateId(bson.ObjectIdHex(delivery.Id), delivery)
+
+	if err != nil {
+		s.logError("Update webhook delivery failed", []interface{}{"error", err.Error(), "delivery_id", delivery.Id})
+	}
+
+	return sErr
+}
+
+// postWebhook send request to url of delivery and return status code and beginning of body of response
+func (s *Service) postWebhook(delivery *billing.WebhookDelivery, secret string, t time.Time) (int, string, error) {
+	req, err := http.NewRequest(http.MethodPost, delivery.Url, strings.NewReader(delivery.Payload))
+
+	if err != nil {
+		return 0, "", err
+	}
+
+	timestamp := t.Unix()
+	signature := webhookSignature(secret, strconv.FormatInt(timestamp, 10), delivery.Payload)
+
+	req.Header.Add(HeaderContentType, MIMEApplicationJSON)
+	req.Header.Add(webhookSignatureHeader, fmt.Sprintf(webhookSignatureFormat, timestamp, signature))
+
+	client := tools.NewLoggedHttpClient(zap.S())
+	client.Timeout = defaultHttpClientTimeout * time.Second
+
+	resp, err := client.Do(req)
+
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			return
+		}
+	}()
+
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, webhookResponseBodyLength))
+
+	return resp.StatusCode, string(b), err
+}
+
+// webhookSignature return hex encoded HMAC-SHA256 of time of signing and payload with secret key of project
+func webhookSignature(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func getWebhookBackoff(attempts int32) time.Duration {
+	backoff := webhookBackoffBase
+
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+
+		if backoff >= webhookBackoffMax {
+			return webhookBackoffMax
+		}
+	}
+
+	return backoff
+}
+
+func (s *Service) dispatchWebhooks() {
+	ticker := time.NewTicker(s.cfg.GetWebhookDispatchInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.dispatchWebhookDeliveries()
+		case <-s.webhookExit:
+			return
+		}
+	}
+}
+
+// dispatchWebhookDeliveries send pending deliveries which time of next attempt has come
+// and return count of delivered
+func (s *Service) dispatchWebhookDeliveries() int {
+	delivered := 0
+
+	for i := 0; i < webhookBatchSize; i++ {
+		delivery, err := s.claimWebhookDelivery()
+
+		if err != nil {
+			if err != mgo.ErrNotFound {
+				s.logError("Claim webhook delivery failed", []interface{}{"error", err.Error()})
+			}
+
+			break
+		}
+
+		if err = s.sendWebhookDelivery(delivery); err != nil {
+			s.logError(
+				"Send webhook failed",
+				[]interface{}{"error", err.Error(), "delivery_id", delivery.Id, "attempts", delivery.Attempts},
+			)
+
+			continue
+		}
+
+		delivered++
+	}
+
+	return delivered
+}
+
+// claimWebhookDelivery reserve one pending delivery for current process, so several service instances
+// can dispatch deliveries simultaneously without sending same delivery twice
+func (s *Service) claimWebhookDelivery() (*billing.WebhookDelivery, error) {
+	now := time.Now()
+	query := bson.M{"status": pkg.WebhookDeliveryStatusPending, "next_attempt_at": bson.M{"$lte": now}}
+	change := mgo.Change{
+		Update:    bson.M{"$set": bson.M{"next_attempt_at": now.Add(webhookClaimLifeTime)}},
+		ReturnNew: true,
+	}
+
+	delivery := &billing.WebhookDelivery{}
+	_, err := s.db.Collection(pkg.CollectionWebhookDelivery).Find(query).Sort("next_attempt_at").Apply(change, delivery)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (s *Service) ListWebhookDeliveries(
+	ctx context.Context,
+	req *grpc.ListWebhookDeliveriesRequest,
+	rsp *grpc.ListWebhookDeliveriesResponse,
+) error {
+	if bson.IsObjectIdHex(req.OrderId) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = orderErrorNotFound
+
+		return nil
+	}
+
+	query := bson.M{"order_id": bson.ObjectIdHex(req.OrderId)}
+
+	var deliveries []*billing.WebhookDelivery
+	err := s.db.Collection(pkg.CollectionWebhookDelivery).Find(query).Sort("_id").
+		Limit(int(req.Limit)).Skip(int(req.Offset)).All(&deliveries)
+
+	if err != nil {
+		s.logError("Query to find webhook deliveries failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = webhookErrorQueryFailed
+
+		return nil
+	}
+
+	count, err := s.db.Collection(pkg.CollectionWebhookDelivery).Find(query).Count()
+
+	if err != nil {
+		s.logError("Query to count webhook deliveries failed", []interface{}{"err", err.Error(), "query", query})
+
+		rsp.Status = pkg.ResponseStatusSystemError
+		rsp.Message = webhookErrorQueryFailed
+
+		return nil
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Count = int32(count)
+	rsp.Items = deliveries
+
+	return nil
+}
+
+// ResendWebhookDelivery send delivery again regardless of its status. Result of sending is saved
+// to history of delivery, if sending failed then delivery will be retried by dispatcher
+func (s *Service) ResendWebhookDelivery(
+	ctx context.Context,
+	req *grpc.ResendWebhookDeliveryRequest,
+	rsp *grpc.ResendWebhookDeliveryResponse,
+) error {
+	if bson.IsObjectIdHex(req.Id) == false {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = webhookErrorNotFound
+
+		return nil
+	}
+
+	delivery := &billing.WebhookDelivery{}
+	err := s.db.Collection(pkg.CollectionWebhookDelivery).FindId(bson.ObjectIdHex(req.Id)).One(delivery)
+
+	if err != nil {
+		if err != mgo.ErrNotFound {
+			s.logError("Query to find webhook delivery failed", []interface{}{"err", err.Error(), "id", req.Id})
+
+			rsp.Status = pkg.ResponseStatusSystemError
+			rsp.Message = webhookErrorQueryFailed
+
+			return nil
+		}
+
+		rsp.Status = pkg.ResponseStatusNotFound
+		rsp.Message = webhookErrorNotFound
+
+		return nil
+	}
+
+	project, ok := s.getCachedProject(delivery.ProjectId)
+
+	if !ok || !isWebhookEnabled(project) {
+		rsp.Status = pkg.ResponseStatusBadData
+		rsp.Message = webhookErrorProject
+
+		return nil
+	}
+
+	// url of project could be changed after delivery was created
+	delivery.Url = project.UrlProcessPayment
+	delivery.Status = pkg.WebhookDeliveryStatusPending
+	delivery.Attempts = 0
+
+	if err = s.sendWebhookDelivery(delivery); err != nil {
+		s.logError("Resend webhook failed", []interface{}{"error", err.Error(), "delivery_id", delivery.Id})
+	}
+
+	rsp.Status = pkg.ResponseStatusOk
+	rsp.Item = delivery
+
+	return nil
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-billing-server/internal/config"
+	"github.com/paysuper/paysuper-billing-server/internal/database"
+	"github.com/paysuper/paysuper-billing-server/internal/mock"
+	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	"github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookServerTest is http server of merchant which save received webhooks
+type webhookServerTest struct {
+	mx       sync.Mutex
+	server   *httptest.Server
+	status   int
+	body     string
+	requests []*http.Request
+	payloads []string
+}
+
+func newWebhookServerTest() *webhookServerTest {
+	s := &webhookServerTest{status: http.StatusOK, body: "ok"}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+
+		s.mx.Lock()
+		defer s.mx.Unlock()
+
+		s.requests = append(s.requests, r)
+		s.payloads = append(s.payloads, string(b))
+
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(s.body))
+	}))
+
+	return s
+}
+
+func (s *webhookServerTest) setResponse(status int, body string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.status, s.body = status, body
+}
+
+type WebhookTestSuite struct {
+	suite.Suite
+	service *Service
+	server  *webhookServerTest
+	project *billing.Project
+	order   *billing.Order
+}
+
+func Test_Webhook(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+func (suite *WebhookTestSuite) SetupTest() {
+	cfg, err := config.NewConfig()
+	assert.NoError(suite.T(), err, "Config load failed")
+	cfg.WebhookMaxAttempts = 2
+
+	settings := database.Connection{
+		Host:     cfg.MongoHost,
+		Database: cfg.MongoDatabase,
+		User:     cfg.MongoUser,
+		Password: cfg.MongoPassword,
+	}
+
+	db, err := database.NewDatabase(settings)
+	assert.NoError(suite.T(), err, "Database connection failed")
+
+	suite.server = newWebhookServerTest()
+
+	suite.project = &billing.Project{
+		Id:                bson.NewObjectId().Hex(),
+		MerchantId:        bson.NewObjectId().Hex(),
+		Name:              map[string]string{"en": "Unit test"},
+		CallbackProtocol:  pkg.ProjectCallbackProtocolDefault,
+		SecretKey:         "webhook_secret",
+		UrlProcessPayment: suite.server.server.URL,
+		Status:            pkg.ProjectStatusInProduction,
+	}
+
+	err = db.Collection(pkg.CollectionProject).Insert(suite.project)
+	assert.NoError(suite.T(), err, "Insert project test data failed")
+
+	suite.order = &billing.Order{
+		Id:     bson.NewObjectId().Hex(),
+		Uuid:   bson.NewObjectId().Hex(),
+		Status: constant.OrderStatusPaymentSystemCreate,
+		Project: &billing.ProjectOrder{
+			Id:         suite.project.Id,
+			MerchantId: suite.project.MerchantId,
+		},
+	}
+
+	err = db.Collection(pkg.CollectionOrder).Insert(suite.order)
+	assert.NoError(suite.T(), err, "Insert order test data failed")
+
+	suite.service = NewBillingService(db, cfg, make(chan bool, 1), nil, nil, nil, mock.NewBrokerMockOk(), nil)
+}
+
+func (suite *WebhookTestSuite) TearDownTest() {
+	suite.server.server.Close()
+
+	if err := suite.service.db.Drop(); err != nil {
+		suite.FailNow("Database deletion failed", "%v", err)
+	}
+
+	suite.service.db.Close()
+}
+
+func (suite *WebhookTestSuite) getDeliveries() []*billing.WebhookDelivery {
+	var deliveries []*billing.WebhookDelivery
+	err := suite.service.db.Collection(pkg.CollectionWebhookDelivery).Find(bson.M{}).Sort("_id").All(&deliveries)
+	assert.NoError(suite.T(), err)
+
+	return deliveries
+}
+
+func (suite *WebhookTestSuite) makeDeliveriesOverdue() {
+	_, err := suite.service.db.Collection(pkg.CollectionWebhookDelivery).UpdateAll(
+		bson.M{},
+		bson.M{"$set": bson.M{"next_attempt_at": time.Now().Add(-time.Second)}},
+	)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_OrderChanged_Ok() {
+	suite.order.Status = constant.OrderStatusPaymentSystemComplete
+
+	err := suite.service.updateOrderWithNotification(suite.order)
+	assert.NoError(suite.T(), err)
+
+	deliveries := suite.getDeliveries()
+	assert.Len(suite.T(), deliveries, 1)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusPending, deliveries[0].Status)
+	assert.Equal(suite.T(), webhookEventOrderChanged, deliveries[0].Event)
+	assert.Equal(suite.T(), suite.order.Id, deliveries[0].OrderId)
+	assert.Empty(suite.T(), deliveries[0].RefundId)
+
+	assert.Equal(suite.T(), 1, suite.service.dispatchWebhookDeliveries())
+	assert.Equal(suite.T(), 0, suite.service.dispatchWebhookDeliveries())
+	assert.Len(suite.T(), suite.server.payloads, 1)
+
+	payload := &webhookPayload{}
+	err = json.Unmarshal([]byte(suite.server.payloads[0]), payload)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), deliveries[0].Id, payload.Id)
+	assert.Equal(suite.T(), webhookEventOrderChanged, payload.Event)
+
+	// merchant check signature by secret key of project
+	header := suite.server.requests[0].Header.Get(webhookSignatureHeader)
+	parts := strings.Split(header, ",")
+	assert.Len(suite.T(), parts, 2)
+
+	timestamp := strings.TrimPrefix(parts[0], "t=")
+	expected := webhookSignature(suite.project.SecretKey, timestamp, suite.server.payloads[0])
+	assert.Equal(suite.T(), "v1="+expected, parts[1])
+
+	deliveries = suite.getDeliveries()
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+	assert.NotNil(suite.T(), deliveries[0].DeliveredAt)
+	assert.Len(suite.T(), deliveries[0].History, 1)
+	assert.Equal(suite.T(), int32(http.StatusOK), deliveries[0].History[0].ResponseCode)
+	assert.Equal(suite.T(), "ok", deliveries[0].History[0].ResponseBody)
+	assert.Empty(suite.T(), deliveries[0].History[0].Error)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_OrderChanged_SecretsNotSent() {
+	suite.order.Project.SecretKey = suite.project.SecretKey
+	suite.order.PaymentMethod = &billing.PaymentMethodOrder{
+		Id:    bson.NewObjectId().Hex(),
+		Name:  "Bank card",
+		Group: "BANKCARD",
+		Params: &billing.PaymentMethodParams{
+			Password:         "ps_password",
+			CallbackPassword: "ps_callback_password",
+		},
+	}
+	suite.order.PspFeeAmount = &billing.OrderFeePsp{AmountPaymentMethodCurrency: 12.34}
+
+	suite.service.sendOrderWebhook(suite.order)
+
+	deliveries := suite.getDeliveries()
+	assert.Len(suite.T(), deliveries, 1)
+	assert.NotContains(suite.T(), deliveries[0].Payload, suite.project.SecretKey)
+	assert.NotContains(suite.T(), deliveries[0].Payload, "ps_password")
+	assert.NotContains(suite.T(), deliveries[0].Payload, "ps_callback_password")
+	assert.NotContains(suite.T(), deliveries[0].Payload, "psp_fee_amount")
+	assert.Contains(suite.T(), deliveries[0].Payload, "BANKCARD")
+
+	// order itself isn't changed
+	assert.Equal(suite.T(), suite.project.SecretKey, suite.order.Project.SecretKey)
+	assert.NotNil(suite.T(), suite.order.PaymentMethod.Params)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_RefundChanged_Ok() {
+	refund := &billing.Refund{
+		Id:     bson.NewObjectId().Hex(),
+		Order:  &billing.RefundOrder{Id: suite.order.Id, Uuid: suite.order.Uuid},
+		Amount: 10,
+		Currency: &billing.Currency{
+			CodeA3: "RUB",
+		},
+		Status: pkg.RefundStatusCompleted,
+	}
+
+	suite.service.sendRefundWebhook(refund, suite.order)
+
+	deliveries := suite.getDeliveries()
+	assert.Len(suite.T(), deliveries, 1)
+	assert.Equal(suite.T(), webhookEventRefundChanged, deliveries[0].Event)
+	assert.Equal(suite.T(), refund.Id, deliveries[0].RefundId)
+	assert.Contains(suite.T(), deliveries[0].Payload, refund.Id)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_WebhooksDisabled_NotCreated() {
+	suite.project.CallbackProtocol = pkg.ProjectCallbackProtocolEmpty
+	suite.service.setCachedProject(suite.project)
+
+	suite.service.sendOrderWebhook(suite.order)
+	assert.Empty(suite.T(), suite.getDeliveries())
+
+	suite.project.CallbackProtocol = pkg.ProjectCallbackProtocolDefault
+	suite.project.UrlProcessPayment = ""
+	suite.service.setCachedProject(suite.project)
+
+	suite.service.sendOrderWebhook(suite.order)
+	assert.Empty(suite.T(), suite.getDeliveries())
+}
+
+func (suite *WebhookTestSuite) TestWebhook_RetryAndFail() {
+	suite.server.setResponse(http.StatusInternalServerError, strings.Repeat("e", webhookResponseBodyLength*2))
+	suite.service.sendOrderWebhook(suite.order)
+
+	assert.Equal(suite.T(), 0, suite.service.dispatchWebhookDeliveries())
+
+	deliveries := suite.getDeliveries()
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusPending, deliveries[0].Status)
+	assert.Equal(suite.T(), int32(1), deliveries[0].Attempts)
+	assert.Len(suite.T(), deliveries[0].History, 1)
+	assert.Equal(suite.T(), int32(http.StatusInternalServerError), deliveries[0].History[0].ResponseCode)
+	assert.Len(suite.T(), deliveries[0].History[0].ResponseBody, webhookResponseBodyLength)
+	assert.NotEmpty(suite.T(), deliveries[0].History[0].Error)
+
+	// next attempt is scheduled with backoff
+	assert.Equal(suite.T(), 0, suite.service.dispatchWebhookDeliveries())
+	assert.Len(suite.T(), suite.server.payloads, 1)
+
+	suite.makeDeliveriesOverdue()
+	assert.Equal(suite.T(), 0, suite.service.dispatchWebhookDeliveries())
+
+	deliveries = suite.getDeliveries()
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusFailed, deliveries[0].Status)
+	assert.Equal(suite.T(), int32(2), deliveries[0].Attempts)
+	assert.Len(suite.T(), deliveries[0].History, 2)
+
+	suite.makeDeliveriesOverdue()
+	assert.Equal(suite.T(), 0, suite.service.dispatchWebhookDeliveries())
+	assert.Len(suite.T(), suite.server.payloads, 2)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ListAndResend_Ok() {
+	suite.server.setResponse(http.StatusBadGateway, "")
+	suite.service.sendOrderWebhook(suite.order)
+
+	for i := 0; i < 2; i++ {
+		suite.makeDeliveriesOverdue()
+		suite.service.dispatchWebhookDeliveries()
+	}
+
+	req := &grpc.ListWebhookDeliveriesRequest{OrderId: suite.order.Id}
+	rsp := &grpc.ListWebhookDeliveriesResponse{}
+	err := suite.service.ListWebhookDeliveries(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp.Status)
+	assert.Equal(suite.T(), int32(1), rsp.Count)
+	assert.Len(suite.T(), rsp.Items, 1)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusFailed, rsp.Items[0].Status)
+
+	suite.server.setResponse(http.StatusOK, "ok")
+
+	rsp1 := &grpc.ResendWebhookDeliveryResponse{}
+	err = suite.service.ResendWebhookDelivery(context.TODO(), &grpc.ResendWebhookDeliveryRequest{Id: rsp.Items[0].Id}, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Equal(suite.T(), pkg.WebhookDeliveryStatusDelivered, rsp1.Item.Status)
+	assert.Len(suite.T(), rsp1.Item.History, 3)
+	assert.Len(suite.T(), suite.server.payloads, 3)
+
+	// delivered webhook can be resent too
+	err = suite.service.ResendWebhookDelivery(context.TODO(), &grpc.ResendWebhookDeliveryRequest{Id: rsp.Items[0].Id}, rsp1)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusOk, rsp1.Status)
+	assert.Len(suite.T(), rsp1.Item.History, 4)
+	assert.Equal(suite.T(), suite.server.payloads[2], suite.server.payloads[3])
+}
+
+func (suite *WebhookTestSuite) TestWebhook_ListDeliveries_OrderIdInvalid_Error() {
+	rsp := &grpc.ListWebhookDeliveriesResponse{}
+	err := suite.service.ListWebhookDeliveries(context.TODO(), &grpc.ListWebhookDeliveriesRequest{OrderId: "unknown"}, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusBadData, rsp.Status)
+	assert.Equal(suite.T(), orderErrorNotFound, rsp.Message)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_Resend_NotFound_Error() {
+	rsp := &grpc.ResendWebhookDeliveryResponse{}
+	req := &grpc.ResendWebhookDeliveryRequest{Id: bson.NewObjectId().Hex()}
+	err := suite.service.ResendWebhookDelivery(context.TODO(), req, rsp)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), pkg.ResponseStatusNotFound, rsp.Status)
+	assert.Equal(suite.T(), webhookErrorNotFound, rsp.Message)
+	assert.Nil(suite.T(), rsp.Item)
+}
+
+func (suite *WebhookTestSuite) TestWebhook_Backoff() {
+	assert.Equal(suite.T(), webhookBackoffBase, getWebhookBackoff(1))
+	assert.Equal(suite.T(), 4*webhookBackoffBase, getWebhookBackoff(3))
+	assert.Equal(suite.T(), webhookBackoffMax, getWebhookBackoff(20))
+}
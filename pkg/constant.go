@@ -8,6 +8,7 @@ const (
 	CollectionCountry                      = "country"
 	CollectionProject                      = "project"
 	CollectionCurrencyRate                 = "currency_rate"
+	CollectionCurrencyRateBatch            = "currency_rate_batch"
 	CollectionOrder                        = "order"
 	CollectionPaymentMethod                = "payment_method"
 	CollectionCommission                   = "commission"
@@ -19,6 +20,14 @@ const (
 	CollectionSystemFees                   = "system_fees"
 	CollectionMerchantPaymentMethodHistory = "payment_method_history"
 	CollectionCustomer                     = "customer"
+	CollectionOutbox                       = "outbox"
+	CollectionLedgerEntry                  = "ledger_entry"
+	CollectionLedgerPosting                = "ledger_posting"
+	CollectionPayout                       = "payout"
+	CollectionDispute                      = "dispute"
+	CollectionSubscription                 = "subscription"
+	CollectionCommissionPlan               = "commission_plan"
+	CollectionWebhookDelivery              = "webhook_delivery"
 
 	CardPayPaymentResponseStatusInProgress = "IN_PROGRESS"
 	CardPayPaymentResponseStatusPending    = "PENDING"
@@ -27,23 +36,44 @@ const (
 	CardPayPaymentResponseStatusAuthorized = "AUTHORIZED"
 	CardPayPaymentResponseStatusCompleted  = "COMPLETED"
 	CardPayPaymentResponseStatusCancelled  = "CANCELLED"
-
-	PaymentCreateFieldOrderId         = "order_id"
-	PaymentCreateFieldPaymentMethodId = "payment_method_id"
-	PaymentCreateFieldEmail           = "email"
-	PaymentCreateFieldPan             = "pan"
-	PaymentCreateFieldCvv             = "cvv"
-	PaymentCreateFieldMonth           = "month"
-	PaymentCreateFieldYear            = "year"
-	PaymentCreateFieldHolder          = "card_holder"
-	PaymentCreateFieldEWallet         = "ewallet"
-	PaymentCreateFieldCrypto          = "address"
-	PaymentCreateFieldStoreData       = "store_data"
-	PaymentCreateFieldRecurringId     = "recurring_id"
-	PaymentCreateFieldStoredCardId    = "stored_card_id"
-	PaymentCreateFieldUserCountry     = "country"
-	PaymentCreateFieldUserCity        = "city"
-	PaymentCreateFieldUserZip         = "zip"
+	CardPayPaymentResponseStatusVoided     = "VOIDED"
+
+	CardPayChargebackStatusOpened = "OPENED"
+	CardPayChargebackStatusWon    = "WON"
+	CardPayChargebackStatusLost   = "LOST"
+
+	CardPayPaymentStatusToComplete = "COMPLETE"
+	CardPayPaymentStatusToReverse  = "REVERSE"
+
+	StripePaymentIntentStatusRequiresPaymentMethod = "requires_payment_method"
+	StripePaymentIntentStatusRequiresAction        = "requires_action"
+	StripePaymentIntentStatusProcessing            = "processing"
+	StripePaymentIntentStatusSucceeded             = "succeeded"
+	StripePaymentIntentStatusCanceled              = "canceled"
+	StripePaymentIntentStatusRequiresCapture       = "requires_capture"
+
+	StripeRefundStatusPending   = "pending"
+	StripeRefundStatusSucceeded = "succeeded"
+	StripeRefundStatusFailed    = "failed"
+	StripeRefundStatusCanceled  = "canceled"
+
+	PaymentCreateFieldOrderId           = "order_id"
+	PaymentCreateFieldPaymentMethodId   = "payment_method_id"
+	PaymentCreateFieldEmail             = "email"
+	PaymentCreateFieldPan               = "pan"
+	PaymentCreateFieldCvv               = "cvv"
+	PaymentCreateFieldMonth             = "month"
+	PaymentCreateFieldYear              = "year"
+	PaymentCreateFieldHolder            = "card_holder"
+	PaymentCreateFieldEWallet           = "ewallet"
+	PaymentCreateFieldCrypto            = "address"
+	PaymentCreateFieldStoreData         = "store_data"
+	PaymentCreateFieldRecurringId       = "recurring_id"
+	PaymentCreateFieldStoredCardId      = "stored_card_id"
+	PaymentCreateFieldMerchantInitiated = "merchant_initiated"
+	PaymentCreateFieldUserCountry       = "country"
+	PaymentCreateFieldUserCity          = "city"
+	PaymentCreateFieldUserZip           = "zip"
 
 	TxnParamsFieldBankCardEmissionCountry = "emission_country"
 	TxnParamsFieldBankCardToken           = "token"
@@ -70,11 +100,23 @@ const (
 	ResponseStatusOk          = int32(200)
 	ResponseStatusBadData     = int32(400)
 	ResponseStatusNotFound    = int32(404)
+	ResponseStatusConflict    = int32(409)
 	ResponseStatusSystemError = int32(500)
 	ResponseStatusTemporary   = int32(410)
 
 	SystemUserId = "000000000000000000000000"
 
+	// order statuses of two-phase payments, partial refunds, disputes and expired orders, other order statuses
+	// declared in recurring repository constants. Order of lost dispute has status chargeback from recurring repository
+	OrderStatusPaymentSystemAuthorized    = int32(13)
+	OrderStatusPaymentSystemCaptured      = int32(14)
+	OrderStatusPaymentSystemVoided        = int32(15)
+	OrderStatusRefundPartial              = int32(16)
+	OrderStatusChargebackOpened           = int32(17)
+	OrderStatusChargebackWon              = int32(18)
+	OrderStatusCanceledByTimeout          = int32(19)
+	OrderStatusPaymentSystemStatusUnknown = int32(20)
+
 	RefundStatusCreated               = int32(0)
 	RefundStatusRejected              = int32(1)
 	RefundStatusInProgress            = int32(2)
@@ -82,10 +124,72 @@ const (
 	RefundStatusPaymentSystemDeclined = int32(4)
 	RefundStatusPaymentSystemCanceled = int32(5)
 
+	OutboxMessageStatusPending   = int32(0)
+	OutboxMessageStatusDelivered = int32(1)
+	OutboxMessageStatusFailed    = int32(2)
+	OutboxMessageStatusDropped   = int32(3)
+
+	WebhookDeliveryStatusPending   = int32(0)
+	WebhookDeliveryStatusDelivered = int32(1)
+	WebhookDeliveryStatusFailed    = int32(2)
+
+	OrderAccountCheckStatusOk       = "ok"
+	OrderAccountCheckStatusRejected = "rejected"
+	OrderAccountCheckStatusFailed   = "failed"
+
+	OrderStatusChangeSourceCallback = "callback"
+	OrderStatusChangeSourceApi      = "api"
+	OrderStatusChangeSourceAdmin    = "admin"
+	OrderStatusChangeSourceSweeper  = "sweeper"
+
+	// topic of message queue to notify merchants about orders which weren't paid until expiration
+	AbandonedCheckoutTopicName = "abandoned-checkout"
+
+	LedgerAccountPaymentSystemReceivable = "payment_system_receivable"
+	LedgerAccountPaymentSystemCost       = "payment_system_cost"
+	LedgerAccountPspRevenue              = "psp_revenue"
+	LedgerAccountPspFxRevenue            = "psp_fx_revenue"
+	LedgerAccountMerchantPayable         = "merchant_payable"
+	LedgerAccountTaxLiability            = "tax_liability"
+	LedgerAccountPspCash                 = "psp_cash"
+
+	LedgerEntrySideDebit  = "debit"
+	LedgerEntrySideCredit = "credit"
+
+	LedgerSourceTypeOrder   = "order"
+	LedgerSourceTypeRefund  = "refund"
+	LedgerSourceTypePayout  = "payout"
+	LedgerSourceTypeDispute = "dispute"
+
+	PayoutStatusDraft    = int32(0)
+	PayoutStatusApproved = int32(1)
+	PayoutStatusPaid     = int32(2)
+	PayoutStatusFailed   = int32(3)
+
+	DisputeStatusOpened            = int32(0)
+	DisputeStatusEvidenceSubmitted = int32(1)
+	DisputeStatusWon               = int32(2)
+	DisputeStatusLost              = int32(3)
+
+	SubscriptionStatusTrial    = int32(0)
+	SubscriptionStatusActive   = int32(1)
+	SubscriptionStatusPastDue  = int32(2)
+	SubscriptionStatusPaused   = int32(3)
+	SubscriptionStatusCanceled = int32(4)
+	SubscriptionStatusUnpaid   = int32(5)
+
+	SubscriptionIntervalDay   = "day"
+	SubscriptionIntervalWeek  = "week"
+	SubscriptionIntervalMonth = "month"
+	SubscriptionIntervalYear  = "year"
+
+	OrderPrivateMetadataSubscriptionId = "SubscriptionId"
+
 	PaymentSystemErrorCreateRefundFailed   = "refund can't be create. try request later"
 	PaymentSystemErrorCreateRefundRejected = "refund create request rejected"
 
 	PaymentSystemHandlerCardPay = "cardpay"
+	PaymentSystemHandlerStripe  = "stripe"
 
 	MerchantAgreementTypeESign = 2
 
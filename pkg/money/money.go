@@ -0,0 +1,344 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RoundingMode is rule of rounding amount to minor units of currency
+type RoundingMode string
+
+const (
+	// DefaultExponent is count of digits after decimal separator for currency without registered exponent
+	DefaultExponent = int32(2)
+
+	RoundingHalfUp   = RoundingMode("half_up")   // half away from zero, default rounding mode
+	RoundingHalfEven = RoundingMode("half_even") // half to nearest even (bankers rounding)
+	RoundingDown     = RoundingMode("down")      // toward zero
+	RoundingUp       = RoundingMode("up")        // away from zero
+)
+
+// Rules is rules of rounding amounts of currency
+type Rules struct {
+	Exponent      int32        // count of digits after decimal separator
+	Mode          RoundingMode // rounding mode of amounts
+	CashIncrement int64        // smallest cash amount in minor units, e.g. 5 for 0.05 CHF. 0 or 1 if not used
+}
+
+var (
+	ErrCurrencyMismatch = errors.New("money: amounts in different currencies")
+
+	mx sync.RWMutex
+
+	rules = make(map[string]Rules)
+
+	// exponents of currencies which minor unit isn't hundredth part of major unit (ISO 4217)
+	exponents = map[string]int32{
+		"BIF": 0,
+		"CLP": 0,
+		"DJF": 0,
+		"GNF": 0,
+		"ISK": 0,
+		"JPY": 0,
+		"KMF": 0,
+		"KRW": 0,
+		"PYG": 0,
+		"RWF": 0,
+		"UGX": 0,
+		"UYI": 0,
+		"VND": 0,
+		"VUV": 0,
+		"XAF": 0,
+		"XOF": 0,
+		"XPF": 0,
+		"BHD": 3,
+		"IQD": 3,
+		"JOD": 3,
+		"KWD": 3,
+		"LYD": 3,
+		"OMR": 3,
+		"TND": 3,
+	}
+)
+
+// Money is amount in minor units of currency. All arithmetic and comparisons of amounts are exact,
+// float amounts are only used as view of amount on boundaries of service
+type Money struct {
+	amount   int64
+	currency string
+	exponent int32
+}
+
+// GetRules return rounding rules of currency. If rules of currency wasn't registered then exponent by
+// ISO 4217 and half up rounding are used
+func GetRules(currency string) Rules {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	if r, ok := rules[currency]; ok {
+		return r
+	}
+
+	r := Rules{Exponent: DefaultExponent, Mode: RoundingHalfUp}
+
+	if exp, ok := exponents[currency]; ok {
+		r.Exponent = exp
+	}
+
+	return r
+}
+
+// SetRules register rounding rules of currency
+func SetRules(currency string, r Rules) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	if !r.Mode.IsValid() {
+		r.Mode = RoundingHalfUp
+	}
+
+	rules[currency] = r
+}
+
+// ReplaceRules replace rounding rules of all currencies at once, so rules of currencies which
+// aren't in new set are removed and rules of different sets are never mixed
+func ReplaceRules(set map[string]Rules) {
+	replaced := make(map[string]Rules, len(set))
+
+	for currency, r := range set {
+		if !r.Mode.IsValid() {
+			r.Mode = RoundingHalfUp
+		}
+
+		replaced[currency] = r
+	}
+
+	mx.Lock()
+	defer mx.Unlock()
+
+	rules = replaced
+}
+
+// Exponent return count of digits after decimal separator for currency
+func Exponent(currency string) int32 {
+	return GetRules(currency).Exponent
+}
+
+func (m RoundingMode) IsValid() bool {
+	return m == RoundingHalfUp || m == RoundingHalfEven || m == RoundingDown || m == RoundingUp
+}
+
+// New create amount from count of minor units of currency
+func New(amount int64, currency string) Money {
+	return Money{amount: amount, currency: currency, exponent: Exponent(currency)}
+}
+
+// Zero create zero amount in currency
+func Zero(currency string) Money {
+	return New(0, currency)
+}
+
+// FromFloat create amount from float amount in major units of currency. Amount rounded to minor units
+// by rounding mode of currency using its shortest decimal representation, so 1.005 is rounded to 1.01
+// with half up rounding
+func FromFloat(amount float64, currency string) Money {
+	r := GetRules(currency)
+
+	return Money{amount: roundDecimal(amount, r.Exponent, r.Mode), currency: currency, exponent: r.Exponent}
+}
+
+// Round round float amount to minor units of currency
+func Round(amount float64, currency string) float64 {
+	return FromFloat(amount, currency).Float64()
+}
+
+// RoundDecimal round value which isn't amount of currency, e.g. percent of fee, to count of digits
+// after decimal separator with half up rounding
+func RoundDecimal(value float64, exp int32) float64 {
+	return float64(roundDecimal(value, exp, RoundingHalfUp)) / pow10(exp)
+}
+
+// Amount return count of minor units
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency return code of currency
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Exponent return count of digits after decimal separator
+func (m Money) Exponent() int32 {
+	return m.exponent
+}
+
+// Float64 return amount in major units of currency, used only as compatibility view of amount
+func (m Money) Float64() float64 {
+	return float64(m.amount) / pow10(m.exponent)
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// SameCurrency check amounts are in same currency
+func (m Money) SameCurrency(o Money) bool {
+	return m.currency == o.currency && m.exponent == o.exponent
+}
+
+// Equals check amounts are in same currency and equal
+func (m Money) Equals(o Money) bool {
+	return m.SameCurrency(o) && m.amount == o.amount
+}
+
+// Compare return -1, 0 or 1 if amount is less, equal or greater than other amount
+func (m Money) Compare(o Money) (int, error) {
+	if !m.SameCurrency(o) {
+		return 0, ErrCurrencyMismatch
+	}
+
+	switch {
+	case m.amount < o.amount:
+		return -1, nil
+	case m.amount > o.amount:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (m Money) Add(o Money) (Money, error) {
+	if !m.SameCurrency(o) {
+		return m, ErrCurrencyMismatch
+	}
+
+	m.amount += o.amount
+
+	return m, nil
+}
+
+func (m Money) Subtract(o Money) (Money, error) {
+	if !m.SameCurrency(o) {
+		return m, ErrCurrencyMismatch
+	}
+
+	m.amount -= o.amount
+
+	return m, nil
+}
+
+func (m Money) Negative() Money {
+	m.amount = -m.amount
+	return m
+}
+
+// Multiply multiply amount by ratio, result rounded to minor units by rounding mode of currency
+func (m Money) Multiply(ratio float64) Money {
+	m.amount = roundDecimal(float64(m.amount)*ratio, 0, GetRules(m.currency).Mode)
+	return m
+}
+
+// Convert convert amount to currency by rate, which is price of one unit of currency in units of amount
+// currency. Result rounded to minor units by rounding mode of currency
+func (m Money) Convert(rate float64, currency string) Money {
+	return FromFloat(m.Float64()/rate, currency)
+}
+
+// RoundCash round amount to cash increment of currency by rounding mode of currency
+func (m Money) RoundCash() Money {
+	r := GetRules(m.currency)
+
+	if r.CashIncrement <= 1 {
+		return m
+	}
+
+	q := roundDecimal(float64(m.amount)/float64(r.CashIncrement), 0, r.Mode)
+	m.amount = q * r.CashIncrement
+
+	return m
+}
+
+// Sum return total of amounts in currency
+func Sum(currency string, values ...Money) (Money, error) {
+	total := Zero(currency)
+
+	for _, v := range values {
+		var err error
+
+		if total, err = total.Add(v); err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+func pow10(exp int32) float64 {
+	return math.Pow10(int(exp))
+}
+
+func roundDecimal(amount float64, exp int32, mode RoundingMode) int64 {
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0
+	}
+
+	sign := int64(1)
+
+	if amount < 0 {
+		sign = -1
+		amount = -amount
+	}
+
+	str := strconv.FormatFloat(amount, 'f', -1, 64)
+	parts := strings.SplitN(str, ".", 2)
+	fraction := ""
+
+	if len(parts) > 1 {
+		fraction = parts[1]
+	}
+
+	for int32(len(fraction)) <= exp {
+		fraction += "0"
+	}
+
+	units, err := strconv.ParseInt(parts[0]+fraction[:exp], 10, 64)
+
+	if err != nil {
+		// amount is out of range of minor units, it can't be represented exactly
+		return sign * int64(math.Round(amount*pow10(exp)))
+	}
+
+	rest := strings.TrimRight(fraction[exp:], "0")
+
+	if rest == "" {
+		return sign * units
+	}
+
+	switch mode {
+	case RoundingDown:
+	case RoundingUp:
+		units++
+	case RoundingHalfEven:
+		if rest[0] > '5' || (rest[0] == '5' && (len(rest) > 1 || units%2 == 1)) {
+			units++
+		}
+	default:
+		if rest[0] >= '5' {
+			units++
+		}
+	}
+
+	return sign * units
+}
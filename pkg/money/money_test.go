@@ -0,0 +1,159 @@
+package money
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type MoneyTestSuite struct {
+	suite.Suite
+}
+
+func Test_Money(t *testing.T) {
+	suite.Run(t, new(MoneyTestSuite))
+}
+
+func (suite *MoneyTestSuite) TestMoney_FromFloat_RoundByExponent() {
+	m := FromFloat(1.005, "USD")
+	assert.Equal(suite.T(), int64(101), m.Amount())
+	assert.Equal(suite.T(), int32(2), m.Exponent())
+	assert.Equal(suite.T(), 1.01, m.Float64())
+
+	m = FromFloat(1234.5, "JPY")
+	assert.Equal(suite.T(), int64(1235), m.Amount())
+	assert.Equal(suite.T(), int32(0), m.Exponent())
+	assert.Equal(suite.T(), float64(1235), m.Float64())
+
+	m = FromFloat(1.2345, "BHD")
+	assert.Equal(suite.T(), int64(1235), m.Amount())
+	assert.Equal(suite.T(), 1.235, m.Float64())
+
+	m = FromFloat(-10.125, "EUR")
+	assert.Equal(suite.T(), int64(-1013), m.Amount())
+
+	m = FromFloat(100, "RUB")
+	assert.Equal(suite.T(), int64(10000), m.Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_Sum_Exact() {
+	var values []Money
+
+	for i := 0; i < 10; i++ {
+		values = append(values, FromFloat(0.1, "USD"))
+	}
+
+	total, err := Sum("USD", values...)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), total.Equals(FromFloat(1, "USD")))
+	assert.Equal(suite.T(), float64(1), total.Float64())
+}
+
+func (suite *MoneyTestSuite) TestMoney_CurrencyMismatch() {
+	_, err := FromFloat(1, "USD").Add(FromFloat(1, "EUR"))
+	assert.Equal(suite.T(), ErrCurrencyMismatch, err)
+
+	_, err = FromFloat(1, "USD").Subtract(FromFloat(1, "EUR"))
+	assert.Equal(suite.T(), ErrCurrencyMismatch, err)
+
+	_, err = FromFloat(1, "USD").Compare(FromFloat(1, "EUR"))
+	assert.Equal(suite.T(), ErrCurrencyMismatch, err)
+
+	assert.False(suite.T(), FromFloat(1, "USD").Equals(FromFloat(1, "EUR")))
+}
+
+func (suite *MoneyTestSuite) TestMoney_Compare() {
+	a, b := FromFloat(10.01, "USD"), FromFloat(10.02, "USD")
+
+	r, err := a.Compare(b)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), -1, r)
+
+	r, err = b.Compare(a)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, r)
+
+	r, err = a.Compare(New(1001, "USD"))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, r)
+}
+
+func (suite *MoneyTestSuite) TestMoney_Multiply() {
+	m := FromFloat(100, "USD").Multiply(1.0 / 3)
+	assert.Equal(suite.T(), int64(3333), m.Amount())
+
+	m = New(5, "USD").Multiply(0.5)
+	assert.Equal(suite.T(), int64(3), m.Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_SetRules() {
+	assert.Equal(suite.T(), DefaultExponent, Exponent("XTS"))
+	assert.Equal(suite.T(), RoundingHalfUp, GetRules("XTS").Mode)
+
+	SetRules("XTS", Rules{Exponent: 4})
+	defer SetRules("XTS", Rules{Exponent: DefaultExponent})
+
+	m := FromFloat(1.23456, "XTS")
+	assert.Equal(suite.T(), int64(12346), m.Amount())
+	assert.Equal(suite.T(), RoundingHalfUp, GetRules("XTS").Mode)
+}
+
+func (suite *MoneyTestSuite) TestMoney_ReplaceRules() {
+	SetRules("XTS", Rules{Exponent: 4})
+	ReplaceRules(map[string]Rules{"XXX": {Exponent: 3}})
+	defer ReplaceRules(nil)
+
+	assert.Equal(suite.T(), DefaultExponent, Exponent("XTS"))
+	assert.Equal(suite.T(), int32(3), Exponent("XXX"))
+	assert.Equal(suite.T(), RoundingHalfUp, GetRules("XXX").Mode)
+}
+
+func (suite *MoneyTestSuite) TestMoney_RoundingModes() {
+	defer SetRules("XTS", Rules{Exponent: DefaultExponent})
+
+	cases := []struct {
+		mode   RoundingMode
+		amount float64
+		expect int64
+	}{
+		{RoundingHalfUp, 2.345, 235},
+		{RoundingHalfUp, -2.345, -235},
+		{RoundingHalfEven, 2.345, 234},
+		{RoundingHalfEven, 2.355, 236},
+		{RoundingHalfEven, 2.3451, 235},
+		{RoundingDown, 2.349, 234},
+		{RoundingDown, -2.349, -234},
+		{RoundingUp, 2.341, 235},
+		{RoundingUp, 2.34, 234},
+	}
+
+	for _, v := range cases {
+		SetRules("XTS", Rules{Exponent: 2, Mode: v.mode})
+		assert.Equal(suite.T(), v.expect, FromFloat(v.amount, "XTS").Amount(), "%s %v", v.mode, v.amount)
+	}
+}
+
+func (suite *MoneyTestSuite) TestMoney_RoundCash() {
+	SetRules("XTS", Rules{Exponent: 2, Mode: RoundingHalfUp, CashIncrement: 5})
+	defer SetRules("XTS", Rules{Exponent: DefaultExponent})
+
+	assert.Equal(suite.T(), int64(1005), FromFloat(10.03, "XTS").RoundCash().Amount())
+	assert.Equal(suite.T(), int64(1000), FromFloat(10.02, "XTS").RoundCash().Amount())
+	assert.Equal(suite.T(), int64(1002), FromFloat(10.02, "USD").RoundCash().Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_Convert() {
+	m := FromFloat(1000, "RUB").Convert(64, "USD")
+	assert.Equal(suite.T(), "USD", m.Currency())
+	assert.Equal(suite.T(), int64(1563), m.Amount())
+
+	m = FromFloat(10, "USD").Convert(0.0092, "JPY")
+	assert.Equal(suite.T(), int32(0), m.Exponent())
+	assert.Equal(suite.T(), int64(1087), m.Amount())
+}
+
+func (suite *MoneyTestSuite) TestMoney_RoundDecimal() {
+	assert.Equal(suite.T(), 2.01, RoundDecimal(2.005, 2))
+	assert.Equal(suite.T(), 0.075, RoundDecimal(0.075, 3))
+	assert.Equal(suite.T(), float64(3), RoundDecimal(2.5, 0))
+}
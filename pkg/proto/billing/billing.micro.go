@@ -28,6 +28,7 @@ It has these top-level messages:
 	Order
 	OrderItem
 	Currency
+	CurrencyMinorUnit
 	PayerData
 	PaymentMethodOrder
 	PaymentMethodParams
@@ -36,9 +37,14 @@ It has these top-level messages:
 	FixedPackages
 	OrderFee
 	OrderFeePsp
+	OrderSystemFee
+	OrderSystemFees
+	OrderStatusChange
+	OrderAccountCheck
 	OrderFeePaymentSystem
 	ProjectPaymentMethod
 	CurrencyRate
+	AppliedCurrencyRate
 	PaymentMethod
 	Country
 	Vat
@@ -54,6 +60,17 @@ It has these top-level messages:
 	RefundPayerData
 	RefundOrder
 	Refund
+	RefundItem
+	LedgerEntry
+	MerchantBalance
+	Payout
+	Dispute
+	DisputeEvidence
+	DisputeEvidenceFile
+	Subscription
+	OutboxMessage
+	WebhookDeliveryAttempt
+	WebhookDelivery
 	SystemFee
 	MinAmount
 	FeeSet
@@ -62,6 +79,9 @@ It has these top-level messages:
 	AddSystemFeesRequest
 	GetSystemFeesRequest
 	CalculatedFeeItem
+	CommissionPlanTier
+	CommissionPlan
+	OrderCommissionPlan
 	MerchantPaymentMethodHistory
 	CustomerIdentity
 	CustomerIpHistory
@@ -116,12 +116,16 @@ type OrderCreateRequest struct {
 	// @inject_tag: json:"-"
 	Metadata map[string]string `protobuf:"bytes,23,rep,name=metadata,proto3" json:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// @inject_tag: json:"-"
-	PrivateMetadata      map[string]string `protobuf:"bytes,24,rep,name=private_metadata,json=privateMetadata,proto3" json:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Token                string            `protobuf:"bytes,25,opt,name=token,proto3" json:"token,omitempty"`
-	User                 *OrderUser        `protobuf:"bytes,26,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+	PrivateMetadata map[string]string `protobuf:"bytes,24,rep,name=private_metadata,json=privateMetadata,proto3" json:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Token           string            `protobuf:"bytes,25,opt,name=token,proto3" json:"token,omitempty"`
+	User            *OrderUser        `protobuf:"bytes,26,opt,name=user,proto3" json:"user,omitempty"`
+	// @inject_tag: query:"PO_AUTHORIZE_ONLY" form:"PO_AUTHORIZE_ONLY" json:"authorize_only"
+	AuthorizeOnly bool `protobuf:"varint,27,opt,name=authorize_only,json=authorizeOnly,proto3" json:"authorize_only" query:"PO_AUTHORIZE_ONLY" form:"PO_AUTHORIZE_ONLY"`
+	// @inject_tag: query:"PO_IDEMPOTENCY_KEY" form:"PO_IDEMPOTENCY_KEY" json:"idempotency_key" validate:"omitempty,max=255"
+	IdempotencyKey       string   `protobuf:"bytes,28,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key" query:"PO_IDEMPOTENCY_KEY" form:"PO_IDEMPOTENCY_KEY" validate:"omitempty,max=255"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *OrderCreateRequest) Reset()         { *m = OrderCreateRequest{} }
@@ -331,6 +335,20 @@ func (m *OrderCreateRequest) GetUser() *OrderUser {
 	return nil
 }
 
+func (m *OrderCreateRequest) GetAuthorizeOnly() bool {
+	if m != nil {
+		return m.AuthorizeOnly
+	}
+	return false
+}
+
+func (m *OrderCreateRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type Project struct {
 	// @inject_tag: json:"id" validate:"omitempty,hexadecimal,len=24"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24"`
@@ -381,10 +399,18 @@ type Project struct {
 	// @inject_tag: json:"updated_at"
 	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,24,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	// @inject_tag: json:"products_count"
-	ProductsCount        int32    `protobuf:"varint,25,opt,name=products_count,json=productsCount,proto3" json:"products_count"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	ProductsCount int32 `protobuf:"varint,25,opt,name=products_count,json=productsCount,proto3" json:"products_count"`
+	// @inject_tag: json:"check_account_required"
+	CheckAccountRequired bool `protobuf:"varint,26,opt,name=check_account_required,json=checkAccountRequired,proto3" json:"check_account_required"`
+	// @inject_tag: json:"version"
+	Version int64 `protobuf:"varint,27,opt,name=version,proto3" json:"version"`
+	// @inject_tag: json:"order_expire_grace_period" validate:"omitempty,numeric,gte=0"
+	OrderExpireGracePeriod int64 `protobuf:"varint,28,opt,name=order_expire_grace_period,json=orderExpireGracePeriod,proto3" json:"order_expire_grace_period" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"payment_status_grace_period" validate:"omitempty,numeric,gte=0"
+	PaymentStatusGracePeriod int64    `protobuf:"varint,29,opt,name=payment_status_grace_period,json=paymentStatusGracePeriod,proto3" json:"payment_status_grace_period" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized         []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache            int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *Project) Reset()         { *m = Project{} }
@@ -587,6 +613,34 @@ func (m *Project) GetProductsCount() int32 {
 	return 0
 }
 
+func (m *Project) GetCheckAccountRequired() bool {
+	if m != nil {
+		return m.CheckAccountRequired
+	}
+	return false
+}
+
+func (m *Project) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Project) GetOrderExpireGracePeriod() int64 {
+	if m != nil {
+		return m.OrderExpireGracePeriod
+	}
+	return 0
+}
+
+func (m *Project) GetPaymentStatusGracePeriod() int64 {
+	if m != nil {
+		return m.PaymentStatusGracePeriod
+	}
+	return 0
+}
+
 type ProjectOrder struct {
 	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	MerchantId           string            `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
@@ -1123,7 +1177,9 @@ type Merchant struct {
 	// @inject_tag: json:"mail_tracking_link"
 	MailTrackingLink string `protobuf:"bytes,31,opt,name=mail_tracking_link,json=mailTrackingLink,proto3" json:"mail_tracking_link"`
 	// @inject_tag: json:"-"
-	S3AgreementName      string   `protobuf:"bytes,32,opt,name=s3_agreement_name,json=s3AgreementName,proto3" json:"-"`
+	S3AgreementName string `protobuf:"bytes,32,opt,name=s3_agreement_name,json=s3AgreementName,proto3" json:"-"`
+	// @inject_tag: json:"version"
+	Version              int64    `protobuf:"varint,33,opt,name=version,proto3" json:"version"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -1364,6 +1420,13 @@ func (m *Merchant) GetS3AgreementName() string {
 	return ""
 }
 
+func (m *Merchant) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type SystemNotificationStatuses struct {
 	From                 int32    `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
 	To                   int32    `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
@@ -1939,10 +2002,28 @@ type Order struct {
 	// @inject_tag: json:"metadata"
 	Metadata map[string]string `protobuf:"bytes,51,rep,name=metadata,proto3" json:"metadata" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// @inject_tag: json:"-"
-	PrivateMetadata      map[string]string `protobuf:"bytes,52,rep,name=private_metadata,json=privateMetadata,proto3" json:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+	PrivateMetadata map[string]string `protobuf:"bytes,52,rep,name=private_metadata,json=privateMetadata,proto3" json:"-" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// @inject_tag: json:"authorize_only"
+	AuthorizeOnly bool `protobuf:"varint,53,opt,name=authorize_only,json=authorizeOnly,proto3" json:"authorize_only"`
+	// @inject_tag: json:"captured_amount"
+	CapturedAmount float64 `protobuf:"fixed64,54,opt,name=captured_amount,json=capturedAmount,proto3" json:"captured_amount"`
+	// @inject_tag: json:"-"
+	CurrencyRates []*AppliedCurrencyRate `protobuf:"bytes,55,rep,name=currency_rates,json=currencyRates,proto3" json:"-"`
+	// @inject_tag: json:"-"
+	FxMarginAmount *OrderFee `protobuf:"bytes,56,opt,name=fx_margin_amount,json=fxMarginAmount,proto3" json:"-"`
+	// @inject_tag: json:"-"
+	CommissionPlan *OrderCommissionPlan `protobuf:"bytes,57,opt,name=commission_plan,json=commissionPlan,proto3" json:"-"`
+	// @inject_tag: json:"-"
+	SystemFees *OrderSystemFees `protobuf:"bytes,58,opt,name=system_fees,json=systemFees,proto3" json:"-"`
+	// @inject_tag: json:"-"
+	AccountCheck *OrderAccountCheck `protobuf:"bytes,59,opt,name=account_check,json=accountCheck,proto3" json:"-"`
+	// @inject_tag: json:"-"
+	StatusHistory []*OrderStatusChange `protobuf:"bytes,60,rep,name=status_history,json=statusHistory,proto3" json:"-"`
+	// @inject_tag: json:"-"
+	Version              int64    `protobuf:"varint,61,opt,name=version,proto3" json:"-"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -2292,6 +2373,69 @@ func (m *Order) GetPrivateMetadata() map[string]string {
 	return nil
 }
 
+func (m *Order) GetAuthorizeOnly() bool {
+	if m != nil {
+		return m.AuthorizeOnly
+	}
+	return false
+}
+
+func (m *Order) GetCapturedAmount() float64 {
+	if m != nil {
+		return m.CapturedAmount
+	}
+	return 0
+}
+
+func (m *Order) GetCurrencyRates() []*AppliedCurrencyRate {
+	if m != nil {
+		return m.CurrencyRates
+	}
+	return nil
+}
+
+func (m *Order) GetFxMarginAmount() *OrderFee {
+	if m != nil {
+		return m.FxMarginAmount
+	}
+	return nil
+}
+
+func (m *Order) GetCommissionPlan() *OrderCommissionPlan {
+	if m != nil {
+		return m.CommissionPlan
+	}
+	return nil
+}
+
+func (m *Order) GetSystemFees() *OrderSystemFees {
+	if m != nil {
+		return m.SystemFees
+	}
+	return nil
+}
+
+func (m *Order) GetAccountCheck() *OrderAccountCheck {
+	if m != nil {
+		return m.AccountCheck
+	}
+	return nil
+}
+
+func (m *Order) GetStatusHistory() []*OrderStatusChange {
+	if m != nil {
+		return m.StatusHistory
+	}
+	return nil
+}
+
+func (m *Order) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type OrderItem struct {
 	//@inject_tag: validate:"required,hexadecimal,len=24" json:"id" bson:"_id"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24" bson:"_id"`
@@ -2316,10 +2460,12 @@ type OrderItem struct {
 	//@inject_tag: json:"created_at"
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	//@inject_tag: json:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	//@inject_tag: json:"quantity"
+	Quantity             int32    `protobuf:"varint,13,opt,name=quantity,proto3" json:"quantity"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *OrderItem) Reset()         { *m = OrderItem{} }
@@ -2431,6 +2577,13 @@ func (m *OrderItem) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *OrderItem) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
 type Currency struct {
 	// @inject_tag: bson:"code_int"
 	CodeInt int32 `protobuf:"varint,1,opt,name=code_int,json=codeInt,proto3" json:"code_int,omitempty" bson:"code_int"`
@@ -2443,10 +2596,12 @@ type Currency struct {
 	// @inject_tag: bson:"created_at"
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
 	// @inject_tag: bson:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+	// @inject_tag: bson:"minor_unit"
+	MinorUnit            *CurrencyMinorUnit `protobuf:"bytes,8,opt,name=minor_unit,json=minorUnit,proto3" json:"minor_unit,omitempty" bson:"minor_unit"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *Currency) Reset()         { *m = Currency{} }
@@ -2516,6 +2671,71 @@ func (m *Currency) GetUpdatedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *Currency) GetMinorUnit() *CurrencyMinorUnit {
+	if m != nil {
+		return m.MinorUnit
+	}
+	return nil
+}
+
+type CurrencyMinorUnit struct {
+	// @inject_tag: bson:"exponent"
+	Exponent int32 `protobuf:"varint,1,opt,name=exponent,proto3" json:"exponent,omitempty" bson:"exponent"`
+	// @inject_tag: bson:"rounding_mode"
+	RoundingMode string `protobuf:"bytes,2,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode,omitempty" bson:"rounding_mode"`
+	// @inject_tag: bson:"cash_increment"
+	CashIncrement        float64  `protobuf:"fixed64,3,opt,name=cash_increment,json=cashIncrement,proto3" json:"cash_increment,omitempty" bson:"cash_increment"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrencyMinorUnit) Reset()         { *m = CurrencyMinorUnit{} }
+func (m *CurrencyMinorUnit) String() string { return proto.CompactTextString(m) }
+func (*CurrencyMinorUnit) ProtoMessage()    {}
+func (*CurrencyMinorUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{20}
+}
+
+func (m *CurrencyMinorUnit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyMinorUnit.Unmarshal(m, b)
+}
+func (m *CurrencyMinorUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyMinorUnit.Marshal(b, m, deterministic)
+}
+func (m *CurrencyMinorUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyMinorUnit.Merge(m, src)
+}
+func (m *CurrencyMinorUnit) XXX_Size() int {
+	return xxx_messageInfo_CurrencyMinorUnit.Size(m)
+}
+func (m *CurrencyMinorUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyMinorUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyMinorUnit proto.InternalMessageInfo
+
+func (m *CurrencyMinorUnit) GetExponent() int32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *CurrencyMinorUnit) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return ""
+}
+
+func (m *CurrencyMinorUnit) GetCashIncrement() float64 {
+	if m != nil {
+		return m.CashIncrement
+	}
+	return 0
+}
+
 type PayerData struct {
 	// @inject_tag: bson:"ip" structure:"ip"
 	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty" bson:"ip" structure:"ip"`
@@ -2543,7 +2763,7 @@ func (m *PayerData) Reset()         { *m = PayerData{} }
 func (m *PayerData) String() string { return proto.CompactTextString(m) }
 func (*PayerData) ProtoMessage()    {}
 func (*PayerData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{20}
+	return fileDescriptor_76f8da37d8b92239, []int{21}
 }
 
 func (m *PayerData) XXX_Unmarshal(b []byte) error {
@@ -2654,7 +2874,7 @@ func (m *PaymentMethodOrder) Reset()         { *m = PaymentMethodOrder{} }
 func (m *PaymentMethodOrder) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodOrder) ProtoMessage()    {}
 func (*PaymentMethodOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{21}
+	return fileDescriptor_76f8da37d8b92239, []int{22}
 }
 
 func (m *PaymentMethodOrder) XXX_Unmarshal(b []byte) error {
@@ -2722,17 +2942,19 @@ type PaymentMethodParams struct {
 	// @inject_tag: bson:"external_id" structure:"external_id"
 	ExternalId string `protobuf:"bytes,6,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty" bson:"external_id" structure:"external_id"`
 	// @inject_tag: bson:"other" structure:"other"
-	Other                map[string]string `protobuf:"bytes,7,rep,name=other,proto3" json:"other,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" bson:"other" structure:"other"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+	Other map[string]string `protobuf:"bytes,7,rep,name=other,proto3" json:"other,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" bson:"other" structure:"other"`
+	// @inject_tag: bson:"chargeback_fee" structure:"chargeback_fee"
+	ChargebackFee        float64  `protobuf:"fixed64,8,opt,name=chargeback_fee,json=chargebackFee,proto3" json:"chargeback_fee,omitempty" bson:"chargeback_fee" structure:"chargeback_fee"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *PaymentMethodParams) Reset()         { *m = PaymentMethodParams{} }
 func (m *PaymentMethodParams) String() string { return proto.CompactTextString(m) }
 func (*PaymentMethodParams) ProtoMessage()    {}
 func (*PaymentMethodParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{22}
+	return fileDescriptor_76f8da37d8b92239, []int{23}
 }
 
 func (m *PaymentMethodParams) XXX_Unmarshal(b []byte) error {
@@ -2795,6 +3017,13 @@ func (m *PaymentMethodParams) GetOther() map[string]string {
 	return nil
 }
 
+func (m *PaymentMethodParams) GetChargebackFee() float64 {
+	if m != nil {
+		return m.ChargebackFee
+	}
+	return 0
+}
+
 type PaymentSystem struct {
 	// @inject_tag: bson:"_id" structure:"_id,bsonobjectid"
 	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id" structure:"_id,bsonobjectid"`
@@ -2820,7 +3049,7 @@ func (m *PaymentSystem) Reset()         { *m = PaymentSystem{} }
 func (m *PaymentSystem) String() string { return proto.CompactTextString(m) }
 func (*PaymentSystem) ProtoMessage()    {}
 func (*PaymentSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{23}
+	return fileDescriptor_76f8da37d8b92239, []int{24}
 }
 
 func (m *PaymentSystem) XXX_Unmarshal(b []byte) error {
@@ -2917,7 +3146,7 @@ func (m *FixedPackage) Reset()         { *m = FixedPackage{} }
 func (m *FixedPackage) String() string { return proto.CompactTextString(m) }
 func (*FixedPackage) ProtoMessage()    {}
 func (*FixedPackage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{24}
+	return fileDescriptor_76f8da37d8b92239, []int{25}
 }
 
 func (m *FixedPackage) XXX_Unmarshal(b []byte) error {
@@ -2984,7 +3213,7 @@ func (m *FixedPackages) Reset()         { *m = FixedPackages{} }
 func (m *FixedPackages) String() string { return proto.CompactTextString(m) }
 func (*FixedPackages) ProtoMessage()    {}
 func (*FixedPackages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{25}
+	return fileDescriptor_76f8da37d8b92239, []int{26}
 }
 
 func (m *FixedPackages) XXX_Unmarshal(b []byte) error {
@@ -3026,7 +3255,7 @@ func (m *OrderFee) Reset()         { *m = OrderFee{} }
 func (m *OrderFee) String() string { return proto.CompactTextString(m) }
 func (*OrderFee) ProtoMessage()    {}
 func (*OrderFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{26}
+	return fileDescriptor_76f8da37d8b92239, []int{27}
 }
 
 func (m *OrderFee) XXX_Unmarshal(b []byte) error {
@@ -3077,7 +3306,7 @@ func (m *OrderFeePsp) Reset()         { *m = OrderFeePsp{} }
 func (m *OrderFeePsp) String() string { return proto.CompactTextString(m) }
 func (*OrderFeePsp) ProtoMessage()    {}
 func (*OrderFeePsp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{27}
+	return fileDescriptor_76f8da37d8b92239, []int{28}
 }
 
 func (m *OrderFeePsp) XXX_Unmarshal(b []byte) error {
@@ -3119,1411 +3348,3192 @@ func (m *OrderFeePsp) GetAmountPspCurrency() float64 {
 	return 0
 }
 
-// Contain information about payment system commission in other currencies
-type OrderFeePaymentSystem struct {
-	// @inject_tag: bson:"amount_payment_method_currency" structure:"amount_payment_method_currency"
-	AmountPaymentMethodCurrency float64 `protobuf:"fixed64,1,opt,name=AmountPaymentMethodCurrency,proto3" json:"AmountPaymentMethodCurrency,omitempty" bson:"amount_payment_method_currency" structure:"amount_payment_method_currency"`
-	// @inject_tag: bson:"amount_merchant_currency" structure:"amount_merchant_currency"
-	AmountMerchantCurrency float64 `protobuf:"fixed64,2,opt,name=AmountMerchantCurrency,proto3" json:"AmountMerchantCurrency,omitempty" bson:"amount_merchant_currency" structure:"amount_merchant_currency"`
-	// @inject_tag: bson:"amount_payment_system_currency" structure:"amount_payment_system_currency"
-	AmountPaymentSystemCurrency float64  `protobuf:"fixed64,3,opt,name=AmountPaymentSystemCurrency,proto3" json:"AmountPaymentSystemCurrency,omitempty" bson:"amount_payment_system_currency" structure:"amount_payment_system_currency"`
+// Contain amount of system fee in all currencies of order
+type OrderSystemFee struct {
+	// @inject_tag: bson:"amount_payment_method_currency"
+	AmountPaymentMethodCurrency float64 `protobuf:"fixed64,1,opt,name=amount_payment_method_currency,json=amountPaymentMethodCurrency,proto3" json:"amount_payment_method_currency,omitempty" bson:"amount_payment_method_currency"`
+	// @inject_tag: bson:"amount_merchant_currency"
+	AmountMerchantCurrency float64 `protobuf:"fixed64,2,opt,name=amount_merchant_currency,json=amountMerchantCurrency,proto3" json:"amount_merchant_currency,omitempty" bson:"amount_merchant_currency"`
+	// @inject_tag: bson:"amount_psp_currency"
+	AmountPspCurrency float64 `protobuf:"fixed64,3,opt,name=amount_psp_currency,json=amountPspCurrency,proto3" json:"amount_psp_currency,omitempty" bson:"amount_psp_currency"`
+	// @inject_tag: bson:"amount_payment_system_currency"
+	AmountPaymentSystemCurrency float64  `protobuf:"fixed64,4,opt,name=amount_payment_system_currency,json=amountPaymentSystemCurrency,proto3" json:"amount_payment_system_currency,omitempty" bson:"amount_payment_system_currency"`
 	XXX_NoUnkeyedLiteral        struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized            []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache               int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *OrderFeePaymentSystem) Reset()         { *m = OrderFeePaymentSystem{} }
-func (m *OrderFeePaymentSystem) String() string { return proto.CompactTextString(m) }
-func (*OrderFeePaymentSystem) ProtoMessage()    {}
-func (*OrderFeePaymentSystem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{28}
+func (m *OrderSystemFee) Reset()         { *m = OrderSystemFee{} }
+func (m *OrderSystemFee) String() string { return proto.CompactTextString(m) }
+func (*OrderSystemFee) ProtoMessage()    {}
+func (*OrderSystemFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{29}
 }
 
-func (m *OrderFeePaymentSystem) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderFeePaymentSystem.Unmarshal(m, b)
+func (m *OrderSystemFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderSystemFee.Unmarshal(m, b)
 }
-func (m *OrderFeePaymentSystem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderFeePaymentSystem.Marshal(b, m, deterministic)
+func (m *OrderSystemFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderSystemFee.Marshal(b, m, deterministic)
 }
-func (m *OrderFeePaymentSystem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderFeePaymentSystem.Merge(m, src)
+func (m *OrderSystemFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderSystemFee.Merge(m, src)
 }
-func (m *OrderFeePaymentSystem) XXX_Size() int {
-	return xxx_messageInfo_OrderFeePaymentSystem.Size(m)
+func (m *OrderSystemFee) XXX_Size() int {
+	return xxx_messageInfo_OrderSystemFee.Size(m)
 }
-func (m *OrderFeePaymentSystem) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderFeePaymentSystem.DiscardUnknown(m)
+func (m *OrderSystemFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderSystemFee.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderFeePaymentSystem proto.InternalMessageInfo
+var xxx_messageInfo_OrderSystemFee proto.InternalMessageInfo
 
-func (m *OrderFeePaymentSystem) GetAmountPaymentMethodCurrency() float64 {
+func (m *OrderSystemFee) GetAmountPaymentMethodCurrency() float64 {
 	if m != nil {
 		return m.AmountPaymentMethodCurrency
 	}
 	return 0
 }
 
-func (m *OrderFeePaymentSystem) GetAmountMerchantCurrency() float64 {
+func (m *OrderSystemFee) GetAmountMerchantCurrency() float64 {
 	if m != nil {
 		return m.AmountMerchantCurrency
 	}
 	return 0
 }
 
-func (m *OrderFeePaymentSystem) GetAmountPaymentSystemCurrency() float64 {
+func (m *OrderSystemFee) GetAmountPspCurrency() float64 {
+	if m != nil {
+		return m.AmountPspCurrency
+	}
+	return 0
+}
+
+func (m *OrderSystemFee) GetAmountPaymentSystemCurrency() float64 {
 	if m != nil {
 		return m.AmountPaymentSystemCurrency
 	}
 	return 0
 }
 
-type ProjectPaymentMethod struct {
-	// @inject_tag: bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	// @inject_tag: bson:"terminal"
-	Terminal string `protobuf:"bytes,2,opt,name=terminal,proto3" json:"terminal,omitempty" bson:"terminal"`
-	// @inject_tag: bson:"password"
-	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty" bson:"password"`
-	// @inject_tag: bson:"callback_password"
-	CallbackPassword string `protobuf:"bytes,4,opt,name=callback_password,json=callbackPassword,proto3" json:"callback_password,omitempty" bson:"callback_password"`
-	// @inject_tag: bson:"created_at"
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+// Contain information about system fees of payment system applied to payment
+type OrderSystemFees struct {
+	// @inject_tag: bson:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"id"`
+	// @inject_tag: bson:"region"
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty" bson:"region"`
+	// @inject_tag: bson:"card_brand"
+	CardBrand string `protobuf:"bytes,3,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty" bson:"card_brand"`
+	// @inject_tag: bson:"transaction_cost"
+	TransactionCost *OrderSystemFee `protobuf:"bytes,4,opt,name=transaction_cost,json=transactionCost,proto3" json:"transaction_cost,omitempty" bson:"transaction_cost"`
+	// @inject_tag: bson:"authorization_fee"
+	AuthorizationFee     *OrderSystemFee `protobuf:"bytes,5,opt,name=authorization_fee,json=authorizationFee,proto3" json:"authorization_fee,omitempty" bson:"authorization_fee"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32           `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ProjectPaymentMethod) Reset()         { *m = ProjectPaymentMethod{} }
-func (m *ProjectPaymentMethod) String() string { return proto.CompactTextString(m) }
-func (*ProjectPaymentMethod) ProtoMessage()    {}
-func (*ProjectPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{29}
+func (m *OrderSystemFees) Reset()         { *m = OrderSystemFees{} }
+func (m *OrderSystemFees) String() string { return proto.CompactTextString(m) }
+func (*OrderSystemFees) ProtoMessage()    {}
+func (*OrderSystemFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{30}
 }
 
-func (m *ProjectPaymentMethod) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProjectPaymentMethod.Unmarshal(m, b)
+func (m *OrderSystemFees) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderSystemFees.Unmarshal(m, b)
 }
-func (m *ProjectPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProjectPaymentMethod.Marshal(b, m, deterministic)
+func (m *OrderSystemFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderSystemFees.Marshal(b, m, deterministic)
 }
-func (m *ProjectPaymentMethod) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectPaymentMethod.Merge(m, src)
+func (m *OrderSystemFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderSystemFees.Merge(m, src)
 }
-func (m *ProjectPaymentMethod) XXX_Size() int {
-	return xxx_messageInfo_ProjectPaymentMethod.Size(m)
+func (m *OrderSystemFees) XXX_Size() int {
+	return xxx_messageInfo_OrderSystemFees.Size(m)
 }
-func (m *ProjectPaymentMethod) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectPaymentMethod.DiscardUnknown(m)
+func (m *OrderSystemFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderSystemFees.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectPaymentMethod proto.InternalMessageInfo
+var xxx_messageInfo_OrderSystemFees proto.InternalMessageInfo
 
-func (m *ProjectPaymentMethod) GetId() string {
+func (m *OrderSystemFees) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *ProjectPaymentMethod) GetTerminal() string {
+func (m *OrderSystemFees) GetRegion() string {
 	if m != nil {
-		return m.Terminal
+		return m.Region
 	}
 	return ""
 }
 
-func (m *ProjectPaymentMethod) GetPassword() string {
+func (m *OrderSystemFees) GetCardBrand() string {
 	if m != nil {
-		return m.Password
+		return m.CardBrand
 	}
 	return ""
 }
 
-func (m *ProjectPaymentMethod) GetCallbackPassword() string {
+func (m *OrderSystemFees) GetTransactionCost() *OrderSystemFee {
 	if m != nil {
-		return m.CallbackPassword
+		return m.TransactionCost
 	}
-	return ""
+	return nil
 }
 
-func (m *ProjectPaymentMethod) GetCreatedAt() *timestamp.Timestamp {
+func (m *OrderSystemFees) GetAuthorizationFee() *OrderSystemFee {
 	if m != nil {
-		return m.CreatedAt
+		return m.AuthorizationFee
 	}
 	return nil
 }
 
-type CurrencyRate struct {
-	// @inject_tag: bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	// @inject_tag: bson:"currency_from"
-	CurrencyFrom int32 `protobuf:"varint,2,opt,name=currency_from,json=currencyFrom,proto3" json:"currency_from,omitempty" bson:"currency_from"`
-	// @inject_tag: bson:"currency_to"
-	CurrencyTo int32 `protobuf:"varint,3,opt,name=currency_to,json=currencyTo,proto3" json:"currency_to,omitempty" bson:"currency_to"`
-	// @inject_tag: bson:"rate"
-	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty" bson:"rate"`
-	// @inject_tag: bson:"is_active"
-	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty" bson:"is_active"`
-	// @inject_tag: bson:"date"
-	Date *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty" bson:"date"`
-	// @inject_tag: bson:"created_at"
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+// Contain information about change of order status
+type OrderStatusChange struct {
+	// @inject_tag: bson:"from" json:"from"
+	From int32 `protobuf:"varint,1,opt,name=from,proto3" json:"from" bson:"from"`
+	// @inject_tag: bson:"to" json:"to"
+	To int32 `protobuf:"varint,2,opt,name=to,proto3" json:"to" bson:"to"`
+	// @inject_tag: bson:"source" json:"source"
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source" bson:"source"`
+	// @inject_tag: bson:"reason" json:"reason"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" bson:"reason"`
+	// @inject_tag: bson:"created_at" json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at" bson:"created_at"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *CurrencyRate) Reset()         { *m = CurrencyRate{} }
-func (m *CurrencyRate) String() string { return proto.CompactTextString(m) }
-func (*CurrencyRate) ProtoMessage()    {}
-func (*CurrencyRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{30}
+func (m *OrderStatusChange) Reset()         { *m = OrderStatusChange{} }
+func (m *OrderStatusChange) String() string { return proto.CompactTextString(m) }
+func (*OrderStatusChange) ProtoMessage()    {}
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{31}
 }
 
-func (m *CurrencyRate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CurrencyRate.Unmarshal(m, b)
-}
-func (m *CurrencyRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CurrencyRate.Marshal(b, m, deterministic)
+func (m *OrderStatusChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderStatusChange.Unmarshal(m, b)
 }
-func (m *CurrencyRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrencyRate.Merge(m, src)
+func (m *OrderStatusChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderStatusChange.Marshal(b, m, deterministic)
 }
-func (m *CurrencyRate) XXX_Size() int {
-	return xxx_messageInfo_CurrencyRate.Size(m)
+func (m *OrderStatusChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderStatusChange.Merge(m, src)
 }
-func (m *CurrencyRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrencyRate.DiscardUnknown(m)
+func (m *OrderStatusChange) XXX_Size() int {
+	return xxx_messageInfo_OrderStatusChange.Size(m)
 }
-
-var xxx_messageInfo_CurrencyRate proto.InternalMessageInfo
-
-func (m *CurrencyRate) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *OrderStatusChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderStatusChange.DiscardUnknown(m)
 }
 
-func (m *CurrencyRate) GetCurrencyFrom() int32 {
-	if m != nil {
-		return m.CurrencyFrom
-	}
-	return 0
-}
+var xxx_messageInfo_OrderStatusChange proto.InternalMessageInfo
 
-func (m *CurrencyRate) GetCurrencyTo() int32 {
+func (m *OrderStatusChange) GetFrom() int32 {
 	if m != nil {
-		return m.CurrencyTo
+		return m.From
 	}
 	return 0
 }
 
-func (m *CurrencyRate) GetRate() float64 {
+func (m *OrderStatusChange) GetTo() int32 {
 	if m != nil {
-		return m.Rate
+		return m.To
 	}
 	return 0
 }
 
-func (m *CurrencyRate) GetIsActive() bool {
+func (m *OrderStatusChange) GetSource() string {
 	if m != nil {
-		return m.IsActive
+		return m.Source
 	}
-	return false
+	return ""
 }
 
-func (m *CurrencyRate) GetDate() *timestamp.Timestamp {
+func (m *OrderStatusChange) GetReason() string {
 	if m != nil {
-		return m.Date
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-func (m *CurrencyRate) GetCreatedAt() *timestamp.Timestamp {
+func (m *OrderStatusChange) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return nil
 }
 
-type PaymentMethod struct {
-	// @inject_tag: bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	// @inject_tag: bson:"name"
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" bson:"name"`
-	// @inject_tag: bson:"group_alias"
-	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty" bson:"group_alias"`
-	// @inject_tag: bson:"currency"
-	Currency *Currency `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty" bson:"currency"`
-	// @inject_tag: bson:"currencies"
-	Currencies []int32 `protobuf:"varint,5,rep,packed,name=currencies,proto3" json:"currencies,omitempty" bson:"currencies"`
-	// @inject_tag: bson:"min_payment_amount"
-	MinPaymentAmount float64 `protobuf:"fixed64,6,opt,name=min_payment_amount,json=minPaymentAmount,proto3" json:"min_payment_amount,omitempty" bson:"min_payment_amount"`
-	// @inject_tag: bson:"max_payment_amount"
-	MaxPaymentAmount float64 `protobuf:"fixed64,7,opt,name=max_payment_amount,json=maxPaymentAmount,proto3" json:"max_payment_amount,omitempty" bson:"max_payment_amount"`
-	// @inject_tag: bson:"params"
-	Params *PaymentMethodParams `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty" bson:"params"`
-	// @inject_tag: bson:"icon"
-	Icon string `protobuf:"bytes,9,opt,name=Icon,proto3" json:"Icon,omitempty" bson:"icon"`
-	// @inject_tag: bson:"payment_system"
-	PaymentSystem *PaymentSystem `protobuf:"bytes,10,opt,name=payment_system,json=paymentSystem,proto3" json:"payment_system,omitempty" bson:"payment_system"`
-	// @inject_tag: bson:"type"
-	Type string `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty" bson:"type"`
-	// @inject_tag: bson:"account_regexp"
-	AccountRegexp string `protobuf:"bytes,12,opt,name=account_regexp,json=accountRegexp,proto3" json:"account_regexp,omitempty" bson:"account_regexp"`
-	// @inject_tag: bson:"is_active"
-	IsActive bool `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty" bson:"is_active"`
-	// @inject_tag: bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
-	// @inject_tag: bson:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+// Contain result of request to project to check account of payer before payment
+type OrderAccountCheck struct {
+	// @inject_tag: bson:"status"
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty" bson:"status"`
+	// @inject_tag: bson:"response_code"
+	ResponseCode int32 `protobuf:"varint,2,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty" bson:"response_code"`
+	// @inject_tag: bson:"response_body"
+	ResponseBody string `protobuf:"bytes,3,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty" bson:"response_body"`
+	// @inject_tag: bson:"error"
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty" bson:"error"`
+	// @inject_tag: bson:"latency"
+	Latency int64 `protobuf:"varint,5,opt,name=latency,proto3" json:"latency,omitempty" bson:"latency"`
+	// @inject_tag: bson:"checked_at"
+	CheckedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty" bson:"checked_at"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaymentMethod) Reset()         { *m = PaymentMethod{} }
-func (m *PaymentMethod) String() string { return proto.CompactTextString(m) }
-func (*PaymentMethod) ProtoMessage()    {}
-func (*PaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{31}
+func (m *OrderAccountCheck) Reset()         { *m = OrderAccountCheck{} }
+func (m *OrderAccountCheck) String() string { return proto.CompactTextString(m) }
+func (*OrderAccountCheck) ProtoMessage()    {}
+func (*OrderAccountCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{32}
 }
 
-func (m *PaymentMethod) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentMethod.Unmarshal(m, b)
+func (m *OrderAccountCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderAccountCheck.Unmarshal(m, b)
 }
-func (m *PaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentMethod.Marshal(b, m, deterministic)
+func (m *OrderAccountCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderAccountCheck.Marshal(b, m, deterministic)
 }
-func (m *PaymentMethod) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentMethod.Merge(m, src)
+func (m *OrderAccountCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAccountCheck.Merge(m, src)
 }
-func (m *PaymentMethod) XXX_Size() int {
-	return xxx_messageInfo_PaymentMethod.Size(m)
+func (m *OrderAccountCheck) XXX_Size() int {
+	return xxx_messageInfo_OrderAccountCheck.Size(m)
 }
-func (m *PaymentMethod) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentMethod.DiscardUnknown(m)
+func (m *OrderAccountCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAccountCheck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentMethod proto.InternalMessageInfo
+var xxx_messageInfo_OrderAccountCheck proto.InternalMessageInfo
 
-func (m *PaymentMethod) GetId() string {
+func (m *OrderAccountCheck) GetStatus() string {
 	if m != nil {
-		return m.Id
+		return m.Status
 	}
 	return ""
 }
 
-func (m *PaymentMethod) GetName() string {
+func (m *OrderAccountCheck) GetResponseCode() int32 {
 	if m != nil {
-		return m.Name
+		return m.ResponseCode
+	}
+	return 0
+}
+
+func (m *OrderAccountCheck) GetResponseBody() string {
+	if m != nil {
+		return m.ResponseBody
 	}
 	return ""
 }
 
-func (m *PaymentMethod) GetGroup() string {
+func (m *OrderAccountCheck) GetError() string {
 	if m != nil {
-		return m.Group
+		return m.Error
 	}
 	return ""
 }
 
-func (m *PaymentMethod) GetCurrency() *Currency {
+func (m *OrderAccountCheck) GetLatency() int64 {
 	if m != nil {
-		return m.Currency
+		return m.Latency
 	}
-	return nil
+	return 0
 }
 
-func (m *PaymentMethod) GetCurrencies() []int32 {
+func (m *OrderAccountCheck) GetCheckedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Currencies
+		return m.CheckedAt
 	}
 	return nil
 }
 
-func (m *PaymentMethod) GetMinPaymentAmount() float64 {
+// Contain information about payment system commission in other currencies
+type OrderFeePaymentSystem struct {
+	// @inject_tag: bson:"amount_payment_method_currency" structure:"amount_payment_method_currency"
+	AmountPaymentMethodCurrency float64 `protobuf:"fixed64,1,opt,name=AmountPaymentMethodCurrency,proto3" json:"AmountPaymentMethodCurrency,omitempty" bson:"amount_payment_method_currency" structure:"amount_payment_method_currency"`
+	// @inject_tag: bson:"amount_merchant_currency" structure:"amount_merchant_currency"
+	AmountMerchantCurrency float64 `protobuf:"fixed64,2,opt,name=AmountMerchantCurrency,proto3" json:"AmountMerchantCurrency,omitempty" bson:"amount_merchant_currency" structure:"amount_merchant_currency"`
+	// @inject_tag: bson:"amount_payment_system_currency" structure:"amount_payment_system_currency"
+	AmountPaymentSystemCurrency float64  `protobuf:"fixed64,3,opt,name=AmountPaymentSystemCurrency,proto3" json:"AmountPaymentSystemCurrency,omitempty" bson:"amount_payment_system_currency" structure:"amount_payment_system_currency"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized            []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache               int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderFeePaymentSystem) Reset()         { *m = OrderFeePaymentSystem{} }
+func (m *OrderFeePaymentSystem) String() string { return proto.CompactTextString(m) }
+func (*OrderFeePaymentSystem) ProtoMessage()    {}
+func (*OrderFeePaymentSystem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{33}
+}
+
+func (m *OrderFeePaymentSystem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderFeePaymentSystem.Unmarshal(m, b)
+}
+func (m *OrderFeePaymentSystem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderFeePaymentSystem.Marshal(b, m, deterministic)
+}
+func (m *OrderFeePaymentSystem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFeePaymentSystem.Merge(m, src)
+}
+func (m *OrderFeePaymentSystem) XXX_Size() int {
+	return xxx_messageInfo_OrderFeePaymentSystem.Size(m)
+}
+func (m *OrderFeePaymentSystem) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFeePaymentSystem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFeePaymentSystem proto.InternalMessageInfo
+
+func (m *OrderFeePaymentSystem) GetAmountPaymentMethodCurrency() float64 {
 	if m != nil {
-		return m.MinPaymentAmount
+		return m.AmountPaymentMethodCurrency
 	}
 	return 0
 }
 
-func (m *PaymentMethod) GetMaxPaymentAmount() float64 {
+func (m *OrderFeePaymentSystem) GetAmountMerchantCurrency() float64 {
 	if m != nil {
-		return m.MaxPaymentAmount
+		return m.AmountMerchantCurrency
 	}
 	return 0
 }
 
-func (m *PaymentMethod) GetParams() *PaymentMethodParams {
+func (m *OrderFeePaymentSystem) GetAmountPaymentSystemCurrency() float64 {
 	if m != nil {
-		return m.Params
+		return m.AmountPaymentSystemCurrency
 	}
-	return nil
+	return 0
 }
 
-func (m *PaymentMethod) GetIcon() string {
-	if m != nil {
-		return m.Icon
-	}
-	return ""
+type ProjectPaymentMethod struct {
+	// @inject_tag: bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// @inject_tag: bson:"terminal"
+	Terminal string `protobuf:"bytes,2,opt,name=terminal,proto3" json:"terminal,omitempty" bson:"terminal"`
+	// @inject_tag: bson:"password"
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty" bson:"password"`
+	// @inject_tag: bson:"callback_password"
+	CallbackPassword string `protobuf:"bytes,4,opt,name=callback_password,json=callbackPassword,proto3" json:"callback_password,omitempty" bson:"callback_password"`
+	// @inject_tag: bson:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaymentMethod) GetPaymentSystem() *PaymentSystem {
+func (m *ProjectPaymentMethod) Reset()         { *m = ProjectPaymentMethod{} }
+func (m *ProjectPaymentMethod) String() string { return proto.CompactTextString(m) }
+func (*ProjectPaymentMethod) ProtoMessage()    {}
+func (*ProjectPaymentMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{34}
+}
+
+func (m *ProjectPaymentMethod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectPaymentMethod.Unmarshal(m, b)
+}
+func (m *ProjectPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectPaymentMethod.Marshal(b, m, deterministic)
+}
+func (m *ProjectPaymentMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectPaymentMethod.Merge(m, src)
+}
+func (m *ProjectPaymentMethod) XXX_Size() int {
+	return xxx_messageInfo_ProjectPaymentMethod.Size(m)
+}
+func (m *ProjectPaymentMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectPaymentMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectPaymentMethod proto.InternalMessageInfo
+
+func (m *ProjectPaymentMethod) GetId() string {
 	if m != nil {
-		return m.PaymentSystem
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *PaymentMethod) GetType() string {
+func (m *ProjectPaymentMethod) GetTerminal() string {
 	if m != nil {
-		return m.Type
+		return m.Terminal
 	}
 	return ""
 }
 
-func (m *PaymentMethod) GetAccountRegexp() string {
+func (m *ProjectPaymentMethod) GetPassword() string {
 	if m != nil {
-		return m.AccountRegexp
+		return m.Password
 	}
 	return ""
 }
 
-func (m *PaymentMethod) GetIsActive() bool {
+func (m *ProjectPaymentMethod) GetCallbackPassword() string {
 	if m != nil {
-		return m.IsActive
+		return m.CallbackPassword
 	}
-	return false
+	return ""
 }
 
-func (m *PaymentMethod) GetCreatedAt() *timestamp.Timestamp {
+func (m *ProjectPaymentMethod) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *PaymentMethod) GetUpdatedAt() *timestamp.Timestamp {
-	if m != nil {
-		return m.UpdatedAt
-	}
+type CurrencyRate struct {
+	// @inject_tag: bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// @inject_tag: bson:"currency_from"
+	CurrencyFrom int32 `protobuf:"varint,2,opt,name=currency_from,json=currencyFrom,proto3" json:"currency_from,omitempty" bson:"currency_from"`
+	// @inject_tag: bson:"currency_to"
+	CurrencyTo int32 `protobuf:"varint,3,opt,name=currency_to,json=currencyTo,proto3" json:"currency_to,omitempty" bson:"currency_to"`
+	// @inject_tag: bson:"rate"
+	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty" bson:"rate"`
+	// @inject_tag: bson:"is_active"
+	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty" bson:"is_active"`
+	// @inject_tag: bson:"date"
+	Date *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty" bson:"date"`
+	// @inject_tag: bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+	// @inject_tag: bson:"batch_id"
+	BatchId              string   `protobuf:"bytes,8,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty" bson:"batch_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrencyRate) Reset()         { *m = CurrencyRate{} }
+func (m *CurrencyRate) String() string { return proto.CompactTextString(m) }
+func (*CurrencyRate) ProtoMessage()    {}
+func (*CurrencyRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{35}
+}
+
+func (m *CurrencyRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyRate.Unmarshal(m, b)
+}
+func (m *CurrencyRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyRate.Marshal(b, m, deterministic)
+}
+func (m *CurrencyRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyRate.Merge(m, src)
+}
+func (m *CurrencyRate) XXX_Size() int {
+	return xxx_messageInfo_CurrencyRate.Size(m)
+}
+func (m *CurrencyRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyRate proto.InternalMessageInfo
+
+func (m *CurrencyRate) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CurrencyRate) GetCurrencyFrom() int32 {
+	if m != nil {
+		return m.CurrencyFrom
+	}
+	return 0
+}
+
+func (m *CurrencyRate) GetCurrencyTo() int32 {
+	if m != nil {
+		return m.CurrencyTo
+	}
+	return 0
+}
+
+func (m *CurrencyRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *CurrencyRate) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *CurrencyRate) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *CurrencyRate) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CurrencyRate) GetBatchId() string {
+	if m != nil {
+		return m.BatchId
+	}
+	return ""
+}
+
+type AppliedCurrencyRate struct {
+	// @inject_tag: bson:"currency_from"
+	CurrencyFrom int32 `protobuf:"varint,1,opt,name=currency_from,json=currencyFrom,proto3" json:"currency_from,omitempty" bson:"currency_from"`
+	// @inject_tag: bson:"currency_to"
+	CurrencyTo int32 `protobuf:"varint,2,opt,name=currency_to,json=currencyTo,proto3" json:"currency_to,omitempty" bson:"currency_to"`
+	// @inject_tag: bson:"rate"
+	Rate float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty" bson:"rate"`
+	// @inject_tag: bson:"date"
+	Date *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty" bson:"date"`
+	// @inject_tag: bson:"market_rate"
+	MarketRate float64 `protobuf:"fixed64,5,opt,name=market_rate,json=marketRate,proto3" json:"market_rate,omitempty" bson:"market_rate"`
+	// @inject_tag: bson:"spread"
+	Spread float64 `protobuf:"fixed64,6,opt,name=spread,proto3" json:"spread,omitempty" bson:"spread"`
+	// @inject_tag: bson:"cross_currency"
+	CrossCurrency int32 `protobuf:"varint,7,opt,name=cross_currency,json=crossCurrency,proto3" json:"cross_currency,omitempty" bson:"cross_currency"`
+	// @inject_tag: bson:"is_inverse"
+	IsInverse            bool     `protobuf:"varint,8,opt,name=is_inverse,json=isInverse,proto3" json:"is_inverse,omitempty" bson:"is_inverse"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *AppliedCurrencyRate) Reset()         { *m = AppliedCurrencyRate{} }
+func (m *AppliedCurrencyRate) String() string { return proto.CompactTextString(m) }
+func (*AppliedCurrencyRate) ProtoMessage()    {}
+func (*AppliedCurrencyRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{36}
+}
+
+func (m *AppliedCurrencyRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppliedCurrencyRate.Unmarshal(m, b)
+}
+func (m *AppliedCurrencyRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppliedCurrencyRate.Marshal(b, m, deterministic)
+}
+func (m *AppliedCurrencyRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedCurrencyRate.Merge(m, src)
+}
+func (m *AppliedCurrencyRate) XXX_Size() int {
+	return xxx_messageInfo_AppliedCurrencyRate.Size(m)
+}
+func (m *AppliedCurrencyRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedCurrencyRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedCurrencyRate proto.InternalMessageInfo
+
+func (m *AppliedCurrencyRate) GetCurrencyFrom() int32 {
+	if m != nil {
+		return m.CurrencyFrom
+	}
+	return 0
+}
+
+func (m *AppliedCurrencyRate) GetCurrencyTo() int32 {
+	if m != nil {
+		return m.CurrencyTo
+	}
+	return 0
+}
+
+func (m *AppliedCurrencyRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *AppliedCurrencyRate) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *AppliedCurrencyRate) GetMarketRate() float64 {
+	if m != nil {
+		return m.MarketRate
+	}
+	return 0
+}
+
+func (m *AppliedCurrencyRate) GetSpread() float64 {
+	if m != nil {
+		return m.Spread
+	}
+	return 0
+}
+
+func (m *AppliedCurrencyRate) GetCrossCurrency() int32 {
+	if m != nil {
+		return m.CrossCurrency
+	}
+	return 0
+}
+
+func (m *AppliedCurrencyRate) GetIsInverse() bool {
+	if m != nil {
+		return m.IsInverse
+	}
+	return false
+}
+
+type PaymentMethod struct {
+	// @inject_tag: bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// @inject_tag: bson:"name"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" bson:"name"`
+	// @inject_tag: bson:"group_alias"
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty" bson:"group_alias"`
+	// @inject_tag: bson:"currency"
+	Currency *Currency `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty" bson:"currency"`
+	// @inject_tag: bson:"currencies"
+	Currencies []int32 `protobuf:"varint,5,rep,packed,name=currencies,proto3" json:"currencies,omitempty" bson:"currencies"`
+	// @inject_tag: bson:"min_payment_amount"
+	MinPaymentAmount float64 `protobuf:"fixed64,6,opt,name=min_payment_amount,json=minPaymentAmount,proto3" json:"min_payment_amount,omitempty" bson:"min_payment_amount"`
+	// @inject_tag: bson:"max_payment_amount"
+	MaxPaymentAmount float64 `protobuf:"fixed64,7,opt,name=max_payment_amount,json=maxPaymentAmount,proto3" json:"max_payment_amount,omitempty" bson:"max_payment_amount"`
+	// @inject_tag: bson:"params"
+	Params *PaymentMethodParams `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty" bson:"params"`
+	// @inject_tag: bson:"icon"
+	Icon string `protobuf:"bytes,9,opt,name=Icon,proto3" json:"Icon,omitempty" bson:"icon"`
+	// @inject_tag: bson:"payment_system"
+	PaymentSystem *PaymentSystem `protobuf:"bytes,10,opt,name=payment_system,json=paymentSystem,proto3" json:"payment_system,omitempty" bson:"payment_system"`
+	// @inject_tag: bson:"type"
+	Type string `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty" bson:"type"`
+	// @inject_tag: bson:"account_regexp"
+	AccountRegexp string `protobuf:"bytes,12,opt,name=account_regexp,json=accountRegexp,proto3" json:"account_regexp,omitempty" bson:"account_regexp"`
+	// @inject_tag: bson:"is_active"
+	IsActive bool `protobuf:"varint,13,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty" bson:"is_active"`
+	// @inject_tag: bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+	// @inject_tag: bson:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentMethod) Reset()         { *m = PaymentMethod{} }
+func (m *PaymentMethod) String() string { return proto.CompactTextString(m) }
+func (*PaymentMethod) ProtoMessage()    {}
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{37}
+}
+
+func (m *PaymentMethod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentMethod.Unmarshal(m, b)
+}
+func (m *PaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentMethod.Marshal(b, m, deterministic)
+}
+func (m *PaymentMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentMethod.Merge(m, src)
+}
+func (m *PaymentMethod) XXX_Size() int {
+	return xxx_messageInfo_PaymentMethod.Size(m)
+}
+func (m *PaymentMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentMethod proto.InternalMessageInfo
+
+func (m *PaymentMethod) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetCurrency() *Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *PaymentMethod) GetCurrencies() []int32 {
+	if m != nil {
+		return m.Currencies
+	}
+	return nil
+}
+
+func (m *PaymentMethod) GetMinPaymentAmount() float64 {
+	if m != nil {
+		return m.MinPaymentAmount
+	}
+	return 0
+}
+
+func (m *PaymentMethod) GetMaxPaymentAmount() float64 {
+	if m != nil {
+		return m.MaxPaymentAmount
+	}
+	return 0
+}
+
+func (m *PaymentMethod) GetParams() *PaymentMethodParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *PaymentMethod) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetPaymentSystem() *PaymentSystem {
+	if m != nil {
+		return m.PaymentSystem
+	}
+	return nil
+}
+
+func (m *PaymentMethod) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetAccountRegexp() string {
+	if m != nil {
+		return m.AccountRegexp
+	}
+	return ""
+}
+
+func (m *PaymentMethod) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *PaymentMethod) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PaymentMethod) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type Country struct {
+	// @inject_tag: bson:"code_int" json:"code_int"
+	CodeInt int32 `protobuf:"varint,1,opt,name=code_int,json=codeInt,proto3" json:"code_int" bson:"code_int"`
+	// @inject_tag: bson:"code_a2" json:"code_a2"
+	CodeA2 string `protobuf:"bytes,2,opt,name=code_a2,json=codeA2,proto3" json:"code_a2" bson:"code_a2"`
+	// @inject_tag: bson:"code_a3" json:"code_a3"
+	CodeA3 string `protobuf:"bytes,3,opt,name=code_a3,json=codeA3,proto3" json:"code_a3" bson:"code_a3"`
+	// @inject_tag: bson:"name" json:"name"
+	Name *Name `protobuf:"bytes,4,opt,name=name,proto3" json:"name" bson:"name"`
+	// @inject_tag: bson:"is_active" json:"is_active"
+	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active" bson:"is_active"`
+	// @inject_tag: bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+	// @inject_tag: bson:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *Country) Reset()         { *m = Country{} }
+func (m *Country) String() string { return proto.CompactTextString(m) }
+func (*Country) ProtoMessage()    {}
+func (*Country) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{38}
+}
+
+func (m *Country) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Country.Unmarshal(m, b)
+}
+func (m *Country) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Country.Marshal(b, m, deterministic)
+}
+func (m *Country) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Country.Merge(m, src)
+}
+func (m *Country) XXX_Size() int {
+	return xxx_messageInfo_Country.Size(m)
+}
+func (m *Country) XXX_DiscardUnknown() {
+	xxx_messageInfo_Country.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Country proto.InternalMessageInfo
+
+func (m *Country) GetCodeInt() int32 {
+	if m != nil {
+		return m.CodeInt
+	}
+	return 0
+}
+
+func (m *Country) GetCodeA2() string {
+	if m != nil {
+		return m.CodeA2
+	}
+	return ""
+}
+
+func (m *Country) GetCodeA3() string {
+	if m != nil {
+		return m.CodeA3
+	}
+	return ""
+}
+
+func (m *Country) GetName() *Name {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *Country) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *Country) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Country) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type Vat struct {
+	// @inject_tag: bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// @inject_tag: bson:"country"
+	Country *Country `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty" bson:"country"`
+	// @inject_tag: bson:"subdivision_code"
+	Subdivision string `protobuf:"bytes,3,opt,name=subdivision,proto3" json:"subdivision,omitempty" bson:"subdivision_code"`
+	// @inject_tag: bson:"vat"
+	Vat float64 `protobuf:"fixed64,4,opt,name=vat,proto3" json:"vat,omitempty" bson:"vat"`
+	// @inject_tag: bson:"is_active"
+	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty" bson:"is_active"`
+	// @inject_tag: bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+	// @inject_tag: bson:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *Vat) Reset()         { *m = Vat{} }
+func (m *Vat) String() string { return proto.CompactTextString(m) }
+func (*Vat) ProtoMessage()    {}
+func (*Vat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{39}
+}
+
+func (m *Vat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Vat.Unmarshal(m, b)
+}
+func (m *Vat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Vat.Marshal(b, m, deterministic)
+}
+func (m *Vat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vat.Merge(m, src)
+}
+func (m *Vat) XXX_Size() int {
+	return xxx_messageInfo_Vat.Size(m)
+}
+func (m *Vat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vat proto.InternalMessageInfo
+
+func (m *Vat) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Vat) GetCountry() *Country {
+	if m != nil {
+		return m.Country
+	}
+	return nil
+}
+
+func (m *Vat) GetSubdivision() string {
+	if m != nil {
+		return m.Subdivision
+	}
+	return ""
+}
+
+func (m *Vat) GetVat() float64 {
+	if m != nil {
+		return m.Vat
+	}
+	return 0
+}
+
+func (m *Vat) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+func (m *Vat) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Vat) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type Commission struct {
+	// @inject_tag: bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
+	// @inject_tag: bson:"pm_id"
+	PaymentMethodId string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty" bson:"pm_id"`
+	// @inject_tag: bson:"project_id"
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" bson:"project_id"`
+	// @inject_tag: bson:"pm_commission"
+	PaymentMethodCommission float64 `protobuf:"fixed64,4,opt,name=payment_method_commission,json=paymentMethodCommission,proto3" json:"payment_method_commission,omitempty" bson:"pm_commission"`
+	// @inject_tag: bson:"psp_commission"
+	PspCommission float64 `protobuf:"fixed64,5,opt,name=psp_commission,json=pspCommission,proto3" json:"psp_commission,omitempty" bson:"psp_commission"`
+	// @inject_tag: bson:"total_commission_to_user"
+	TotalCommissionToUser float64 `protobuf:"fixed64,6,opt,name=total_commission_to_user,json=totalCommissionToUser,proto3" json:"total_commission_to_user,omitempty" bson:"total_commission_to_user"`
+	// @inject_tag: bson:"start_date"
+	StartDate *timestamp.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" bson:"start_date"`
+	// @inject_tag: bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
+	// @inject_tag: bson:"updated_at"
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *Commission) Reset()         { *m = Commission{} }
+func (m *Commission) String() string { return proto.CompactTextString(m) }
+func (*Commission) ProtoMessage()    {}
+func (*Commission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{40}
+}
+
+func (m *Commission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Commission.Unmarshal(m, b)
+}
+func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Commission.Marshal(b, m, deterministic)
+}
+func (m *Commission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commission.Merge(m, src)
+}
+func (m *Commission) XXX_Size() int {
+	return xxx_messageInfo_Commission.Size(m)
+}
+func (m *Commission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Commission proto.InternalMessageInfo
+
+func (m *Commission) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Commission) GetPaymentMethodId() string {
+	if m != nil {
+		return m.PaymentMethodId
+	}
+	return ""
+}
+
+func (m *Commission) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *Commission) GetPaymentMethodCommission() float64 {
+	if m != nil {
+		return m.PaymentMethodCommission
+	}
+	return 0
+}
+
+func (m *Commission) GetPspCommission() float64 {
+	if m != nil {
+		return m.PspCommission
+	}
+	return 0
+}
+
+func (m *Commission) GetTotalCommissionToUser() float64 {
+	if m != nil {
+		return m.TotalCommissionToUser
+	}
+	return 0
+}
+
+func (m *Commission) GetStartDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartDate
+	}
+	return nil
+}
+
+func (m *Commission) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Commission) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type CardExpire struct {
+	// @inject_tag: bson:"month"
+	Month string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty" bson:"month"`
+	// @inject_tag: bson:"year"
+	Year                 string   `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty" bson:"year"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CardExpire) Reset()         { *m = CardExpire{} }
+func (m *CardExpire) String() string { return proto.CompactTextString(m) }
+func (*CardExpire) ProtoMessage()    {}
+func (*CardExpire) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{41}
+}
+
+func (m *CardExpire) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CardExpire.Unmarshal(m, b)
+}
+func (m *CardExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CardExpire.Marshal(b, m, deterministic)
+}
+func (m *CardExpire) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardExpire.Merge(m, src)
+}
+func (m *CardExpire) XXX_Size() int {
+	return xxx_messageInfo_CardExpire.Size(m)
+}
+func (m *CardExpire) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardExpire.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CardExpire proto.InternalMessageInfo
+
+func (m *CardExpire) GetMonth() string {
+	if m != nil {
+		return m.Month
+	}
+	return ""
+}
+
+func (m *CardExpire) GetYear() string {
+	if m != nil {
+		return m.Year
+	}
+	return ""
+}
+
+type SavedCard struct {
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pan                  string      `protobuf:"bytes,2,opt,name=pan,proto3" json:"pan,omitempty"`
+	Expire               *CardExpire `protobuf:"bytes,3,opt,name=expire,proto3" json:"expire,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32       `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *SavedCard) Reset()         { *m = SavedCard{} }
+func (m *SavedCard) String() string { return proto.CompactTextString(m) }
+func (*SavedCard) ProtoMessage()    {}
+func (*SavedCard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{42}
+}
+
+func (m *SavedCard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SavedCard.Unmarshal(m, b)
+}
+func (m *SavedCard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SavedCard.Marshal(b, m, deterministic)
+}
+func (m *SavedCard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SavedCard.Merge(m, src)
+}
+func (m *SavedCard) XXX_Size() int {
+	return xxx_messageInfo_SavedCard.Size(m)
+}
+func (m *SavedCard) XXX_DiscardUnknown() {
+	xxx_messageInfo_SavedCard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SavedCard proto.InternalMessageInfo
+
+func (m *SavedCard) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SavedCard) GetPan() string {
+	if m != nil {
+		return m.Pan
+	}
+	return ""
+}
+
+func (m *SavedCard) GetExpire() *CardExpire {
+	if m != nil {
+		return m.Expire
+	}
+	return nil
+}
+
+type PaymentFormPaymentMethod struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Icon string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// @inject_tag: json:"group_alias"
+	Group         string `protobuf:"bytes,5,opt,name=group,proto3" json:"group_alias"`
+	AccountRegexp string `protobuf:"bytes,6,opt,name=account_regexp,json=accountRegexp,proto3" json:"account_regexp,omitempty"`
+	// @inject_tag: json:"has_saved_cards"
+	HasSavedCards bool `protobuf:"varint,12,opt,name=has_saved_cards,json=hasSavedCards,proto3" json:"has_saved_cards"`
+	// @inject_tag: json:"saved_cards,omitempty"
+	SavedCards           []*SavedCard `protobuf:"bytes,13,rep,name=saved_cards,json=savedCards,proto3" json:"saved_cards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32        `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaymentFormPaymentMethod) Reset()         { *m = PaymentFormPaymentMethod{} }
+func (m *PaymentFormPaymentMethod) String() string { return proto.CompactTextString(m) }
+func (*PaymentFormPaymentMethod) ProtoMessage()    {}
+func (*PaymentFormPaymentMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{43}
+}
+
+func (m *PaymentFormPaymentMethod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentFormPaymentMethod.Unmarshal(m, b)
+}
+func (m *PaymentFormPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentFormPaymentMethod.Marshal(b, m, deterministic)
+}
+func (m *PaymentFormPaymentMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormPaymentMethod.Merge(m, src)
+}
+func (m *PaymentFormPaymentMethod) XXX_Size() int {
+	return xxx_messageInfo_PaymentFormPaymentMethod.Size(m)
+}
+func (m *PaymentFormPaymentMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentFormPaymentMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentFormPaymentMethod proto.InternalMessageInfo
+
+func (m *PaymentFormPaymentMethod) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaymentFormPaymentMethod) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PaymentFormPaymentMethod) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *PaymentFormPaymentMethod) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PaymentFormPaymentMethod) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *PaymentFormPaymentMethod) GetAccountRegexp() string {
+	if m != nil {
+		return m.AccountRegexp
+	}
+	return ""
+}
+
+func (m *PaymentFormPaymentMethod) GetHasSavedCards() bool {
+	if m != nil {
+		return m.HasSavedCards
+	}
+	return false
+}
+
+func (m *PaymentFormPaymentMethod) GetSavedCards() []*SavedCard {
+	if m != nil {
+		return m.SavedCards
+	}
+	return nil
+}
+
+type MerchantPaymentMethodPerTransactionCommission struct {
+	// @inject_tag: validate:"omitempty,numeric,gte=0" json:"fee"
+	Fee float64 `protobuf:"fixed64,1,opt,name=fee,proto3" json:"fee" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"currency"
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantPaymentMethodPerTransactionCommission) Reset() {
+	*m = MerchantPaymentMethodPerTransactionCommission{}
+}
+func (m *MerchantPaymentMethodPerTransactionCommission) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MerchantPaymentMethodPerTransactionCommission) ProtoMessage() {}
+func (*MerchantPaymentMethodPerTransactionCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{44}
+}
+
+func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Unmarshal(m, b)
+}
+func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Marshal(b, m, deterministic)
+}
+func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Merge(m, src)
+}
+func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Size() int {
+	return xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Size(m)
+}
+func (m *MerchantPaymentMethodPerTransactionCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission proto.InternalMessageInfo
+
+func (m *MerchantPaymentMethodPerTransactionCommission) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *MerchantPaymentMethodPerTransactionCommission) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type MerchantPaymentMethodCommissions struct {
+	// @inject_tag: validate:"omitempty,numeric,gte=0,lte=100" json:"fee"
+	Fee float64 `protobuf:"fixed64,1,opt,name=fee,proto3" json:"fee" validate:"omitempty,numeric,gte=0,lte=100"`
+	// @inject_tag: validate:"required" json:"per_transaction"
+	PerTransaction       *MerchantPaymentMethodPerTransactionCommission `protobuf:"bytes,2,opt,name=per_transaction,json=perTransaction,proto3" json:"per_transaction" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantPaymentMethodCommissions) Reset()         { *m = MerchantPaymentMethodCommissions{} }
+func (m *MerchantPaymentMethodCommissions) String() string { return proto.CompactTextString(m) }
+func (*MerchantPaymentMethodCommissions) ProtoMessage()    {}
+func (*MerchantPaymentMethodCommissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{45}
+}
+
+func (m *MerchantPaymentMethodCommissions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantPaymentMethodCommissions.Unmarshal(m, b)
+}
+func (m *MerchantPaymentMethodCommissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantPaymentMethodCommissions.Marshal(b, m, deterministic)
+}
+func (m *MerchantPaymentMethodCommissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethodCommissions.Merge(m, src)
+}
+func (m *MerchantPaymentMethodCommissions) XXX_Size() int {
+	return xxx_messageInfo_MerchantPaymentMethodCommissions.Size(m)
+}
+func (m *MerchantPaymentMethodCommissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantPaymentMethodCommissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantPaymentMethodCommissions proto.InternalMessageInfo
+
+func (m *MerchantPaymentMethodCommissions) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *MerchantPaymentMethodCommissions) GetPerTransaction() *MerchantPaymentMethodPerTransactionCommission {
+	if m != nil {
+		return m.PerTransaction
+	}
+	return nil
+}
+
+type MerchantPaymentMethodIntegration struct {
+	// @inject_tag: json:"terminal_id"
+	TerminalId string `protobuf:"bytes,1,opt,name=terminal_id,json=terminalId,proto3" json:"terminal_id"`
+	// @inject_tag: json:"terminal_password"
+	TerminalPassword string `protobuf:"bytes,2,opt,name=terminal_password,json=terminalPassword,proto3" json:"terminal_password"`
+	// @inject_tag: json:"terminal_callback_password"
+	TerminalCallbackPassword string `protobuf:"bytes,3,opt,name=terminal_callback_password,json=terminalCallbackPassword,proto3" json:"terminal_callback_password"`
+	// @inject_tag: json:"integrated"
+	Integrated           bool     `protobuf:"varint,4,opt,name=integrated,proto3" json:"integrated"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantPaymentMethodIntegration) Reset()         { *m = MerchantPaymentMethodIntegration{} }
+func (m *MerchantPaymentMethodIntegration) String() string { return proto.CompactTextString(m) }
+func (*MerchantPaymentMethodIntegration) ProtoMessage()    {}
+func (*MerchantPaymentMethodIntegration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{46}
+}
+
+func (m *MerchantPaymentMethodIntegration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantPaymentMethodIntegration.Unmarshal(m, b)
+}
+func (m *MerchantPaymentMethodIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantPaymentMethodIntegration.Marshal(b, m, deterministic)
+}
+func (m *MerchantPaymentMethodIntegration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethodIntegration.Merge(m, src)
+}
+func (m *MerchantPaymentMethodIntegration) XXX_Size() int {
+	return xxx_messageInfo_MerchantPaymentMethodIntegration.Size(m)
+}
+func (m *MerchantPaymentMethodIntegration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantPaymentMethodIntegration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantPaymentMethodIntegration proto.InternalMessageInfo
+
+func (m *MerchantPaymentMethodIntegration) GetTerminalId() string {
+	if m != nil {
+		return m.TerminalId
+	}
+	return ""
+}
+
+func (m *MerchantPaymentMethodIntegration) GetTerminalPassword() string {
+	if m != nil {
+		return m.TerminalPassword
+	}
+	return ""
+}
+
+func (m *MerchantPaymentMethodIntegration) GetTerminalCallbackPassword() string {
+	if m != nil {
+		return m.TerminalCallbackPassword
+	}
+	return ""
+}
+
+func (m *MerchantPaymentMethodIntegration) GetIntegrated() bool {
+	if m != nil {
+		return m.Integrated
+	}
+	return false
+}
+
+type MerchantPaymentMethodIdentification struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required" json:"name"
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantPaymentMethodIdentification) Reset()         { *m = MerchantPaymentMethodIdentification{} }
+func (m *MerchantPaymentMethodIdentification) String() string { return proto.CompactTextString(m) }
+func (*MerchantPaymentMethodIdentification) ProtoMessage()    {}
+func (*MerchantPaymentMethodIdentification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{47}
+}
+
+func (m *MerchantPaymentMethodIdentification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantPaymentMethodIdentification.Unmarshal(m, b)
+}
+func (m *MerchantPaymentMethodIdentification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantPaymentMethodIdentification.Marshal(b, m, deterministic)
+}
+func (m *MerchantPaymentMethodIdentification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethodIdentification.Merge(m, src)
+}
+func (m *MerchantPaymentMethodIdentification) XXX_Size() int {
+	return xxx_messageInfo_MerchantPaymentMethodIdentification.Size(m)
+}
+func (m *MerchantPaymentMethodIdentification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantPaymentMethodIdentification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantPaymentMethodIdentification proto.InternalMessageInfo
+
+func (m *MerchantPaymentMethodIdentification) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *MerchantPaymentMethodIdentification) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type MerchantPaymentMethod struct {
+	PaymentMethod        *MerchantPaymentMethodIdentification `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Commission           *MerchantPaymentMethodCommissions    `protobuf:"bytes,4,opt,name=commission,proto3" json:"commission,omitempty"`
+	Integration          *MerchantPaymentMethodIntegration    `protobuf:"bytes,5,opt,name=integration,proto3" json:"integration,omitempty"`
+	IsActive             bool                                 `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantPaymentMethod) Reset()         { *m = MerchantPaymentMethod{} }
+func (m *MerchantPaymentMethod) String() string { return proto.CompactTextString(m) }
+func (*MerchantPaymentMethod) ProtoMessage()    {}
+func (*MerchantPaymentMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{48}
+}
+
+func (m *MerchantPaymentMethod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantPaymentMethod.Unmarshal(m, b)
+}
+func (m *MerchantPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantPaymentMethod.Marshal(b, m, deterministic)
+}
+func (m *MerchantPaymentMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethod.Merge(m, src)
+}
+func (m *MerchantPaymentMethod) XXX_Size() int {
+	return xxx_messageInfo_MerchantPaymentMethod.Size(m)
+}
+func (m *MerchantPaymentMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantPaymentMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantPaymentMethod proto.InternalMessageInfo
+
+func (m *MerchantPaymentMethod) GetPaymentMethod() *MerchantPaymentMethodIdentification {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return nil
+}
+
+func (m *MerchantPaymentMethod) GetCommission() *MerchantPaymentMethodCommissions {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *MerchantPaymentMethod) GetIntegration() *MerchantPaymentMethodIntegration {
+	if m != nil {
+		return m.Integration
+	}
+	return nil
+}
+
+func (m *MerchantPaymentMethod) GetIsActive() bool {
+	if m != nil {
+		return m.IsActive
+	}
+	return false
+}
+
+type RefundPayerData struct {
+	Country              string   `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Zip                  string   `protobuf:"bytes,2,opt,name=zip,proto3" json:"zip,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RefundPayerData) Reset()         { *m = RefundPayerData{} }
+func (m *RefundPayerData) String() string { return proto.CompactTextString(m) }
+func (*RefundPayerData) ProtoMessage()    {}
+func (*RefundPayerData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{49}
+}
+
+func (m *RefundPayerData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundPayerData.Unmarshal(m, b)
+}
+func (m *RefundPayerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundPayerData.Marshal(b, m, deterministic)
+}
+func (m *RefundPayerData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundPayerData.Merge(m, src)
+}
+func (m *RefundPayerData) XXX_Size() int {
+	return xxx_messageInfo_RefundPayerData.Size(m)
+}
+func (m *RefundPayerData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundPayerData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundPayerData proto.InternalMessageInfo
+
+func (m *RefundPayerData) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *RefundPayerData) GetZip() string {
+	if m != nil {
+		return m.Zip
+	}
+	return ""
+}
+
+func (m *RefundPayerData) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+type RefundOrder struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RefundOrder) Reset()         { *m = RefundOrder{} }
+func (m *RefundOrder) String() string { return proto.CompactTextString(m) }
+func (*RefundOrder) ProtoMessage()    {}
+func (*RefundOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{50}
+}
+
+func (m *RefundOrder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundOrder.Unmarshal(m, b)
+}
+func (m *RefundOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundOrder.Marshal(b, m, deterministic)
+}
+func (m *RefundOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundOrder.Merge(m, src)
+}
+func (m *RefundOrder) XXX_Size() int {
+	return xxx_messageInfo_RefundOrder.Size(m)
+}
+func (m *RefundOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundOrder proto.InternalMessageInfo
+
+func (m *RefundOrder) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RefundOrder) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+type Refund struct {
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Order                *RefundOrder           `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	ExternalId           string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Amount               float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatorId            string                 `protobuf:"bytes,5,opt,name=creatorId,proto3" json:"creatorId,omitempty"`
+	Reason               string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Currency             *Currency              `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt            *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PayerData            *RefundPayerData       `protobuf:"bytes,11,opt,name=payer_data,json=payerData,proto3" json:"payer_data,omitempty"`
+	SalesTax             float32                `protobuf:"fixed32,12,opt,name=sales_tax,json=salesTax,proto3" json:"sales_tax,omitempty"`
+	Items                []*RefundItem          `protobuf:"bytes,13,rep,name=items,proto3" json:"items,omitempty"`
+	TaxAmount            float64                `protobuf:"fixed64,14,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	CurrencyRates        []*AppliedCurrencyRate `protobuf:"bytes,15,rep,name=currency_rates,json=currencyRates,proto3" json:"currency_rates,omitempty"`
+	Version              int64                  `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *Refund) Reset()         { *m = Refund{} }
+func (m *Refund) String() string { return proto.CompactTextString(m) }
+func (*Refund) ProtoMessage()    {}
+func (*Refund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{51}
+}
+
+func (m *Refund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Refund.Unmarshal(m, b)
+}
+func (m *Refund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Refund.Marshal(b, m, deterministic)
+}
+func (m *Refund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Refund.Merge(m, src)
+}
+func (m *Refund) XXX_Size() int {
+	return xxx_messageInfo_Refund.Size(m)
+}
+func (m *Refund) XXX_DiscardUnknown() {
+	xxx_messageInfo_Refund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Refund proto.InternalMessageInfo
+
+func (m *Refund) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Refund) GetOrder() *RefundOrder {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (m *Refund) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *Refund) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Refund) GetCreatorId() string {
+	if m != nil {
+		return m.CreatorId
+	}
+	return ""
+}
+
+func (m *Refund) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Refund) GetCurrency() *Currency {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *Refund) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Refund) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *Refund) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *Refund) GetPayerData() *RefundPayerData {
+	if m != nil {
+		return m.PayerData
+	}
+	return nil
+}
+
+func (m *Refund) GetSalesTax() float32 {
+	if m != nil {
+		return m.SalesTax
+	}
+	return 0
+}
+
+func (m *Refund) GetItems() []*RefundItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Refund) GetTaxAmount() float64 {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return 0
+}
+
+func (m *Refund) GetCurrencyRates() []*AppliedCurrencyRate {
+	if m != nil {
+		return m.CurrencyRates
+	}
+	return nil
+}
+
+func (m *Refund) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type RefundItem struct {
+	// @inject_tag: bson:"item_id"
+	ItemId   string  `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty" bson:"item_id"`
+	Sku      string  `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity int32   `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Amount   float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// @inject_tag: bson:"tax_amount"
+	TaxAmount            float64  `protobuf:"fixed64,5,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty" bson:"tax_amount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RefundItem) Reset()         { *m = RefundItem{} }
+func (m *RefundItem) String() string { return proto.CompactTextString(m) }
+func (*RefundItem) ProtoMessage()    {}
+func (*RefundItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{52}
+}
+
+func (m *RefundItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundItem.Unmarshal(m, b)
+}
+func (m *RefundItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundItem.Marshal(b, m, deterministic)
+}
+func (m *RefundItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundItem.Merge(m, src)
+}
+func (m *RefundItem) XXX_Size() int {
+	return xxx_messageInfo_RefundItem.Size(m)
+}
+func (m *RefundItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundItem proto.InternalMessageInfo
+
+func (m *RefundItem) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *RefundItem) GetSku() string {
+	if m != nil {
+		return m.Sku
+	}
+	return ""
+}
+
+func (m *RefundItem) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *RefundItem) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *RefundItem) GetTaxAmount() float64 {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	Id                     string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JournalId              string               `protobuf:"bytes,2,opt,name=journal_id,json=journalId,proto3" json:"journal_id,omitempty"`
+	SourceType             string               `protobuf:"bytes,3,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	SourceId               string               `protobuf:"bytes,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	MerchantId             string               `protobuf:"bytes,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	OrderId                string               `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Account                string               `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
+	Side                   string               `protobuf:"bytes,8,opt,name=side,proto3" json:"side,omitempty"`
+	Amount                 float64              `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency               string               `protobuf:"bytes,10,opt,name=currency,proto3" json:"currency,omitempty"`
+	AmountMerchantCurrency float64              `protobuf:"fixed64,11,opt,name=amount_merchant_currency,json=amountMerchantCurrency,proto3" json:"amount_merchant_currency,omitempty"`
+	MerchantCurrency       string               `protobuf:"bytes,12,opt,name=merchant_currency,json=merchantCurrency,proto3" json:"merchant_currency,omitempty"`
+	AmountPspCurrency      float64              `protobuf:"fixed64,13,opt,name=amount_psp_currency,json=amountPspCurrency,proto3" json:"amount_psp_currency,omitempty"`
+	PspCurrency            string               `protobuf:"bytes,14,opt,name=psp_currency,json=pspCurrency,proto3" json:"psp_currency,omitempty"`
+	CreatedAt              *timestamp.Timestamp `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized       []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache          int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{53}
+}
+
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LedgerEntry.Unmarshal(m, b)
+}
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
+}
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
+}
+func (m *LedgerEntry) XXX_Size() int {
+	return xxx_messageInfo_LedgerEntry.Size(m)
+}
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
+
+func (m *LedgerEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetJournalId() string {
+	if m != nil {
+		return m.JournalId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetSourceId() string {
+	if m != nil {
+		return m.SourceId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetAmountMerchantCurrency() float64 {
+	if m != nil {
+		return m.AmountMerchantCurrency
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetMerchantCurrency() string {
+	if m != nil {
+		return m.MerchantCurrency
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetAmountPspCurrency() float64 {
+	if m != nil {
+		return m.AmountPspCurrency
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetPspCurrency() string {
+	if m != nil {
+		return m.PspCurrency
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
 	return nil
 }
 
-type Country struct {
-	// @inject_tag: bson:"code_int" json:"code_int"
-	CodeInt int32 `protobuf:"varint,1,opt,name=code_int,json=codeInt,proto3" json:"code_int" bson:"code_int"`
-	// @inject_tag: bson:"code_a2" json:"code_a2"
-	CodeA2 string `protobuf:"bytes,2,opt,name=code_a2,json=codeA2,proto3" json:"code_a2" bson:"code_a2"`
-	// @inject_tag: bson:"code_a3" json:"code_a3"
-	CodeA3 string `protobuf:"bytes,3,opt,name=code_a3,json=codeA3,proto3" json:"code_a3" bson:"code_a3"`
-	// @inject_tag: bson:"name" json:"name"
-	Name *Name `protobuf:"bytes,4,opt,name=name,proto3" json:"name" bson:"name"`
-	// @inject_tag: bson:"is_active" json:"is_active"
-	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active" bson:"is_active"`
-	// @inject_tag: bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
-	// @inject_tag: bson:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+type MerchantBalance struct {
+	MerchantId           string   `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Debit                float64  `protobuf:"fixed64,3,opt,name=debit,proto3" json:"debit,omitempty"`
+	Credit               float64  `protobuf:"fixed64,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	Balance              float64  `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantBalance) Reset()         { *m = MerchantBalance{} }
+func (m *MerchantBalance) String() string { return proto.CompactTextString(m) }
+func (*MerchantBalance) ProtoMessage()    {}
+func (*MerchantBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{54}
+}
+
+func (m *MerchantBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantBalance.Unmarshal(m, b)
+}
+func (m *MerchantBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantBalance.Marshal(b, m, deterministic)
+}
+func (m *MerchantBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantBalance.Merge(m, src)
+}
+func (m *MerchantBalance) XXX_Size() int {
+	return xxx_messageInfo_MerchantBalance.Size(m)
+}
+func (m *MerchantBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantBalance proto.InternalMessageInfo
+
+func (m *MerchantBalance) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *MerchantBalance) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *MerchantBalance) GetDebit() float64 {
+	if m != nil {
+		return m.Debit
+	}
+	return 0
+}
+
+func (m *MerchantBalance) GetCredit() float64 {
+	if m != nil {
+		return m.Credit
+	}
+	return 0
+}
+
+func (m *MerchantBalance) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+type Payout struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MerchantId           string               `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Currency             string               `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               int32                `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	PeriodFrom           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=period_from,json=periodFrom,proto3" json:"period_from,omitempty"`
+	PeriodTo             *timestamp.Timestamp `protobuf:"bytes,6,opt,name=period_to,json=periodTo,proto3" json:"period_to,omitempty"`
+	OrdersAmount         float64              `protobuf:"fixed64,7,opt,name=orders_amount,json=ordersAmount,proto3" json:"orders_amount,omitempty"`
+	RefundsAmount        float64              `protobuf:"fixed64,8,opt,name=refunds_amount,json=refundsAmount,proto3" json:"refunds_amount,omitempty"`
+	FeesAmount           float64              `protobuf:"fixed64,9,opt,name=fees_amount,json=feesAmount,proto3" json:"fees_amount,omitempty"`
+	TaxAmount            float64              `protobuf:"fixed64,10,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
+	ReserveAmount        float64              `protobuf:"fixed64,11,opt,name=reserve_amount,json=reserveAmount,proto3" json:"reserve_amount,omitempty"`
+	PreviousBalance      float64              `protobuf:"fixed64,12,opt,name=previous_balance,json=previousBalance,proto3" json:"previous_balance,omitempty"`
+	Amount               float64              `protobuf:"fixed64,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionId        string               `protobuf:"bytes,14,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	FailureReason        string               `protobuf:"bytes,15,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ApprovedAt           *timestamp.Timestamp `protobuf:"bytes,18,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	PaidAt               *timestamp.Timestamp `protobuf:"bytes,19,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	ChargebacksAmount    float64              `protobuf:"fixed64,20,opt,name=chargebacks_amount,json=chargebacksAmount,proto3" json:"chargebacks_amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *Country) Reset()         { *m = Country{} }
-func (m *Country) String() string { return proto.CompactTextString(m) }
-func (*Country) ProtoMessage()    {}
-func (*Country) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{32}
+func (m *Payout) Reset()         { *m = Payout{} }
+func (m *Payout) String() string { return proto.CompactTextString(m) }
+func (*Payout) ProtoMessage()    {}
+func (*Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{55}
 }
 
-func (m *Country) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Country.Unmarshal(m, b)
+func (m *Payout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payout.Unmarshal(m, b)
 }
-func (m *Country) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Country.Marshal(b, m, deterministic)
+func (m *Payout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payout.Marshal(b, m, deterministic)
 }
-func (m *Country) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Country.Merge(m, src)
+func (m *Payout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payout.Merge(m, src)
 }
-func (m *Country) XXX_Size() int {
-	return xxx_messageInfo_Country.Size(m)
+func (m *Payout) XXX_Size() int {
+	return xxx_messageInfo_Payout.Size(m)
 }
-func (m *Country) XXX_DiscardUnknown() {
-	xxx_messageInfo_Country.DiscardUnknown(m)
+func (m *Payout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payout.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Country proto.InternalMessageInfo
+var xxx_messageInfo_Payout proto.InternalMessageInfo
 
-func (m *Country) GetCodeInt() int32 {
+func (m *Payout) GetId() string {
 	if m != nil {
-		return m.CodeInt
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *Country) GetCodeA2() string {
+func (m *Payout) GetMerchantId() string {
 	if m != nil {
-		return m.CodeA2
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *Country) GetCodeA3() string {
+func (m *Payout) GetCurrency() string {
 	if m != nil {
-		return m.CodeA3
+		return m.Currency
 	}
 	return ""
 }
 
-func (m *Country) GetName() *Name {
+func (m *Payout) GetStatus() int32 {
 	if m != nil {
-		return m.Name
+		return m.Status
 	}
-	return nil
+	return 0
 }
 
-func (m *Country) GetIsActive() bool {
+func (m *Payout) GetPeriodFrom() *timestamp.Timestamp {
 	if m != nil {
-		return m.IsActive
+		return m.PeriodFrom
 	}
-	return false
+	return nil
 }
 
-func (m *Country) GetCreatedAt() *timestamp.Timestamp {
+func (m *Payout) GetPeriodTo() *timestamp.Timestamp {
 	if m != nil {
-		return m.CreatedAt
+		return m.PeriodTo
 	}
 	return nil
 }
 
-func (m *Country) GetUpdatedAt() *timestamp.Timestamp {
+func (m *Payout) GetOrdersAmount() float64 {
 	if m != nil {
-		return m.UpdatedAt
+		return m.OrdersAmount
 	}
-	return nil
+	return 0
 }
 
-type Vat struct {
-	// @inject_tag: bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	// @inject_tag: bson:"country"
-	Country *Country `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty" bson:"country"`
-	// @inject_tag: bson:"subdivision_code"
-	Subdivision string `protobuf:"bytes,3,opt,name=subdivision,proto3" json:"subdivision,omitempty" bson:"subdivision_code"`
-	// @inject_tag: bson:"vat"
-	Vat float64 `protobuf:"fixed64,4,opt,name=vat,proto3" json:"vat,omitempty" bson:"vat"`
-	// @inject_tag: bson:"is_active"
-	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty" bson:"is_active"`
-	// @inject_tag: bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
-	// @inject_tag: bson:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Payout) GetRefundsAmount() float64 {
+	if m != nil {
+		return m.RefundsAmount
+	}
+	return 0
 }
 
-func (m *Vat) Reset()         { *m = Vat{} }
-func (m *Vat) String() string { return proto.CompactTextString(m) }
-func (*Vat) ProtoMessage()    {}
-func (*Vat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{33}
+func (m *Payout) GetFeesAmount() float64 {
+	if m != nil {
+		return m.FeesAmount
+	}
+	return 0
 }
 
-func (m *Vat) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Vat.Unmarshal(m, b)
-}
-func (m *Vat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Vat.Marshal(b, m, deterministic)
-}
-func (m *Vat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vat.Merge(m, src)
-}
-func (m *Vat) XXX_Size() int {
-	return xxx_messageInfo_Vat.Size(m)
+func (m *Payout) GetTaxAmount() float64 {
+	if m != nil {
+		return m.TaxAmount
+	}
+	return 0
 }
-func (m *Vat) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vat.DiscardUnknown(m)
+
+func (m *Payout) GetReserveAmount() float64 {
+	if m != nil {
+		return m.ReserveAmount
+	}
+	return 0
 }
 
-var xxx_messageInfo_Vat proto.InternalMessageInfo
+func (m *Payout) GetPreviousBalance() float64 {
+	if m != nil {
+		return m.PreviousBalance
+	}
+	return 0
+}
 
-func (m *Vat) GetId() string {
+func (m *Payout) GetAmount() float64 {
 	if m != nil {
-		return m.Id
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *Vat) GetCountry() *Country {
+func (m *Payout) GetTransactionId() string {
 	if m != nil {
-		return m.Country
+		return m.TransactionId
 	}
-	return nil
+	return ""
 }
 
-func (m *Vat) GetSubdivision() string {
+func (m *Payout) GetFailureReason() string {
 	if m != nil {
-		return m.Subdivision
+		return m.FailureReason
 	}
 	return ""
 }
 
-func (m *Vat) GetVat() float64 {
+func (m *Payout) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Vat
+		return m.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (m *Vat) GetIsActive() bool {
+func (m *Payout) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.IsActive
+		return m.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-func (m *Vat) GetCreatedAt() *timestamp.Timestamp {
+func (m *Payout) GetApprovedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.CreatedAt
+		return m.ApprovedAt
 	}
 	return nil
 }
 
-func (m *Vat) GetUpdatedAt() *timestamp.Timestamp {
+func (m *Payout) GetPaidAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.UpdatedAt
+		return m.PaidAt
 	}
 	return nil
 }
 
-type Commission struct {
-	// @inject_tag: bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"_id"`
-	// @inject_tag: bson:"pm_id"
-	PaymentMethodId string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty" bson:"pm_id"`
-	// @inject_tag: bson:"project_id"
-	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" bson:"project_id"`
-	// @inject_tag: bson:"pm_commission"
-	PaymentMethodCommission float64 `protobuf:"fixed64,4,opt,name=payment_method_commission,json=paymentMethodCommission,proto3" json:"payment_method_commission,omitempty" bson:"pm_commission"`
-	// @inject_tag: bson:"psp_commission"
-	PspCommission float64 `protobuf:"fixed64,5,opt,name=psp_commission,json=pspCommission,proto3" json:"psp_commission,omitempty" bson:"psp_commission"`
-	// @inject_tag: bson:"total_commission_to_user"
-	TotalCommissionToUser float64 `protobuf:"fixed64,6,opt,name=total_commission_to_user,json=totalCommissionToUser,proto3" json:"total_commission_to_user,omitempty" bson:"total_commission_to_user"`
-	// @inject_tag: bson:"start_date"
-	StartDate *timestamp.Timestamp `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty" bson:"start_date"`
-	// @inject_tag: bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" bson:"created_at"`
-	// @inject_tag: bson:"updated_at"
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" bson:"updated_at"`
+func (m *Payout) GetChargebacksAmount() float64 {
+	if m != nil {
+		return m.ChargebacksAmount
+	}
+	return 0
+}
+
+type Dispute struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId              string               `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MerchantId           string               `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ExternalId           string               `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	ReasonCode           string               `protobuf:"bytes,5,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason               string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Amount               float64              `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             *Currency            `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               int32                `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	EvidenceDueAt        *timestamp.Timestamp `protobuf:"bytes,10,opt,name=evidence_due_at,json=evidenceDueAt,proto3" json:"evidence_due_at,omitempty"`
+	Evidence             []*DisputeEvidence   `protobuf:"bytes,11,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	FeeAmount            float64              `protobuf:"fixed64,12,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ClosedAt             *timestamp.Timestamp `protobuf:"bytes,15,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *Commission) Reset()         { *m = Commission{} }
-func (m *Commission) String() string { return proto.CompactTextString(m) }
-func (*Commission) ProtoMessage()    {}
-func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{34}
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{56}
 }
 
-func (m *Commission) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Commission.Unmarshal(m, b)
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dispute.Unmarshal(m, b)
 }
-func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Commission.Marshal(b, m, deterministic)
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
 }
-func (m *Commission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Commission.Merge(m, src)
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
 }
-func (m *Commission) XXX_Size() int {
-	return xxx_messageInfo_Commission.Size(m)
+func (m *Dispute) XXX_Size() int {
+	return xxx_messageInfo_Dispute.Size(m)
 }
-func (m *Commission) XXX_DiscardUnknown() {
-	xxx_messageInfo_Commission.DiscardUnknown(m)
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Commission proto.InternalMessageInfo
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
 
-func (m *Commission) GetId() string {
+func (m *Dispute) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Commission) GetPaymentMethodId() string {
+func (m *Dispute) GetOrderId() string {
 	if m != nil {
-		return m.PaymentMethodId
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *Commission) GetProjectId() string {
+func (m *Dispute) GetMerchantId() string {
 	if m != nil {
-		return m.ProjectId
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *Commission) GetPaymentMethodCommission() float64 {
+func (m *Dispute) GetExternalId() string {
 	if m != nil {
-		return m.PaymentMethodCommission
+		return m.ExternalId
 	}
-	return 0
+	return ""
 }
 
-func (m *Commission) GetPspCommission() float64 {
+func (m *Dispute) GetReasonCode() string {
 	if m != nil {
-		return m.PspCommission
+		return m.ReasonCode
+	}
+	return ""
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Dispute) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *Commission) GetTotalCommissionToUser() float64 {
+func (m *Dispute) GetCurrency() *Currency {
 	if m != nil {
-		return m.TotalCommissionToUser
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *Dispute) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
 	return 0
 }
 
-func (m *Commission) GetStartDate() *timestamp.Timestamp {
+func (m *Dispute) GetEvidenceDueAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.StartDate
+		return m.EvidenceDueAt
 	}
 	return nil
 }
 
-func (m *Commission) GetCreatedAt() *timestamp.Timestamp {
+func (m *Dispute) GetEvidence() []*DisputeEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *Dispute) GetFeeAmount() float64 {
+	if m != nil {
+		return m.FeeAmount
+	}
+	return 0
+}
+
+func (m *Dispute) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
 		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *Commission) GetUpdatedAt() *timestamp.Timestamp {
+func (m *Dispute) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
 		return m.UpdatedAt
 	}
 	return nil
 }
 
-type CardExpire struct {
-	// @inject_tag: bson:"month"
-	Month string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty" bson:"month"`
-	// @inject_tag: bson:"year"
-	Year                 string   `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty" bson:"year"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Dispute) GetClosedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return nil
 }
 
-func (m *CardExpire) Reset()         { *m = CardExpire{} }
-func (m *CardExpire) String() string { return proto.CompactTextString(m) }
-func (*CardExpire) ProtoMessage()    {}
-func (*CardExpire) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{35}
+type DisputeEvidence struct {
+	Text                 string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Files                []*DisputeEvidenceFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	CreatedAt            *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *CardExpire) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CardExpire.Unmarshal(m, b)
+func (m *DisputeEvidence) Reset()         { *m = DisputeEvidence{} }
+func (m *DisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence) ProtoMessage()    {}
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{57}
 }
-func (m *CardExpire) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CardExpire.Marshal(b, m, deterministic)
+
+func (m *DisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidence.Unmarshal(m, b)
 }
-func (m *CardExpire) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardExpire.Merge(m, src)
+func (m *DisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidence.Marshal(b, m, deterministic)
+}
+func (m *DisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence.Merge(m, src)
 }
-func (m *CardExpire) XXX_Size() int {
-	return xxx_messageInfo_CardExpire.Size(m)
+func (m *DisputeEvidence) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidence.Size(m)
 }
-func (m *CardExpire) XXX_DiscardUnknown() {
-	xxx_messageInfo_CardExpire.DiscardUnknown(m)
+func (m *DisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CardExpire proto.InternalMessageInfo
+var xxx_messageInfo_DisputeEvidence proto.InternalMessageInfo
 
-func (m *CardExpire) GetMonth() string {
+func (m *DisputeEvidence) GetText() string {
 	if m != nil {
-		return m.Month
+		return m.Text
 	}
 	return ""
 }
 
-func (m *CardExpire) GetYear() string {
+func (m *DisputeEvidence) GetFiles() []*DisputeEvidenceFile {
 	if m != nil {
-		return m.Year
+		return m.Files
 	}
-	return ""
+	return nil
 }
 
-type SavedCard struct {
-	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pan                  string      `protobuf:"bytes,2,opt,name=pan,proto3" json:"pan,omitempty"`
-	Expire               *CardExpire `protobuf:"bytes,3,opt,name=expire,proto3" json:"expire,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte      `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32       `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *DisputeEvidence) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (m *SavedCard) Reset()         { *m = SavedCard{} }
-func (m *SavedCard) String() string { return proto.CompactTextString(m) }
-func (*SavedCard) ProtoMessage()    {}
-func (*SavedCard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{36}
+type DisputeEvidenceFile struct {
+	// @inject_tag: validate:"required"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,url"
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty" validate:"required,url"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *SavedCard) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SavedCard.Unmarshal(m, b)
+func (m *DisputeEvidenceFile) Reset()         { *m = DisputeEvidenceFile{} }
+func (m *DisputeEvidenceFile) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidenceFile) ProtoMessage()    {}
+func (*DisputeEvidenceFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{58}
 }
-func (m *SavedCard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SavedCard.Marshal(b, m, deterministic)
+
+func (m *DisputeEvidenceFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidenceFile.Unmarshal(m, b)
 }
-func (m *SavedCard) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SavedCard.Merge(m, src)
+func (m *DisputeEvidenceFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidenceFile.Marshal(b, m, deterministic)
 }
-func (m *SavedCard) XXX_Size() int {
-	return xxx_messageInfo_SavedCard.Size(m)
+func (m *DisputeEvidenceFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidenceFile.Merge(m, src)
 }
-func (m *SavedCard) XXX_DiscardUnknown() {
-	xxx_messageInfo_SavedCard.DiscardUnknown(m)
+func (m *DisputeEvidenceFile) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidenceFile.Size(m)
+}
+func (m *DisputeEvidenceFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidenceFile.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SavedCard proto.InternalMessageInfo
+var xxx_messageInfo_DisputeEvidenceFile proto.InternalMessageInfo
 
-func (m *SavedCard) GetId() string {
+func (m *DisputeEvidenceFile) GetName() string {
 	if m != nil {
-		return m.Id
+		return m.Name
 	}
 	return ""
 }
 
-func (m *SavedCard) GetPan() string {
+func (m *DisputeEvidenceFile) GetUrl() string {
 	if m != nil {
-		return m.Pan
+		return m.Url
 	}
 	return ""
 }
 
-func (m *SavedCard) GetExpire() *CardExpire {
-	if m != nil {
-		return m.Expire
-	}
-	return nil
-}
-
-type PaymentFormPaymentMethod struct {
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Icon string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	// @inject_tag: json:"group_alias"
-	Group         string `protobuf:"bytes,5,opt,name=group,proto3" json:"group_alias"`
-	AccountRegexp string `protobuf:"bytes,6,opt,name=account_regexp,json=accountRegexp,proto3" json:"account_regexp,omitempty"`
-	// @inject_tag: json:"has_saved_cards"
-	HasSavedCards bool `protobuf:"varint,12,opt,name=has_saved_cards,json=hasSavedCards,proto3" json:"has_saved_cards"`
-	// @inject_tag: json:"saved_cards,omitempty"
-	SavedCards           []*SavedCard `protobuf:"bytes,13,rep,name=saved_cards,json=savedCards,proto3" json:"saved_cards,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32        `json:"-" bson:"-" structure:"-" validate:"-"`
+type Subscription struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId            string               `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	MerchantId           string               `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	ProductId            string               `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PlanId               string               `protobuf:"bytes,5,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	CustomerId           string               `protobuf:"bytes,6,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	StoredCardId         string               `protobuf:"bytes,7,opt,name=stored_card_id,json=storedCardId,proto3" json:"stored_card_id,omitempty"`
+	PaymentMethodId      string               `protobuf:"bytes,8,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"`
+	Email                string               `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
+	Amount               float64              `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string               `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	Interval             string               `protobuf:"bytes,12,opt,name=interval,proto3" json:"interval,omitempty"`
+	IntervalCount        int32                `protobuf:"varint,13,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count,omitempty"`
+	Status               int32                `protobuf:"varint,14,opt,name=status,proto3" json:"status,omitempty"`
+	TrialEndAt           *timestamp.Timestamp `protobuf:"bytes,15,opt,name=trial_end_at,json=trialEndAt,proto3" json:"trial_end_at,omitempty"`
+	CurrentPeriodStart   *timestamp.Timestamp `protobuf:"bytes,16,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
+	CurrentPeriodEnd     *timestamp.Timestamp `protobuf:"bytes,17,opt,name=current_period_end,json=currentPeriodEnd,proto3" json:"current_period_end,omitempty"`
+	NextPaymentAt        *timestamp.Timestamp `protobuf:"bytes,18,opt,name=next_payment_at,json=nextPaymentAt,proto3" json:"next_payment_at,omitempty"`
+	RetryAttempts        int32                `protobuf:"varint,19,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
+	PendingOrderId       string               `protobuf:"bytes,20,opt,name=pending_order_id,json=pendingOrderId,proto3" json:"pending_order_id,omitempty"`
+	LastOrderId          string               `protobuf:"bytes,21,opt,name=last_order_id,json=lastOrderId,proto3" json:"last_order_id,omitempty"`
+	FailureReason        string               `protobuf:"bytes,22,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CancelAtPeriodEnd    bool                 `protobuf:"varint,23,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd,proto3" json:"cancel_at_period_end,omitempty"`
+	CanceledAt           *timestamp.Timestamp `protobuf:"bytes,24,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	PausedAt             *timestamp.Timestamp `protobuf:"bytes,25,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,26,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,27,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PendingOrderAt       *timestamp.Timestamp `protobuf:"bytes,28,opt,name=pending_order_at,json=pendingOrderAt,proto3" json:"pending_order_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *PaymentFormPaymentMethod) Reset()         { *m = PaymentFormPaymentMethod{} }
-func (m *PaymentFormPaymentMethod) String() string { return proto.CompactTextString(m) }
-func (*PaymentFormPaymentMethod) ProtoMessage()    {}
-func (*PaymentFormPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{37}
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{59}
 }
 
-func (m *PaymentFormPaymentMethod) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentFormPaymentMethod.Unmarshal(m, b)
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
 }
-func (m *PaymentFormPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentFormPaymentMethod.Marshal(b, m, deterministic)
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
 }
-func (m *PaymentFormPaymentMethod) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormPaymentMethod.Merge(m, src)
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
 }
-func (m *PaymentFormPaymentMethod) XXX_Size() int {
-	return xxx_messageInfo_PaymentFormPaymentMethod.Size(m)
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
 }
-func (m *PaymentFormPaymentMethod) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentFormPaymentMethod.DiscardUnknown(m)
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PaymentFormPaymentMethod proto.InternalMessageInfo
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
 
-func (m *PaymentFormPaymentMethod) GetId() string {
+func (m *Subscription) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetName() string {
+func (m *Subscription) GetProjectId() string {
 	if m != nil {
-		return m.Name
+		return m.ProjectId
 	}
 	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetIcon() string {
+func (m *Subscription) GetMerchantId() string {
 	if m != nil {
-		return m.Icon
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetType() string {
+func (m *Subscription) GetProductId() string {
 	if m != nil {
-		return m.Type
+		return m.ProductId
 	}
 	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetGroup() string {
+func (m *Subscription) GetPlanId() string {
 	if m != nil {
-		return m.Group
+		return m.PlanId
 	}
 	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetAccountRegexp() string {
+func (m *Subscription) GetCustomerId() string {
 	if m != nil {
-		return m.AccountRegexp
+		return m.CustomerId
 	}
 	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetHasSavedCards() bool {
+func (m *Subscription) GetStoredCardId() string {
 	if m != nil {
-		return m.HasSavedCards
+		return m.StoredCardId
 	}
-	return false
+	return ""
 }
 
-func (m *PaymentFormPaymentMethod) GetSavedCards() []*SavedCard {
+func (m *Subscription) GetPaymentMethodId() string {
 	if m != nil {
-		return m.SavedCards
+		return m.PaymentMethodId
 	}
-	return nil
-}
-
-type MerchantPaymentMethodPerTransactionCommission struct {
-	// @inject_tag: validate:"omitempty,numeric,gte=0" json:"fee"
-	Fee float64 `protobuf:"fixed64,1,opt,name=fee,proto3" json:"fee" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: json:"currency"
-	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *MerchantPaymentMethodPerTransactionCommission) Reset() {
-	*m = MerchantPaymentMethodPerTransactionCommission{}
-}
-func (m *MerchantPaymentMethodPerTransactionCommission) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MerchantPaymentMethodPerTransactionCommission) ProtoMessage() {}
-func (*MerchantPaymentMethodPerTransactionCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{38}
+	return ""
 }
 
-func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Unmarshal(m, b)
-}
-func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Marshal(b, m, deterministic)
-}
-func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Merge(m, src)
-}
-func (m *MerchantPaymentMethodPerTransactionCommission) XXX_Size() int {
-	return xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.Size(m)
-}
-func (m *MerchantPaymentMethodPerTransactionCommission) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission.DiscardUnknown(m)
+func (m *Subscription) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
 }
 
-var xxx_messageInfo_MerchantPaymentMethodPerTransactionCommission proto.InternalMessageInfo
-
-func (m *MerchantPaymentMethodPerTransactionCommission) GetFee() float64 {
+func (m *Subscription) GetAmount() float64 {
 	if m != nil {
-		return m.Fee
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *MerchantPaymentMethodPerTransactionCommission) GetCurrency() string {
+func (m *Subscription) GetCurrency() string {
 	if m != nil {
 		return m.Currency
 	}
 	return ""
 }
 
-type MerchantPaymentMethodCommissions struct {
-	// @inject_tag: validate:"omitempty,numeric,gte=0,lte=100" json:"fee"
-	Fee float64 `protobuf:"fixed64,1,opt,name=fee,proto3" json:"fee" validate:"omitempty,numeric,gte=0,lte=100"`
-	// @inject_tag: validate:"required" json:"per_transaction"
-	PerTransaction       *MerchantPaymentMethodPerTransactionCommission `protobuf:"bytes,2,opt,name=per_transaction,json=perTransaction,proto3" json:"per_transaction" validate:"required"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                                         `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                          `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *MerchantPaymentMethodCommissions) Reset()         { *m = MerchantPaymentMethodCommissions{} }
-func (m *MerchantPaymentMethodCommissions) String() string { return proto.CompactTextString(m) }
-func (*MerchantPaymentMethodCommissions) ProtoMessage()    {}
-func (*MerchantPaymentMethodCommissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{39}
+func (m *Subscription) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
 }
 
-func (m *MerchantPaymentMethodCommissions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantPaymentMethodCommissions.Unmarshal(m, b)
-}
-func (m *MerchantPaymentMethodCommissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantPaymentMethodCommissions.Marshal(b, m, deterministic)
-}
-func (m *MerchantPaymentMethodCommissions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethodCommissions.Merge(m, src)
-}
-func (m *MerchantPaymentMethodCommissions) XXX_Size() int {
-	return xxx_messageInfo_MerchantPaymentMethodCommissions.Size(m)
-}
-func (m *MerchantPaymentMethodCommissions) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantPaymentMethodCommissions.DiscardUnknown(m)
+func (m *Subscription) GetIntervalCount() int32 {
+	if m != nil {
+		return m.IntervalCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_MerchantPaymentMethodCommissions proto.InternalMessageInfo
-
-func (m *MerchantPaymentMethodCommissions) GetFee() float64 {
+func (m *Subscription) GetStatus() int32 {
 	if m != nil {
-		return m.Fee
+		return m.Status
 	}
 	return 0
 }
 
-func (m *MerchantPaymentMethodCommissions) GetPerTransaction() *MerchantPaymentMethodPerTransactionCommission {
+func (m *Subscription) GetTrialEndAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PerTransaction
+		return m.TrialEndAt
 	}
 	return nil
 }
 
-type MerchantPaymentMethodIntegration struct {
-	// @inject_tag: json:"terminal_id"
-	TerminalId string `protobuf:"bytes,1,opt,name=terminal_id,json=terminalId,proto3" json:"terminal_id"`
-	// @inject_tag: json:"terminal_password"
-	TerminalPassword string `protobuf:"bytes,2,opt,name=terminal_password,json=terminalPassword,proto3" json:"terminal_password"`
-	// @inject_tag: json:"terminal_callback_password"
-	TerminalCallbackPassword string `protobuf:"bytes,3,opt,name=terminal_callback_password,json=terminalCallbackPassword,proto3" json:"terminal_callback_password"`
-	// @inject_tag: json:"integrated"
-	Integrated           bool     `protobuf:"varint,4,opt,name=integrated,proto3" json:"integrated"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Subscription) GetCurrentPeriodStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.CurrentPeriodStart
+	}
+	return nil
 }
 
-func (m *MerchantPaymentMethodIntegration) Reset()         { *m = MerchantPaymentMethodIntegration{} }
-func (m *MerchantPaymentMethodIntegration) String() string { return proto.CompactTextString(m) }
-func (*MerchantPaymentMethodIntegration) ProtoMessage()    {}
-func (*MerchantPaymentMethodIntegration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{40}
+func (m *Subscription) GetCurrentPeriodEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.CurrentPeriodEnd
+	}
+	return nil
 }
 
-func (m *MerchantPaymentMethodIntegration) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantPaymentMethodIntegration.Unmarshal(m, b)
+func (m *Subscription) GetNextPaymentAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextPaymentAt
+	}
+	return nil
 }
-func (m *MerchantPaymentMethodIntegration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantPaymentMethodIntegration.Marshal(b, m, deterministic)
+
+func (m *Subscription) GetRetryAttempts() int32 {
+	if m != nil {
+		return m.RetryAttempts
+	}
+	return 0
 }
-func (m *MerchantPaymentMethodIntegration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethodIntegration.Merge(m, src)
+
+func (m *Subscription) GetPendingOrderId() string {
+	if m != nil {
+		return m.PendingOrderId
+	}
+	return ""
 }
-func (m *MerchantPaymentMethodIntegration) XXX_Size() int {
-	return xxx_messageInfo_MerchantPaymentMethodIntegration.Size(m)
+
+func (m *Subscription) GetLastOrderId() string {
+	if m != nil {
+		return m.LastOrderId
+	}
+	return ""
 }
-func (m *MerchantPaymentMethodIntegration) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantPaymentMethodIntegration.DiscardUnknown(m)
+
+func (m *Subscription) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
 }
 
-var xxx_messageInfo_MerchantPaymentMethodIntegration proto.InternalMessageInfo
+func (m *Subscription) GetCancelAtPeriodEnd() bool {
+	if m != nil {
+		return m.CancelAtPeriodEnd
+	}
+	return false
+}
 
-func (m *MerchantPaymentMethodIntegration) GetTerminalId() string {
+func (m *Subscription) GetCanceledAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.TerminalId
+		return m.CanceledAt
 	}
-	return ""
+	return nil
 }
 
-func (m *MerchantPaymentMethodIntegration) GetTerminalPassword() string {
+func (m *Subscription) GetPausedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.TerminalPassword
+		return m.PausedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *MerchantPaymentMethodIntegration) GetTerminalCallbackPassword() string {
+func (m *Subscription) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.TerminalCallbackPassword
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (m *MerchantPaymentMethodIntegration) GetIntegrated() bool {
+func (m *Subscription) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Integrated
+		return m.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-type MerchantPaymentMethodIdentification struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"required" json:"name"
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name" validate:"required"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *Subscription) GetPendingOrderAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.PendingOrderAt
+	}
+	return nil
 }
 
-func (m *MerchantPaymentMethodIdentification) Reset()         { *m = MerchantPaymentMethodIdentification{} }
-func (m *MerchantPaymentMethodIdentification) String() string { return proto.CompactTextString(m) }
-func (*MerchantPaymentMethodIdentification) ProtoMessage()    {}
-func (*MerchantPaymentMethodIdentification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{41}
+type OutboxMessage struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic                string               `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Order                *Order               `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	Status               int32                `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts             int32                `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string               `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt        *timestamp.Timestamp `protobuf:"bytes,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeliveredAt          *timestamp.Timestamp `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	OrderVersion         int64                `protobuf:"varint,11,opt,name=order_version,json=orderVersion,proto3" json:"order_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *MerchantPaymentMethodIdentification) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantPaymentMethodIdentification.Unmarshal(m, b)
+func (m *OutboxMessage) Reset()         { *m = OutboxMessage{} }
+func (m *OutboxMessage) String() string { return proto.CompactTextString(m) }
+func (*OutboxMessage) ProtoMessage()    {}
+func (*OutboxMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{60}
 }
-func (m *MerchantPaymentMethodIdentification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantPaymentMethodIdentification.Marshal(b, m, deterministic)
+
+func (m *OutboxMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutboxMessage.Unmarshal(m, b)
 }
-func (m *MerchantPaymentMethodIdentification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethodIdentification.Merge(m, src)
+func (m *OutboxMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutboxMessage.Marshal(b, m, deterministic)
 }
-func (m *MerchantPaymentMethodIdentification) XXX_Size() int {
-	return xxx_messageInfo_MerchantPaymentMethodIdentification.Size(m)
+func (m *OutboxMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboxMessage.Merge(m, src)
 }
-func (m *MerchantPaymentMethodIdentification) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantPaymentMethodIdentification.DiscardUnknown(m)
+func (m *OutboxMessage) XXX_Size() int {
+	return xxx_messageInfo_OutboxMessage.Size(m)
+}
+func (m *OutboxMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboxMessage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MerchantPaymentMethodIdentification proto.InternalMessageInfo
+var xxx_messageInfo_OutboxMessage proto.InternalMessageInfo
 
-func (m *MerchantPaymentMethodIdentification) GetId() string {
+func (m *OutboxMessage) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *MerchantPaymentMethodIdentification) GetName() string {
+func (m *OutboxMessage) GetTopic() string {
 	if m != nil {
-		return m.Name
+		return m.Topic
 	}
 	return ""
 }
 
-type MerchantPaymentMethod struct {
-	PaymentMethod        *MerchantPaymentMethodIdentification `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	Commission           *MerchantPaymentMethodCommissions    `protobuf:"bytes,4,opt,name=commission,proto3" json:"commission,omitempty"`
-	Integration          *MerchantPaymentMethodIntegration    `protobuf:"bytes,5,opt,name=integration,proto3" json:"integration,omitempty"`
-	IsActive             bool                                 `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte                               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                                `json:"-" bson:"-" structure:"-" validate:"-"`
+func (m *OutboxMessage) GetOrder() *Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
 }
 
-func (m *MerchantPaymentMethod) Reset()         { *m = MerchantPaymentMethod{} }
-func (m *MerchantPaymentMethod) String() string { return proto.CompactTextString(m) }
-func (*MerchantPaymentMethod) ProtoMessage()    {}
-func (*MerchantPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{42}
+func (m *OutboxMessage) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
 }
 
-func (m *MerchantPaymentMethod) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MerchantPaymentMethod.Unmarshal(m, b)
-}
-func (m *MerchantPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MerchantPaymentMethod.Marshal(b, m, deterministic)
-}
-func (m *MerchantPaymentMethod) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethod.Merge(m, src)
-}
-func (m *MerchantPaymentMethod) XXX_Size() int {
-	return xxx_messageInfo_MerchantPaymentMethod.Size(m)
+func (m *OutboxMessage) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
 }
-func (m *MerchantPaymentMethod) XXX_DiscardUnknown() {
-	xxx_messageInfo_MerchantPaymentMethod.DiscardUnknown(m)
+
+func (m *OutboxMessage) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
 
-var xxx_messageInfo_MerchantPaymentMethod proto.InternalMessageInfo
+func (m *OutboxMessage) GetNextAttemptAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return nil
+}
 
-func (m *MerchantPaymentMethod) GetPaymentMethod() *MerchantPaymentMethodIdentification {
+func (m *OutboxMessage) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PaymentMethod
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *MerchantPaymentMethod) GetCommission() *MerchantPaymentMethodCommissions {
+func (m *OutboxMessage) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Commission
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-func (m *MerchantPaymentMethod) GetIntegration() *MerchantPaymentMethodIntegration {
+func (m *OutboxMessage) GetDeliveredAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Integration
+		return m.DeliveredAt
 	}
 	return nil
 }
 
-func (m *MerchantPaymentMethod) GetIsActive() bool {
+func (m *OutboxMessage) GetOrderVersion() int64 {
 	if m != nil {
-		return m.IsActive
+		return m.OrderVersion
 	}
-	return false
+	return 0
 }
 
-type RefundPayerData struct {
-	Country              string   `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
-	Zip                  string   `protobuf:"bytes,2,opt,name=zip,proto3" json:"zip,omitempty"`
-	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+type WebhookDeliveryAttempt struct {
+	ResponseCode         int32                `protobuf:"varint,1,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`
+	ResponseBody         string               `protobuf:"bytes,2,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	Error                string               `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Duration             int64                `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *RefundPayerData) Reset()         { *m = RefundPayerData{} }
-func (m *RefundPayerData) String() string { return proto.CompactTextString(m) }
-func (*RefundPayerData) ProtoMessage()    {}
-func (*RefundPayerData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{43}
+func (m *WebhookDeliveryAttempt) Reset()         { *m = WebhookDeliveryAttempt{} }
+func (m *WebhookDeliveryAttempt) String() string { return proto.CompactTextString(m) }
+func (*WebhookDeliveryAttempt) ProtoMessage()    {}
+func (*WebhookDeliveryAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{61}
 }
 
-func (m *RefundPayerData) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RefundPayerData.Unmarshal(m, b)
+func (m *WebhookDeliveryAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDeliveryAttempt.Unmarshal(m, b)
 }
-func (m *RefundPayerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RefundPayerData.Marshal(b, m, deterministic)
+func (m *WebhookDeliveryAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDeliveryAttempt.Marshal(b, m, deterministic)
 }
-func (m *RefundPayerData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefundPayerData.Merge(m, src)
+func (m *WebhookDeliveryAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeliveryAttempt.Merge(m, src)
 }
-func (m *RefundPayerData) XXX_Size() int {
-	return xxx_messageInfo_RefundPayerData.Size(m)
+func (m *WebhookDeliveryAttempt) XXX_Size() int {
+	return xxx_messageInfo_WebhookDeliveryAttempt.Size(m)
 }
-func (m *RefundPayerData) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefundPayerData.DiscardUnknown(m)
+func (m *WebhookDeliveryAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeliveryAttempt.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RefundPayerData proto.InternalMessageInfo
+var xxx_messageInfo_WebhookDeliveryAttempt proto.InternalMessageInfo
 
-func (m *RefundPayerData) GetCountry() string {
+func (m *WebhookDeliveryAttempt) GetResponseCode() int32 {
 	if m != nil {
-		return m.Country
+		return m.ResponseCode
 	}
-	return ""
+	return 0
 }
 
-func (m *RefundPayerData) GetZip() string {
+func (m *WebhookDeliveryAttempt) GetResponseBody() string {
 	if m != nil {
-		return m.Zip
+		return m.ResponseBody
 	}
 	return ""
 }
 
-func (m *RefundPayerData) GetState() string {
+func (m *WebhookDeliveryAttempt) GetError() string {
 	if m != nil {
-		return m.State
+		return m.Error
 	}
 	return ""
 }
 
-type RefundOrder struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
-
-func (m *RefundOrder) Reset()         { *m = RefundOrder{} }
-func (m *RefundOrder) String() string { return proto.CompactTextString(m) }
-func (*RefundOrder) ProtoMessage()    {}
-func (*RefundOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{44}
-}
-
-func (m *RefundOrder) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RefundOrder.Unmarshal(m, b)
-}
-func (m *RefundOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RefundOrder.Marshal(b, m, deterministic)
-}
-func (m *RefundOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RefundOrder.Merge(m, src)
-}
-func (m *RefundOrder) XXX_Size() int {
-	return xxx_messageInfo_RefundOrder.Size(m)
-}
-func (m *RefundOrder) XXX_DiscardUnknown() {
-	xxx_messageInfo_RefundOrder.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RefundOrder proto.InternalMessageInfo
-
-func (m *RefundOrder) GetId() string {
+func (m *WebhookDeliveryAttempt) GetDuration() int64 {
 	if m != nil {
-		return m.Id
+		return m.Duration
 	}
-	return ""
+	return 0
 }
 
-func (m *RefundOrder) GetUuid() string {
+func (m *WebhookDeliveryAttempt) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.Uuid
+		return m.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-type Refund struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Order                *RefundOrder         `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
-	ExternalId           string               `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
-	Amount               float64              `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreatorId            string               `protobuf:"bytes,5,opt,name=creatorId,proto3" json:"creatorId,omitempty"`
-	Reason               string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
-	Currency             *Currency            `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
-	Status               int32                `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	PayerData            *RefundPayerData     `protobuf:"bytes,11,opt,name=payer_data,json=payerData,proto3" json:"payer_data,omitempty"`
-	SalesTax             float32              `protobuf:"fixed32,12,opt,name=sales_tax,json=salesTax,proto3" json:"sales_tax,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+type WebhookDelivery struct {
+	Id                   string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId            string                    `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	OrderId              string                    `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RefundId             string                    `protobuf:"bytes,4,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Event                string                    `protobuf:"bytes,5,opt,name=event,proto3" json:"event,omitempty"`
+	Url                  string                    `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Payload              string                    `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status               int32                     `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Attempts             int32                     `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	History              []*WebhookDeliveryAttempt `protobuf:"bytes,10,rep,name=history,proto3" json:"history,omitempty"`
+	NextAttemptAt        *timestamp.Timestamp      `protobuf:"bytes,11,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt            *timestamp.Timestamp      `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp      `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeliveredAt          *timestamp.Timestamp      `protobuf:"bytes,14,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *Refund) Reset()         { *m = Refund{} }
-func (m *Refund) String() string { return proto.CompactTextString(m) }
-func (*Refund) ProtoMessage()    {}
-func (*Refund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{45}
+func (m *WebhookDelivery) Reset()         { *m = WebhookDelivery{} }
+func (m *WebhookDelivery) String() string { return proto.CompactTextString(m) }
+func (*WebhookDelivery) ProtoMessage()    {}
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{62}
 }
 
-func (m *Refund) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Refund.Unmarshal(m, b)
+func (m *WebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDelivery.Unmarshal(m, b)
 }
-func (m *Refund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Refund.Marshal(b, m, deterministic)
+func (m *WebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDelivery.Marshal(b, m, deterministic)
 }
-func (m *Refund) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Refund.Merge(m, src)
+func (m *WebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDelivery.Merge(m, src)
 }
-func (m *Refund) XXX_Size() int {
-	return xxx_messageInfo_Refund.Size(m)
+func (m *WebhookDelivery) XXX_Size() int {
+	return xxx_messageInfo_WebhookDelivery.Size(m)
 }
-func (m *Refund) XXX_DiscardUnknown() {
-	xxx_messageInfo_Refund.DiscardUnknown(m)
+func (m *WebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDelivery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Refund proto.InternalMessageInfo
+var xxx_messageInfo_WebhookDelivery proto.InternalMessageInfo
 
-func (m *Refund) GetId() string {
+func (m *WebhookDelivery) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *Refund) GetOrder() *RefundOrder {
+func (m *WebhookDelivery) GetProjectId() string {
 	if m != nil {
-		return m.Order
+		return m.ProjectId
 	}
-	return nil
+	return ""
 }
 
-func (m *Refund) GetExternalId() string {
+func (m *WebhookDelivery) GetOrderId() string {
 	if m != nil {
-		return m.ExternalId
+		return m.OrderId
 	}
 	return ""
 }
 
-func (m *Refund) GetAmount() float64 {
+func (m *WebhookDelivery) GetRefundId() string {
 	if m != nil {
-		return m.Amount
+		return m.RefundId
 	}
-	return 0
+	return ""
 }
 
-func (m *Refund) GetCreatorId() string {
+func (m *WebhookDelivery) GetEvent() string {
 	if m != nil {
-		return m.CreatorId
+		return m.Event
 	}
 	return ""
 }
 
-func (m *Refund) GetReason() string {
+func (m *WebhookDelivery) GetUrl() string {
 	if m != nil {
-		return m.Reason
+		return m.Url
 	}
 	return ""
 }
 
-func (m *Refund) GetCurrency() *Currency {
+func (m *WebhookDelivery) GetPayload() string {
 	if m != nil {
-		return m.Currency
+		return m.Payload
 	}
-	return nil
+	return ""
 }
 
-func (m *Refund) GetStatus() int32 {
+func (m *WebhookDelivery) GetStatus() int32 {
 	if m != nil {
 		return m.Status
 	}
 	return 0
 }
 
-func (m *Refund) GetCreatedAt() *timestamp.Timestamp {
+func (m *WebhookDelivery) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDelivery) GetHistory() []*WebhookDeliveryAttempt {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) GetNextAttemptAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.CreatedAt
+		return m.NextAttemptAt
 	}
 	return nil
 }
 
-func (m *Refund) GetUpdatedAt() *timestamp.Timestamp {
+func (m *WebhookDelivery) GetCreatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.UpdatedAt
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (m *Refund) GetPayerData() *RefundPayerData {
+func (m *WebhookDelivery) GetUpdatedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.PayerData
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-func (m *Refund) GetSalesTax() float32 {
+func (m *WebhookDelivery) GetDeliveredAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.SalesTax
+		return m.DeliveredAt
 	}
-	return 0
+	return nil
 }
 
 type SystemFee struct {
@@ -4544,7 +6554,7 @@ func (m *SystemFee) Reset()         { *m = SystemFee{} }
 func (m *SystemFee) String() string { return proto.CompactTextString(m) }
 func (*SystemFee) ProtoMessage()    {}
 func (*SystemFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{46}
+	return fileDescriptor_76f8da37d8b92239, []int{63}
 }
 
 func (m *SystemFee) XXX_Unmarshal(b []byte) error {
@@ -4607,7 +6617,7 @@ func (m *MinAmount) Reset()         { *m = MinAmount{} }
 func (m *MinAmount) String() string { return proto.CompactTextString(m) }
 func (*MinAmount) ProtoMessage()    {}
 func (*MinAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{47}
+	return fileDescriptor_76f8da37d8b92239, []int{64}
 }
 
 func (m *MinAmount) XXX_Unmarshal(b []byte) error {
@@ -4658,7 +6668,7 @@ func (m *FeeSet) Reset()         { *m = FeeSet{} }
 func (m *FeeSet) String() string { return proto.CompactTextString(m) }
 func (*FeeSet) ProtoMessage()    {}
 func (*FeeSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{48}
+	return fileDescriptor_76f8da37d8b92239, []int{65}
 }
 
 func (m *FeeSet) XXX_Unmarshal(b []byte) error {
@@ -4716,17 +6726,29 @@ type SystemFees struct {
 	// @inject_tag: json:"created_at" validate:"required"
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at" validate:"required"`
 	// @inject_tag: json:"is_active" validate:"required"
-	IsActive             bool     `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active" validate:"required"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	IsActive bool `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active" validate:"required"`
+	// @inject_tag: json:"version"
+	Version int32 `protobuf:"varint,9,opt,name=version,proto3" json:"version"`
+	// @inject_tag: json:"effective_from"
+	EffectiveFrom *timestamp.Timestamp `protobuf:"bytes,10,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	// @inject_tag: json:"effective_to"
+	EffectiveTo *timestamp.Timestamp `protobuf:"bytes,11,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to"`
+	// @inject_tag: json:"reactivated_from_id"
+	ReactivatedFromId string `protobuf:"bytes,12,opt,name=reactivated_from_id,json=reactivatedFromId,proto3" json:"reactivated_from_id"`
+	// @inject_tag: json:"closed_by_user_id"
+	ClosedByUserId string `protobuf:"bytes,13,opt,name=closed_by_user_id,json=closedByUserId,proto3" json:"closed_by_user_id"`
+	// @inject_tag: json:"closed_at"
+	ClosedAt             *timestamp.Timestamp `protobuf:"bytes,14,opt,name=closed_at,json=closedAt,proto3" json:"closed_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *SystemFees) Reset()         { *m = SystemFees{} }
 func (m *SystemFees) String() string { return proto.CompactTextString(m) }
 func (*SystemFees) ProtoMessage()    {}
 func (*SystemFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{49}
+	return fileDescriptor_76f8da37d8b92239, []int{66}
 }
 
 func (m *SystemFees) XXX_Unmarshal(b []byte) error {
@@ -4803,6 +6825,48 @@ func (m *SystemFees) GetIsActive() bool {
 	return false
 }
 
+func (m *SystemFees) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SystemFees) GetEffectiveFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+func (m *SystemFees) GetEffectiveTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveTo
+	}
+	return nil
+}
+
+func (m *SystemFees) GetReactivatedFromId() string {
+	if m != nil {
+		return m.ReactivatedFromId
+	}
+	return ""
+}
+
+func (m *SystemFees) GetClosedByUserId() string {
+	if m != nil {
+		return m.ClosedByUserId
+	}
+	return ""
+}
+
+func (m *SystemFees) GetClosedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return nil
+}
+
 type SystemFeesList struct {
 	// @inject_tag: json:"system_fees"
 	SystemFees           []*SystemFees `protobuf:"bytes,1,rep,name=system_fees,json=systemFees,proto3" json:"system_fees"`
@@ -4815,7 +6879,7 @@ func (m *SystemFeesList) Reset()         { *m = SystemFeesList{} }
 func (m *SystemFeesList) String() string { return proto.CompactTextString(m) }
 func (*SystemFeesList) ProtoMessage()    {}
 func (*SystemFeesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{50}
+	return fileDescriptor_76f8da37d8b92239, []int{67}
 }
 
 func (m *SystemFeesList) XXX_Unmarshal(b []byte) error {
@@ -4853,17 +6917,21 @@ type AddSystemFeesRequest struct {
 	// @inject_tag: json:"fees" validate:"required,gte=1,dive"
 	Fees []*FeeSet `protobuf:"bytes,5,rep,name=fees,proto3" json:"fees" validate:"required,gte=1,dive"`
 	// @inject_tag: json:"user_id" validate:"required,hexadecimal,len=24"
-	UserId               string   `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required,hexadecimal,len=24"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	UserId string `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"effective_from"
+	EffectiveFrom *timestamp.Timestamp `protobuf:"bytes,7,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	// @inject_tag: json:"effective_to"
+	EffectiveTo          *timestamp.Timestamp `protobuf:"bytes,8,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *AddSystemFeesRequest) Reset()         { *m = AddSystemFeesRequest{} }
 func (m *AddSystemFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*AddSystemFeesRequest) ProtoMessage()    {}
 func (*AddSystemFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{51}
+	return fileDescriptor_76f8da37d8b92239, []int{68}
 }
 
 func (m *AddSystemFeesRequest) XXX_Unmarshal(b []byte) error {
@@ -4919,6 +6987,20 @@ func (m *AddSystemFeesRequest) GetUserId() string {
 	return ""
 }
 
+func (m *AddSystemFeesRequest) GetEffectiveFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+func (m *AddSystemFeesRequest) GetEffectiveTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveTo
+	}
+	return nil
+}
+
 type GetSystemFeesRequest struct {
 	// @inject_tag: json:"method_id" validate:"required,hexadecimal,len=24"
 	MethodId string `protobuf:"bytes,1,opt,name=method_id,json=methodId,proto3" json:"method_id" validate:"required,hexadecimal,len=24"`
@@ -4929,17 +7011,19 @@ type GetSystemFeesRequest struct {
 	// @inject_tag: json:"amount" validate:"required,numeric,gte=0"
 	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount" validate:"required,numeric,gte=0"`
 	// @inject_tag: json:"currency" validate:"required,alpha,len=3"
-	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"date"
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *GetSystemFeesRequest) Reset()         { *m = GetSystemFeesRequest{} }
 func (m *GetSystemFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSystemFeesRequest) ProtoMessage()    {}
 func (*GetSystemFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{52}
+	return fileDescriptor_76f8da37d8b92239, []int{69}
 }
 
 func (m *GetSystemFeesRequest) XXX_Unmarshal(b []byte) error {
@@ -4995,6 +7079,13 @@ func (m *GetSystemFeesRequest) GetCurrency() string {
 	return ""
 }
 
+func (m *GetSystemFeesRequest) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
 type CalculatedFeeItem struct {
 	// @inject_tag: json:"fee_amount" validate:"required,numeric,gte=0"
 	FeeAmount float64 `protobuf:"fixed64,1,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount" validate:"required,numeric,gte=0"`
@@ -5011,7 +7102,7 @@ func (m *CalculatedFeeItem) Reset()         { *m = CalculatedFeeItem{} }
 func (m *CalculatedFeeItem) String() string { return proto.CompactTextString(m) }
 func (*CalculatedFeeItem) ProtoMessage()    {}
 func (*CalculatedFeeItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{53}
+	return fileDescriptor_76f8da37d8b92239, []int{70}
 }
 
 func (m *CalculatedFeeItem) XXX_Unmarshal(b []byte) error {
@@ -5053,6 +7144,270 @@ func (m *CalculatedFeeItem) GetFeeName() string {
 	return ""
 }
 
+type CommissionPlanTier struct {
+	// @inject_tag: json:"volume_from" bson:"volume_from" validate:"omitempty,numeric,gte=0"
+	VolumeFrom float64 `protobuf:"fixed64,1,opt,name=volume_from,json=volumeFrom,proto3" json:"volume_from" bson:"volume_from" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"percent_fee" bson:"percent_fee" validate:"omitempty,numeric,gte=0,lte=100"
+	PercentFee float64 `protobuf:"fixed64,2,opt,name=percent_fee,json=percentFee,proto3" json:"percent_fee" bson:"percent_fee" validate:"omitempty,numeric,gte=0,lte=100"`
+	// @inject_tag: json:"fixed_fee" bson:"fixed_fee" validate:"omitempty,numeric,gte=0"
+	FixedFee             float64  `protobuf:"fixed64,3,opt,name=fixed_fee,json=fixedFee,proto3" json:"fixed_fee" bson:"fixed_fee" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CommissionPlanTier) Reset()         { *m = CommissionPlanTier{} }
+func (m *CommissionPlanTier) String() string { return proto.CompactTextString(m) }
+func (*CommissionPlanTier) ProtoMessage()    {}
+func (*CommissionPlanTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{71}
+}
+
+func (m *CommissionPlanTier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommissionPlanTier.Unmarshal(m, b)
+}
+func (m *CommissionPlanTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommissionPlanTier.Marshal(b, m, deterministic)
+}
+func (m *CommissionPlanTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionPlanTier.Merge(m, src)
+}
+func (m *CommissionPlanTier) XXX_Size() int {
+	return xxx_messageInfo_CommissionPlanTier.Size(m)
+}
+func (m *CommissionPlanTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionPlanTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionPlanTier proto.InternalMessageInfo
+
+func (m *CommissionPlanTier) GetVolumeFrom() float64 {
+	if m != nil {
+		return m.VolumeFrom
+	}
+	return 0
+}
+
+func (m *CommissionPlanTier) GetPercentFee() float64 {
+	if m != nil {
+		return m.PercentFee
+	}
+	return 0
+}
+
+func (m *CommissionPlanTier) GetFixedFee() float64 {
+	if m != nil {
+		return m.FixedFee
+	}
+	return 0
+}
+
+type CommissionPlan struct {
+	// @inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// @inject_tag: json:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"payment_method_id" validate:"required,hexadecimal,len=24"
+	PaymentMethodId string `protobuf:"bytes,3,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: json:"version"
+	Version int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	// @inject_tag: json:"currency" validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	// @inject_tag: json:"tiers" validate:"required,min=1,dive"
+	Tiers []*CommissionPlanTier `protobuf:"bytes,6,rep,name=tiers,proto3" json:"tiers" validate:"required,min=1,dive"`
+	// @inject_tag: json:"min_fee" validate:"omitempty,numeric,gte=0"
+	MinFee float64 `protobuf:"fixed64,7,opt,name=min_fee,json=minFee,proto3" json:"min_fee" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"max_fee" validate:"omitempty,numeric,gte=0"
+	MaxFee float64 `protobuf:"fixed64,8,opt,name=max_fee,json=maxFee,proto3" json:"max_fee" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: json:"effective_from"
+	EffectiveFrom *timestamp.Timestamp `protobuf:"bytes,9,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from"`
+	// @inject_tag: json:"effective_to"
+	EffectiveTo *timestamp.Timestamp `protobuf:"bytes,10,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to"`
+	// @inject_tag: json:"user_id"
+	UserId string `protobuf:"bytes,11,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	// @inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CommissionPlan) Reset()         { *m = CommissionPlan{} }
+func (m *CommissionPlan) String() string { return proto.CompactTextString(m) }
+func (*CommissionPlan) ProtoMessage()    {}
+func (*CommissionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{72}
+}
+
+func (m *CommissionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommissionPlan.Unmarshal(m, b)
+}
+func (m *CommissionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommissionPlan.Marshal(b, m, deterministic)
+}
+func (m *CommissionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionPlan.Merge(m, src)
+}
+func (m *CommissionPlan) XXX_Size() int {
+	return xxx_messageInfo_CommissionPlan.Size(m)
+}
+func (m *CommissionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionPlan proto.InternalMessageInfo
+
+func (m *CommissionPlan) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CommissionPlan) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *CommissionPlan) GetPaymentMethodId() string {
+	if m != nil {
+		return m.PaymentMethodId
+	}
+	return ""
+}
+
+func (m *CommissionPlan) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CommissionPlan) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *CommissionPlan) GetTiers() []*CommissionPlanTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+func (m *CommissionPlan) GetMinFee() float64 {
+	if m != nil {
+		return m.MinFee
+	}
+	return 0
+}
+
+func (m *CommissionPlan) GetMaxFee() float64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+func (m *CommissionPlan) GetEffectiveFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+func (m *CommissionPlan) GetEffectiveTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveTo
+	}
+	return nil
+}
+
+func (m *CommissionPlan) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *CommissionPlan) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type OrderCommissionPlan struct {
+	// @inject_tag: bson:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" bson:"id"`
+	// @inject_tag: bson:"version"
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty" bson:"version"`
+	// @inject_tag: bson:"monthly_volume"
+	MonthlyVolume float64 `protobuf:"fixed64,3,opt,name=monthly_volume,json=monthlyVolume,proto3" json:"monthly_volume,omitempty" bson:"monthly_volume"`
+	// @inject_tag: bson:"volume_from"
+	VolumeFrom           float64  `protobuf:"fixed64,4,opt,name=volume_from,json=volumeFrom,proto3" json:"volume_from,omitempty" bson:"volume_from"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderCommissionPlan) Reset()         { *m = OrderCommissionPlan{} }
+func (m *OrderCommissionPlan) String() string { return proto.CompactTextString(m) }
+func (*OrderCommissionPlan) ProtoMessage()    {}
+func (*OrderCommissionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76f8da37d8b92239, []int{73}
+}
+
+func (m *OrderCommissionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderCommissionPlan.Unmarshal(m, b)
+}
+func (m *OrderCommissionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderCommissionPlan.Marshal(b, m, deterministic)
+}
+func (m *OrderCommissionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCommissionPlan.Merge(m, src)
+}
+func (m *OrderCommissionPlan) XXX_Size() int {
+	return xxx_messageInfo_OrderCommissionPlan.Size(m)
+}
+func (m *OrderCommissionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCommissionPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCommissionPlan proto.InternalMessageInfo
+
+func (m *OrderCommissionPlan) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *OrderCommissionPlan) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OrderCommissionPlan) GetMonthlyVolume() float64 {
+	if m != nil {
+		return m.MonthlyVolume
+	}
+	return 0
+}
+
+func (m *OrderCommissionPlan) GetVolumeFrom() float64 {
+	if m != nil {
+		return m.VolumeFrom
+	}
+	return 0
+}
+
 type MerchantPaymentMethodHistory struct {
 	// @inject_tag: validate:"required,hexadecimal,len=24"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
@@ -5073,7 +7428,7 @@ func (m *MerchantPaymentMethodHistory) Reset()         { *m = MerchantPaymentMet
 func (m *MerchantPaymentMethodHistory) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodHistory) ProtoMessage()    {}
 func (*MerchantPaymentMethodHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{54}
+	return fileDescriptor_76f8da37d8b92239, []int{74}
 }
 
 func (m *MerchantPaymentMethodHistory) XXX_Unmarshal(b []byte) error {
@@ -5145,7 +7500,7 @@ func (m *CustomerIdentity) Reset()         { *m = CustomerIdentity{} }
 func (m *CustomerIdentity) String() string { return proto.CompactTextString(m) }
 func (*CustomerIdentity) ProtoMessage()    {}
 func (*CustomerIdentity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{55}
+	return fileDescriptor_76f8da37d8b92239, []int{75}
 }
 
 func (m *CustomerIdentity) XXX_Unmarshal(b []byte) error {
@@ -5220,7 +7575,7 @@ func (m *CustomerIpHistory) Reset()         { *m = CustomerIpHistory{} }
 func (m *CustomerIpHistory) String() string { return proto.CompactTextString(m) }
 func (*CustomerIpHistory) ProtoMessage()    {}
 func (*CustomerIpHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{56}
+	return fileDescriptor_76f8da37d8b92239, []int{76}
 }
 
 func (m *CustomerIpHistory) XXX_Unmarshal(b []byte) error {
@@ -5270,7 +7625,7 @@ func (m *CustomerAddressHistory) Reset()         { *m = CustomerAddressHistory{}
 func (m *CustomerAddressHistory) String() string { return proto.CompactTextString(m) }
 func (*CustomerAddressHistory) ProtoMessage()    {}
 func (*CustomerAddressHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{57}
+	return fileDescriptor_76f8da37d8b92239, []int{77}
 }
 
 func (m *CustomerAddressHistory) XXX_Unmarshal(b []byte) error {
@@ -5338,7 +7693,7 @@ func (m *CustomerStringValueHistory) Reset()         { *m = CustomerStringValueH
 func (m *CustomerStringValueHistory) String() string { return proto.CompactTextString(m) }
 func (*CustomerStringValueHistory) ProtoMessage()    {}
 func (*CustomerStringValueHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{58}
+	return fileDescriptor_76f8da37d8b92239, []int{78}
 }
 
 func (m *CustomerStringValueHistory) XXX_Unmarshal(b []byte) error {
@@ -5412,7 +7767,7 @@ func (m *Customer) Reset()         { *m = Customer{} }
 func (m *Customer) String() string { return proto.CompactTextString(m) }
 func (*Customer) ProtoMessage()    {}
 func (*Customer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{59}
+	return fileDescriptor_76f8da37d8b92239, []int{79}
 }
 
 func (m *Customer) XXX_Unmarshal(b []byte) error {
@@ -5593,7 +7948,7 @@ func (m *TokenUserEmailValue) Reset()         { *m = TokenUserEmailValue{} }
 func (m *TokenUserEmailValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserEmailValue) ProtoMessage()    {}
 func (*TokenUserEmailValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{60}
+	return fileDescriptor_76f8da37d8b92239, []int{80}
 }
 
 func (m *TokenUserEmailValue) XXX_Unmarshal(b []byte) error {
@@ -5641,7 +7996,7 @@ func (m *TokenUserPhoneValue) Reset()         { *m = TokenUserPhoneValue{} }
 func (m *TokenUserPhoneValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserPhoneValue) ProtoMessage()    {}
 func (*TokenUserPhoneValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{61}
+	return fileDescriptor_76f8da37d8b92239, []int{81}
 }
 
 func (m *TokenUserPhoneValue) XXX_Unmarshal(b []byte) error {
@@ -5688,7 +8043,7 @@ func (m *TokenUserIpValue) Reset()         { *m = TokenUserIpValue{} }
 func (m *TokenUserIpValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserIpValue) ProtoMessage()    {}
 func (*TokenUserIpValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{62}
+	return fileDescriptor_76f8da37d8b92239, []int{82}
 }
 
 func (m *TokenUserIpValue) XXX_Unmarshal(b []byte) error {
@@ -5728,7 +8083,7 @@ func (m *TokenUserLocaleValue) Reset()         { *m = TokenUserLocaleValue{} }
 func (m *TokenUserLocaleValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserLocaleValue) ProtoMessage()    {}
 func (*TokenUserLocaleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{63}
+	return fileDescriptor_76f8da37d8b92239, []int{83}
 }
 
 func (m *TokenUserLocaleValue) XXX_Unmarshal(b []byte) error {
@@ -5767,7 +8122,7 @@ func (m *TokenUserValue) Reset()         { *m = TokenUserValue{} }
 func (m *TokenUserValue) String() string { return proto.CompactTextString(m) }
 func (*TokenUserValue) ProtoMessage()    {}
 func (*TokenUserValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{64}
+	return fileDescriptor_76f8da37d8b92239, []int{84}
 }
 
 func (m *TokenUserValue) XXX_Unmarshal(b []byte) error {
@@ -5817,7 +8172,7 @@ func (m *TokenUser) Reset()         { *m = TokenUser{} }
 func (m *TokenUser) String() string { return proto.CompactTextString(m) }
 func (*TokenUser) ProtoMessage()    {}
 func (*TokenUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{65}
+	return fileDescriptor_76f8da37d8b92239, []int{85}
 }
 
 func (m *TokenUser) XXX_Unmarshal(b []byte) error {
@@ -5922,7 +8277,7 @@ func (m *TokenSettingsReturnUrl) Reset()         { *m = TokenSettingsReturnUrl{}
 func (m *TokenSettingsReturnUrl) String() string { return proto.CompactTextString(m) }
 func (*TokenSettingsReturnUrl) ProtoMessage()    {}
 func (*TokenSettingsReturnUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{66}
+	return fileDescriptor_76f8da37d8b92239, []int{86}
 }
 
 func (m *TokenSettingsReturnUrl) XXX_Unmarshal(b []byte) error {
@@ -5973,7 +8328,7 @@ func (m *TokenSettingsItem) Reset()         { *m = TokenSettingsItem{} }
 func (m *TokenSettingsItem) String() string { return proto.CompactTextString(m) }
 func (*TokenSettingsItem) ProtoMessage()    {}
 func (*TokenSettingsItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{67}
+	return fileDescriptor_76f8da37d8b92239, []int{87}
 }
 
 func (m *TokenSettingsItem) XXX_Unmarshal(b []byte) error {
@@ -6043,7 +8398,7 @@ func (m *TokenSettings) Reset()         { *m = TokenSettings{} }
 func (m *TokenSettings) String() string { return proto.CompactTextString(m) }
 func (*TokenSettings) ProtoMessage()    {}
 func (*TokenSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76f8da37d8b92239, []int{68}
+	return fileDescriptor_76f8da37d8b92239, []int{88}
 }
 
 func (m *TokenSettings) XXX_Unmarshal(b []byte) error {
@@ -6162,6 +8517,7 @@ func init() {
 	proto.RegisterType((*OrderItem)(nil), "billing.OrderItem")
 	proto.RegisterMapType((map[string]string)(nil), "billing.OrderItem.MetadataEntry")
 	proto.RegisterType((*Currency)(nil), "billing.Currency")
+	proto.RegisterType((*CurrencyMinorUnit)(nil), "billing.CurrencyMinorUnit")
 	proto.RegisterType((*PayerData)(nil), "billing.PayerData")
 	proto.RegisterType((*PaymentMethodOrder)(nil), "billing.PaymentMethodOrder")
 	proto.RegisterType((*PaymentMethodParams)(nil), "billing.PaymentMethodParams")
@@ -6171,9 +8527,14 @@ func init() {
 	proto.RegisterType((*FixedPackages)(nil), "billing.FixedPackages")
 	proto.RegisterType((*OrderFee)(nil), "billing.OrderFee")
 	proto.RegisterType((*OrderFeePsp)(nil), "billing.OrderFeePsp")
+	proto.RegisterType((*OrderSystemFee)(nil), "billing.OrderSystemFee")
+	proto.RegisterType((*OrderSystemFees)(nil), "billing.OrderSystemFees")
+	proto.RegisterType((*OrderStatusChange)(nil), "billing.OrderStatusChange")
+	proto.RegisterType((*OrderAccountCheck)(nil), "billing.OrderAccountCheck")
 	proto.RegisterType((*OrderFeePaymentSystem)(nil), "billing.OrderFeePaymentSystem")
 	proto.RegisterType((*ProjectPaymentMethod)(nil), "billing.ProjectPaymentMethod")
 	proto.RegisterType((*CurrencyRate)(nil), "billing.CurrencyRate")
+	proto.RegisterType((*AppliedCurrencyRate)(nil), "billing.AppliedCurrencyRate")
 	proto.RegisterType((*PaymentMethod)(nil), "billing.PaymentMethod")
 	proto.RegisterType((*Country)(nil), "billing.Country")
 	proto.RegisterType((*Vat)(nil), "billing.Vat")
@@ -6189,6 +8550,17 @@ func init() {
 	proto.RegisterType((*RefundPayerData)(nil), "billing.RefundPayerData")
 	proto.RegisterType((*RefundOrder)(nil), "billing.RefundOrder")
 	proto.RegisterType((*Refund)(nil), "billing.Refund")
+	proto.RegisterType((*RefundItem)(nil), "billing.RefundItem")
+	proto.RegisterType((*LedgerEntry)(nil), "billing.LedgerEntry")
+	proto.RegisterType((*MerchantBalance)(nil), "billing.MerchantBalance")
+	proto.RegisterType((*Payout)(nil), "billing.Payout")
+	proto.RegisterType((*Dispute)(nil), "billing.Dispute")
+	proto.RegisterType((*DisputeEvidence)(nil), "billing.DisputeEvidence")
+	proto.RegisterType((*DisputeEvidenceFile)(nil), "billing.DisputeEvidenceFile")
+	proto.RegisterType((*Subscription)(nil), "billing.Subscription")
+	proto.RegisterType((*OutboxMessage)(nil), "billing.OutboxMessage")
+	proto.RegisterType((*WebhookDeliveryAttempt)(nil), "billing.WebhookDeliveryAttempt")
+	proto.RegisterType((*WebhookDelivery)(nil), "billing.WebhookDelivery")
 	proto.RegisterType((*SystemFee)(nil), "billing.SystemFee")
 	proto.RegisterType((*MinAmount)(nil), "billing.MinAmount")
 	proto.RegisterType((*FeeSet)(nil), "billing.FeeSet")
@@ -6198,6 +8570,9 @@ func init() {
 	proto.RegisterType((*AddSystemFeesRequest)(nil), "billing.AddSystemFeesRequest")
 	proto.RegisterType((*GetSystemFeesRequest)(nil), "billing.GetSystemFeesRequest")
 	proto.RegisterType((*CalculatedFeeItem)(nil), "billing.CalculatedFeeItem")
+	proto.RegisterType((*CommissionPlanTier)(nil), "billing.CommissionPlanTier")
+	proto.RegisterType((*CommissionPlan)(nil), "billing.CommissionPlan")
+	proto.RegisterType((*OrderCommissionPlan)(nil), "billing.OrderCommissionPlan")
 	proto.RegisterType((*MerchantPaymentMethodHistory)(nil), "billing.MerchantPaymentMethodHistory")
 	proto.RegisterType((*CustomerIdentity)(nil), "billing.CustomerIdentity")
 	proto.RegisterType((*CustomerIpHistory)(nil), "billing.CustomerIpHistory")
@@ -6221,353 +8596,488 @@ func init() {
 func init() { proto.RegisterFile("billing/billing.proto", fileDescriptor_76f8da37d8b92239) }
 
 var fileDescriptor_76f8da37d8b92239 = []byte{
-	// 5558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
-	0x75, 0x98, 0x0f, 0xe7, 0xf3, 0x86, 0xc3, 0x21, 0x9b, 0xbf, 0x26, 0x25, 0xad, 0xa8, 0x91, 0x57,
-	0xd2, 0x7e, 0x44, 0xc9, 0x94, 0x76, 0xfd, 0xdb, 0x8d, 0x44, 0x51, 0xa2, 0x77, 0xbc, 0xbb, 0x32,
-	0xd1, 0xe4, 0x0a, 0xb1, 0x1d, 0xbb, 0x51, 0x9c, 0x2e, 0x92, 0x6d, 0xcd, 0x74, 0xb7, 0xbb, 0x6a,
-	0x24, 0x72, 0x4f, 0x39, 0x04, 0x41, 0x02, 0xc4, 0x97, 0x20, 0xf1, 0x31, 0x40, 0x6e, 0x39, 0xe5,
-	0x94, 0x00, 0xb9, 0x05, 0x48, 0x10, 0x1f, 0x12, 0x20, 0x17, 0x1f, 0x72, 0xc9, 0x29, 0x81, 0x0f,
-	0xb9, 0x27, 0xf7, 0xa0, 0xbe, 0x5d, 0xfd, 0x99, 0x21, 0x87, 0x32, 0xd6, 0x48, 0x2e, 0x64, 0x57,
-	0xd5, 0xab, 0xd7, 0x55, 0xaf, 0x5e, 0xbd, 0x7f, 0x0f, 0x2c, 0x1f, 0xfa, 0x83, 0x81, 0x1f, 0x1c,
-	0xdf, 0x93, 0xff, 0x37, 0xa3, 0x38, 0xa4, 0xa1, 0x55, 0x97, 0xcd, 0xf5, 0xeb, 0xc7, 0x61, 0x78,
-	0x3c, 0xc0, 0xf7, 0x78, 0xf7, 0xe1, 0xe8, 0xe8, 0x1e, 0xf5, 0x87, 0x98, 0x50, 0x34, 0x8c, 0x04,
-	0x64, 0xf7, 0x16, 0x54, 0x9f, 0xa3, 0x21, 0xb6, 0xe6, 0xa0, 0x8c, 0x03, 0xbb, 0xb4, 0x51, 0xba,
-	0xd3, 0x74, 0xca, 0x38, 0x60, 0xed, 0x78, 0x64, 0x97, 0x45, 0x3b, 0x1e, 0x75, 0xff, 0xa1, 0x09,
-	0xd6, 0xf7, 0x63, 0x0f, 0xc7, 0x3b, 0x31, 0x46, 0x14, 0x3b, 0xf8, 0x67, 0x23, 0x4c, 0xa8, 0x75,
-	0x0d, 0x20, 0x8a, 0xc3, 0x9f, 0xe2, 0x3e, 0x75, 0x7d, 0x4f, 0x4e, 0x6f, 0xca, 0x9e, 0x9e, 0x67,
-	0x5d, 0x85, 0x26, 0xf1, 0x8f, 0x03, 0x44, 0x47, 0x31, 0x96, 0xc8, 0x92, 0x0e, 0x6b, 0x05, 0x6a,
-	0x68, 0x18, 0x8e, 0x02, 0x6a, 0x57, 0x36, 0x4a, 0x77, 0x4a, 0x8e, 0x6c, 0x59, 0xeb, 0xd0, 0xe8,
-	0x8f, 0xe2, 0x18, 0x07, 0xfd, 0x33, 0xbb, 0xca, 0x27, 0xe9, 0xb6, 0x65, 0x43, 0x1d, 0xf5, 0xfb,
-	0x7c, 0xd2, 0x0c, 0x1f, 0x52, 0x4d, 0x6b, 0x0d, 0x1a, 0x21, 0x5b, 0x20, 0x5b, 0x48, 0x4d, 0x0c,
-	0xf1, 0x76, 0xcf, 0xb3, 0x36, 0xa0, 0xe5, 0x61, 0xd2, 0x8f, 0xfd, 0x88, 0xfa, 0x61, 0x60, 0xd7,
-	0xf9, 0xa8, 0xd9, 0x65, 0xbd, 0x0d, 0x73, 0x11, 0x3a, 0x1b, 0xe2, 0x80, 0xba, 0x43, 0x4c, 0x4f,
-	0x42, 0xcf, 0x6e, 0x70, 0xa0, 0xb6, 0xec, 0xfd, 0x9c, 0x77, 0xb2, 0xed, 0x8e, 0xe2, 0x81, 0xfb,
-	0x0a, 0xc7, 0xfe, 0xd1, 0x99, 0xdd, 0x14, 0x1b, 0x1a, 0xc5, 0x83, 0x17, 0xbc, 0x43, 0x0d, 0x07,
-	0x21, 0x65, 0xc3, 0xa0, 0x87, 0x9f, 0xf3, 0x0e, 0xeb, 0x3a, 0xb4, 0xd8, 0x30, 0x19, 0xf5, 0xfb,
-	0x98, 0x10, 0xbb, 0xc5, 0xc7, 0xd9, 0x8c, 0x7d, 0xd1, 0xc3, 0xb6, 0xc0, 0x00, 0x8e, 0x90, 0x3f,
-	0xb0, 0x67, 0xc5, 0x16, 0x46, 0xf1, 0x60, 0x17, 0xf9, 0x03, 0x36, 0x37, 0x42, 0x67, 0x38, 0x76,
-	0xf1, 0x90, 0x8d, 0xb6, 0xc5, 0x5c, 0xde, 0xf5, 0x6c, 0x98, 0x02, 0x88, 0x4e, 0xc2, 0x00, 0xdb,
-	0x73, 0x06, 0xc0, 0x1e, 0xeb, 0x61, 0xd4, 0x8e, 0xf1, 0x31, 0xdb, 0x7f, 0x87, 0x8f, 0xc9, 0x16,
-	0x7b, 0xa9, 0x98, 0xe8, 0x47, 0xf6, 0xbc, 0x78, 0x29, 0x6f, 0xf7, 0x22, 0xeb, 0x23, 0x98, 0x09,
-	0xe9, 0x09, 0x8e, 0xed, 0x85, 0x8d, 0xca, 0x9d, 0xd6, 0xd6, 0xad, 0x4d, 0xc5, 0x65, 0x79, 0x4e,
-	0xd8, 0xfc, 0x3e, 0x03, 0x7c, 0x16, 0xd0, 0xf8, 0xcc, 0x11, 0x93, 0xac, 0x1e, 0x40, 0x8c, 0x5e,
-	0xbb, 0x11, 0x8a, 0xd1, 0x90, 0xd8, 0x16, 0x47, 0xf1, 0xee, 0x24, 0x14, 0x0e, 0x7a, 0xbd, 0xc7,
-	0x81, 0x05, 0x9a, 0x66, 0xac, 0xda, 0x6c, 0x8d, 0x0c, 0xd5, 0x61, 0xe8, 0x9d, 0xd9, 0x8b, 0x62,
-	0x8d, 0x31, 0x7a, 0xfd, 0x24, 0xf4, 0xce, 0xac, 0x55, 0xa8, 0xfb, 0xc4, 0xfd, 0x29, 0x09, 0x03,
-	0x7b, 0x69, 0xa3, 0x74, 0xa7, 0xe1, 0xd4, 0x7c, 0xf2, 0x3d, 0x12, 0x06, 0x8c, 0x8b, 0x06, 0x28,
-	0x38, 0x1e, 0xa1, 0x63, 0x6c, 0x2f, 0x0b, 0x2e, 0x52, 0x6d, 0x36, 0x16, 0xc5, 0xa1, 0x37, 0xea,
-	0x53, 0x62, 0xaf, 0x6c, 0x54, 0xd8, 0x98, 0x6a, 0x5b, 0xcf, 0xa0, 0x31, 0xc4, 0x14, 0x79, 0x88,
-	0x22, 0x7b, 0x95, 0x2f, 0xfa, 0x9d, 0x49, 0x8b, 0xfe, 0x5c, 0xc2, 0x8a, 0x35, 0xeb, 0xa9, 0xd6,
-	0x8f, 0x60, 0x3e, 0x8a, 0xfd, 0x57, 0x88, 0x62, 0x57, 0xa3, 0xb3, 0x39, 0xba, 0xfb, 0x93, 0xd0,
-	0xed, 0x89, 0x39, 0x69, 0xac, 0x9d, 0x28, 0xdd, 0x6b, 0x2d, 0xc1, 0x0c, 0x0d, 0x5f, 0xe2, 0xc0,
-	0x5e, 0xe3, 0x1b, 0x13, 0x0d, 0xeb, 0x16, 0x54, 0x47, 0x04, 0xc7, 0xf6, 0xfa, 0x46, 0xe9, 0x4e,
-	0x6b, 0xcb, 0x4a, 0xbf, 0xe6, 0x0b, 0x82, 0x63, 0x87, 0x8f, 0xaf, 0x7f, 0x13, 0x20, 0x39, 0x2d,
-	0x6b, 0x1e, 0x2a, 0x2f, 0xf1, 0x99, 0xbc, 0xbb, 0xec, 0x91, 0x61, 0x7f, 0x85, 0x06, 0x23, 0x75,
-	0x63, 0x45, 0xe3, 0xdb, 0xe5, 0x6f, 0x96, 0xd6, 0x3f, 0x82, 0xb9, 0xf4, 0x21, 0x4d, 0x35, 0xfb,
-	0x3b, 0xd0, 0x4e, 0xed, 0x6b, 0xaa, 0xc9, 0x4f, 0x60, 0xa9, 0x88, 0x36, 0xd3, 0xe0, 0xe8, 0xfe,
-	0xbc, 0x09, 0xf5, 0x3d, 0x21, 0x9c, 0x98, 0x80, 0xd3, 0x12, 0xab, 0xec, 0x7b, 0xec, 0xfe, 0x0c,
-	0x71, 0xdc, 0x3f, 0x41, 0x01, 0x17, 0x65, 0x62, 0x2e, 0xa8, 0xae, 0x9e, 0x67, 0x6d, 0x42, 0x35,
-	0x40, 0x43, 0x6c, 0x57, 0xf8, 0x21, 0xae, 0x6b, 0xea, 0x4a, 0x84, 0x9b, 0x4c, 0x8c, 0x8a, 0xe3,
-	0xe2, 0x70, 0x6c, 0x19, 0xfe, 0x90, 0x31, 0x9f, 0x10, 0x61, 0xa2, 0x61, 0xbd, 0x07, 0x0b, 0x7d,
-	0x34, 0x18, 0x1c, 0xa2, 0xfe, 0x4b, 0x57, 0x0b, 0x39, 0x21, 0xc9, 0xe6, 0xd5, 0xc0, 0x8e, 0x12,
-	0x76, 0x26, 0x30, 0x17, 0xd7, 0xfd, 0x70, 0x20, 0x65, 0x9b, 0x06, 0xde, 0x93, 0xfd, 0xd6, 0xb7,
-	0x60, 0xad, 0xcf, 0x59, 0xc9, 0x15, 0x62, 0x10, 0x0d, 0x06, 0xe1, 0x6b, 0xec, 0xb9, 0xa3, 0x78,
-	0x40, 0xec, 0x3a, 0x67, 0xf2, 0x15, 0x01, 0xc0, 0xf9, 0x61, 0x5b, 0x0c, 0x7f, 0x11, 0x0f, 0x08,
-	0x9b, 0xca, 0xa1, 0x5d, 0xef, 0x2c, 0x40, 0x43, 0xbf, 0x2f, 0x25, 0x98, 0x98, 0xda, 0xe0, 0xb7,
-	0x6a, 0x85, 0x03, 0x3c, 0x15, 0xe3, 0x42, 0x9e, 0xf1, 0xa9, 0x1f, 0xc3, 0x95, 0xf4, 0xd4, 0x18,
-	0x7b, 0x7e, 0xcc, 0xf4, 0x01, 0x9f, 0xdc, 0xe4, 0x93, 0x6d, 0x73, 0xb2, 0x23, 0x01, 0xf8, 0xf4,
-	0xdb, 0xd0, 0x19, 0xf8, 0x43, 0x9f, 0x92, 0x84, 0x18, 0x42, 0x6c, 0xce, 0x89, 0x6e, 0x4d, 0x8a,
-	0xf7, 0xc1, 0x1a, 0xfa, 0x81, 0xab, 0x84, 0xb4, 0xd4, 0x1b, 0x2d, 0xae, 0x37, 0xe6, 0x87, 0x7e,
-	0xb0, 0x27, 0x06, 0xb6, 0x85, 0x06, 0x61, 0xd0, 0xe8, 0x34, 0x0b, 0x3d, 0x2b, 0xa1, 0xd1, 0x69,
-	0x1a, 0xfa, 0x26, 0xb4, 0xe5, 0x86, 0xb9, 0x70, 0x25, 0x76, 0x9b, 0x53, 0x6b, 0x56, 0x74, 0x72,
-	0xf1, 0x4a, 0xac, 0xfb, 0xb0, 0xe4, 0x13, 0x57, 0x49, 0x09, 0xb7, 0x7f, 0x82, 0xfb, 0x2f, 0xc3,
-	0x11, 0xe5, 0x82, 0xb6, 0xe1, 0x58, 0x3e, 0xd9, 0x93, 0x43, 0x3b, 0x72, 0x84, 0x69, 0x03, 0x82,
-	0xfb, 0x31, 0xa6, 0x2e, 0x63, 0xd0, 0x8e, 0xd4, 0x7e, 0xbc, 0xe7, 0x53, 0x7c, 0x66, 0xdd, 0x05,
-	0x4b, 0xab, 0x42, 0x37, 0xc6, 0x3f, 0x1b, 0xf9, 0x31, 0xf6, 0xb8, 0x04, 0x6e, 0x38, 0x0b, 0x7a,
-	0xc4, 0x91, 0x03, 0xd6, 0xbb, 0xb0, 0x40, 0x70, 0xe0, 0xb9, 0xe6, 0x4a, 0xed, 0x05, 0x0e, 0xdd,
-	0x61, 0x03, 0xcf, 0x93, 0xc5, 0x32, 0x58, 0xa6, 0x47, 0xf8, 0x1a, 0x5d, 0xa5, 0x2e, 0x2d, 0xbe,
-	0x80, 0xce, 0x28, 0x1e, 0xf0, 0x15, 0x6e, 0x4b, 0xb5, 0xb9, 0x09, 0x8b, 0x0c, 0x36, 0x8a, 0x43,
-	0xa6, 0x82, 0x14, 0xc9, 0xa4, 0x94, 0x65, 0x68, 0xf6, 0xc4, 0x88, 0x24, 0x99, 0xc2, 0xad, 0x8f,
-	0x99, 0x2b, 0xab, 0x25, 0x8d, 0x5b, 0x9d, 0x2e, 0x57, 0x5a, 0xf7, 0x61, 0x29, 0x05, 0xab, 0x34,
-	0x9f, 0x10, 0xc7, 0x96, 0x01, 0xae, 0x34, 0xe0, 0x0a, 0xd4, 0x08, 0x45, 0x74, 0xc4, 0xc4, 0x72,
-	0xe9, 0xce, 0x8c, 0x23, 0x5b, 0xd6, 0xb7, 0x00, 0x04, 0xef, 0x7a, 0x2e, 0xa2, 0xf6, 0x2a, 0x17,
-	0x70, 0xeb, 0x9b, 0xc2, 0xb8, 0xd9, 0x54, 0xc6, 0xcd, 0xe6, 0x81, 0x32, 0x6e, 0x9c, 0xa6, 0x84,
-	0xde, 0xa6, 0x6c, 0xea, 0x28, 0xf2, 0xd4, 0x54, 0xfb, 0xfc, 0xa9, 0x12, 0x7a, 0x9b, 0x72, 0xab,
-	0x40, 0x1f, 0x38, 0x27, 0xe2, 0x1a, 0x5f, 0x55, 0x5b, 0xf5, 0xee, 0xb0, 0xce, 0xf5, 0x6f, 0x40,
-	0x53, 0x5f, 0xfe, 0xa9, 0xe4, 0xd1, 0x7f, 0x54, 0x60, 0x56, 0x8a, 0x0f, 0x7e, 0x27, 0xa7, 0x17,
-	0x4a, 0x0f, 0x52, 0x42, 0xe9, 0x7a, 0x56, 0x28, 0x71, 0xac, 0x39, 0xc9, 0x94, 0xb1, 0x43, 0xaa,
-	0x13, 0xed, 0x90, 0x99, 0xb4, 0x1d, 0x92, 0xbb, 0x2b, 0xb5, 0x82, 0xbb, 0x92, 0xe6, 0xfc, 0x7a,
-	0x96, 0xf3, 0x0b, 0x59, 0xb9, 0x31, 0x05, 0x2b, 0x37, 0xa7, 0x62, 0x65, 0x18, 0xc7, 0xca, 0x85,
-	0xe2, 0xb5, 0x55, 0x2c, 0x5e, 0x2f, 0x7f, 0xc8, 0xbf, 0x28, 0x41, 0xe7, 0x73, 0x79, 0x62, 0x3b,
-	0x61, 0x40, 0x51, 0x9f, 0x5a, 0x4f, 0x00, 0xd0, 0x88, 0x9e, 0x84, 0xb1, 0xff, 0x25, 0x16, 0xe7,
-	0xdd, 0xda, 0xea, 0xea, 0xc3, 0xcb, 0x40, 0x6f, 0x6b, 0x48, 0xc7, 0x98, 0x65, 0x3d, 0x82, 0x26,
-	0xc5, 0xfd, 0x93, 0xc0, 0xef, 0xa3, 0x01, 0x7f, 0x6b, 0x6b, 0xeb, 0xc6, 0x38, 0x14, 0x07, 0x0a,
-	0xd0, 0x49, 0xe6, 0x74, 0x7f, 0x08, 0xf6, 0x38, 0x30, 0xcb, 0x92, 0x7c, 0x25, 0x76, 0xa8, 0x15,
-	0x9a, 0x38, 0x2a, 0xb9, 0x45, 0xde, 0x60, 0xbd, 0xc2, 0xe2, 0xac, 0x88, 0x5e, 0xde, 0xe8, 0xbe,
-	0x86, 0xb5, 0xb1, 0xbb, 0x78, 0x53, 0xe4, 0xdc, 0x7a, 0x0b, 0x89, 0xcf, 0x6d, 0x79, 0xe9, 0x1f,
-	0xa8, 0x76, 0xf7, 0x9f, 0x0c, 0x6a, 0x3f, 0x41, 0xc1, 0x4b, 0x3f, 0x38, 0xb6, 0xee, 0x1a, 0xfe,
-	0x84, 0xa0, 0xf5, 0x82, 0x26, 0x94, 0x52, 0x30, 0x86, 0x8b, 0xa1, 0x96, 0x57, 0x36, 0x96, 0xc7,
-	0xdc, 0x0e, 0xcf, 0x8b, 0xd9, 0x75, 0xa9, 0x48, 0xb7, 0x43, 0x34, 0x99, 0x8c, 0x90, 0x6c, 0xe9,
-	0x06, 0xa3, 0xe1, 0x21, 0x8e, 0xe5, 0x92, 0xda, 0xb2, 0xf7, 0x39, 0xef, 0x64, 0x3b, 0x21, 0xaf,
-	0xfd, 0x23, 0xe5, 0xb5, 0x88, 0x06, 0x43, 0xeb, 0x61, 0x2a, 0xef, 0x11, 0x47, 0x2b, 0x9b, 0xdd,
-	0xdf, 0x03, 0x4b, 0x6d, 0xe3, 0x33, 0x44, 0xe8, 0x1e, 0x3a, 0x63, 0x2a, 0x65, 0x13, 0xaa, 0x4c,
-	0x36, 0xc9, 0x5d, 0x4c, 0x92, 0x62, 0x1c, 0xce, 0xf0, 0xb0, 0xca, 0xa6, 0x87, 0xd5, 0x7d, 0x08,
-	0xb3, 0x0a, 0x3b, 0xb3, 0x0b, 0x73, 0x72, 0xa7, 0xf0, 0x34, 0xba, 0xbf, 0x06, 0x68, 0xa8, 0x69,
-	0xb9, 0x29, 0xef, 0x48, 0xe3, 0x53, 0x70, 0xe2, 0x72, 0x8e, 0x13, 0x13, 0xfb, 0x53, 0x13, 0xb8,
-	0x6a, 0x10, 0xf8, 0x1d, 0x98, 0x47, 0x03, 0x8a, 0xe3, 0x00, 0x51, 0xff, 0x15, 0x76, 0xf9, 0xb8,
-	0x20, 0x55, 0xc7, 0xe8, 0x7f, 0x2e, 0xcf, 0xe2, 0x35, 0x3e, 0x24, 0x3e, 0xc5, 0x8a, 0x68, 0xb2,
-	0x69, 0xbd, 0x0b, 0x75, 0x4e, 0xf3, 0x58, 0x08, 0x9d, 0xd6, 0xd6, 0x7c, 0x72, 0xce, 0xa2, 0xdf,
-	0x51, 0x00, 0xfc, 0x40, 0x28, 0xa3, 0x65, 0x43, 0x1e, 0x08, 0x6b, 0xb0, 0x8b, 0xfd, 0xa5, 0x1f,
-	0x49, 0x01, 0xc3, 0x1e, 0xd9, 0x62, 0xfb, 0x3e, 0x55, 0x66, 0x09, 0x7f, 0x36, 0xb9, 0xa1, 0x95,
-	0xe6, 0x86, 0xbb, 0x60, 0xc9, 0x47, 0x17, 0x79, 0x1e, 0x67, 0x49, 0xa4, 0x7c, 0xb9, 0x05, 0x39,
-	0xb2, 0xad, 0x07, 0xac, 0x7b, 0xb0, 0xc8, 0xbc, 0x30, 0x42, 0x63, 0xc4, 0x7a, 0x14, 0x07, 0x09,
-	0xef, 0xce, 0x32, 0x87, 0x24, 0x1b, 0x2d, 0x43, 0x8d, 0xa2, 0x53, 0xa6, 0x0b, 0xe6, 0xa4, 0xe5,
-	0x8f, 0x4e, 0x7b, 0x9e, 0xf5, 0x10, 0x1a, 0x7d, 0x71, 0xcd, 0x08, 0x37, 0x34, 0x5a, 0x5b, 0xf6,
-	0x38, 0x51, 0xe0, 0x68, 0x48, 0x6b, 0x0b, 0xea, 0x87, 0xe2, 0x8a, 0x70, 0xb3, 0xa3, 0x68, 0x92,
-	0xbc, 0x42, 0x8e, 0x02, 0x34, 0x14, 0xf4, 0xc2, 0x04, 0x05, 0x6d, 0x5d, 0x5e, 0x41, 0x2f, 0x4e,
-	0xa3, 0xa0, 0x9f, 0xc2, 0xfc, 0x91, 0x1f, 0x13, 0x9a, 0x58, 0x7a, 0x94, 0xdb, 0x22, 0x93, 0x11,
-	0xcc, 0xf1, 0x39, 0xca, 0x06, 0xa4, 0xd6, 0xd7, 0x60, 0xce, 0x27, 0xee, 0x2b, 0x44, 0x5d, 0x1c,
-	0xa0, 0xc3, 0x01, 0xf6, 0xb8, 0x81, 0xd2, 0x70, 0x66, 0x7d, 0xf2, 0x02, 0xd1, 0x67, 0xa2, 0xcf,
-	0x7a, 0x0c, 0xd7, 0x7c, 0x66, 0x06, 0x0c, 0x87, 0x3e, 0x21, 0xec, 0xb0, 0x68, 0xe8, 0x32, 0x76,
-	0xd6, 0x93, 0x56, 0xf8, 0xa4, 0x35, 0x9f, 0xec, 0x68, 0x98, 0x83, 0x90, 0xb1, 0xbd, 0xc2, 0xf0,
-	0x10, 0x56, 0x4e, 0x10, 0x71, 0xb5, 0x46, 0x4f, 0x42, 0x23, 0xab, 0x7c, 0xea, 0xd2, 0x09, 0x22,
-	0x8a, 0xf0, 0xfb, 0x3a, 0x4a, 0xf2, 0x2e, 0x2c, 0xb0, 0x59, 0x11, 0x89, 0x8c, 0x09, 0xb6, 0xd0,
-	0x96, 0x27, 0x88, 0xec, 0x91, 0x28, 0x81, 0xfd, 0x08, 0x5a, 0x03, 0x24, 0xc8, 0xc1, 0x6c, 0xd3,
-	0x35, 0x4e, 0x8a, 0x2b, 0xb9, 0x53, 0x4d, 0x24, 0x8a, 0x03, 0x83, 0x44, 0xba, 0x5c, 0x81, 0xa6,
-	0x4f, 0xf8, 0x4b, 0xb0, 0xc7, 0x9d, 0xc8, 0x86, 0xd3, 0xf0, 0xc9, 0x3e, 0x6f, 0x5b, 0xcf, 0xa1,
-	0x93, 0x8e, 0x90, 0x10, 0xfb, 0x2a, 0x37, 0x3a, 0xde, 0xce, 0xa1, 0xdf, 0xdc, 0x33, 0x83, 0x26,
-	0xd2, 0x9b, 0x9f, 0x4b, 0x45, 0x52, 0x84, 0xdc, 0x3c, 0x8e, 0x31, 0xe6, 0x18, 0xe9, 0x59, 0x84,
-	0xed, 0x6b, 0xc2, 0xb6, 0xd2, 0xbd, 0x07, 0x67, 0x11, 0xb6, 0x3e, 0x80, 0xd5, 0x04, 0x8c, 0xb0,
-	0x3f, 0xaf, 0x7c, 0xe4, 0x72, 0xd9, 0xf4, 0x96, 0x20, 0x9a, 0x1e, 0xde, 0xc7, 0x01, 0x7d, 0xe1,
-	0xa3, 0xcf, 0x99, 0xe2, 0xe0, 0x0e, 0x80, 0x3f, 0x70, 0x69, 0x8c, 0xfa, 0x8c, 0x6f, 0xdd, 0x81,
-	0x1f, 0xbc, 0xb4, 0xaf, 0x0b, 0xdd, 0xce, 0x46, 0x0e, 0xe4, 0xc0, 0x67, 0x7e, 0xf0, 0x92, 0x1b,
-	0x24, 0x0f, 0xdc, 0xe4, 0x3d, 0x5c, 0xfa, 0x6c, 0x08, 0xe9, 0x43, 0x1e, 0x6c, 0xab, 0x7e, 0x26,
-	0x7d, 0xd6, 0x11, 0x2c, 0x16, 0x6c, 0xaf, 0xc0, 0x22, 0x78, 0x68, 0x5a, 0x04, 0xad, 0xad, 0xb7,
-	0x72, 0x64, 0x4a, 0xa1, 0x31, 0x2d, 0x86, 0xc7, 0xb0, 0xbe, 0x7f, 0x46, 0x28, 0x1e, 0x72, 0x43,
-	0xc8, 0xef, 0x73, 0x01, 0xb0, 0xcf, 0xef, 0x19, 0x26, 0x4c, 0x20, 0x1d, 0xc5, 0xe1, 0x90, 0xbf,
-	0x6a, 0xc6, 0xe1, 0xcf, 0x4c, 0x18, 0xd3, 0x90, 0xbf, 0x68, 0xc6, 0x29, 0xd3, 0xb0, 0xfb, 0x3f,
-	0x65, 0x98, 0x35, 0x27, 0x17, 0x09, 0x78, 0xea, 0xd3, 0x81, 0x36, 0x57, 0x78, 0x83, 0xc9, 0xb5,
-	0x21, 0x26, 0x84, 0x39, 0xad, 0x52, 0xcb, 0xc9, 0x66, 0xd6, 0x10, 0xad, 0xe6, 0x0c, 0xd1, 0x55,
-	0xa8, 0xf3, 0xcb, 0xe0, 0x7b, 0x52, 0x6c, 0xd7, 0x58, 0xb3, 0xe7, 0x29, 0xa6, 0xe2, 0xfb, 0xe1,
-	0xf2, 0x5a, 0x30, 0x15, 0x6f, 0xcb, 0xe0, 0x4d, 0x8c, 0x91, 0xc7, 0x05, 0x36, 0x0f, 0xde, 0x38,
-	0x18, 0x31, 0xe3, 0xa6, 0x41, 0xe4, 0x86, 0xb9, 0x80, 0x6e, 0x6d, 0xdd, 0xd4, 0xf4, 0x1b, 0x4f,
-	0x1b, 0x47, 0x4f, 0xca, 0xc8, 0xa3, 0xe6, 0xe5, 0xe5, 0x11, 0x4c, 0x21, 0x8f, 0xba, 0x43, 0x98,
-	0xe7, 0x26, 0xf7, 0xde, 0x00, 0xd1, 0xa3, 0x30, 0x1e, 0xee, 0x62, 0x53, 0x07, 0x33, 0xf2, 0x97,
-	0x0b, 0xa3, 0x9c, 0xe5, 0x4c, 0x94, 0xf3, 0x6d, 0x98, 0xc3, 0x47, 0x47, 0xb8, 0xcf, 0x75, 0x61,
-	0xcc, 0xb4, 0x54, 0x85, 0xcf, 0x6d, 0xeb, 0x5e, 0x07, 0x51, 0xdc, 0x3d, 0x82, 0x06, 0x7f, 0xdd,
-	0x01, 0x3a, 0x65, 0x6c, 0xc1, 0x6f, 0x91, 0x34, 0xaa, 0xd8, 0x33, 0xeb, 0xe3, 0x93, 0x85, 0xf2,
-	0xe7, 0xcf, 0x97, 0x09, 0xba, 0x76, 0xbf, 0x84, 0x45, 0xfe, 0x9e, 0x27, 0xe2, 0x04, 0xb6, 0xa5,
-	0xb2, 0xb3, 0x13, 0x75, 0x2b, 0xde, 0xaa, 0x95, 0xab, 0x52, 0x9a, 0x65, 0x43, 0x69, 0x5e, 0x87,
-	0x56, 0x14, 0x12, 0x8a, 0x06, 0x6e, 0x3f, 0xf4, 0x14, 0x83, 0x81, 0xe8, 0xda, 0x09, 0x3d, 0x9c,
-	0x68, 0xe4, 0xaa, 0xa1, 0x91, 0xbb, 0xff, 0x5e, 0x81, 0xa6, 0x0e, 0x60, 0xe5, 0xf8, 0x78, 0x05,
-	0x6a, 0xe1, 0x21, 0xf3, 0x74, 0xe4, 0xab, 0x64, 0x8b, 0xbd, 0x0c, 0x9f, 0x72, 0xb3, 0x61, 0xc0,
-	0x58, 0x52, 0xbe, 0x4c, 0x75, 0xf5, 0xbc, 0x42, 0x1b, 0x44, 0x5b, 0x3d, 0x33, 0xa6, 0x0d, 0xca,
-	0xce, 0x82, 0xcb, 0x12, 0x1e, 0xf5, 0xf5, 0xb1, 0x27, 0xb9, 0xb8, 0xcd, 0x7b, 0x5f, 0xc8, 0xce,
-	0xc4, 0x54, 0xad, 0x9b, 0xa6, 0x2a, 0xf3, 0x20, 0xd9, 0x43, 0x32, 0x59, 0xf8, 0x39, 0x6d, 0xde,
-	0xab, 0x27, 0xb3, 0x6d, 0x29, 0xab, 0xa3, 0xec, 0x47, 0x6c, 0x5b, 0x83, 0xb0, 0x8f, 0x06, 0x58,
-	0x9a, 0x1d, 0xb2, 0x65, 0x7d, 0x98, 0x36, 0x3c, 0x5a, 0x5b, 0x57, 0xd3, 0x41, 0xbe, 0xf4, 0x01,
-	0x25, 0x66, 0xc9, 0x47, 0x46, 0x4c, 0x73, 0x96, 0x4b, 0xed, 0x8d, 0x7c, 0x74, 0x70, 0x6c, 0x28,
-	0xf3, 0x1a, 0x00, 0xf3, 0x1a, 0x52, 0xa1, 0x67, 0xee, 0x47, 0x70, 0x17, 0xed, 0x8d, 0xc2, 0x7a,
-	0xdd, 0x7f, 0xb3, 0x61, 0xa6, 0xd8, 0xf7, 0xbd, 0x07, 0x75, 0x99, 0x48, 0xc8, 0xd9, 0x94, 0xa6,
-	0x77, 0xeb, 0x28, 0x28, 0xeb, 0x0e, 0xcc, 0xab, 0x5c, 0x84, 0x4e, 0x04, 0x88, 0x83, 0x9f, 0x8b,
-	0x8c, 0x09, 0x3d, 0xcf, 0xba, 0x0d, 0x1d, 0x05, 0xa9, 0x5c, 0xca, 0x6a, 0x0a, 0x50, 0x79, 0x94,
-	0x99, 0xc4, 0xc1, 0x4c, 0x3e, 0x71, 0xb0, 0x05, 0xcb, 0x3a, 0x01, 0x12, 0xf4, 0xc3, 0x21, 0x56,
-	0xc1, 0xa6, 0x1a, 0xbf, 0x5d, 0x8b, 0x2a, 0x17, 0xc2, 0xc7, 0x64, 0xbc, 0xa9, 0x07, 0xab, 0x99,
-	0x39, 0xfa, 0xe6, 0xd5, 0xc7, 0xb9, 0x27, 0xcb, 0x29, 0x44, 0x3a, 0x2c, 0xf6, 0x10, 0x56, 0xf4,
-	0x9e, 0x47, 0xd4, 0x7c, 0x7f, 0x83, 0xbf, 0x7f, 0x49, 0xed, 0x5c, 0x0c, 0xca, 0x05, 0x7c, 0x0a,
-	0x76, 0x76, 0x96, 0x5e, 0x41, 0x73, 0xdc, 0x0a, 0x56, 0xd2, 0xa8, 0xf4, 0x12, 0xbe, 0x80, 0x35,
-	0x85, 0x8c, 0xdb, 0x1e, 0xb1, 0x88, 0x64, 0x5f, 0x54, 0x7a, 0x2a, 0xb4, 0xcc, 0x26, 0x71, 0xd4,
-	0xd4, 0x6d, 0x6a, 0x7d, 0x02, 0xea, 0x30, 0x54, 0x06, 0xa1, 0xc5, 0x19, 0xf7, 0x46, 0x9a, 0x71,
-	0x15, 0x2f, 0x98, 0x89, 0x83, 0x76, 0x64, 0xf6, 0x59, 0x4f, 0x72, 0xb9, 0x9d, 0x76, 0xc6, 0x2e,
-	0x4a, 0x69, 0x62, 0xc1, 0x55, 0x99, 0xc4, 0xcf, 0x07, 0xb0, 0x9a, 0xc6, 0x91, 0xb0, 0x98, 0x30,
-	0xc4, 0x97, 0xa2, 0x1c, 0x8e, 0x9e, 0x67, 0x6d, 0xc3, 0xb5, 0xec, 0xb4, 0xf4, 0x29, 0x75, 0xf8,
-	0x29, 0xad, 0xa7, 0x27, 0xa7, 0xce, 0xea, 0x77, 0xe1, 0xfa, 0x18, 0x14, 0xfa, 0xc8, 0xe6, 0xc7,
-	0x1d, 0xd9, 0xd5, 0x22, 0xbc, 0xfa, 0xe0, 0x1e, 0xc1, 0xd5, 0x0c, 0xe6, 0x34, 0x07, 0x2f, 0xf0,
-	0xb5, 0xad, 0xa5, 0x70, 0xa4, 0xf8, 0xf8, 0x05, 0xbc, 0x55, 0x8c, 0x40, 0xaf, 0xcc, 0x1a, 0xb7,
-	0xb2, 0x2b, 0x05, 0x58, 0xf5, 0xc2, 0x7e, 0x92, 0xc3, 0x2b, 0x88, 0xdd, 0x1f, 0x84, 0xe4, 0xa2,
-	0x4e, 0xc2, 0x7a, 0xfe, 0x3c, 0x76, 0xf8, 0xf4, 0x6d, 0x6a, 0xf8, 0x30, 0x4b, 0x13, 0x7c, 0x98,
-	0xe5, 0xcb, 0xdb, 0x0c, 0x2b, 0xd3, 0xf8, 0x30, 0xb7, 0xa0, 0x23, 0x13, 0x58, 0xea, 0xea, 0x48,
-	0x77, 0xa0, 0x2d, 0x12, 0x59, 0x2a, 0xd5, 0xfa, 0x09, 0xdc, 0x10, 0x07, 0xe3, 0xfa, 0x01, 0xf7,
-	0x06, 0xa4, 0xe8, 0x62, 0xd6, 0xad, 0x26, 0xb8, 0xcd, 0xcf, 0xec, 0x9a, 0x00, 0xec, 0x05, 0x7b,
-	0x24, 0xda, 0xd6, 0x50, 0x9a, 0xbe, 0x0e, 0xdc, 0x4a, 0x30, 0x69, 0xb3, 0xae, 0x08, 0xdd, 0x1a,
-	0x47, 0xd7, 0x55, 0xe8, 0x94, 0xe5, 0x5a, 0x80, 0xf3, 0x00, 0x6e, 0x4b, 0x9c, 0xe1, 0x88, 0x4e,
-	0x46, 0xba, 0xce, 0x91, 0xde, 0x14, 0xe0, 0xdf, 0x1f, 0xd1, 0x09, 0x58, 0x7f, 0x0c, 0xef, 0x1b,
-	0x7b, 0x96, 0x3c, 0x21, 0x6c, 0xc9, 0x42, 0xd4, 0x57, 0x38, 0xea, 0xdb, 0x7a, 0xfb, 0x62, 0x86,
-	0x30, 0x18, 0x0b, 0xd0, 0xe7, 0x6f, 0x80, 0xc8, 0x84, 0x2a, 0xa5, 0x70, 0x95, 0x5f, 0xed, 0xf4,
-	0x0d, 0xd8, 0x63, 0x10, 0x4a, 0x3f, 0x60, 0x58, 0xcb, 0x20, 0xa0, 0xa7, 0x81, 0x92, 0x57, 0xd7,
-	0x8a, 0x32, 0x9e, 0x69, 0x59, 0x73, 0x70, 0x1a, 0x98, 0x82, 0x6b, 0x25, 0x2a, 0x1c, 0xb4, 0x0e,
-	0xc0, 0x52, 0xaf, 0xe1, 0x89, 0x02, 0xe2, 0x53, 0x4c, 0xec, 0xeb, 0x19, 0xf7, 0x2b, 0x85, 0xdf,
-	0xd1, 0x70, 0x02, 0xf5, 0x42, 0x94, 0xed, 0xb7, 0xbe, 0x0d, 0x73, 0x8c, 0x8d, 0x8e, 0xb0, 0xbe,
-	0xf1, 0x1b, 0x9c, 0x6f, 0x97, 0xd2, 0x18, 0x77, 0x31, 0xde, 0x23, 0x91, 0x33, 0x1b, 0x91, 0x68,
-	0x17, 0xab, 0xab, 0xff, 0x08, 0x2c, 0x25, 0x9d, 0x8d, 0xf9, 0x37, 0x32, 0xd7, 0x5d, 0xcd, 0x77,
-	0x94, 0x62, 0x4e, 0x10, 0x3c, 0x86, 0x45, 0x1a, 0x4a, 0x72, 0x1b, 0x18, 0xba, 0x63, 0x31, 0xd0,
-	0x90, 0x53, 0x3e, 0xc1, 0xf0, 0x83, 0x84, 0xf6, 0x92, 0x23, 0x0c, 0x3c, 0x5f, 0xcb, 0xf8, 0x5c,
-	0x7a, 0x27, 0x26, 0x47, 0x68, 0x7a, 0x8b, 0x66, 0x82, 0xfa, 0x26, 0x54, 0x28, 0x3a, 0xb5, 0xdf,
-	0x2e, 0x5a, 0xcc, 0x01, 0x3a, 0x75, 0xd8, 0x28, 0xb3, 0x20, 0x47, 0x23, 0xdf, 0xb3, 0x6f, 0x09,
-	0x0b, 0x92, 0x3d, 0x5b, 0x07, 0xb0, 0x86, 0x4f, 0x23, 0x3f, 0xc6, 0x2e, 0xbb, 0xdd, 0x2e, 0x0d,
-	0x5d, 0xe6, 0x05, 0xb8, 0x7e, 0x10, 0x8d, 0xa8, 0x7d, 0xfb, 0x5c, 0xa9, 0xb0, 0x2c, 0x26, 0x3f,
-	0x45, 0x14, 0x1f, 0x84, 0xbb, 0x61, 0x3c, 0xec, 0xb1, 0x89, 0xd6, 0x7d, 0x58, 0xa2, 0x21, 0x33,
-	0x9c, 0x33, 0xf9, 0xac, 0xf7, 0x38, 0xb7, 0x5b, 0x7c, 0x2c, 0x9d, 0xd1, 0x7a, 0x06, 0x1d, 0xb9,
-	0x68, 0x57, 0xd9, 0x8b, 0xef, 0x5f, 0xc0, 0x5e, 0x9c, 0x3b, 0x4c, 0x1b, 0xf8, 0x2a, 0xa1, 0x7c,
-	0x77, 0x72, 0x42, 0xd9, 0xfa, 0x0e, 0xac, 0x73, 0xe7, 0x4f, 0x85, 0xbe, 0x98, 0x29, 0x98, 0xa4,
-	0xb4, 0x36, 0xb9, 0x34, 0x5b, 0x65, 0x10, 0x12, 0xf1, 0x53, 0x44, 0x91, 0x4e, 0x6c, 0x99, 0xb9,
-	0xf8, 0x7b, 0x99, 0x5c, 0xfc, 0x1d, 0x98, 0xf1, 0x29, 0x1e, 0x12, 0xfb, 0x3e, 0xe7, 0xf5, 0xcc,
-	0x0a, 0x7a, 0xec, 0x0c, 0x05, 0x80, 0xe1, 0xd6, 0x7c, 0x7d, 0xac, 0x5b, 0xb3, 0x95, 0xf1, 0xb2,
-	0xbe, 0x69, 0x58, 0xc5, 0x0f, 0xf8, 0x0b, 0x32, 0xe4, 0x19, 0x6b, 0x11, 0x3f, 0x2f, 0x48, 0xee,
-	0x3f, 0xe4, 0x18, 0x6e, 0xe6, 0xcc, 0x93, 0x0b, 0xe4, 0xf3, 0xd7, 0x1f, 0x83, 0x95, 0xb7, 0x63,
-	0xa6, 0x4a, 0x8f, 0xf7, 0xe0, 0xca, 0x04, 0xc9, 0x32, 0x15, 0xaa, 0xa7, 0xb0, 0x52, 0x2c, 0x44,
-	0xfe, 0x6f, 0x25, 0xfb, 0xff, 0x59, 0x39, 0x8e, 0x8c, 0x4d, 0x2e, 0xec, 0x38, 0xce, 0x43, 0x85,
-	0xbc, 0x1c, 0x49, 0xbf, 0x81, 0x3d, 0x16, 0x7a, 0x8a, 0xe7, 0xfb, 0x05, 0x09, 0x3f, 0xd6, 0xc6,
-	0xf2, 0x63, 0x3d, 0xc3, 0x8f, 0x2b, 0x50, 0xe3, 0x45, 0x02, 0xc4, 0x6e, 0xf0, 0x7b, 0x20, 0x5b,
-	0x6c, 0x4d, 0xa3, 0x78, 0xa0, 0x82, 0xd2, 0xa3, 0x78, 0x90, 0xf2, 0xe7, 0xa0, 0xc8, 0x9f, 0x63,
-	0x7b, 0x1e, 0xcb, 0xbd, 0x69, 0x3b, 0xa7, 0x75, 0x79, 0x3b, 0x67, 0x76, 0x0a, 0x3b, 0xe7, 0xcd,
-	0xdc, 0xc4, 0xff, 0x2e, 0x41, 0x43, 0xab, 0xed, 0x35, 0x68, 0xf4, 0x43, 0x0f, 0xbb, 0xbe, 0x8c,
-	0xa9, 0xcc, 0x38, 0x75, 0xd6, 0xee, 0x05, 0xd4, 0x5a, 0x05, 0xfe, 0xe8, 0xa2, 0x07, 0xea, 0x5c,
-	0x59, 0x73, 0xfb, 0x81, 0x75, 0xc3, 0x38, 0xc5, 0xd6, 0x56, 0x5b, 0x53, 0xeb, 0x39, 0x1a, 0x62,
-	0x79, 0xa8, 0x22, 0x52, 0x85, 0x78, 0x78, 0x85, 0x1f, 0x29, 0x8f, 0x54, 0x6d, 0xf3, 0x76, 0x86,
-	0x66, 0xb5, 0xcb, 0xd3, 0xac, 0x3e, 0x4d, 0x3c, 0xe9, 0x17, 0x65, 0x68, 0x72, 0xb5, 0xc7, 0x24,
-	0xa6, 0x8c, 0x12, 0x94, 0x74, 0x94, 0xc0, 0x88, 0xbf, 0x94, 0xd3, 0xf1, 0x97, 0xfb, 0x30, 0x2b,
-	0x1f, 0x5d, 0x99, 0x1e, 0x2e, 0xd8, 0x75, 0x4b, 0x82, 0xf0, 0xa4, 0xca, 0x0d, 0x19, 0xb1, 0x29,
-	0xa6, 0x0f, 0x0f, 0xe0, 0xc8, 0xdc, 0xc8, 0x4c, 0x92, 0x1b, 0xd1, 0x11, 0x9b, 0x9a, 0x99, 0x43,
-	0x31, 0x0b, 0xaf, 0xea, 0xf9, 0xc2, 0x2b, 0xea, 0x0f, 0xf1, 0x97, 0x61, 0x80, 0x25, 0x3f, 0xeb,
-	0x76, 0x12, 0x41, 0x01, 0x33, 0x82, 0xa2, 0x83, 0x32, 0x2d, 0x33, 0x15, 0xf5, 0x8f, 0x25, 0xb0,
-	0xf2, 0x5e, 0x5b, 0xee, 0x96, 0x17, 0xa5, 0xf2, 0x1e, 0x42, 0x4d, 0x1a, 0x68, 0x95, 0x8c, 0x4a,
-	0xdc, 0x4b, 0xdb, 0x79, 0x0c, 0xc6, 0x91, 0xb0, 0xd6, 0xc7, 0x89, 0x13, 0x29, 0x63, 0x99, 0x82,
-	0x52, 0x2b, 0xd9, 0xd9, 0xd2, 0xb4, 0x68, 0xa7, 0x4c, 0x0b, 0xb6, 0x8b, 0xe3, 0x38, 0x1c, 0x29,
-	0xea, 0x89, 0x46, 0xf7, 0xaf, 0xcb, 0x99, 0x60, 0xb2, 0xb4, 0xf7, 0x6c, 0xa8, 0x9f, 0xa0, 0xc0,
-	0x1b, 0xe0, 0x58, 0x05, 0xd6, 0x64, 0x93, 0xd3, 0x0f, 0xc7, 0x43, 0x3f, 0x40, 0x2a, 0x37, 0xa7,
-	0xdb, 0x5c, 0x91, 0x22, 0x42, 0x5e, 0x87, 0xb1, 0x8a, 0x7b, 0xe8, 0x76, 0x3a, 0xd5, 0xad, 0x80,
-	0x32, 0x65, 0x47, 0x7b, 0x0a, 0x38, 0x13, 0x3c, 0xab, 0xe5, 0x82, 0x67, 0x1f, 0xab, 0xba, 0xc0,
-	0x3a, 0x97, 0x3d, 0xb7, 0x27, 0x51, 0x30, 0x5f, 0x18, 0x78, 0xf9, 0xfa, 0xb3, 0xee, 0x7f, 0x96,
-	0xa1, 0x9d, 0xa2, 0xf3, 0x85, 0x4e, 0xfc, 0x5d, 0xa8, 0xcb, 0xf4, 0x9f, 0x3c, 0xf2, 0x82, 0xb4,
-	0xa0, 0x7c, 0xb0, 0x9e, 0xc0, 0x62, 0x91, 0x63, 0x51, 0x1d, 0xe7, 0xc8, 0x5a, 0x28, 0xef, 0x56,
-	0xbc, 0x07, 0x0b, 0x06, 0x8e, 0x08, 0xc7, 0x7e, 0xa8, 0x89, 0x9d, 0x0c, 0xec, 0xf1, 0xfe, 0xb4,
-	0xd4, 0xa9, 0x4d, 0x94, 0x3a, 0xf5, 0xcb, 0x4b, 0x9d, 0xc6, 0x34, 0x52, 0xe7, 0xcf, 0x4a, 0x30,
-	0xbb, 0xeb, 0x9f, 0x62, 0x6f, 0x0f, 0xf5, 0x5f, 0xb2, 0x5b, 0x7b, 0x11, 0x22, 0x9b, 0x49, 0xf6,
-	0xca, 0xf9, 0x49, 0x76, 0x76, 0xd9, 0x63, 0xbf, 0x2f, 0x04, 0x72, 0xc9, 0x11, 0x8d, 0x89, 0x22,
-	0xb8, 0xfb, 0x29, 0xb4, 0xcd, 0x55, 0x31, 0x07, 0xa6, 0x7d, 0xc4, 0x3a, 0xdc, 0x48, 0xf4, 0xd8,
-	0x25, 0xce, 0x8e, 0x49, 0x9c, 0xd0, 0x04, 0x77, 0x66, 0x8f, 0x8c, 0x56, 0xf7, 0x0f, 0x4a, 0x32,
-	0x76, 0xbe, 0x8b, 0xb1, 0xf5, 0x18, 0xae, 0x08, 0xc3, 0x39, 0xc5, 0xbf, 0x3b, 0x66, 0xcd, 0x40,
-	0xc9, 0x99, 0x04, 0x62, 0x7d, 0x08, 0x2b, 0x62, 0x58, 0x67, 0x5b, 0xcd, 0xd0, 0x7e, 0xc9, 0x19,
-	0x33, 0xda, 0xfd, 0xdb, 0x12, 0xb4, 0x0c, 0x2f, 0xeb, 0xb7, 0xb7, 0x12, 0xeb, 0x7d, 0x58, 0x90,
-	0x68, 0x49, 0xb4, 0x63, 0x1e, 0x64, 0xc9, 0xc9, 0x0f, 0x74, 0x7f, 0x55, 0x82, 0xe5, 0x42, 0x9f,
-	0xea, 0xb7, 0xb8, 0x83, 0xec, 0x9b, 0xc5, 0x82, 0x32, 0x7b, 0x99, 0x04, 0xd2, 0xfd, 0x65, 0x89,
-	0xd9, 0x9d, 0xd2, 0x0e, 0x37, 0xc3, 0x7f, 0xd9, 0x0b, 0xf0, 0x1b, 0x15, 0xc3, 0xd5, 0x31, 0x62,
-	0x38, 0x7d, 0xf9, 0x67, 0xa6, 0xb8, 0xfc, 0xdd, 0xdf, 0x2f, 0xc3, 0xac, 0xbe, 0x74, 0x4c, 0x27,
-	0x67, 0x37, 0x70, 0x13, 0xda, 0xea, 0x2a, 0xba, 0x3c, 0x9b, 0x28, 0x72, 0x87, 0xb3, 0xaa, 0x73,
-	0x37, 0x0e, 0x87, 0x4c, 0x0f, 0x68, 0x20, 0x1a, 0xf2, 0xcd, 0xcc, 0x38, 0xa0, 0xba, 0x0e, 0x42,
-	0x9d, 0x5f, 0xaa, 0x1a, 0xf9, 0xa5, 0x89, 0x56, 0x94, 0xaa, 0x5f, 0xa9, 0x5d, 0xb0, 0x7e, 0xe5,
-	0xf2, 0xf2, 0xaf, 0xfb, 0x2f, 0x55, 0xad, 0x2a, 0xc6, 0x1c, 0x62, 0x91, 0x14, 0xd3, 0x7a, 0xba,
-	0x62, 0xe8, 0xe9, 0x94, 0x6c, 0xab, 0x9e, 0x2f, 0xdb, 0xde, 0x02, 0x45, 0x24, 0x1f, 0x13, 0x7b,
-	0x66, 0xa3, 0x62, 0x90, 0xcd, 0xc7, 0x64, 0x4c, 0x2d, 0x6b, 0x6d, 0xaa, 0x5a, 0xd6, 0xfa, 0x98,
-	0x5a, 0xd6, 0xc4, 0xba, 0x69, 0x4c, 0x61, 0xdd, 0x58, 0x50, 0xed, 0xf5, 0xc3, 0x40, 0x9a, 0x64,
-	0xfc, 0xb9, 0xc0, 0xe2, 0x81, 0x69, 0x2c, 0x1e, 0x95, 0x8f, 0x6c, 0x19, 0xf9, 0x48, 0xa3, 0x56,
-	0x2a, 0xc6, 0xc7, 0xf8, 0x34, 0x92, 0x95, 0x31, 0xaa, 0x56, 0xca, 0xe1, 0x9d, 0x69, 0x16, 0x6a,
-	0x4f, 0x54, 0x89, 0x73, 0x97, 0x57, 0x89, 0x9d, 0x69, 0x54, 0xe2, 0x9f, 0x94, 0xb5, 0x0d, 0x71,
-	0x21, 0xf7, 0x63, 0x2b, 0xe5, 0x7e, 0x6c, 0x99, 0x7e, 0x49, 0xe5, 0xff, 0x81, 0x5f, 0xf2, 0x47,
-	0x65, 0xa8, 0xbc, 0x40, 0xf9, 0x22, 0xb0, 0x77, 0xd3, 0x1e, 0xc9, 0xc4, 0x02, 0xac, 0x0d, 0x68,
-	0x91, 0xd1, 0xa1, 0xe7, 0xbf, 0xf2, 0x09, 0xf3, 0xa1, 0x05, 0x59, 0xcc, 0x2e, 0x66, 0x19, 0xbe,
-	0x42, 0x54, 0x4a, 0x17, 0xf6, 0x38, 0x0d, 0x29, 0x1a, 0x97, 0x27, 0x45, 0x73, 0x1a, 0x52, 0xfc,
-	0x4d, 0x05, 0x20, 0x29, 0xf8, 0x29, 0xa0, 0xc8, 0x42, 0x36, 0x47, 0xa2, 0xea, 0x78, 0x3b, 0xe9,
-	0x1c, 0x88, 0x97, 0xf9, 0x98, 0xaa, 0x92, 0xfd, 0x98, 0xea, 0xdb, 0xb9, 0x60, 0x73, 0x52, 0x8c,
-	0x24, 0x89, 0xb4, 0x9a, 0x42, 0x69, 0x2c, 0xeb, 0x6d, 0x11, 0xeb, 0x35, 0x26, 0xcc, 0xf0, 0x09,
-	0xed, 0x88, 0x44, 0x06, 0xd8, 0x37, 0xc0, 0x16, 0x91, 0xc6, 0x7c, 0x99, 0x93, 0x94, 0x4f, 0xcb,
-	0x7c, 0x3c, 0x5b, 0xe1, 0xc4, 0x08, 0x48, 0x28, 0x8a, 0x29, 0x8f, 0x7b, 0x5e, 0x84, 0x97, 0x38,
-	0xf4, 0xd3, 0xbc, 0x8c, 0xff, 0xaa, 0x8e, 0xed, 0x43, 0x80, 0x1d, 0x14, 0x7b, 0xcf, 0x78, 0xc0,
-	0x95, 0x89, 0xfd, 0x61, 0x18, 0xd0, 0x13, 0x79, 0x70, 0xa2, 0xc1, 0x44, 0xd8, 0x19, 0x46, 0xb1,
-	0x52, 0x10, 0xec, 0xb9, 0xfb, 0x43, 0x68, 0xee, 0xa3, 0x57, 0xd8, 0x63, 0x93, 0x73, 0x87, 0x3d,
-	0x0f, 0x95, 0x08, 0x05, 0x12, 0x9e, 0x3d, 0x5a, 0xef, 0x41, 0x4d, 0xc4, 0x74, 0xa5, 0x4d, 0xbc,
-	0x98, 0xdc, 0x07, 0xfd, 0x76, 0x47, 0x82, 0x30, 0xad, 0x6d, 0x4b, 0x99, 0xba, 0x1b, 0xc6, 0xc3,
-	0xe9, 0xb5, 0x97, 0x05, 0x55, 0xbf, 0xaf, 0xef, 0x12, 0x7f, 0xd6, 0x72, 0xb8, 0x6a, 0xc8, 0xe1,
-	0x42, 0x6f, 0xb4, 0x40, 0x3a, 0xd7, 0x8a, 0xa4, 0xf3, 0x2d, 0xe8, 0x9c, 0x20, 0xe2, 0x12, 0x46,
-	0x05, 0xb7, 0x8f, 0x62, 0x8f, 0x70, 0x29, 0xde, 0x70, 0xda, 0x27, 0x88, 0x68, 0xda, 0x10, 0xeb,
-	0x01, 0xb4, 0x4c, 0x98, 0x76, 0x26, 0x82, 0xab, 0x21, 0x1d, 0x20, 0x7a, 0x52, 0xf7, 0xc7, 0x70,
-	0xb7, 0xb0, 0x3c, 0x6a, 0x0f, 0xc7, 0x07, 0x31, 0x0a, 0x08, 0xbb, 0xfa, 0x61, 0x60, 0x70, 0xec,
-	0x3c, 0x54, 0x8e, 0x30, 0x96, 0x66, 0x25, 0x7b, 0x9c, 0x54, 0x57, 0xd3, 0xfd, 0xf3, 0x12, 0x6c,
-	0x14, 0xe2, 0x4f, 0x30, 0x92, 0x02, 0x94, 0x2e, 0x74, 0x22, 0x1c, 0xbb, 0x34, 0x59, 0x81, 0x14,
-	0x6f, 0x1f, 0x4e, 0x2e, 0xea, 0x1a, 0xb7, 0x6a, 0x67, 0x2e, 0x4a, 0x8d, 0x74, 0xff, 0x75, 0xdc,
-	0xba, 0x7a, 0x01, 0xc5, 0xc7, 0xa2, 0x02, 0x94, 0x99, 0x63, 0xca, 0xc8, 0x4c, 0x3e, 0xb6, 0x04,
-	0xd5, 0xd5, 0xe3, 0xd6, 0xa5, 0x06, 0xd0, 0xd6, 0xa5, 0x20, 0xc1, 0xbc, 0x1a, 0xd0, 0xd6, 0xe5,
-	0x47, 0xb0, 0xae, 0x81, 0xf3, 0x36, 0xa9, 0xe0, 0x20, 0x5b, 0x41, 0xec, 0x64, 0x6d, 0xd3, 0xb7,
-	0x00, 0x7c, 0xb9, 0x34, 0x2c, 0x2c, 0xd8, 0x86, 0x63, 0xf4, 0x74, 0x7b, 0x70, 0xb3, 0x78, 0x3f,
-	0x1e, 0x0e, 0x26, 0x94, 0xa5, 0x15, 0x30, 0x75, 0xf7, 0x2f, 0xcb, 0xb0, 0x5c, 0x88, 0xcb, 0xda,
-	0xcf, 0x25, 0xf6, 0xc5, 0x25, 0x7b, 0x7f, 0xf2, 0xa9, 0xa4, 0xd7, 0x90, 0xcd, 0xf4, 0xf7, 0x00,
-	0x32, 0x62, 0xd5, 0xfc, 0x00, 0xf0, 0x3c, 0xe6, 0x71, 0x8c, 0xc9, 0xd6, 0xa7, 0xd0, 0xf2, 0x93,
-	0xf3, 0x93, 0x16, 0xfc, 0x39, 0xb8, 0x8c, 0x03, 0x77, 0xcc, 0xd9, 0x13, 0xe3, 0x04, 0xdd, 0x7d,
-	0xe8, 0x38, 0xf8, 0x68, 0x14, 0x78, 0x49, 0xb0, 0x70, 0x7c, 0x71, 0x96, 0x8c, 0xe3, 0x95, 0x0b,
-	0xe2, 0x78, 0x15, 0xb3, 0xf2, 0xea, 0xeb, 0xd0, 0x12, 0x48, 0xc7, 0xc6, 0xd6, 0x78, 0xfe, 0xab,
-	0x9c, 0xe4, 0xbf, 0xba, 0xbf, 0xac, 0x40, 0x4d, 0xcc, 0x29, 0x50, 0x84, 0x33, 0x3c, 0x8b, 0x2f,
-	0x6f, 0x4e, 0x92, 0x64, 0x34, 0xde, 0xe1, 0x08, 0x90, 0xf3, 0xab, 0xb7, 0x92, 0xe8, 0x7a, 0x35,
-	0x15, 0x5d, 0xbf, 0x0a, 0x42, 0x3b, 0x84, 0x71, 0x4f, 0x45, 0x5c, 0x92, 0x0e, 0xf1, 0x05, 0x2c,
-	0x22, 0x61, 0x20, 0x05, 0x9b, 0x6c, 0xa5, 0xcc, 0xfb, 0xfa, 0xf9, 0xe6, 0x7d, 0x52, 0x3e, 0xd0,
-	0x98, 0x50, 0x3e, 0xf0, 0x15, 0x95, 0x1c, 0x5a, 0xdf, 0x00, 0xf1, 0x91, 0x2f, 0x4f, 0xba, 0xc9,
-	0x60, 0xbe, 0x9d, 0x21, 0xae, 0xe6, 0x0a, 0xa7, 0x19, 0x69, 0x06, 0xb9, 0x02, 0x4d, 0x82, 0x06,
-	0x98, 0xb8, 0x14, 0x9d, 0x72, 0x09, 0x5e, 0x76, 0x1a, 0xbc, 0xe3, 0x00, 0x9d, 0x76, 0xff, 0xb4,
-	0x04, 0x4d, 0x9d, 0x15, 0x65, 0xbc, 0x14, 0xe1, 0xb8, 0x8f, 0xa5, 0xc1, 0x5b, 0x72, 0x54, 0xd3,
-	0x7a, 0x07, 0xe6, 0xe5, 0xa3, 0x9b, 0x11, 0xba, 0x1d, 0xd9, 0xaf, 0xdd, 0xf3, 0x6b, 0x00, 0x47,
-	0xfe, 0xa9, 0x9b, 0x2a, 0x3e, 0x6c, 0x1e, 0xf9, 0xa7, 0xd2, 0x71, 0xb9, 0x01, 0xb3, 0x6c, 0x38,
-	0x53, 0x83, 0xd8, 0x3a, 0xf2, 0x4f, 0xb5, 0x7b, 0xfe, 0x08, 0x9a, 0x9f, 0xfb, 0x81, 0x84, 0xbf,
-	0x4c, 0x1d, 0xe3, 0x1f, 0x97, 0xa1, 0xb6, 0x8b, 0xf1, 0x3e, 0xa6, 0xd6, 0x63, 0x68, 0x31, 0x1f,
-	0x4c, 0x4c, 0x12, 0x4e, 0x9a, 0xf9, 0xfd, 0x94, 0x80, 0xda, 0xd4, 0xaf, 0x93, 0x59, 0x74, 0x18,
-	0xea, 0x0e, 0xeb, 0x63, 0x98, 0x37, 0x14, 0x82, 0xdb, 0x0f, 0x89, 0xb2, 0xbf, 0xad, 0x4c, 0xa9,
-	0xea, 0x2e, 0xc6, 0x4e, 0x87, 0x9a, 0x8a, 0x80, 0x50, 0xeb, 0x11, 0x2c, 0xa8, 0x8f, 0x79, 0x44,
-	0xed, 0x3f, 0xd3, 0x39, 0xf5, 0xb1, 0xf3, 0xe7, 0x53, 0xc0, 0xbb, 0x18, 0xaf, 0x7f, 0x0c, 0x9d,
-	0xcc, 0xf2, 0xce, 0x0b, 0xa5, 0x96, 0xcc, 0x50, 0xea, 0x1f, 0x96, 0x01, 0x34, 0x7a, 0x92, 0xbb,
-	0xae, 0x57, 0xa0, 0x99, 0xb5, 0x57, 0x1b, 0x43, 0x65, 0xa8, 0x26, 0x9f, 0x92, 0x57, 0x52, 0x9f,
-	0x92, 0x5f, 0x03, 0x60, 0xca, 0xde, 0x3d, 0x8c, 0x51, 0xa0, 0xe2, 0x1a, 0x4d, 0xd6, 0xf3, 0x84,
-	0x75, 0x58, 0x37, 0xa1, 0x7a, 0x84, 0xb1, 0x22, 0x76, 0x27, 0x43, 0x6c, 0x87, 0x0f, 0x9a, 0x85,
-	0xc4, 0xb5, 0x54, 0x21, 0xf1, 0x1b, 0xc4, 0x42, 0x53, 0xb2, 0xb3, 0x91, 0x91, 0x9d, 0xbb, 0x30,
-	0x97, 0xd0, 0xe1, 0x33, 0x9f, 0x30, 0x1f, 0xba, 0x95, 0x54, 0x14, 0x10, 0x19, 0x55, 0x5c, 0xcc,
-	0x1f, 0x0a, 0x71, 0x80, 0xe8, 0xe7, 0xee, 0x5f, 0x95, 0x60, 0x69, 0xdb, 0xf3, 0x8c, 0x51, 0x59,
-	0xb8, 0x93, 0x22, 0x65, 0x69, 0x2c, 0x29, 0xcb, 0x13, 0x48, 0x59, 0xf9, 0x8d, 0x92, 0xb2, 0xfb,
-	0x17, 0x25, 0x58, 0xfa, 0x2e, 0xa6, 0x5f, 0xcd, 0x52, 0xc7, 0xc9, 0x6a, 0xf3, 0xa2, 0xce, 0x64,
-	0x2e, 0x6a, 0x04, 0x0b, 0x3b, 0x68, 0xd0, 0x1f, 0x0d, 0xd8, 0x01, 0xee, 0x62, 0xcc, 0x53, 0xb8,
-	0x4c, 0x80, 0x24, 0x15, 0x1e, 0x25, 0x29, 0x40, 0x74, 0xd1, 0x06, 0x13, 0x20, 0x18, 0x67, 0xc5,
-	0x50, 0xeb, 0x08, 0x63, 0x33, 0x71, 0xc8, 0x40, 0x74, 0x4a, 0xac, 0xe9, 0xd4, 0x8f, 0x30, 0xff,
-	0xa8, 0xa8, 0xfb, 0x5f, 0x25, 0xb8, 0x5a, 0xa8, 0x90, 0x3f, 0xf1, 0x09, 0x0d, 0xe3, 0xb3, 0xe9,
-	0x3f, 0xcd, 0x7c, 0x0a, 0x69, 0xcb, 0x42, 0x1a, 0x27, 0xe7, 0x7d, 0x07, 0x90, 0x31, 0x47, 0xd2,
-	0x5c, 0x5f, 0x9d, 0x86, 0xeb, 0xc7, 0x95, 0xe4, 0x77, 0x7f, 0x55, 0x82, 0xf9, 0x9d, 0x11, 0xa1,
-	0xe1, 0x90, 0x35, 0x99, 0x31, 0x24, 0xca, 0xb3, 0xcd, 0xfd, 0x94, 0x72, 0xfb, 0x49, 0x7b, 0xa7,
-	0xe5, 0xac, 0x77, 0xaa, 0xfc, 0x8c, 0x4a, 0xda, 0xcf, 0x10, 0xd2, 0xa7, 0x6a, 0x24, 0x72, 0xd8,
-	0xc1, 0xeb, 0x6a, 0x68, 0xe9, 0xc3, 0xab, 0xf6, 0x1b, 0x84, 0x33, 0xba, 0x3f, 0x81, 0x05, 0xbd,
-	0xa9, 0xc8, 0x3c, 0x35, 0x91, 0x32, 0x9d, 0xe5, 0x29, 0xd3, 0x34, 0xfe, 0xf2, 0x34, 0xf8, 0xff,
-	0xae, 0x04, 0x2b, 0xea, 0x05, 0xb2, 0x8e, 0x45, 0xbd, 0xe5, 0xab, 0x28, 0x84, 0x7f, 0x93, 0x70,
-	0xf0, 0x10, 0xd6, 0xd5, 0xca, 0xf7, 0x69, 0xec, 0x07, 0xc7, 0x2f, 0xd8, 0x41, 0xa8, 0xd5, 0xeb,
-	0x53, 0x2a, 0x99, 0xa7, 0xf4, 0x06, 0x94, 0xfa, 0x75, 0x1d, 0x1a, 0xea, 0x7d, 0xb9, 0x7b, 0x93,
-	0x2e, 0x26, 0x2f, 0x67, 0x8a, 0xc9, 0xcf, 0x37, 0xfd, 0x74, 0x3e, 0xb8, 0x3a, 0xb9, 0x48, 0x7f,
-	0x66, 0x62, 0x91, 0x7e, 0x6d, 0x72, 0x91, 0x7e, 0xbd, 0xa8, 0x48, 0x5f, 0x39, 0x27, 0x0d, 0xc3,
-	0xe3, 0x4e, 0x0a, 0xf7, 0x67, 0x27, 0x16, 0xee, 0xdf, 0x86, 0x0e, 0xea, 0xf7, 0x71, 0x44, 0x5d,
-	0x9d, 0x1a, 0x17, 0x81, 0xd1, 0x39, 0xd1, 0xfd, 0x99, 0x4a, 0x90, 0x5f, 0x03, 0x10, 0xa5, 0x54,
-	0xc7, 0x58, 0xfe, 0x62, 0x41, 0xd3, 0x69, 0xf2, 0xd2, 0x29, 0xd6, 0x61, 0x7e, 0x00, 0xd0, 0x9e,
-	0xe6, 0x03, 0x80, 0x0f, 0xa0, 0xe1, 0xcb, 0x9b, 0x6e, 0xcf, 0x71, 0x9d, 0xb1, 0x66, 0x98, 0xb8,
-	0x69, 0x51, 0xe0, 0x68, 0x50, 0xc6, 0x04, 0x7e, 0xe4, 0x9e, 0x08, 0x46, 0xb1, 0x3b, 0x99, 0x5f,
-	0xbe, 0xc8, 0x5d, 0x37, 0xa7, 0xe9, 0xeb, 0x9b, 0xf7, 0x09, 0x74, 0x54, 0x39, 0x98, 0x9a, 0x3f,
-	0x9f, 0x31, 0xb2, 0x8a, 0x6f, 0x93, 0x33, 0x87, 0xd2, 0xb7, 0xeb, 0x7b, 0x30, 0x27, 0xa8, 0xa8,
-	0x11, 0x2d, 0x64, 0x4a, 0xad, 0xc6, 0x33, 0xb7, 0xd3, 0x16, 0x53, 0x15, 0xae, 0x1f, 0xc1, 0x6a,
-	0xe6, 0x1c, 0x34, 0x52, 0xeb, 0xe2, 0x48, 0x97, 0xd3, 0x87, 0xa6, 0x90, 0x7f, 0xc7, 0xa8, 0xca,
-	0x59, 0x1c, 0xb3, 0xd7, 0x0b, 0x16, 0xe5, 0x2c, 0x5d, 0xde, 0x7b, 0x58, 0xfe, 0xca, 0x8a, 0x72,
-	0xbe, 0x0b, 0x8b, 0x07, 0xe1, 0x4b, 0x1c, 0xf0, 0x6f, 0x1c, 0xf9, 0x3d, 0xe3, 0x92, 0xa3, 0x58,
-	0x9e, 0x98, 0x52, 0xbf, 0x9c, 0x96, 0xfa, 0x29, 0x44, 0xfc, 0xc7, 0x8a, 0x2e, 0x8b, 0xe8, 0x0e,
-	0xcc, 0x6b, 0x44, 0xbd, 0x68, 0x02, 0x96, 0xee, 0xfb, 0xb0, 0xa4, 0x21, 0x3f, 0xe3, 0x2c, 0x32,
-	0x09, 0xfa, 0x16, 0xcc, 0x69, 0xe8, 0x49, 0x70, 0x3f, 0xaf, 0x42, 0x53, 0x03, 0xe6, 0x44, 0xdf,
-	0x96, 0xf9, 0x55, 0xb5, 0x79, 0x75, 0x0b, 0xa8, 0xa8, 0x04, 0xdb, 0x96, 0x92, 0x58, 0xd5, 0x71,
-	0x73, 0x12, 0x82, 0x29, 0x79, 0xf6, 0x9e, 0x14, 0x54, 0x42, 0x7d, 0xae, 0xe6, 0xa7, 0x08, 0x68,
-	0xf5, 0xe1, 0x35, 0x93, 0x60, 0xc2, 0x9c, 0x5e, 0xcb, 0x83, 0x4a, 0x2a, 0x72, 0xe1, 0xf6, 0x81,
-	0x16, 0x6e, 0x22, 0x4a, 0x7b, 0x2d, 0x0f, 0x6e, 0x90, 0xb2, 0xe8, 0xa3, 0xa5, 0xe6, 0x65, 0x3f,
-	0x5a, 0xca, 0x16, 0xb9, 0xe9, 0x17, 0x4e, 0xfa, 0x68, 0xc9, 0x10, 0xa4, 0xad, 0xac, 0x20, 0x2d,
-	0x10, 0xc8, 0xb3, 0x45, 0x02, 0xf9, 0xcd, 0x6e, 0xc8, 0x2e, 0xac, 0xf0, 0x95, 0xee, 0x63, 0x4a,
-	0xfd, 0xe0, 0x98, 0x38, 0x98, 0x8e, 0xe2, 0xe0, 0x8b, 0x78, 0xc0, 0x4c, 0x06, 0xf5, 0xfb, 0x1b,
-	0xd2, 0x64, 0x90, 0x4d, 0xfe, 0x7d, 0x67, 0xa2, 0x1a, 0xf9, 0x73, 0xf7, 0x07, 0xb0, 0x90, 0xc2,
-	0xc3, 0xed, 0x61, 0x59, 0xaa, 0x58, 0x4a, 0x4a, 0x15, 0x13, 0x53, 0x7b, 0xe6, 0xc2, 0x3e, 0xf1,
-	0xdf, 0x57, 0xa0, 0x9d, 0xc2, 0x7d, 0x9e, 0xa1, 0xf7, 0x3b, 0x00, 0x31, 0xdf, 0x86, 0x3b, 0x8a,
-	0x07, 0xd2, 0xa8, 0xbd, 0x9e, 0x3e, 0x98, 0xdc, 0x76, 0x9d, 0x66, 0xac, 0x77, 0x3e, 0xe9, 0xd7,
-	0xdd, 0xc6, 0x6d, 0x20, 0xff, 0xf3, 0x6c, 0xb5, 0xa2, 0x9f, 0x67, 0xbb, 0xaf, 0xca, 0x85, 0xeb,
-	0x19, 0x4d, 0x95, 0x23, 0x9e, 0x2a, 0x1b, 0xce, 0x14, 0x72, 0x36, 0xf2, 0x85, 0x9c, 0x37, 0x60,
-	0x56, 0xff, 0x06, 0x8c, 0xef, 0x31, 0x16, 0xae, 0x30, 0x10, 0xd5, 0xd7, 0xf3, 0x88, 0xf5, 0x38,
-	0xc7, 0xa8, 0x5f, 0x2b, 0x7e, 0xf3, 0x38, 0x66, 0x7d, 0x23, 0x26, 0x7b, 0xf2, 0xe8, 0x87, 0x1f,
-	0x1f, 0xfb, 0xf4, 0x64, 0x74, 0xb8, 0xd9, 0x0f, 0x87, 0xf7, 0x22, 0x74, 0x46, 0x46, 0x11, 0x8e,
-	0xf5, 0xc3, 0x5d, 0xb9, 0x94, 0xbb, 0x04, 0xc7, 0xaf, 0x58, 0xff, 0xcb, 0x63, 0xf1, 0x73, 0x80,
-	0xea, 0x37, 0x03, 0x0f, 0x6b, 0xbc, 0xf9, 0xe0, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x38, 0xf7,
-	0xab, 0xdc, 0x4d, 0x50, 0x00, 0x00,
+	// 7727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x92, 0x18, 0xfa, 0xdf, 0x1d, 0xcd, 0xee, 0x26, 0x8b, 0x14, 0x55, 0xa4, 0xa4, 0x11, 0xa7, 0x67,
+	0xa4, 0xd1, 0x7c, 0x24, 0xcd, 0xa3, 0xe6, 0xaf, 0x91, 0x67, 0x28, 0x4a, 0x7a, 0xd3, 0x3b, 0xa3,
+	0x19, 0xa2, 0xc4, 0x91, 0xbd, 0x6f, 0xbd, 0x5b, 0x48, 0x76, 0x25, 0xc9, 0x7a, 0xea, 0xae, 0xaa,
+	0x57, 0x55, 0x4d, 0x91, 0xe3, 0x8b, 0x0f, 0x0b, 0xf8, 0x03, 0xef, 0x65, 0x61, 0xbf, 0x8b, 0x01,
+	0x03, 0xde, 0xa3, 0x2f, 0xbe, 0xd8, 0x86, 0x4f, 0xf6, 0xc1, 0xb0, 0x0d, 0xc3, 0x86, 0x7d, 0x30,
+	0x6c, 0x5f, 0x0c, 0xc3, 0x58, 0xc3, 0x06, 0x7c, 0xf2, 0xc9, 0x67, 0x1b, 0x91, 0xbf, 0xca, 0xfa,
+	0x74, 0xb3, 0x9b, 0xb4, 0xdf, 0xc3, 0x5e, 0xa4, 0xce, 0xc8, 0xc8, 0xa8, 0xcc, 0xc8, 0xc8, 0xc8,
+	0x88, 0xc8, 0xc8, 0x24, 0x5c, 0x39, 0x70, 0x47, 0x23, 0xd7, 0x3b, 0xba, 0x2f, 0xfe, 0xbf, 0x17,
+	0x84, 0x7e, 0xec, 0x1b, 0x0d, 0x51, 0xdc, 0xbc, 0x79, 0xe4, 0xfb, 0x47, 0x23, 0x7a, 0x9f, 0x81,
+	0x0f, 0x26, 0x87, 0xf7, 0x63, 0x77, 0x4c, 0xa3, 0x98, 0x8c, 0x03, 0x8e, 0xd9, 0xbf, 0x0d, 0xd5,
+	0xef, 0xc9, 0x98, 0x1a, 0x5d, 0x28, 0x53, 0xcf, 0x2c, 0x6d, 0x95, 0xee, 0xb4, 0xac, 0x32, 0xf5,
+	0xb0, 0x1c, 0x4e, 0xcc, 0x32, 0x2f, 0x87, 0x93, 0xfe, 0x1f, 0x03, 0x18, 0x3f, 0x84, 0x0e, 0x0d,
+	0x77, 0x43, 0x4a, 0x62, 0x6a, 0xd1, 0x5f, 0x4d, 0x68, 0x14, 0x1b, 0x37, 0x00, 0x82, 0xd0, 0xff,
+	0x25, 0x1d, 0xc6, 0xb6, 0xeb, 0x88, 0xe6, 0x2d, 0x01, 0x19, 0x38, 0xc6, 0x75, 0x68, 0x45, 0xee,
+	0x91, 0x47, 0xe2, 0x49, 0x48, 0x05, 0xb1, 0x04, 0x60, 0xac, 0x43, 0x9d, 0x8c, 0xfd, 0x89, 0x17,
+	0x9b, 0x95, 0xad, 0xd2, 0x9d, 0x92, 0x25, 0x4a, 0xc6, 0x26, 0x34, 0x87, 0x93, 0x30, 0xa4, 0xde,
+	0xf0, 0xcc, 0xac, 0xb2, 0x46, 0xaa, 0x6c, 0x98, 0xd0, 0x20, 0xc3, 0x21, 0x6b, 0x54, 0x63, 0x55,
+	0xb2, 0x68, 0x6c, 0x40, 0xd3, 0xc7, 0x0e, 0x62, 0x47, 0xea, 0xbc, 0x8a, 0x95, 0x07, 0x8e, 0xb1,
+	0x05, 0x6d, 0x87, 0x46, 0xc3, 0xd0, 0x0d, 0x62, 0xd7, 0xf7, 0xcc, 0x06, 0xab, 0xd5, 0x41, 0xc6,
+	0x2d, 0xe8, 0x06, 0xe4, 0x6c, 0x4c, 0xbd, 0xd8, 0x1e, 0xd3, 0xf8, 0xd8, 0x77, 0xcc, 0x26, 0x43,
+	0xea, 0x08, 0xe8, 0x73, 0x06, 0xc4, 0xe1, 0x4e, 0xc2, 0x91, 0x7d, 0x42, 0x43, 0xf7, 0xf0, 0xcc,
+	0x6c, 0xf1, 0x01, 0x4d, 0xc2, 0xd1, 0x4b, 0x06, 0x90, 0xd5, 0x9e, 0x1f, 0x63, 0x35, 0xa8, 0xea,
+	0xef, 0x19, 0xc0, 0xb8, 0x09, 0x6d, 0xac, 0x8e, 0x26, 0xc3, 0x21, 0x8d, 0x22, 0xb3, 0xcd, 0xea,
+	0xb1, 0xc5, 0x0b, 0x0e, 0xc1, 0x21, 0x20, 0xc2, 0x21, 0x71, 0x47, 0xe6, 0x12, 0x1f, 0xc2, 0x24,
+	0x1c, 0x3d, 0x23, 0xee, 0x08, 0xdb, 0x06, 0xe4, 0x8c, 0x86, 0x36, 0x1d, 0x63, 0x6d, 0x87, 0xb7,
+	0x65, 0xa0, 0xa7, 0xe3, 0x14, 0x42, 0x70, 0xec, 0x7b, 0xd4, 0xec, 0x6a, 0x08, 0x7b, 0x08, 0x41,
+	0x6e, 0x87, 0xf4, 0x08, 0xc7, 0xdf, 0x63, 0x75, 0xa2, 0x84, 0x1f, 0xe5, 0x0d, 0xdd, 0xc0, 0x5c,
+	0xe6, 0x1f, 0x65, 0xe5, 0x41, 0x60, 0x7c, 0x09, 0x35, 0x3f, 0x3e, 0xa6, 0xa1, 0xb9, 0xb2, 0x55,
+	0xb9, 0xd3, 0xde, 0xbe, 0x7d, 0x4f, 0x4a, 0x59, 0x5e, 0x12, 0xee, 0xfd, 0x80, 0x88, 0x4f, 0xbd,
+	0x38, 0x3c, 0xb3, 0x78, 0x23, 0x63, 0x00, 0x10, 0x92, 0xd7, 0x76, 0x40, 0x42, 0x32, 0x8e, 0x4c,
+	0x83, 0x91, 0x78, 0x6f, 0x16, 0x09, 0x8b, 0xbc, 0xde, 0x63, 0xc8, 0x9c, 0x4c, 0x2b, 0x94, 0x65,
+	0xec, 0x23, 0x92, 0x3a, 0xf0, 0x9d, 0x33, 0x73, 0x95, 0xf7, 0x31, 0x24, 0xaf, 0x1f, 0xfb, 0xce,
+	0x99, 0x71, 0x15, 0x1a, 0x6e, 0x64, 0xff, 0x32, 0xf2, 0x3d, 0x73, 0x6d, 0xab, 0x74, 0xa7, 0x69,
+	0xd5, 0xdd, 0xe8, 0x77, 0x22, 0xdf, 0x43, 0x29, 0x1a, 0x11, 0xef, 0x68, 0x42, 0x8e, 0xa8, 0x79,
+	0x85, 0x4b, 0x91, 0x2c, 0x63, 0x5d, 0x10, 0xfa, 0xce, 0x64, 0x18, 0x47, 0xe6, 0xfa, 0x56, 0x05,
+	0xeb, 0x64, 0xd9, 0x78, 0x0a, 0xcd, 0x31, 0x8d, 0x89, 0x43, 0x62, 0x62, 0x5e, 0x65, 0x9d, 0x7e,
+	0x77, 0x56, 0xa7, 0x9f, 0x0b, 0x5c, 0xde, 0x67, 0xd5, 0xd4, 0xf8, 0x3d, 0x58, 0x0e, 0x42, 0xf7,
+	0x84, 0xc4, 0xd4, 0x56, 0xe4, 0x4c, 0x46, 0xee, 0xc3, 0x59, 0xe4, 0xf6, 0x78, 0x9b, 0x34, 0xd5,
+	0x5e, 0x90, 0x86, 0x1a, 0x6b, 0x50, 0x8b, 0xfd, 0x57, 0xd4, 0x33, 0x37, 0xd8, 0xc0, 0x78, 0xc1,
+	0xb8, 0x0d, 0xd5, 0x49, 0x44, 0x43, 0x73, 0x73, 0xab, 0x74, 0xa7, 0xbd, 0x6d, 0xa4, 0x3f, 0xf3,
+	0x63, 0x44, 0x43, 0x8b, 0xd5, 0xa3, 0xb0, 0x93, 0x49, 0x7c, 0xec, 0x87, 0xee, 0x4f, 0xd4, 0xf6,
+	0xbd, 0xd1, 0x99, 0x79, 0x8d, 0x71, 0xae, 0xa3, 0xa0, 0x3f, 0x78, 0xa3, 0x33, 0xe3, 0x1d, 0xe8,
+	0xb9, 0x0e, 0x1d, 0x07, 0x7e, 0x8c, 0x2b, 0xcf, 0x7e, 0x45, 0xcf, 0xcc, 0xeb, 0xec, 0x73, 0x5d,
+	0x0d, 0xfc, 0x2d, 0x3d, 0xdb, 0xfc, 0x0c, 0x20, 0x99, 0x7d, 0x63, 0x19, 0x2a, 0x88, 0xca, 0x75,
+	0x01, 0xfe, 0xc4, 0xde, 0x9e, 0x90, 0xd1, 0x44, 0x6a, 0x00, 0x5e, 0xf8, 0xa2, 0xfc, 0x59, 0x69,
+	0xf3, 0x4b, 0xe8, 0xa6, 0x27, 0x7d, 0xa1, 0xd6, 0x0f, 0xa1, 0x93, 0xe2, 0xd3, 0x42, 0x8d, 0x1f,
+	0xc3, 0x5a, 0x11, 0xaf, 0x17, 0xa1, 0xd1, 0xff, 0x4f, 0x00, 0x8d, 0x3d, 0xae, 0xec, 0x50, 0x61,
+	0x2a, 0x0d, 0x58, 0x76, 0x1d, 0x5c, 0x8f, 0x63, 0x1a, 0x0e, 0x8f, 0x89, 0xc7, 0x54, 0x23, 0x6f,
+	0x0b, 0x12, 0x34, 0x70, 0x8c, 0x7b, 0x50, 0xf5, 0xc8, 0x98, 0x9a, 0x15, 0x26, 0x14, 0x9b, 0x6a,
+	0xb6, 0x04, 0xc1, 0x7b, 0xa8, 0x96, 0xf9, 0xf4, 0x33, 0x3c, 0xec, 0x86, 0x3b, 0x46, 0x61, 0xe6,
+	0x2a, 0x91, 0x17, 0x8c, 0xf7, 0x61, 0x65, 0x48, 0x46, 0xa3, 0x03, 0x32, 0x7c, 0x65, 0x2b, 0xa5,
+	0xc9, 0x35, 0xe3, 0xb2, 0xac, 0xd8, 0x15, 0xf0, 0x14, 0x32, 0x53, 0xff, 0x43, 0x7f, 0x64, 0xd6,
+	0xd3, 0xc8, 0x7b, 0x02, 0x6e, 0x7c, 0x0e, 0x1b, 0x43, 0x26, 0x9a, 0x36, 0x57, 0xab, 0x64, 0x34,
+	0xf2, 0x5f, 0x53, 0xc7, 0x9e, 0x84, 0xa3, 0xc8, 0x6c, 0xb0, 0x45, 0xb3, 0xce, 0x11, 0x98, 0x7c,
+	0xed, 0xf0, 0xea, 0x1f, 0xc3, 0x51, 0x84, 0x4d, 0x19, 0xb6, 0xed, 0x9c, 0x79, 0x64, 0xec, 0x0e,
+	0x85, 0x46, 0xe4, 0x4d, 0x9b, 0x4c, 0xd6, 0xd6, 0x19, 0xc2, 0x13, 0x5e, 0xcf, 0xf5, 0x23, 0x6b,
+	0xfa, 0x08, 0xae, 0xa5, 0x9b, 0x86, 0xd4, 0x71, 0x43, 0xdc, 0x5f, 0x58, 0xe3, 0x16, 0x6b, 0x6c,
+	0xea, 0x8d, 0x2d, 0x81, 0xc0, 0x9a, 0xbf, 0x03, 0xbd, 0x91, 0x3b, 0x76, 0xe3, 0x28, 0x61, 0x06,
+	0x57, 0xc3, 0x5d, 0x0e, 0x56, 0xac, 0xf8, 0x00, 0x8c, 0xb1, 0xeb, 0xd9, 0x52, 0xe9, 0x8b, 0x7d,
+	0xa8, 0xcd, 0xf6, 0xa1, 0xe5, 0xb1, 0xeb, 0xed, 0xf1, 0x8a, 0x1d, 0x06, 0x67, 0xd8, 0xe4, 0x34,
+	0x8b, 0xbd, 0x24, 0xb0, 0xc9, 0x69, 0x1a, 0xfb, 0x2d, 0xe8, 0x88, 0x01, 0x33, 0x65, 0x1d, 0x99,
+	0x1d, 0xc6, 0xad, 0x25, 0x0e, 0x64, 0xea, 0x3a, 0x32, 0x3e, 0x84, 0x35, 0x37, 0xb2, 0xa5, 0xd6,
+	0xb1, 0x87, 0xc7, 0x74, 0xf8, 0xca, 0x9f, 0xc4, 0x4c, 0x71, 0x37, 0x2d, 0xc3, 0x8d, 0xf6, 0x44,
+	0xd5, 0xae, 0xa8, 0xc1, 0xdd, 0x25, 0xa2, 0xc3, 0x90, 0xc6, 0x6c, 0x29, 0xf6, 0xc4, 0x6e, 0xca,
+	0x20, 0xdf, 0xd2, 0x33, 0xe3, 0x2e, 0x18, 0x6a, 0x6b, 0xb5, 0x43, 0xfa, 0xab, 0x89, 0x1b, 0x52,
+	0x87, 0x69, 0xf4, 0xa6, 0xb5, 0xa2, 0x6a, 0x2c, 0x51, 0x61, 0xbc, 0x07, 0x2b, 0x11, 0xf5, 0x1c,
+	0x5b, 0xef, 0xa9, 0xb9, 0xc2, 0xb0, 0x7b, 0x58, 0xf1, 0x7d, 0xd2, 0x59, 0xc4, 0xc5, 0x7d, 0x89,
+	0xf5, 0xd1, 0x96, 0xdb, 0xaf, 0xc1, 0x3a, 0xd0, 0x9b, 0x84, 0x23, 0xd6, 0xc3, 0x1d, 0x0e, 0x36,
+	0xee, 0xc1, 0x2a, 0xe2, 0x06, 0xa1, 0x8f, 0x5b, 0x9a, 0x64, 0x99, 0xd0, 0xda, 0x48, 0x66, 0x8f,
+	0xd7, 0x08, 0x96, 0x49, 0xda, 0x6a, 0x9a, 0xd9, 0xe6, 0xb7, 0xa6, 0x68, 0xcb, 0xd9, 0x65, 0x9b,
+	0xe0, 0x87, 0xb0, 0x96, 0xc2, 0x95, 0x3b, 0x29, 0x57, 0xef, 0x86, 0x86, 0x2e, 0x77, 0xd4, 0x75,
+	0xa8, 0x47, 0x31, 0x89, 0x27, 0xa8, 0xe6, 0x4b, 0x77, 0x6a, 0x96, 0x28, 0x19, 0x9f, 0x03, 0x70,
+	0xd9, 0x75, 0x6c, 0x12, 0x9b, 0x57, 0x99, 0xc2, 0xdc, 0xbc, 0xc7, 0x8d, 0xa5, 0x7b, 0xd2, 0x58,
+	0xba, 0xb7, 0x2f, 0x8d, 0x25, 0xab, 0x25, 0xb0, 0x77, 0x62, 0x6c, 0x3a, 0x09, 0x1c, 0xd9, 0xd4,
+	0x3c, 0xbf, 0xa9, 0xc0, 0xde, 0x89, 0x99, 0x95, 0xa1, 0x26, 0x9c, 0x31, 0x71, 0x83, 0xf5, 0xaa,
+	0x23, 0xa1, 0xbb, 0x8c, 0x85, 0x1f, 0xc1, 0x7a, 0x8a, 0xd5, 0xc9, 0x6c, 0x6e, 0xb2, 0xf9, 0x59,
+	0x1b, 0x6a, 0x0c, 0x57, 0x13, 0x6a, 0x42, 0xe3, 0x84, 0x86, 0x11, 0x6e, 0xf0, 0xa8, 0xce, 0x2b,
+	0x96, 0x2c, 0xe2, 0x72, 0xe4, 0x4b, 0x98, 0x9e, 0x06, 0x6e, 0x48, 0xed, 0xa3, 0x90, 0x0c, 0xa9,
+	0x1d, 0xd0, 0xd0, 0xf5, 0x1d, 0xa6, 0xd2, 0x2b, 0xd6, 0x3a, 0x43, 0x78, 0xca, 0xea, 0x7f, 0x8e,
+	0xd5, 0x7b, 0xac, 0x16, 0x97, 0xa3, 0x14, 0x7a, 0xce, 0xb9, 0x74, 0xe3, 0x1b, 0xac, 0xb1, 0x29,
+	0x50, 0x5e, 0x30, 0x0c, 0xad, 0xf9, 0xe6, 0xa7, 0xd0, 0x52, 0x6a, 0x6c, 0x21, 0xcd, 0xfa, 0xa7,
+	0x15, 0x58, 0x12, 0x8a, 0x90, 0x69, 0x97, 0xc5, 0xd5, 0xeb, 0x83, 0x94, 0x7a, 0xbd, 0x99, 0x55,
+	0xaf, 0x8c, 0x6a, 0x4e, 0xc7, 0x66, 0x2c, 0xb4, 0xea, 0x4c, 0x0b, 0xad, 0x96, 0xb6, 0xd0, 0x72,
+	0xab, 0xbe, 0x5e, 0xb0, 0xea, 0xd3, 0x6b, 0xb8, 0x91, 0x5d, 0xc3, 0x85, 0x8b, 0xb2, 0xb9, 0xc0,
+	0xa2, 0x6c, 0x2d, 0xb4, 0x28, 0x61, 0xda, 0xa2, 0x2c, 0xdc, 0x28, 0xda, 0xc5, 0x1b, 0xc5, 0xc5,
+	0x27, 0xf9, 0xd7, 0x25, 0xe8, 0x3d, 0x17, 0x33, 0xb6, 0xeb, 0x7b, 0x31, 0x19, 0xc6, 0xc6, 0x63,
+	0x00, 0x65, 0x85, 0xf0, 0xf9, 0x6e, 0x6f, 0xf7, 0xd5, 0xe4, 0x65, 0xb0, 0x77, 0x14, 0xa6, 0xa5,
+	0xb5, 0x32, 0xbe, 0x82, 0x56, 0x4c, 0x87, 0xc7, 0x9e, 0x3b, 0x24, 0x23, 0xf6, 0xd5, 0xf6, 0xf6,
+	0x9b, 0xd3, 0x48, 0xec, 0x4b, 0x44, 0x2b, 0x69, 0xd3, 0xff, 0x05, 0x98, 0xd3, 0xd0, 0x0c, 0x43,
+	0xc8, 0x15, 0x1f, 0xa1, 0xda, 0x9a, 0xf9, 0x54, 0x89, 0x21, 0xb2, 0x02, 0x42, 0xb9, 0x2d, 0x5e,
+	0xe1, 0x50, 0x56, 0xe8, 0xbf, 0x86, 0x8d, 0xa9, 0xa3, 0xb8, 0x2c, 0x71, 0x66, 0xd7, 0xfa, 0x91,
+	0xcb, 0xbc, 0x1c, 0xe1, 0x39, 0xc9, 0x72, 0xff, 0x9f, 0x6b, 0xdc, 0x7e, 0x4c, 0xbc, 0x57, 0xae,
+	0x77, 0x64, 0xdc, 0xd5, 0x3c, 0x2d, 0xce, 0xeb, 0x15, 0xc5, 0x28, 0xb9, 0x55, 0x6a, 0xce, 0x97,
+	0xec, 0x5e, 0x59, 0xeb, 0x1e, 0x3a, 0x64, 0x8e, 0x13, 0xe2, 0x72, 0xa9, 0x08, 0x87, 0x8c, 0x17,
+	0x99, 0x99, 0x29, 0x14, 0x98, 0x37, 0x19, 0x1f, 0xd0, 0x50, 0x74, 0xa9, 0x23, 0xa0, 0xdf, 0x33,
+	0x20, 0x8e, 0x24, 0x7a, 0xed, 0x1e, 0x4a, 0x7f, 0x8e, 0x17, 0x90, 0xac, 0x43, 0x63, 0xb1, 0x8e,
+	0x18, 0x59, 0x51, 0xec, 0xff, 0x45, 0x30, 0xe4, 0x30, 0xbe, 0x23, 0x51, 0xbc, 0x47, 0xce, 0x70,
+	0x73, 0xbc, 0x07, 0x55, 0xd4, 0xb2, 0x66, 0xe9, 0x5c, 0x7d, 0xcc, 0xf0, 0x34, 0xdf, 0xb3, 0xac,
+	0xfb, 0x9e, 0xfd, 0x8f, 0x60, 0x49, 0x52, 0xff, 0x31, 0x2a, 0xd0, 0x3b, 0x85, 0xb3, 0xd1, 0xff,
+	0x3f, 0x00, 0x4d, 0xd9, 0x2c, 0xd7, 0xe4, 0x5d, 0x61, 0x96, 0x73, 0x49, 0xbc, 0x92, 0x93, 0x44,
+	0xcd, 0x32, 0x97, 0x0c, 0xae, 0x6a, 0x0c, 0x7e, 0x17, 0x96, 0xc9, 0x28, 0xa6, 0xa1, 0x47, 0x62,
+	0xf7, 0x84, 0xda, 0xac, 0x9e, 0xb3, 0xaa, 0xa7, 0xc1, 0xbf, 0x17, 0x73, 0xf1, 0x9a, 0x1e, 0x44,
+	0x6e, 0x4c, 0x25, 0xd3, 0x44, 0xd1, 0x78, 0x0f, 0x1a, 0x8c, 0xe7, 0x21, 0x57, 0x3a, 0xed, 0xed,
+	0xe5, 0x64, 0x9e, 0x39, 0xdc, 0x92, 0x08, 0x6c, 0x42, 0x62, 0xe4, 0x65, 0x53, 0x4c, 0x08, 0x16,
+	0x70, 0x61, 0xff, 0xe4, 0x06, 0x42, 0xc1, 0xe0, 0x4f, 0xec, 0xec, 0xd0, 0x8d, 0xa5, 0x81, 0xc5,
+	0x7e, 0xeb, 0xd2, 0xd0, 0x4e, 0x4b, 0xc3, 0x5d, 0x30, 0xc4, 0x4f, 0x9b, 0x38, 0x0e, 0x13, 0x49,
+	0x22, 0xbd, 0xdc, 0x15, 0x51, 0xb3, 0xa3, 0x2a, 0x8c, 0xfb, 0xb0, 0x8a, 0xfe, 0x69, 0x14, 0x87,
+	0x04, 0x21, 0x52, 0x82, 0xb8, 0xdf, 0x6b, 0xe8, 0x55, 0x42, 0x8c, 0xae, 0x40, 0x3d, 0x26, 0xa7,
+	0xb8, 0x17, 0x70, 0xd7, 0xb7, 0x16, 0x93, 0xd3, 0x81, 0x63, 0x7c, 0x04, 0xcd, 0x21, 0x5f, 0x66,
+	0x11, 0x33, 0x99, 0xda, 0xdb, 0xe6, 0x34, 0x55, 0x60, 0x29, 0x4c, 0x63, 0x1b, 0x1a, 0x07, 0x7c,
+	0x89, 0x98, 0xcb, 0x53, 0x1a, 0x89, 0x25, 0x64, 0x49, 0x44, 0xcd, 0xd4, 0x58, 0x99, 0x61, 0x6a,
+	0x18, 0x17, 0x37, 0x35, 0x56, 0x17, 0x31, 0x35, 0x9e, 0xc0, 0xf2, 0xa1, 0x1b, 0x46, 0x71, 0x62,
+	0xb3, 0xc6, 0xe6, 0xda, 0xb9, 0x04, 0xba, 0xac, 0x8d, 0xb4, 0x66, 0x63, 0xe3, 0x6d, 0xe8, 0xba,
+	0x91, 0x7d, 0x42, 0x62, 0x9b, 0x7a, 0xe4, 0x60, 0x44, 0x1d, 0x66, 0x6a, 0x35, 0xad, 0x25, 0x37,
+	0x7a, 0x49, 0xe2, 0xa7, 0x1c, 0x66, 0x7c, 0x0d, 0x37, 0x5c, 0x34, 0x68, 0xc6, 0x63, 0x37, 0x42,
+	0x83, 0xc3, 0x8e, 0x7d, 0x1b, 0xc5, 0x59, 0x35, 0x5a, 0x67, 0x8d, 0x36, 0xdc, 0x68, 0x57, 0xe1,
+	0xec, 0xfb, 0x28, 0xf6, 0x92, 0xc2, 0x47, 0xb0, 0x7e, 0x4c, 0x22, 0x5b, 0xed, 0xe8, 0x49, 0xd0,
+	0xe8, 0x2a, 0xb7, 0x78, 0x8e, 0x49, 0x24, 0x19, 0xff, 0x42, 0xd6, 0xe1, 0x0e, 0x88, 0xad, 0x82,
+	0x28, 0xd0, 0x1a, 0x98, 0x7c, 0xb7, 0x3c, 0x26, 0xd1, 0x5e, 0x14, 0x24, 0xb8, 0x5f, 0x42, 0x7b,
+	0x44, 0x38, 0x3b, 0xfc, 0x09, 0xb7, 0xbb, 0xda, 0xdb, 0xd7, 0x72, 0xb3, 0x9a, 0x68, 0x14, 0x0b,
+	0x46, 0xea, 0xb7, 0x71, 0x0d, 0x5a, 0x6e, 0xc4, 0x3e, 0xa2, 0x8c, 0xb0, 0xa6, 0x1b, 0xbd, 0x60,
+	0x65, 0xe3, 0x7b, 0xe8, 0xa5, 0x63, 0x47, 0x91, 0x79, 0x9d, 0x19, 0x1d, 0xb7, 0x72, 0xe4, 0xef,
+	0xed, 0xe9, 0xe1, 0x24, 0x11, 0xe7, 0xe8, 0xa6, 0x62, 0x4c, 0x5c, 0x6f, 0x1e, 0x85, 0x94, 0x32,
+	0x8a, 0xf1, 0x59, 0x40, 0x99, 0x99, 0x55, 0xb3, 0x3a, 0x0a, 0xba, 0x7f, 0x16, 0x50, 0xe3, 0x63,
+	0xb8, 0x9a, 0xa0, 0x45, 0xf8, 0xcf, 0x89, 0x4b, 0x6c, 0xa6, 0x9b, 0xde, 0xe0, 0x4c, 0x53, 0xd5,
+	0x2f, 0xa8, 0x17, 0xbf, 0x74, 0xc9, 0x73, 0xdc, 0x38, 0x98, 0x2b, 0xe3, 0x8e, 0xec, 0x38, 0x24,
+	0x43, 0x94, 0x5b, 0x7b, 0xe4, 0x7a, 0xaf, 0xcc, 0x9b, 0x7c, 0x6f, 0xc7, 0x9a, 0x7d, 0x51, 0xf1,
+	0x9d, 0xeb, 0xbd, 0x62, 0x06, 0xc9, 0x03, 0x3b, 0xf9, 0x0e, 0xd3, 0x3e, 0x5b, 0x5c, 0xfb, 0x44,
+	0x0f, 0x76, 0x24, 0x5c, 0x6a, 0x1f, 0x69, 0x80, 0xbe, 0x99, 0x32, 0x40, 0x37, 0x09, 0xac, 0x16,
+	0x0c, 0xbc, 0xc0, 0x56, 0xf8, 0x48, 0xb7, 0x15, 0xda, 0xdb, 0x6f, 0xe4, 0x18, 0x98, 0x22, 0xa3,
+	0xdb, 0x12, 0x5f, 0xc3, 0xe6, 0x8b, 0xb3, 0x28, 0xa6, 0x63, 0x66, 0x22, 0xb9, 0x43, 0xa6, 0x1a,
+	0xb8, 0x41, 0x4a, 0x23, 0x54, 0x55, 0x87, 0xa1, 0x3f, 0x66, 0x9f, 0xaa, 0x59, 0xec, 0x37, 0xaa,
+	0xe9, 0xd8, 0x67, 0x1f, 0xaa, 0x59, 0xe5, 0xd8, 0xef, 0xff, 0xef, 0x32, 0x2c, 0xe9, 0x8d, 0x8b,
+	0x54, 0x7f, 0xec, 0xc6, 0x23, 0x65, 0xc8, 0xb0, 0x02, 0x8e, 0x7a, 0x4c, 0xa3, 0x08, 0x1d, 0x73,
+	0xb1, 0xff, 0x89, 0x62, 0xd6, 0x44, 0xad, 0xe6, 0x4c, 0xd4, 0xab, 0xd0, 0x60, 0xcb, 0xc4, 0x75,
+	0x84, 0x42, 0xaf, 0x63, 0x71, 0xe0, 0x48, 0x71, 0x63, 0xe3, 0x31, 0xeb, 0x4a, 0xdc, 0x58, 0x59,
+	0x04, 0xbc, 0x42, 0x4a, 0x1c, 0xb3, 0x21, 0x03, 0x5e, 0x16, 0x25, 0x68, 0xf6, 0x34, 0x23, 0x31,
+	0x60, 0xa6, 0xba, 0xdb, 0xdb, 0x6f, 0x29, 0xfe, 0x4d, 0xe7, 0x8d, 0xa5, 0x1a, 0x65, 0x34, 0x55,
+	0xeb, 0xe2, 0x9a, 0x0a, 0x16, 0xd0, 0x54, 0xfd, 0x31, 0x2c, 0x33, 0x63, 0x7c, 0x6f, 0x44, 0xe2,
+	0x43, 0x3f, 0x1c, 0x3f, 0xa3, 0xfa, 0xee, 0x8c, 0xec, 0x2f, 0x17, 0x46, 0x86, 0xcb, 0x99, 0xc8,
+	0xf0, 0x2d, 0xe8, 0xd2, 0xc3, 0x43, 0x3a, 0x64, 0xbb, 0x64, 0x48, 0x62, 0x3e, 0x1f, 0x65, 0xab,
+	0xa3, 0xa0, 0x16, 0x89, 0x69, 0xff, 0x10, 0x9a, 0xec, 0x73, 0xfb, 0xe4, 0x14, 0xc5, 0x82, 0xad,
+	0x2f, 0x61, 0x6e, 0xe1, 0x6f, 0x84, 0xb1, 0xc6, 0xdc, 0x2c, 0x60, 0xbf, 0x2f, 0x12, 0xa8, 0xee,
+	0xff, 0x04, 0xab, 0xec, 0x3b, 0x8f, 0xf9, 0x0c, 0xec, 0x88, 0x6d, 0xd0, 0x4c, 0x36, 0x62, 0xfe,
+	0x55, 0x59, 0x54, 0xdb, 0x69, 0x59, 0xdb, 0x4e, 0x31, 0xa8, 0xeb, 0x47, 0x31, 0x19, 0xd9, 0x43,
+	0xdf, 0x91, 0x02, 0x06, 0x1c, 0xb4, 0xeb, 0x3b, 0x34, 0xd9, 0xab, 0xab, 0xda, 0x5e, 0xdd, 0xff,
+	0xcf, 0x15, 0x68, 0xa9, 0xa0, 0x5f, 0x4e, 0x8e, 0xd7, 0xa1, 0xee, 0x1f, 0xa0, 0x0f, 0x24, 0x3e,
+	0x25, 0x4a, 0xf8, 0x31, 0x7a, 0xca, 0x0c, 0x8a, 0x11, 0x8a, 0xa4, 0xf8, 0x98, 0x04, 0x0d, 0x9c,
+	0x42, 0xeb, 0x44, 0xd9, 0x43, 0x35, 0xdd, 0x3a, 0xc5, 0xb9, 0xc0, 0x1f, 0x3c, 0x52, 0xee, 0x52,
+	0x47, 0x48, 0x71, 0x87, 0x41, 0x5f, 0x0a, 0x60, 0x62, 0xc4, 0x36, 0x74, 0x23, 0x16, 0xbd, 0x64,
+	0xfc, 0x91, 0x34, 0xe6, 0x1e, 0x50, 0x87, 0x41, 0x55, 0x63, 0x1c, 0x96, 0xb4, 0x47, 0xca, 0x6e,
+	0x80, 0xc3, 0x1a, 0xf9, 0x43, 0x32, 0xa2, 0xc2, 0x20, 0x11, 0x25, 0xe3, 0x93, 0xb4, 0x49, 0xd2,
+	0xde, 0xbe, 0x9e, 0x0e, 0x8c, 0xa6, 0x27, 0x28, 0x31, 0x58, 0xbe, 0xd4, 0xe2, 0xc0, 0x4b, 0x4c,
+	0x9f, 0x6f, 0xe5, 0x23, 0xaa, 0x53, 0xc3, 0xbf, 0x37, 0x00, 0xd0, 0x9f, 0x48, 0x85, 0xeb, 0x99,
+	0x87, 0xc1, 0x9c, 0xb7, 0x4b, 0x85, 0x2e, 0xfb, 0xff, 0xea, 0x3a, 0xd4, 0x8a, 0xbd, 0xe2, 0xfb,
+	0xd0, 0x10, 0x87, 0x2f, 0x39, 0x6b, 0x53, 0xf7, 0x7b, 0x2d, 0x89, 0x65, 0xdc, 0x81, 0x65, 0xf1,
+	0xd3, 0x56, 0x87, 0x27, 0x7c, 0xe2, 0xbb, 0x81, 0xd6, 0x60, 0xe0, 0x60, 0x64, 0x4d, 0x62, 0x4a,
+	0x67, 0xb3, 0x9a, 0x42, 0x94, 0xbe, 0x66, 0xe6, 0xb0, 0xa5, 0x96, 0x3f, 0x6c, 0xd9, 0x86, 0x2b,
+	0x92, 0x94, 0xeb, 0x0d, 0xfd, 0x31, 0x95, 0x01, 0xb5, 0x3a, 0x5b, 0x5d, 0xab, 0xa2, 0x72, 0xc0,
+	0xea, 0x44, 0x4c, 0x6d, 0x00, 0x57, 0x33, 0x6d, 0xd4, 0xca, 0x6b, 0x4c, 0x73, 0x5c, 0xae, 0xa4,
+	0x08, 0x49, 0x30, 0x1a, 0x1b, 0x6a, 0xcc, 0x93, 0x58, 0xff, 0x7e, 0x93, 0x7d, 0x7f, 0x4d, 0x8e,
+	0x7c, 0x12, 0x6b, 0x1d, 0xf8, 0x16, 0xcc, 0x6c, 0x2b, 0xd5, 0x83, 0xd6, 0xb4, 0x1e, 0xac, 0xa7,
+	0x49, 0xa9, 0x2e, 0xfc, 0x08, 0x1b, 0x92, 0x18, 0xb3, 0x4a, 0x42, 0x1e, 0xfd, 0x9f, 0x57, 0x7b,
+	0x4a, 0xb2, 0x68, 0xad, 0x58, 0xb2, 0xe9, 0x4e, 0x6c, 0x7c, 0x03, 0x72, 0x32, 0xe4, 0xa9, 0x4b,
+	0x7b, 0xab, 0x92, 0xf2, 0x7e, 0x79, 0xd8, 0x43, 0xc8, 0x82, 0x7e, 0xd8, 0xd2, 0x09, 0x74, 0x98,
+	0xf1, 0x38, 0x77, 0x1e, 0xd6, 0xc9, 0x58, 0x4c, 0xa9, 0x9d, 0x98, 0x4b, 0x55, 0xe6, 0xb0, 0xec,
+	0x63, 0xb8, 0x9a, 0xa6, 0x91, 0x88, 0x18, 0x37, 0xd1, 0xd7, 0x82, 0x1c, 0x8d, 0x81, 0x63, 0xec,
+	0xc0, 0x8d, 0x6c, 0xb3, 0xf4, 0x2c, 0xf5, 0xd8, 0x2c, 0x6d, 0xa6, 0x1b, 0xa7, 0xe6, 0xea, 0x2f,
+	0xc0, 0xcd, 0x29, 0x24, 0xd4, 0x94, 0x2d, 0x4f, 0x9b, 0xb2, 0xeb, 0x45, 0x74, 0xd5, 0xc4, 0x7d,
+	0x05, 0xd7, 0x33, 0x94, 0xd3, 0x12, 0xbc, 0xc2, 0xfa, 0xb6, 0x91, 0xa2, 0x91, 0x92, 0xe3, 0x97,
+	0xf0, 0x46, 0x31, 0x01, 0xd5, 0x33, 0x63, 0x5a, 0xcf, 0xae, 0x15, 0x50, 0x55, 0x1d, 0xfb, 0x03,
+	0x78, 0xa3, 0x90, 0xd9, 0xc3, 0x91, 0x1f, 0xcd, 0xeb, 0x3e, 0x6c, 0xe6, 0xe7, 0x63, 0x97, 0x35,
+	0xdf, 0x89, 0x35, 0xef, 0x66, 0x6d, 0x86, 0x77, 0x73, 0xe5, 0xe2, 0x36, 0xc3, 0xfa, 0x22, 0xde,
+	0xcd, 0x6d, 0xe8, 0x89, 0x43, 0x3f, 0xb9, 0x74, 0x84, 0xa3, 0xd0, 0xe1, 0x87, 0x7f, 0xf2, 0x78,
+	0xfa, 0x1b, 0x78, 0x93, 0x4f, 0x8c, 0x8d, 0xb1, 0xfe, 0x28, 0x90, 0xaa, 0x0b, 0xed, 0x5e, 0xc5,
+	0x70, 0x93, 0xcd, 0xd9, 0x0d, 0x8e, 0x38, 0xf0, 0xf6, 0xa2, 0x60, 0x47, 0x61, 0x29, 0xfe, 0x5a,
+	0x70, 0x3b, 0xa1, 0xa4, 0xcc, 0xba, 0x22, 0x72, 0x1b, 0x8c, 0x5c, 0x5f, 0x92, 0x93, 0x96, 0x6b,
+	0x01, 0xcd, 0x7d, 0x78, 0x47, 0xd0, 0xf4, 0x27, 0xf1, 0x6c, 0xa2, 0x9b, 0x8c, 0xe8, 0x5b, 0x1c,
+	0xfd, 0x87, 0x49, 0x3c, 0x83, 0xea, 0xef, 0xc3, 0x07, 0xda, 0x98, 0x65, 0xf0, 0x96, 0xd9, 0x7f,
+	0x85, 0xa4, 0xaf, 0x31, 0xd2, 0xef, 0xa8, 0xe1, 0x8b, 0x58, 0x2e, 0x6b, 0x50, 0x40, 0x3e, 0xbf,
+	0x02, 0xf8, 0xe9, 0xb1, 0xdc, 0x14, 0xf8, 0x11, 0x61, 0x7a, 0x05, 0xec, 0x21, 0x86, 0xdc, 0x1f,
+	0x28, 0x6c, 0x64, 0x08, 0xc4, 0xa7, 0x9e, 0xd4, 0x57, 0x37, 0x8a, 0x4e, 0x89, 0xd3, 0xba, 0x66,
+	0xff, 0xd4, 0xd3, 0x15, 0xd7, 0x7a, 0x50, 0x58, 0x69, 0xec, 0x83, 0x21, 0x3f, 0xc3, 0xc2, 0xe7,
+	0x91, 0x1b, 0xd3, 0xc8, 0xbc, 0x99, 0x71, 0xcc, 0x52, 0xf4, 0x2d, 0x85, 0xc7, 0x49, 0xaf, 0x04,
+	0x59, 0xb8, 0xf1, 0x05, 0x74, 0x51, 0x8c, 0x0e, 0xa9, 0x5a, 0xf1, 0x5b, 0x4c, 0x6e, 0xd7, 0xd2,
+	0x14, 0x9f, 0x51, 0xba, 0x17, 0x05, 0xd6, 0x52, 0x10, 0x05, 0xcf, 0xa8, 0x5c, 0xfa, 0x5f, 0x81,
+	0x21, 0xb5, 0xb3, 0xd6, 0xfe, 0xcd, 0xcc, 0x72, 0x97, 0xed, 0x2d, 0xb9, 0x31, 0x27, 0x04, 0xbe,
+	0x86, 0xd5, 0xd8, 0x17, 0xec, 0xd6, 0x28, 0xf4, 0xa7, 0x52, 0x88, 0x7d, 0xc6, 0xf9, 0x84, 0xc2,
+	0xef, 0xc2, 0x46, 0x46, 0x22, 0x34, 0x3a, 0x6f, 0x67, 0x7c, 0x2e, 0x35, 0x12, 0x5d, 0x22, 0x14,
+	0xbf, 0x79, 0x31, 0x21, 0xfd, 0x16, 0x54, 0x62, 0x72, 0x6a, 0xde, 0x2a, 0xea, 0xcc, 0x3e, 0x39,
+	0xb5, 0xb0, 0x16, 0x2d, 0xc8, 0xc9, 0xc4, 0x75, 0xcc, 0xdb, 0xdc, 0x82, 0xc4, 0xdf, 0xc6, 0x3e,
+	0x6c, 0x88, 0x73, 0x09, 0x5c, 0xdd, 0x18, 0x3b, 0x40, 0x2f, 0xc0, 0x76, 0xbd, 0x60, 0x12, 0x9b,
+	0xef, 0x9c, 0xab, 0x15, 0xae, 0xf0, 0xc6, 0x4f, 0x48, 0x4c, 0xf7, 0xfd, 0x67, 0x7e, 0x38, 0x1e,
+	0x60, 0x43, 0x3c, 0x2a, 0x8a, 0x7d, 0x34, 0x9c, 0x33, 0x67, 0x76, 0xef, 0x33, 0x69, 0x37, 0x58,
+	0x5d, 0xfa, 0xd4, 0xee, 0x29, 0xf4, 0x44, 0xa7, 0x6d, 0x69, 0x2f, 0x7e, 0x30, 0x87, 0xbd, 0xd8,
+	0x3d, 0x48, 0x95, 0xd5, 0x21, 0xfc, 0xdd, 0x73, 0x0e, 0xe1, 0x1f, 0xc2, 0x26, 0xfe, 0x2f, 0xbf,
+	0x85, 0x83, 0x27, 0xc9, 0x41, 0xcf, 0x3d, 0xa6, 0xcd, 0xae, 0x22, 0x86, 0x20, 0xfc, 0x84, 0xc4,
+	0x44, 0x9d, 0xf5, 0xe8, 0xf9, 0x0b, 0xf7, 0x33, 0xf9, 0x0b, 0x77, 0xa0, 0xe6, 0xc6, 0x74, 0x1c,
+	0x99, 0x1f, 0x6e, 0x55, 0xf2, 0x3d, 0x18, 0xe0, 0x1c, 0x72, 0x04, 0xcd, 0xad, 0xf9, 0xd9, 0x54,
+	0xb7, 0x66, 0x3b, 0xe3, 0x65, 0x7d, 0xa6, 0x59, 0xc5, 0x0f, 0xb6, 0x2a, 0x79, 0xf6, 0x4c, 0xb5,
+	0x88, 0xbf, 0x2f, 0x48, 0x88, 0xf8, 0x68, 0xab, 0x92, 0x72, 0x53, 0xa5, 0x79, 0x32, 0x4f, 0x0e,
+	0x44, 0x3e, 0x8b, 0xe1, 0xe3, 0x29, 0x59, 0x0c, 0x43, 0x12, 0xc4, 0x93, 0x10, 0xb7, 0x19, 0x3e,
+	0xda, 0x4f, 0xd8, 0x68, 0xbb, 0x12, 0x2c, 0xe6, 0x7f, 0x17, 0xba, 0x72, 0x94, 0xcc, 0x7d, 0x8c,
+	0xcc, 0x4f, 0x33, 0xe3, 0xdb, 0x09, 0x82, 0x91, 0x4b, 0x1d, 0xb5, 0x21, 0x93, 0x98, 0x5a, 0x9d,
+	0xa1, 0x56, 0x8a, 0x8c, 0x87, 0xb0, 0x7c, 0x78, 0x6a, 0x8f, 0x49, 0x78, 0xe4, 0x7a, 0xf2, 0x73,
+	0x9f, 0x4d, 0x5b, 0x9f, 0xdd, 0xc3, 0xd3, 0xe7, 0x0c, 0x33, 0x91, 0x40, 0x2d, 0x88, 0x16, 0x8c,
+	0x88, 0x67, 0x7e, 0x5e, 0x24, 0x81, 0x49, 0x14, 0x6d, 0x6f, 0x44, 0x3c, 0xab, 0x3b, 0x4c, 0x95,
+	0x8d, 0xcf, 0xa1, 0x9d, 0x2c, 0xee, 0xc8, 0xfc, 0x22, 0x13, 0xc0, 0x64, 0x24, 0xd4, 0xea, 0x8d,
+	0x2c, 0x88, 0xd4, 0x6f, 0xe3, 0x2b, 0x90, 0xc1, 0x79, 0x7e, 0xae, 0x64, 0x3e, 0x14, 0xeb, 0x2f,
+	0xd5, 0x58, 0x68, 0x72, 0x76, 0xc2, 0x64, 0x2d, 0x11, 0xad, 0x64, 0xec, 0x40, 0x57, 0x9c, 0x13,
+	0x1e, 0xbb, 0x51, 0xec, 0x87, 0x67, 0xe6, 0x97, 0x5b, 0x95, 0x3c, 0x05, 0x1e, 0x7c, 0xd8, 0x3d,
+	0x26, 0xde, 0x11, 0xb5, 0x3a, 0xbc, 0xc5, 0x37, 0xbc, 0x81, 0x1e, 0x46, 0x7a, 0x94, 0x0e, 0x23,
+	0x7d, 0x0d, 0x46, 0xde, 0x72, 0x5d, 0x28, 0xe9, 0x63, 0x00, 0xd7, 0x66, 0xec, 0x25, 0x0b, 0x91,
+	0x7a, 0x02, 0xeb, 0xc5, 0xdb, 0xc6, 0x9f, 0xad, 0x14, 0x96, 0xff, 0x21, 0x43, 0x05, 0xa8, 0x18,
+	0xe6, 0x0e, 0x15, 0x2c, 0x43, 0x25, 0x7a, 0x35, 0x11, 0x9e, 0x22, 0xfe, 0x2c, 0x8c, 0x0d, 0x9c,
+	0xef, 0x09, 0x26, 0x1a, 0xa8, 0x3e, 0x55, 0x03, 0x35, 0x32, 0x1a, 0x68, 0x1d, 0xea, 0x2c, 0xf5,
+	0x05, 0x83, 0x5c, 0xa8, 0xf9, 0x44, 0x09, 0xfb, 0x34, 0x09, 0x47, 0xf2, 0x80, 0x62, 0x12, 0x8e,
+	0x52, 0x1e, 0x3c, 0x14, 0x79, 0xf0, 0x38, 0xe6, 0xa9, 0xfa, 0x2a, 0x6d, 0xd9, 0xb6, 0x2f, 0x6e,
+	0xd9, 0x2e, 0x2d, 0x62, 0xd9, 0x6e, 0x42, 0xf3, 0x57, 0x13, 0xe2, 0xc5, 0x18, 0x09, 0xea, 0x30,
+	0x4b, 0x5b, 0x95, 0x2f, 0x17, 0x34, 0xf8, 0xfb, 0x65, 0x68, 0x2a, 0x23, 0x6e, 0x03, 0x4f, 0x45,
+	0x1c, 0x6a, 0xbb, 0x22, 0xc2, 0x56, 0xc3, 0x30, 0x94, 0x43, 0x07, 0x5e, 0x8c, 0xe1, 0x45, 0x56,
+	0x45, 0x1e, 0xc8, 0x39, 0xc7, 0xe2, 0xce, 0x03, 0xe3, 0x4d, 0x6d, 0x86, 0xdb, 0xdb, 0x1d, 0xc5,
+	0x49, 0x8c, 0xfd, 0x8a, 0x09, 0xe7, 0x71, 0x4b, 0xc2, 0x82, 0x6d, 0x66, 0x4d, 0xc6, 0x2d, 0x77,
+	0x58, 0x39, 0xc3, 0xcf, 0xfa, 0xc5, 0xf9, 0xd9, 0x58, 0x84, 0x9f, 0x9f, 0x03, 0x8c, 0x5d, 0xcf,
+	0x0f, 0xed, 0x89, 0xe7, 0xc6, 0x22, 0x2c, 0xba, 0x99, 0xf3, 0xad, 0x9e, 0x23, 0xca, 0x8f, 0x9e,
+	0x1b, 0x5b, 0xad, 0xb1, 0xfc, 0xd9, 0xff, 0x4b, 0xb0, 0x92, 0xab, 0xc7, 0xf9, 0xa1, 0xa7, 0x81,
+	0xef, 0x51, 0xc5, 0x39, 0x55, 0xc6, 0x0c, 0x80, 0xd0, 0x9f, 0x78, 0x0e, 0x9a, 0x10, 0x63, 0x8c,
+	0xd7, 0x71, 0x06, 0x2e, 0x49, 0xe0, 0x73, 0x8c, 0xd8, 0xdd, 0x82, 0xee, 0x90, 0x44, 0xc7, 0xe8,
+	0xf6, 0x85, 0x2c, 0x76, 0x2e, 0x62, 0x8a, 0x1d, 0x84, 0x0e, 0x24, 0xb0, 0xff, 0xeb, 0x32, 0xb4,
+	0x98, 0xf1, 0x86, 0xfb, 0xbe, 0x88, 0x75, 0x95, 0x54, 0xac, 0x4b, 0x8b, 0x22, 0x96, 0xd3, 0x51,
+	0xc4, 0x0f, 0x61, 0x49, 0xfc, 0xb4, 0x45, 0xfa, 0x43, 0xc1, 0x6c, 0xb5, 0x05, 0x0a, 0x16, 0x70,
+	0x5e, 0x59, 0xdc, 0xb1, 0x78, 0x5e, 0xb1, 0x4a, 0x9e, 0xfd, 0xd5, 0x92, 0xb3, 0x3f, 0x15, 0x77,
+	0xac, 0xeb, 0x67, 0x84, 0x7a, 0xca, 0x65, 0x23, 0x9f, 0x72, 0x19, 0xbb, 0x63, 0xfa, 0x13, 0x86,
+	0xfb, 0xf8, 0x1a, 0x55, 0xe5, 0x24, 0x0e, 0x08, 0x7a, 0x1c, 0x50, 0x85, 0x16, 0xdb, 0xfa, 0x51,
+	0xeb, 0x3f, 0x2b, 0x81, 0x91, 0x8f, 0x3d, 0xe4, 0x34, 0x57, 0xd1, 0x51, 0xf5, 0x47, 0x50, 0x17,
+	0x6e, 0x46, 0x25, 0xb3, 0xad, 0xee, 0xa5, 0xbd, 0x15, 0xc4, 0xb1, 0x04, 0xae, 0xf1, 0x28, 0x09,
+	0x85, 0x88, 0x88, 0x3c, 0xe7, 0xd4, 0x7a, 0xb6, 0xb5, 0x30, 0x90, 0x3b, 0x29, 0x03, 0x19, 0x47,
+	0x71, 0x14, 0xfa, 0x13, 0xc9, 0x3d, 0x5e, 0xe8, 0xff, 0x87, 0x32, 0xac, 0x16, 0x7c, 0x14, 0x27,
+	0xf6, 0x98, 0x78, 0xce, 0x88, 0x86, 0x32, 0x3c, 0x2c, 0x8a, 0x8c, 0x7f, 0x34, 0x1c, 0xbb, 0x1e,
+	0x91, 0x67, 0xcf, 0xaa, 0x8c, 0x75, 0x01, 0x89, 0xa2, 0xd7, 0x7e, 0x28, 0xa3, 0x77, 0xaa, 0x9c,
+	0x4e, 0xe5, 0x90, 0x48, 0x99, 0x04, 0xc1, 0x3d, 0x89, 0x9c, 0x09, 0x01, 0xd7, 0x73, 0x21, 0xe0,
+	0x47, 0x32, 0x23, 0xb8, 0xc1, 0xf4, 0xe9, 0x3b, 0xb3, 0x38, 0x58, 0x90, 0x12, 0x8c, 0xc2, 0x7f,
+	0x4c, 0xc2, 0x23, 0xca, 0xba, 0x73, 0x48, 0xa9, 0x08, 0xb9, 0x75, 0x12, 0xe8, 0x33, 0x4a, 0x2f,
+	0x9e, 0x50, 0xda, 0xff, 0x6f, 0x65, 0xe8, 0xa4, 0xa6, 0x63, 0x2e, 0xc1, 0x78, 0x0f, 0x1a, 0xe2,
+	0x14, 0xdc, 0xac, 0x4c, 0x3b, 0x1d, 0x17, 0x3f, 0x8c, 0xc7, 0xb0, 0x5a, 0xe4, 0x45, 0x57, 0xa7,
+	0x45, 0x6d, 0x0c, 0x92, 0xf7, 0xa1, 0xdf, 0x87, 0x15, 0x8d, 0x86, 0xc8, 0xa5, 0x12, 0x73, 0x92,
+	0x54, 0x88, 0x14, 0xac, 0x94, 0x52, 0xad, 0xcf, 0x54, 0xaa, 0x8d, 0x8b, 0x2b, 0xd5, 0xe6, 0x22,
+	0x47, 0x36, 0x7f, 0xb3, 0x04, 0x4b, 0xcf, 0xdc, 0x53, 0xea, 0xec, 0x91, 0xe1, 0x2b, 0x5c, 0xdc,
+	0xf3, 0x30, 0x59, 0xcf, 0x35, 0xa9, 0x9c, 0x9f, 0x6b, 0x82, 0x3a, 0x21, 0x74, 0x87, 0x7c, 0xbf,
+	0x29, 0x59, 0xbc, 0x30, 0x73, 0x87, 0xe9, 0x7f, 0x0b, 0x1d, 0xbd, 0x57, 0xe8, 0xad, 0x77, 0x0e,
+	0x11, 0x60, 0x07, 0x1c, 0x62, 0x96, 0xb6, 0x2a, 0xa9, 0xa0, 0xb8, 0x8e, 0x6e, 0x2d, 0x1d, 0x6a,
+	0xa5, 0xfe, 0x1f, 0x96, 0xc4, 0x41, 0x11, 0x9e, 0x47, 0x7d, 0x0d, 0xd7, 0xb8, 0x8d, 0x9e, 0x12,
+	0xf3, 0x5d, 0x3d, 0x75, 0xa6, 0x64, 0xcd, 0x42, 0x31, 0x3e, 0x81, 0x75, 0x5e, 0xad, 0x92, 0x0e,
+	0xf4, 0x73, 0xac, 0x92, 0x35, 0xa5, 0xb6, 0xff, 0x0f, 0x4b, 0xd0, 0xd6, 0x42, 0x0a, 0xbf, 0xbd,
+	0x9e, 0x18, 0x1f, 0xc0, 0x8a, 0x20, 0x1b, 0x05, 0xbb, 0xfa, 0x44, 0x96, 0xac, 0x7c, 0x45, 0xff,
+	0x0f, 0xcb, 0xd0, 0x4d, 0x7b, 0x1a, 0xc6, 0x2e, 0xbc, 0x21, 0x02, 0x53, 0x99, 0xf8, 0xcf, 0x30,
+	0xd3, 0x7b, 0x32, 0xa3, 0xf7, 0x9f, 0x81, 0x29, 0x88, 0xa8, 0x78, 0xd9, 0x30, 0xd3, 0x7f, 0x52,
+	0xdc, 0xff, 0x7b, 0xb0, 0x2a, 0x3f, 0x1f, 0x05, 0xf6, 0x30, 0x33, 0x02, 0x92, 0x1d, 0x41, 0x41,
+	0x77, 0x85, 0x57, 0x95, 0x5a, 0xf3, 0xd9, 0xee, 0xf2, 0xe1, 0x2a, 0x36, 0xfc, 0x69, 0x09, 0x7a,
+	0x19, 0x87, 0xab, 0xc8, 0xc8, 0x16, 0x17, 0x33, 0xca, 0xa9, 0x8b, 0x19, 0x37, 0x00, 0x86, 0x24,
+	0x74, 0xec, 0x83, 0x90, 0x78, 0x52, 0xaf, 0xb7, 0x10, 0xf2, 0x18, 0x01, 0xc6, 0x63, 0x58, 0x8e,
+	0x43, 0xe2, 0x45, 0xb8, 0x18, 0x7c, 0xcf, 0x1e, 0xfa, 0x51, 0x2c, 0xb4, 0xd0, 0xd5, 0x29, 0xbe,
+	0x9e, 0xd5, 0xd3, 0x1a, 0xec, 0xfa, 0x11, 0x66, 0x89, 0xac, 0x48, 0x6f, 0x99, 0xa7, 0xd9, 0x1c,
+	0x52, 0xbe, 0xac, 0x66, 0x10, 0x59, 0x4e, 0xb5, 0x78, 0x46, 0x69, 0xff, 0x4f, 0x4a, 0xb0, 0x92,
+	0x73, 0xeb, 0xe6, 0x39, 0x73, 0xc7, 0xa1, 0x47, 0xfe, 0x24, 0x1c, 0xca, 0xa3, 0x4d, 0x51, 0xe2,
+	0x2c, 0x21, 0x91, 0xca, 0x62, 0x13, 0xa5, 0x8c, 0xba, 0xab, 0x2d, 0xa0, 0xee, 0xfa, 0xff, 0x55,
+	0x76, 0x52, 0xf7, 0x5e, 0xb5, 0xb0, 0x76, 0x49, 0x74, 0x80, 0x95, 0x98, 0x29, 0x47, 0xa3, 0xc0,
+	0xf7, 0x22, 0xca, 0x8f, 0x5e, 0x79, 0x9f, 0x97, 0x24, 0x90, 0x1d, 0xbe, 0xea, 0x48, 0xec, 0x6a,
+	0x4a, 0x45, 0xd8, 0x7b, 0x02, 0xc8, 0xee, 0xa7, 0xa0, 0x15, 0x13, 0x86, 0xbe, 0x4c, 0x7e, 0xe3,
+	0x05, 0xdc, 0xe7, 0x47, 0x24, 0x56, 0xc9, 0xfa, 0x15, 0x4b, 0x16, 0xd9, 0x10, 0xb1, 0x6b, 0xf3,
+	0x9b, 0xc9, 0x1c, 0x7b, 0x27, 0xee, 0xff, 0xfb, 0x12, 0x5c, 0x29, 0x0c, 0xda, 0xfd, 0x16, 0xb5,
+	0x46, 0xf6, 0xcb, 0xe9, 0xf5, 0x21, 0x56, 0xdf, 0x2c, 0x94, 0xfe, 0xbf, 0x28, 0xc1, 0x9a, 0x72,
+	0xfb, 0xb5, 0xae, 0xe5, 0xd6, 0xd1, 0xff, 0x53, 0x0b, 0xa9, 0x3a, 0xc5, 0x42, 0xba, 0x84, 0x04,
+	0xfe, 0xed, 0x32, 0x2c, 0xe9, 0xb1, 0xa3, 0xdc, 0x00, 0xde, 0x02, 0x15, 0x4d, 0xb2, 0xd9, 0xd2,
+	0x11, 0x42, 0x27, 0x81, 0xcf, 0x70, 0x09, 0xdd, 0x84, 0xb6, 0x42, 0x8a, 0x7d, 0x36, 0x98, 0x9a,
+	0x05, 0x12, 0xb4, 0xef, 0xab, 0x04, 0x86, 0xaa, 0x96, 0xc0, 0x30, 0xd3, 0x31, 0x93, 0xa9, 0x93,
+	0xf5, 0x39, 0x53, 0x27, 0x2f, 0x61, 0x73, 0x6c, 0x40, 0xf3, 0x80, 0xc4, 0xc3, 0x63, 0x34, 0x2e,
+	0x79, 0x76, 0x61, 0x83, 0x95, 0x07, 0x4e, 0xff, 0xef, 0x94, 0x61, 0xb5, 0x20, 0xc0, 0x96, 0x67,
+	0x4a, 0xe9, 0x7c, 0xa6, 0x94, 0xa7, 0x32, 0xa5, 0xa2, 0x31, 0x45, 0x8e, 0xbb, 0x3a, 0xe7, 0xb8,
+	0x31, 0x9f, 0x87, 0x84, 0xaf, 0x68, 0xcc, 0xb3, 0x4b, 0x6a, 0x8c, 0x14, 0x70, 0x90, 0x25, 0xd2,
+	0x44, 0xa2, 0x80, 0x25, 0xe6, 0x88, 0x68, 0x06, 0x2f, 0x31, 0xab, 0x37, 0xf4, 0xa3, 0x28, 0x7d,
+	0x64, 0x5d, 0xb3, 0x3a, 0x0c, 0xaa, 0x96, 0xca, 0x0d, 0x00, 0x37, 0xb2, 0x5d, 0x0f, 0xc3, 0x5d,
+	0x54, 0xe4, 0x3c, 0xb4, 0xdc, 0x68, 0xc0, 0x01, 0xfd, 0x7f, 0x5d, 0x85, 0xce, 0xec, 0x05, 0x50,
+	0x64, 0x75, 0x29, 0xf7, 0xa3, 0xa2, 0xb9, 0x1f, 0x29, 0x5b, 0xac, 0x7a, 0xbe, 0x2d, 0xf6, 0x06,
+	0x48, 0x5e, 0xba, 0x34, 0x32, 0x6b, 0x5b, 0x15, 0x8d, 0xbb, 0x2e, 0x8d, 0xa6, 0x5c, 0xa6, 0xa9,
+	0x2f, 0x74, 0x99, 0xa6, 0x31, 0xe5, 0x32, 0x4d, 0xe2, 0xb4, 0x35, 0x17, 0x70, 0xda, 0x0c, 0xa8,
+	0x0e, 0x86, 0xbe, 0x27, 0x3c, 0x4d, 0xf6, 0xbb, 0xc0, 0x91, 0x83, 0x45, 0x1c, 0x39, 0x99, 0x2c,
+	0xd4, 0xd6, 0x92, 0x85, 0xb4, 0x14, 0xe7, 0x90, 0x1e, 0xd1, 0xd3, 0x40, 0x24, 0xb4, 0xca, 0x28,
+	0xaa, 0xc5, 0x80, 0xe9, 0xe5, 0xd7, 0x99, 0x69, 0xc2, 0x77, 0x2f, 0x6e, 0xc2, 0xf7, 0x16, 0x31,
+	0xe1, 0xff, 0x46, 0x59, 0xf9, 0x3c, 0x73, 0x45, 0x83, 0xb6, 0x53, 0xd1, 0xa0, 0x6d, 0x3d, 0x4c,
+	0x54, 0xf9, 0xb3, 0x1f, 0x26, 0xea, 0xff, 0xd5, 0x32, 0x54, 0x5e, 0x92, 0x7c, 0xee, 0xf6, 0x7b,
+	0xe9, 0x40, 0xcb, 0xcc, 0xbc, 0xe9, 0x2d, 0x68, 0x47, 0x93, 0x03, 0xc7, 0x3d, 0x71, 0x59, 0xf0,
+	0x9a, 0xb3, 0x45, 0x07, 0xa1, 0x27, 0x7b, 0x42, 0x62, 0xa1, 0x99, 0xf1, 0xe7, 0x22, 0xac, 0x68,
+	0x5e, 0x9c, 0x15, 0xad, 0x45, 0x58, 0xf1, 0x0f, 0x2a, 0x00, 0xc9, 0x09, 0x43, 0x01, 0x47, 0x56,
+	0xb2, 0x09, 0x0c, 0xf2, 0xfa, 0x4d, 0x2f, 0x9d, 0xa0, 0xe0, 0x64, 0x6e, 0x87, 0x57, 0xb2, 0xb7,
+	0xc3, 0xbf, 0xc8, 0x9d, 0x04, 0x27, 0x27, 0x19, 0x82, 0x49, 0x57, 0x53, 0x24, 0xb5, 0x6e, 0xdd,
+	0xe2, 0x07, 0xb1, 0x5a, 0x03, 0xae, 0x8f, 0x3b, 0x41, 0x14, 0x68, 0x68, 0x9f, 0x82, 0xc9, 0x8f,
+	0x01, 0xf3, 0xd9, 0xc9, 0x42, 0x3f, 0x5d, 0x61, 0xf5, 0xd9, 0xc4, 0x64, 0x64, 0x60, 0x14, 0x93,
+	0x30, 0x66, 0x87, 0x92, 0xf3, 0xc8, 0x12, 0xc3, 0x7e, 0x42, 0xe2, 0xdf, 0xd6, 0xb4, 0x7d, 0x02,
+	0xb0, 0x4b, 0x42, 0x87, 0x5f, 0xe1, 0x42, 0xb5, 0x3f, 0xf6, 0xbd, 0xf8, 0x58, 0x4c, 0x1c, 0x2f,
+	0xa0, 0x0a, 0x3b, 0xa3, 0x24, 0x94, 0x1b, 0x04, 0xfe, 0xee, 0xff, 0x02, 0x5a, 0x2f, 0xc8, 0x09,
+	0x75, 0xb0, 0x71, 0x6e, 0xb2, 0x97, 0xa1, 0x12, 0x10, 0xe9, 0x97, 0xe0, 0x4f, 0xe3, 0x7d, 0xa8,
+	0xf3, 0x03, 0x57, 0xe1, 0xc3, 0xaf, 0x26, 0xeb, 0x41, 0x7d, 0xdd, 0x12, 0x28, 0xfd, 0xbf, 0x5c,
+	0x06, 0x53, 0xe8, 0x54, 0x3c, 0x99, 0x5d, 0x7c, 0xf7, 0x32, 0xa0, 0xea, 0x0e, 0xd5, 0x5a, 0x62,
+	0xbf, 0x95, 0x1e, 0xae, 0x6a, 0x7a, 0xb8, 0x30, 0xc8, 0x56, 0xa0, 0x9d, 0xeb, 0x45, 0xda, 0xf9,
+	0x36, 0x60, 0xb6, 0xb8, 0x1d, 0x21, 0x17, 0x6c, 0xf4, 0xaf, 0x22, 0xa6, 0xc5, 0x9b, 0x56, 0xe7,
+	0x98, 0x44, 0x8a, 0x37, 0x91, 0xf1, 0x00, 0xda, 0x3a, 0x4e, 0x27, 0x73, 0xbc, 0xaa, 0x30, 0x2d,
+	0x88, 0x54, 0xa3, 0xfe, 0xef, 0xc3, 0xdd, 0xc2, 0xdc, 0xe5, 0x3d, 0x1a, 0xee, 0xeb, 0xce, 0x98,
+	0x92, 0xd8, 0x65, 0xa8, 0xa0, 0x13, 0xc6, 0x4d, 0x72, 0xfc, 0x39, 0x2b, 0xe9, 0xb5, 0xff, 0xb7,
+	0x4a, 0xb0, 0x55, 0x48, 0x3f, 0xa1, 0x18, 0x15, 0x90, 0xb4, 0xa1, 0x17, 0xd0, 0xd0, 0xd6, 0xdc,
+	0x41, 0xa1, 0xde, 0x3e, 0x99, 0x9d, 0x71, 0x3d, 0xad, 0xd7, 0x56, 0x37, 0x48, 0xd5, 0xf4, 0xff,
+	0xed, 0xb4, 0x7e, 0x0d, 0xbc, 0x98, 0x1e, 0xf1, 0x8b, 0x1b, 0x68, 0x50, 0x49, 0x03, 0x3d, 0x79,
+	0x3d, 0x02, 0x24, 0x68, 0xc0, 0x2c, 0x73, 0x85, 0xa0, 0x2c, 0x73, 0xce, 0x82, 0x65, 0x59, 0xa1,
+	0x2c, 0xf3, 0x2f, 0x61, 0x53, 0x21, 0xe7, 0xed, 0x79, 0x2e, 0x41, 0xa6, 0xc4, 0xd8, 0xcd, 0xda,
+	0xf5, 0x6f, 0x00, 0xb8, 0xa2, 0x6b, 0x94, 0x5b, 0xff, 0x4d, 0x4b, 0x83, 0xf4, 0x07, 0xf0, 0x56,
+	0xf1, 0x78, 0x1c, 0xea, 0xcd, 0xc8, 0x19, 0x2f, 0x10, 0xea, 0xfe, 0xdf, 0x2d, 0xc3, 0x95, 0x42,
+	0x5a, 0xc6, 0x8b, 0x5c, 0xd6, 0x1d, 0x5f, 0x64, 0x1f, 0xcc, 0x9e, 0x95, 0x74, 0x1f, 0xb2, 0x69,
+	0x78, 0x03, 0x80, 0x8c, 0x5a, 0xd5, 0x5f, 0x34, 0x38, 0x4f, 0x78, 0x2c, 0xad, 0xb1, 0xf1, 0x2d,
+	0xb4, 0xdd, 0x64, 0xfe, 0xcc, 0xda, 0x3c, 0xb4, 0xb4, 0x09, 0xb7, 0xf4, 0xd6, 0x33, 0xe3, 0x9a,
+	0xfd, 0x17, 0xd0, 0xb3, 0xe8, 0xe1, 0xc4, 0x73, 0x92, 0x33, 0x90, 0xe9, 0x99, 0xd3, 0xe2, 0x78,
+	0xa2, 0x5c, 0x70, 0x3c, 0x51, 0xd1, 0xd3, 0xa2, 0x7f, 0x06, 0x6d, 0x4e, 0x74, 0xea, 0x91, 0x01,
+	0x4b, 0x4e, 0x29, 0x27, 0xc9, 0x29, 0xfd, 0xff, 0x55, 0x85, 0x3a, 0x6f, 0x53, 0xb0, 0x11, 0xd6,
+	0x58, 0x8a, 0x9d, 0x59, 0xce, 0x64, 0x00, 0x69, 0xdf, 0xb0, 0x38, 0xca, 0xf9, 0xa9, 0xd5, 0xc9,
+	0x41, 0x68, 0x35, 0x75, 0x10, 0x7a, 0x1d, 0xf8, 0xee, 0xe0, 0x87, 0x03, 0x19, 0x21, 0x4e, 0x00,
+	0x5a, 0x98, 0xa4, 0x9e, 0x0a, 0x93, 0xdc, 0xcd, 0x1c, 0x9f, 0x9e, 0x63, 0xde, 0x27, 0x41, 0x90,
+	0xe6, 0x8c, 0xdc, 0xbe, 0xdf, 0xd0, 0x7d, 0x00, 0xe3, 0x53, 0xe0, 0xaf, 0x96, 0xb0, 0x8c, 0x18,
+	0xb3, 0x9d, 0xc9, 0x5e, 0xc8, 0x48, 0x85, 0xd5, 0x0a, 0xe4, 0x4f, 0x14, 0xa8, 0x88, 0x8c, 0x68,
+	0x64, 0x63, 0x1e, 0xd2, 0x12, 0xcb, 0xfd, 0x6f, 0x32, 0x00, 0xa6, 0xfa, 0xbf, 0x2b, 0xb3, 0x62,
+	0xb8, 0xda, 0x5e, 0xcd, 0x10, 0xd4, 0xd3, 0x62, 0x30, 0x75, 0x9b, 0x9c, 0x4a, 0xbf, 0xa4, 0xcb,
+	0xe6, 0xa3, 0x15, 0x93, 0xd3, 0xa9, 0x79, 0x22, 0xbd, 0xc5, 0xf3, 0x44, 0xb4, 0x24, 0x87, 0xe5,
+	0x54, 0x92, 0x43, 0xff, 0xaf, 0x97, 0x00, 0x92, 0x3e, 0xb1, 0xdb, 0x1e, 0x18, 0x74, 0x54, 0xa2,
+	0x57, 0xc7, 0xe2, 0xc0, 0x91, 0x47, 0xf0, 0xe5, 0xe4, 0x08, 0x5e, 0x3f, 0x3a, 0xae, 0xa4, 0x8f,
+	0x8e, 0xa7, 0xca, 0x57, 0x7a, 0xac, 0xb5, 0xcc, 0x58, 0xfb, 0x7f, 0x54, 0x85, 0xf6, 0x77, 0xd4,
+	0x39, 0x92, 0x47, 0x31, 0xd9, 0x35, 0x70, 0x03, 0xe0, 0x97, 0xfe, 0x44, 0x8a, 0x35, 0xef, 0x4b,
+	0x4b, 0x40, 0x06, 0xec, 0x38, 0x89, 0x07, 0xf4, 0xf8, 0x35, 0x26, 0x21, 0xf6, 0x1c, 0xc4, 0xee,
+	0x30, 0xe1, 0x94, 0x71, 0x04, 0x75, 0x41, 0xa6, 0xc9, 0x01, 0x83, 0xdc, 0x15, 0xef, 0x5a, 0xee,
+	0xfe, 0xcc, 0x8c, 0x17, 0x7f, 0xb4, 0x67, 0x82, 0x1a, 0xe9, 0x67, 0x82, 0x0c, 0xa8, 0x46, 0xae,
+	0x23, 0x2f, 0x37, 0xb2, 0xdf, 0x1a, 0x77, 0x5a, 0x53, 0xd3, 0x10, 0x20, 0x97, 0x08, 0x35, 0x3d,
+	0x10, 0xdd, 0x9e, 0x19, 0x88, 0x7e, 0x1f, 0x56, 0xf2, 0x4d, 0x96, 0xc4, 0x05, 0xac, 0x39, 0xa3,
+	0xd6, 0x9d, 0x69, 0x51, 0xeb, 0x37, 0x61, 0x29, 0x85, 0xc8, 0x33, 0xad, 0xdb, 0x81, 0x86, 0x92,
+	0x5e, 0xd6, 0xbd, 0x45, 0x42, 0x58, 0xbf, 0x4e, 0xdd, 0x21, 0x1e, 0x11, 0x6f, 0x98, 0xbb, 0xe6,
+	0x54, 0xca, 0x4d, 0xd3, 0xac, 0x4b, 0x3b, 0x6b, 0x50, 0x73, 0xe8, 0x81, 0x2b, 0x0f, 0xc1, 0x79,
+	0x01, 0xe7, 0x63, 0x18, 0x52, 0xc7, 0x55, 0xd2, 0xca, 0x4b, 0x38, 0xab, 0x07, 0xfc, 0xab, 0x42,
+	0x54, 0x65, 0xb1, 0xff, 0x4f, 0xea, 0x50, 0x17, 0x77, 0xf5, 0x16, 0x7e, 0x29, 0x60, 0x33, 0x73,
+	0x30, 0xd5, 0x2a, 0x54, 0x8d, 0xd5, 0x94, 0x6a, 0x7c, 0x08, 0x6d, 0x7e, 0x6c, 0xc7, 0x63, 0x52,
+	0xe7, 0xc7, 0x01, 0x81, 0xa3, 0xb3, 0x68, 0xd5, 0xa7, 0xd0, 0x12, 0x8d, 0x63, 0x7f, 0x0e, 0x0f,
+	0xb7, 0xc9, 0x91, 0xf7, 0x7d, 0x8c, 0x85, 0x31, 0x01, 0x8f, 0xd2, 0x41, 0x93, 0x25, 0x0e, 0x14,
+	0xfa, 0xe9, 0x16, 0x74, 0x43, 0xa6, 0x3f, 0xa2, 0xf4, 0xb5, 0x86, 0x8e, 0x80, 0x0a, 0xb4, 0x9b,
+	0xd0, 0xc6, 0xf4, 0x30, 0x3b, 0x25, 0xf8, 0x80, 0xa0, 0x9d, 0x22, 0xd5, 0x00, 0x59, 0x35, 0xc8,
+	0x3e, 0x13, 0xd1, 0xf0, 0x84, 0xa6, 0x1f, 0x4f, 0xe9, 0x08, 0xa8, 0x40, 0x7b, 0x17, 0xb3, 0xfe,
+	0xe8, 0x89, 0xeb, 0x4f, 0x22, 0x5b, 0xce, 0x1d, 0x7f, 0x37, 0xa5, 0x27, 0xe1, 0x52, 0x90, 0x92,
+	0x55, 0xd8, 0x49, 0xad, 0xc2, 0x5b, 0xd0, 0xd5, 0x0f, 0x3a, 0xd4, 0xf5, 0x81, 0x8e, 0x06, 0x1d,
+	0xb0, 0x28, 0x1b, 0x3e, 0xcb, 0xc0, 0x5f, 0x3f, 0x61, 0x9b, 0x22, 0x7f, 0x22, 0xa5, 0x23, 0xa0,
+	0x56, 0xd1, 0x11, 0xc2, 0xf2, 0xc5, 0x37, 0xb5, 0x95, 0x45, 0x36, 0xb5, 0x87, 0xd0, 0x26, 0x41,
+	0x10, 0xfa, 0x27, 0xf3, 0xde, 0x02, 0x06, 0x89, 0xbe, 0x13, 0x1b, 0x0f, 0xa0, 0x11, 0x10, 0x77,
+	0xce, 0x24, 0xfe, 0x3a, 0xa2, 0xee, 0xc4, 0x78, 0xdf, 0x3a, 0x39, 0x54, 0x57, 0xd3, 0xbc, 0xc6,
+	0xf5, 0x86, 0x56, 0x23, 0x34, 0xfd, 0x7f, 0xac, 0x42, 0xe3, 0x89, 0x1b, 0x05, 0x93, 0x82, 0xb8,
+	0xb4, 0xae, 0x67, 0xcb, 0x69, 0x3d, 0x9b, 0x59, 0x5c, 0x95, 0xdc, 0xe2, 0xca, 0x58, 0x3e, 0xd5,
+	0x9c, 0xe5, 0x73, 0x13, 0xda, 0x7c, 0xba, 0xf8, 0x39, 0x8b, 0xd0, 0xf2, 0x1c, 0xc4, 0x4e, 0x59,
+	0xa6, 0x19, 0x39, 0x89, 0xb8, 0x34, 0x52, 0xe2, 0xa2, 0x1b, 0x3f, 0xcd, 0x45, 0x8c, 0x9f, 0x56,
+	0x6a, 0x85, 0x3f, 0x86, 0x1e, 0x3d, 0x71, 0x1d, 0xea, 0x0d, 0xa9, 0xed, 0x4c, 0xe8, 0x7c, 0x66,
+	0x4c, 0x47, 0x36, 0x79, 0x32, 0xa1, 0x3b, 0x18, 0xbb, 0x6c, 0x4a, 0x80, 0xb8, 0x89, 0x93, 0x18,
+	0x32, 0x82, 0xd9, 0x4f, 0x45, 0xbd, 0xa5, 0x30, 0x71, 0xe1, 0x69, 0x59, 0xd9, 0x7c, 0xb1, 0xb4,
+	0x0e, 0x55, 0xa2, 0x75, 0x5a, 0x80, 0x3b, 0x17, 0x17, 0xe0, 0xee, 0x62, 0x56, 0x59, 0x2b, 0xb9,
+	0x4a, 0x72, 0xfe, 0x9e, 0xd1, 0x1c, 0x8a, 0x8b, 0x23, 0x98, 0x2b, 0xd0, 0xcb, 0x8c, 0x95, 0xb9,
+	0xf0, 0xf4, 0x34, 0x56, 0xf7, 0x2e, 0xe9, 0x69, 0x6c, 0x6c, 0x43, 0xed, 0xd0, 0x1d, 0xd1, 0xc8,
+	0x2c, 0x67, 0xac, 0xa9, 0x4c, 0xe3, 0x67, 0xee, 0x88, 0x5a, 0x1c, 0x35, 0xc3, 0x8a, 0xca, 0x22,
+	0x3b, 0xd9, 0x43, 0x58, 0x2d, 0x20, 0x5c, 0xf8, 0x00, 0x87, 0x48, 0x2c, 0x2c, 0xab, 0xc4, 0xc2,
+	0xfe, 0xbf, 0x6c, 0xc1, 0xd2, 0x8b, 0xc9, 0x41, 0x92, 0xc7, 0x58, 0x60, 0x17, 0x69, 0x81, 0xaf,
+	0x72, 0x36, 0xf0, 0x75, 0xee, 0xaa, 0xe1, 0xed, 0x9d, 0xc9, 0x50, 0xbb, 0x39, 0xdc, 0x12, 0x10,
+	0x7e, 0x71, 0x18, 0xb3, 0x83, 0xb5, 0x8b, 0xc3, 0x58, 0xe4, 0x84, 0x87, 0x93, 0x28, 0xf6, 0xc7,
+	0xba, 0x51, 0x04, 0x12, 0x34, 0x70, 0xf0, 0x42, 0x7f, 0x14, 0xfb, 0xa1, 0x08, 0x62, 0x20, 0x0e,
+	0x37, 0x8f, 0x96, 0x38, 0x14, 0x63, 0x16, 0x83, 0x29, 0x31, 0xbe, 0x66, 0x71, 0x8c, 0x4f, 0x65,
+	0x69, 0xb5, 0xf4, 0x0b, 0xa0, 0xc9, 0xe2, 0x84, 0xa9, 0x16, 0x55, 0x3b, 0xb3, 0xd7, 0x6e, 0x42,
+	0x13, 0xfd, 0xc3, 0xf0, 0x44, 0xbd, 0x0b, 0xa1, 0xca, 0xa8, 0xdc, 0xe5, 0x6f, 0xf1, 0x72, 0x12,
+	0x4f, 0x8e, 0xec, 0x48, 0x28, 0x7f, 0x39, 0x29, 0x59, 0xcc, 0xdd, 0xd4, 0x62, 0xfe, 0x12, 0x96,
+	0xe2, 0xd0, 0x25, 0x23, 0x9b, 0x7a, 0x73, 0x0a, 0x30, 0x30, 0xfc, 0xa7, 0x1e, 0xca, 0xfe, 0x77,
+	0xb0, 0xc6, 0x3b, 0x19, 0x8b, 0x5c, 0x1d, 0x9b, 0x05, 0xfb, 0xe6, 0xd8, 0x3c, 0x0c, 0xd1, 0x8e,
+	0xa7, 0xf2, 0xbc, 0xc0, 0x56, 0xc6, 0x37, 0x60, 0x64, 0xa8, 0x51, 0xcf, 0x99, 0x63, 0x37, 0x59,
+	0x4e, 0xd1, 0x7a, 0xca, 0x32, 0x00, 0x7a, 0x1e, 0x3d, 0x4d, 0x3d, 0xf1, 0x70, 0xfe, 0xc6, 0xd2,
+	0xc1, 0x26, 0xc9, 0x0b, 0x0f, 0x6c, 0x1b, 0xc7, 0x6c, 0x41, 0x12, 0xc7, 0x74, 0x1c, 0xc4, 0x11,
+	0xdb, 0x62, 0x6a, 0xb8, 0x8d, 0xc7, 0xe1, 0xd9, 0x8e, 0x00, 0xb2, 0x7b, 0xa2, 0x94, 0x67, 0x36,
+	0xaa, 0xad, 0x60, 0x4d, 0x5c, 0xff, 0xe4, 0x70, 0x79, 0x7d, 0xaf, 0x0f, 0x1d, 0x76, 0xa5, 0x51,
+	0xa1, 0xf1, 0xc7, 0xb9, 0xd8, 0xeb, 0x0b, 0x12, 0x27, 0xbf, 0x55, 0xaf, 0x17, 0x6d, 0xd5, 0xf7,
+	0x61, 0x6d, 0x88, 0x96, 0xc1, 0xc8, 0x26, 0x29, 0x5e, 0xf1, 0xab, 0x5e, 0x2b, 0xbc, 0x6e, 0x47,
+	0x63, 0xc8, 0x43, 0x68, 0x73, 0xe0, 0xbc, 0x6f, 0x73, 0x81, 0x44, 0xe7, 0x1a, 0x2e, 0x20, 0x13,
+	0xa1, 0xe1, 0x36, 0xe6, 0xb0, 0xca, 0x18, 0xf2, 0x4e, 0x56, 0x21, 0x6f, 0x5e, 0x5c, 0x21, 0x5f,
+	0x5b, 0xf0, 0x81, 0x8f, 0xf4, 0x8c, 0x10, 0x7e, 0xf7, 0x6a, 0x36, 0x81, 0xd4, 0x6c, 0xed, 0xc4,
+	0xfd, 0x7f, 0x53, 0x81, 0xce, 0x0f, 0x93, 0xf8, 0xc0, 0x3f, 0x7d, 0x2e, 0x5e, 0x2d, 0x28, 0x7a,
+	0xf5, 0xc0, 0x0f, 0xdc, 0xa1, 0x7a, 0xf5, 0x00, 0x0b, 0xc6, 0xdb, 0x32, 0xf8, 0xc1, 0x95, 0x6e,
+	0x37, 0x9d, 0x2c, 0x22, 0xc3, 0x1e, 0xd3, 0xac, 0xe7, 0x4d, 0x68, 0x2a, 0x71, 0xab, 0xb1, 0x1a,
+	0x55, 0x46, 0xd5, 0xc7, 0xe4, 0x87, 0x27, 0x4d, 0x70, 0x0d, 0xd6, 0x42, 0xc8, 0x53, 0x04, 0x28,
+	0x99, 0x17, 0xf8, 0xf3, 0x1d, 0xf4, 0x30, 0x99, 0x17, 0xb2, 0x9c, 0x9b, 0xb0, 0xdf, 0x50, 0x80,
+	0xde, 0x78, 0x04, 0x4b, 0x0e, 0x1d, 0xb9, 0x27, 0x34, 0x9c, 0x37, 0x28, 0xd2, 0x56, 0xf8, 0x3b,
+	0xb1, 0xb2, 0xfd, 0x6d, 0x19, 0x37, 0x68, 0xb3, 0xb8, 0x01, 0xb7, 0xfd, 0x5f, 0x72, 0x58, 0xff,
+	0xdf, 0x95, 0x60, 0xfd, 0xcf, 0xd3, 0x83, 0x63, 0xdf, 0x7f, 0xf5, 0x84, 0xb7, 0x95, 0x4b, 0x38,
+	0x9f, 0xd1, 0x52, 0x9a, 0x27, 0xa3, 0xa5, 0x3c, 0x2b, 0xa3, 0xa5, 0xa2, 0x67, 0xb4, 0x6c, 0x42,
+	0xd3, 0x99, 0x88, 0xc0, 0x60, 0x95, 0x75, 0x4d, 0x95, 0x2f, 0x93, 0x34, 0xf1, 0x27, 0x55, 0xe8,
+	0x65, 0x46, 0xb4, 0xe8, 0x6e, 0xab, 0x9b, 0xaf, 0x95, 0xb4, 0xf9, 0x7a, 0x0d, 0x5a, 0xdc, 0x2b,
+	0xd2, 0xe2, 0x0f, 0x1c, 0x20, 0x76, 0xb6, 0x13, 0xaa, 0x1e, 0x1a, 0xe6, 0x05, 0x69, 0x0d, 0xd4,
+	0x93, 0x6b, 0x06, 0x26, 0x9a, 0xe7, 0x67, 0x23, 0x9f, 0xc8, 0xcd, 0x54, 0x16, 0xa7, 0x06, 0xd6,
+	0x74, 0xf9, 0x6f, 0x65, 0xe4, 0xff, 0x73, 0x68, 0xc8, 0xab, 0x33, 0x90, 0x79, 0xba, 0xae, 0x78,
+	0x66, 0x2d, 0x89, 0x5f, 0xb4, 0x36, 0xda, 0x97, 0x5b, 0x1b, 0x4b, 0x17, 0x5f, 0x1b, 0x9d, 0xcb,
+	0xac, 0x8d, 0xee, 0x42, 0x6b, 0xa3, 0xff, 0xc7, 0x25, 0x68, 0x25, 0x79, 0x86, 0x38, 0x1f, 0x34,
+	0x1c, 0xca, 0x0c, 0xfd, 0x92, 0x25, 0x8b, 0xcc, 0x19, 0xe5, 0x3f, 0xed, 0x4c, 0x44, 0xa2, 0x27,
+	0xe0, 0x7a, 0x32, 0xc6, 0xa1, 0xab, 0xbc, 0xdf, 0x8a, 0x30, 0xc2, 0x5d, 0xe9, 0xfd, 0xbe, 0x09,
+	0x98, 0x2d, 0x6a, 0x67, 0x5e, 0xff, 0x68, 0x1f, 0xba, 0xa7, 0x2a, 0x6f, 0xe9, 0x2b, 0x68, 0x3d,
+	0x57, 0x57, 0xbb, 0x2e, 0xf2, 0x82, 0xc8, 0x5f, 0x2b, 0x43, 0xfd, 0x19, 0xa5, 0x2f, 0x28, 0xde,
+	0xfc, 0x6c, 0x8f, 0xd5, 0x85, 0x32, 0x9e, 0x81, 0xa1, 0x0b, 0x06, 0xc7, 0xba, 0xa7, 0x3e, 0x27,
+	0xee, 0xaf, 0xc2, 0x58, 0x01, 0x8c, 0x47, 0x05, 0xd9, 0x82, 0xf5, 0xcc, 0x15, 0xc5, 0x19, 0x89,
+	0x82, 0x5f, 0x15, 0x25, 0x0a, 0x36, 0xa6, 0xb6, 0xcf, 0xe5, 0x08, 0x6e, 0x3e, 0x82, 0x5e, 0xa6,
+	0x7b, 0xe7, 0xe5, 0x75, 0x97, 0xf4, 0xbc, 0xee, 0x7f, 0x54, 0x05, 0x98, 0x91, 0x42, 0x79, 0x0d,
+	0x5a, 0xd9, 0xc3, 0xe8, 0xe6, 0x58, 0x5a, 0xa8, 0x49, 0x7e, 0x65, 0x65, 0x46, 0x7e, 0x65, 0x35,
+	0x9b, 0x5f, 0xf9, 0x16, 0x54, 0xd9, 0xfd, 0x39, 0xce, 0xec, 0x5e, 0x86, 0xd9, 0x16, 0xab, 0xd4,
+	0x9f, 0xf0, 0xa9, 0xa7, 0x9e, 0xf0, 0xb9, 0x44, 0x92, 0x54, 0xea, 0x60, 0xa4, 0x99, 0xc9, 0x09,
+	0xd0, 0x02, 0xc7, 0x5c, 0x73, 0xc8, 0x22, 0x5e, 0xbd, 0x4b, 0xde, 0xbf, 0x61, 0x51, 0xa9, 0x79,
+	0xfc, 0x55, 0xd9, 0x82, 0x05, 0xa6, 0x1e, 0xc1, 0x52, 0x42, 0x22, 0xf6, 0xe7, 0xd0, 0x1e, 0x6d,
+	0x85, 0xbf, 0xef, 0x63, 0xac, 0x32, 0xa4, 0xac, 0xdf, 0x6c, 0xdc, 0xd8, 0x07, 0x64, 0x8c, 0x78,
+	0xe3, 0x4d, 0xab, 0xc2, 0x8f, 0x0d, 0xf0, 0x61, 0xbc, 0x15, 0xe1, 0x53, 0x1e, 0x9c, 0xd9, 0x92,
+	0x8d, 0xfc, 0xa9, 0x94, 0x2e, 0xaf, 0x78, 0x7c, 0xf6, 0x23, 0x67, 0x67, 0xca, 0xfd, 0xec, 0x2e,
+	0xe0, 0x7e, 0x3e, 0x83, 0x6e, 0x22, 0x37, 0xdf, 0xb9, 0x11, 0x3a, 0xe5, 0xa9, 0xeb, 0x91, 0xa5,
+	0xcc, 0x79, 0x40, 0xf1, 0xcd, 0xc8, 0xfe, 0xdf, 0x2b, 0xc3, 0xda, 0x8e, 0xe3, 0x68, 0xb5, 0xe2,
+	0x89, 0x81, 0x94, 0xe8, 0x95, 0xa6, 0x8a, 0xde, 0x42, 0xa9, 0xbd, 0x97, 0x13, 0xbd, 0xbc, 0x20,
+	0x34, 0x2e, 0x2b, 0x08, 0xcd, 0x85, 0x04, 0x01, 0x4f, 0x7f, 0xd7, 0x7e, 0x4e, 0xe3, 0xdf, 0x0c,
+	0xb3, 0xa6, 0x1d, 0x6d, 0xe8, 0xaa, 0xb5, 0x96, 0x71, 0x35, 0x17, 0x4c, 0x79, 0xec, 0x07, 0xb0,
+	0xb2, 0x4b, 0x46, 0xc3, 0xc9, 0x88, 0x49, 0x2f, 0xa5, 0xec, 0x68, 0x26, 0x1d, 0xa7, 0x29, 0x65,
+	0xe3, 0x34, 0xb8, 0x45, 0x50, 0x9a, 0xdd, 0x68, 0x30, 0xe8, 0xaa, 0x5f, 0xc3, 0x43, 0x14, 0x75,
+	0x51, 0xab, 0x65, 0x35, 0x0e, 0x29, 0x7b, 0xca, 0xb1, 0x1f, 0x81, 0x91, 0xbe, 0xe6, 0xbb, 0xef,
+	0xf2, 0x73, 0xc4, 0x13, 0x7f, 0x34, 0x19, 0xd3, 0x24, 0x15, 0xb2, 0x64, 0x01, 0x07, 0xc9, 0x44,
+	0x48, 0xb9, 0xc3, 0xa1, 0x86, 0xe6, 0x7a, 0x14, 0x04, 0x08, 0x37, 0xc7, 0x6b, 0xd0, 0xe2, 0x57,
+	0x22, 0x0e, 0x29, 0xff, 0x66, 0xc9, 0x6a, 0x32, 0x00, 0x26, 0x72, 0xff, 0x97, 0x0a, 0x74, 0xd3,
+	0x5f, 0x5d, 0x3c, 0x9a, 0x5e, 0x18, 0x3b, 0xa8, 0x14, 0xc7, 0x0e, 0x34, 0x65, 0x56, 0x4d, 0x2b,
+	0xb3, 0x59, 0x93, 0xf7, 0x33, 0x7c, 0x87, 0x8d, 0x86, 0xfc, 0x81, 0x5d, 0xfd, 0x49, 0x9a, 0x3c,
+	0xc3, 0x2c, 0x8e, 0x89, 0x6b, 0x05, 0xf7, 0x4f, 0xb9, 0x69, 0x95, 0xac, 0xfa, 0xd8, 0xc5, 0x6d,
+	0x89, 0x55, 0x90, 0x53, 0xed, 0x26, 0x52, 0x7d, 0x4c, 0x4e, 0xb1, 0x22, 0xbf, 0x88, 0x5a, 0x97,
+	0x5d, 0x44, 0xb0, 0x98, 0x36, 0xd5, 0xd6, 0x77, 0x7b, 0xc6, 0xd6, 0xb2, 0x88, 0x89, 0xd6, 0xff,
+	0x2b, 0x25, 0xf1, 0x2a, 0xd9, 0x39, 0xb3, 0xac, 0x4d, 0x4c, 0x39, 0x3d, 0x31, 0xb7, 0xa0, 0xcb,
+	0x72, 0x89, 0x46, 0x67, 0x36, 0x17, 0x3b, 0x79, 0x7d, 0x51, 0x40, 0x5f, 0x32, 0x60, 0x56, 0x50,
+	0xab, 0x59, 0x41, 0xed, 0xff, 0xcf, 0x12, 0x5c, 0x2f, 0xcc, 0x17, 0x90, 0xd7, 0xc0, 0x17, 0x16,
+	0xbc, 0x27, 0x90, 0x4e, 0x7c, 0x30, 0x2b, 0x99, 0xf7, 0x2c, 0x0a, 0x3f, 0x97, 0xcd, 0x96, 0x48,
+	0x33, 0xb7, 0xba, 0xc8, 0xbe, 0x3d, 0xed, 0x39, 0x3f, 0xcc, 0xcb, 0x5f, 0xde, 0x55, 0x31, 0x38,
+	0xca, 0x4f, 0x64, 0xcf, 0x3d, 0x36, 0x3b, 0xc7, 0xab, 0x91, 0x69, 0x50, 0x95, 0x74, 0x1a, 0x14,
+	0xb7, 0x9f, 0xaa, 0xda, 0xbd, 0x38, 0x5c, 0x4b, 0xea, 0x25, 0x35, 0x91, 0x62, 0x28, 0xcb, 0x97,
+	0xc8, 0xb6, 0xec, 0xff, 0x01, 0xac, 0xa8, 0x41, 0x05, 0xfa, 0xac, 0xf1, 0x8b, 0xaa, 0x4b, 0xec,
+	0xa2, 0x6a, 0x9a, 0x7e, 0x79, 0x11, 0xfa, 0xff, 0xb8, 0x04, 0xeb, 0xf2, 0x03, 0xe2, 0x0d, 0x0c,
+	0xed, 0x89, 0x80, 0xff, 0xef, 0x8f, 0xe8, 0x5d, 0xc6, 0x69, 0x1d, 0xc3, 0xa6, 0xec, 0xf9, 0x8b,
+	0x38, 0x74, 0xbd, 0xa3, 0x97, 0x38, 0x11, 0xb2, 0xf7, 0x6a, 0x96, 0x4a, 0xfa, 0x2c, 0x5d, 0x82,
+	0x53, 0xff, 0xbd, 0x01, 0x4d, 0xf9, 0xbd, 0x22, 0xe7, 0x58, 0x7b, 0x88, 0xae, 0x9c, 0x79, 0x88,
+	0xee, 0xfc, 0xcc, 0x14, 0x15, 0xdf, 0xad, 0xce, 0x7e, 0xe0, 0xaf, 0x36, 0xf3, 0x81, 0xbf, 0xfa,
+	0xec, 0x07, 0xfe, 0x1a, 0x45, 0x0f, 0xfc, 0xc9, 0x58, 0x7c, 0x53, 0x8b, 0xc5, 0x27, 0x8f, 0xfe,
+	0x2d, 0xcd, 0x7c, 0xf4, 0xef, 0x1d, 0xe8, 0x91, 0xe1, 0x90, 0x06, 0xb1, 0xad, 0x2e, 0x24, 0x73,
+	0x25, 0xda, 0xe5, 0xe0, 0xef, 0x04, 0x14, 0xd9, 0xc3, 0x16, 0x2d, 0x39, 0xa2, 0xe2, 0xb0, 0x05,
+	0xff, 0x64, 0x0f, 0x3e, 0xbb, 0x82, 0x00, 0xfd, 0xf1, 0xc0, 0xce, 0x22, 0x8f, 0x07, 0x7e, 0x0c,
+	0x4d, 0x57, 0xac, 0x74, 0xb3, 0xcb, 0xb6, 0xa9, 0x0d, 0xed, 0x10, 0x2a, 0xad, 0x0a, 0x2c, 0x85,
+	0x8a, 0x42, 0xe0, 0x06, 0xea, 0xe9, 0x8c, 0x5e, 0xe6, 0xe9, 0x8c, 0xdc, 0x72, 0xb3, 0x5a, 0xae,
+	0xfc, 0x69, 0x7c, 0x03, 0x3d, 0xf1, 0x71, 0xd5, 0x7e, 0x39, 0xe3, 0x26, 0x16, 0xaf, 0x26, 0xab,
+	0x4b, 0x52, 0x65, 0xe3, 0x77, 0xa0, 0xcb, 0xb9, 0xa8, 0x08, 0xad, 0x64, 0x9e, 0x69, 0x99, 0x2e,
+	0xdc, 0x56, 0x87, 0x37, 0x95, 0xb4, 0x7e, 0x0f, 0xae, 0x66, 0xe6, 0x41, 0x11, 0x35, 0xe6, 0x27,
+	0x7a, 0x25, 0x3d, 0x69, 0x92, 0xf8, 0x43, 0xed, 0x7d, 0x87, 0xd5, 0x29, 0x63, 0x9d, 0xf3, 0x79,
+	0x87, 0xb5, 0x8b, 0x07, 0x3a, 0xae, 0x2c, 0x10, 0xe8, 0xb8, 0xdc, 0x13, 0x0e, 0x3f, 0x87, 0xd5,
+	0x7d, 0xfc, 0x43, 0x3f, 0xec, 0xe5, 0x64, 0xb6, 0xce, 0xb0, 0x6a, 0x8a, 0x3e, 0xd1, 0xb5, 0x7e,
+	0x39, 0xad, 0xf5, 0x53, 0x84, 0xd8, 0x1f, 0x87, 0xba, 0x28, 0xa1, 0x3b, 0xb0, 0xac, 0x08, 0x0d,
+	0x82, 0x19, 0x54, 0xfa, 0x1f, 0xc0, 0x9a, 0xc2, 0xfc, 0x8e, 0x89, 0xc8, 0x2c, 0xec, 0xdb, 0xd0,
+	0x55, 0xd8, 0xb3, 0xf0, 0xfe, 0xa8, 0x0a, 0x2d, 0x85, 0x98, 0x53, 0x7d, 0xdb, 0xfa, 0x5b, 0xed,
+	0xfa, 0xd2, 0x2d, 0xe0, 0xa2, 0x54, 0x6c, 0xdb, 0x52, 0x63, 0x55, 0xa7, 0xb5, 0x49, 0x18, 0x26,
+	0xf5, 0xd9, 0xfb, 0x42, 0x51, 0xd5, 0x33, 0x17, 0x27, 0xd3, 0x43, 0x50, 0xcf, 0xb9, 0xa3, 0x06,
+	0xe3, 0x1e, 0xd9, 0x46, 0x1e, 0x55, 0x70, 0x91, 0x29, 0xb7, 0x8f, 0x95, 0x72, 0xe3, 0xfe, 0xd7,
+	0x8d, 0x3c, 0xba, 0xc6, 0xca, 0xa2, 0x07, 0x4f, 0x5b, 0x17, 0x7d, 0xf0, 0x34, 0xfb, 0x5c, 0x8a,
+	0xfa, 0xe0, 0xac, 0x07, 0x4f, 0x35, 0x45, 0xda, 0xce, 0x2a, 0xd2, 0x02, 0x85, 0xbc, 0x54, 0xa4,
+	0x90, 0x2f, 0xb7, 0x42, 0x9e, 0xc1, 0x3a, 0xeb, 0xe9, 0x0b, 0x1a, 0xe3, 0x0d, 0xfa, 0xc8, 0xa2,
+	0xf1, 0x24, 0xf4, 0x7e, 0xe4, 0x41, 0x5a, 0xf9, 0x57, 0x3d, 0x84, 0xc9, 0x20, 0x8a, 0xec, 0x9e,
+	0x6a, 0xb2, 0x35, 0xb2, 0xdf, 0xfd, 0xdf, 0x85, 0x95, 0x14, 0x1d, 0xe6, 0xef, 0x89, 0x8c, 0xbb,
+	0x52, 0x92, 0x71, 0x97, 0xb8, 0x9e, 0xb5, 0xb9, 0xa3, 0x7a, 0xff, 0xb4, 0x02, 0x9d, 0x14, 0xed,
+	0xf3, 0x0c, 0xbd, 0x3f, 0x07, 0x10, 0xb2, 0x61, 0xe0, 0x5f, 0x40, 0x12, 0x46, 0xed, 0xcd, 0xf4,
+	0xc4, 0xe4, 0x86, 0x6b, 0xb5, 0x42, 0x35, 0xf2, 0x19, 0x9d, 0x99, 0x3a, 0x80, 0xfc, 0x9f, 0xc3,
+	0xab, 0x17, 0xfd, 0x39, 0xbc, 0x0f, 0x65, 0x52, 0x65, 0x23, 0xb3, 0x53, 0xe5, 0x98, 0x27, 0x73,
+	0x2b, 0x33, 0x4f, 0x02, 0x35, 0xf3, 0x4f, 0x02, 0x61, 0x02, 0x9b, 0xfc, 0x1b, 0x39, 0xae, 0x83,
+	0x22, 0x8c, 0x8f, 0xfc, 0xb4, 0x25, 0x6c, 0xe0, 0x44, 0xc6, 0xd7, 0x39, 0x41, 0x7d, 0xbb, 0xf8,
+	0xcb, 0xd3, 0x84, 0xf5, 0x52, 0x42, 0xf6, 0xf8, 0xab, 0x5f, 0x3c, 0x3a, 0x72, 0xe3, 0xe3, 0xc9,
+	0xc1, 0xbd, 0xa1, 0x3f, 0xbe, 0x1f, 0x90, 0xb3, 0x68, 0x12, 0xd0, 0x50, 0xfd, 0xb8, 0x2b, 0xba,
+	0x72, 0x97, 0xa5, 0x41, 0x85, 0xf7, 0x83, 0x57, 0x47, 0xfc, 0xcf, 0x2f, 0xca, 0xbf, 0xd1, 0x78,
+	0x50, 0x67, 0xc5, 0x07, 0xff, 0x77, 0x00, 0xdc, 0xbe, 0x10, 0x39, 0xbd, 0x71, 0x00, 0x00,
 }
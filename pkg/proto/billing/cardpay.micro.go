@@ -22,6 +22,8 @@ It has these top-level messages:
 	CardPayRefundCallbackRefundData
 	CardPayRefundCallbackPaymentData
 	CardPayRefundCallback
+	CardPayChargebackCallbackChargebackData
+	CardPayChargebackCallback
 */
 package billing
 
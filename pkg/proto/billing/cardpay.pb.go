@@ -1,11 +1,13 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: billing/cardpay.proto
 
-package billing // import "github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+package billing
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -16,7 +18,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type CardPayAddress struct {
 	Country              string   `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
@@ -34,16 +36,17 @@ func (m *CardPayAddress) Reset()         { *m = CardPayAddress{} }
 func (m *CardPayAddress) String() string { return proto.CompactTextString(m) }
 func (*CardPayAddress) ProtoMessage()    {}
 func (*CardPayAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{0}
+	return fileDescriptor_68d01ffc85afaf12, []int{0}
 }
+
 func (m *CardPayAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayAddress.Unmarshal(m, b)
 }
 func (m *CardPayAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayAddress.Marshal(b, m, deterministic)
 }
-func (dst *CardPayAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayAddress.Merge(dst, src)
+func (m *CardPayAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayAddress.Merge(m, src)
 }
 func (m *CardPayAddress) XXX_Size() int {
 	return xxx_messageInfo_CardPayAddress.Size(m)
@@ -110,16 +113,17 @@ func (m *CardPayItem) Reset()         { *m = CardPayItem{} }
 func (m *CardPayItem) String() string { return proto.CompactTextString(m) }
 func (*CardPayItem) ProtoMessage()    {}
 func (*CardPayItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{1}
+	return fileDescriptor_68d01ffc85afaf12, []int{1}
 }
+
 func (m *CardPayItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayItem.Unmarshal(m, b)
 }
 func (m *CardPayItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayItem.Marshal(b, m, deterministic)
 }
-func (dst *CardPayItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayItem.Merge(dst, src)
+func (m *CardPayItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayItem.Merge(m, src)
 }
 func (m *CardPayItem) XXX_Size() int {
 	return xxx_messageInfo_CardPayItem.Size(m)
@@ -172,16 +176,17 @@ func (m *CardPayMerchantOrder) Reset()         { *m = CardPayMerchantOrder{} }
 func (m *CardPayMerchantOrder) String() string { return proto.CompactTextString(m) }
 func (*CardPayMerchantOrder) ProtoMessage()    {}
 func (*CardPayMerchantOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{2}
+	return fileDescriptor_68d01ffc85afaf12, []int{2}
 }
+
 func (m *CardPayMerchantOrder) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayMerchantOrder.Unmarshal(m, b)
 }
 func (m *CardPayMerchantOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayMerchantOrder.Marshal(b, m, deterministic)
 }
-func (dst *CardPayMerchantOrder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayMerchantOrder.Merge(dst, src)
+func (m *CardPayMerchantOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayMerchantOrder.Merge(m, src)
 }
 func (m *CardPayMerchantOrder) XXX_Size() int {
 	return xxx_messageInfo_CardPayMerchantOrder.Size(m)
@@ -234,16 +239,17 @@ func (m *CallbackCardPayBankCardAccount) Reset()         { *m = CallbackCardPayB
 func (m *CallbackCardPayBankCardAccount) String() string { return proto.CompactTextString(m) }
 func (*CallbackCardPayBankCardAccount) ProtoMessage()    {}
 func (*CallbackCardPayBankCardAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{3}
+	return fileDescriptor_68d01ffc85afaf12, []int{3}
 }
+
 func (m *CallbackCardPayBankCardAccount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallbackCardPayBankCardAccount.Unmarshal(m, b)
 }
 func (m *CallbackCardPayBankCardAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CallbackCardPayBankCardAccount.Marshal(b, m, deterministic)
 }
-func (dst *CallbackCardPayBankCardAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallbackCardPayBankCardAccount.Merge(dst, src)
+func (m *CallbackCardPayBankCardAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackCardPayBankCardAccount.Merge(m, src)
 }
 func (m *CallbackCardPayBankCardAccount) XXX_Size() int {
 	return xxx_messageInfo_CallbackCardPayBankCardAccount.Size(m)
@@ -296,16 +302,17 @@ func (m *CallbackCardPayCryptoCurrencyAccount) Reset()         { *m = CallbackCa
 func (m *CallbackCardPayCryptoCurrencyAccount) String() string { return proto.CompactTextString(m) }
 func (*CallbackCardPayCryptoCurrencyAccount) ProtoMessage()    {}
 func (*CallbackCardPayCryptoCurrencyAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{4}
+	return fileDescriptor_68d01ffc85afaf12, []int{4}
 }
+
 func (m *CallbackCardPayCryptoCurrencyAccount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallbackCardPayCryptoCurrencyAccount.Unmarshal(m, b)
 }
 func (m *CallbackCardPayCryptoCurrencyAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CallbackCardPayCryptoCurrencyAccount.Marshal(b, m, deterministic)
 }
-func (dst *CallbackCardPayCryptoCurrencyAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallbackCardPayCryptoCurrencyAccount.Merge(dst, src)
+func (m *CallbackCardPayCryptoCurrencyAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackCardPayCryptoCurrencyAccount.Merge(m, src)
 }
 func (m *CallbackCardPayCryptoCurrencyAccount) XXX_Size() int {
 	return xxx_messageInfo_CallbackCardPayCryptoCurrencyAccount.Size(m)
@@ -358,16 +365,17 @@ func (m *CardPayCustomer) Reset()         { *m = CardPayCustomer{} }
 func (m *CardPayCustomer) String() string { return proto.CompactTextString(m) }
 func (*CardPayCustomer) ProtoMessage()    {}
 func (*CardPayCustomer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{5}
+	return fileDescriptor_68d01ffc85afaf12, []int{5}
 }
+
 func (m *CardPayCustomer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayCustomer.Unmarshal(m, b)
 }
 func (m *CardPayCustomer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayCustomer.Marshal(b, m, deterministic)
 }
-func (dst *CardPayCustomer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayCustomer.Merge(dst, src)
+func (m *CardPayCustomer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayCustomer.Merge(m, src)
 }
 func (m *CardPayCustomer) XXX_Size() int {
 	return xxx_messageInfo_CardPayCustomer.Size(m)
@@ -417,16 +425,17 @@ func (m *CardPayEWalletAccount) Reset()         { *m = CardPayEWalletAccount{} }
 func (m *CardPayEWalletAccount) String() string { return proto.CompactTextString(m) }
 func (*CardPayEWalletAccount) ProtoMessage()    {}
 func (*CardPayEWalletAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{6}
+	return fileDescriptor_68d01ffc85afaf12, []int{6}
 }
+
 func (m *CardPayEWalletAccount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayEWalletAccount.Unmarshal(m, b)
 }
 func (m *CardPayEWalletAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayEWalletAccount.Marshal(b, m, deterministic)
 }
-func (dst *CardPayEWalletAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayEWalletAccount.Merge(dst, src)
+func (m *CardPayEWalletAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayEWalletAccount.Merge(m, src)
 }
 func (m *CardPayEWalletAccount) XXX_Size() int {
 	return xxx_messageInfo_CardPayEWalletAccount.Size(m)
@@ -466,16 +475,17 @@ func (m *CallbackCardPayPaymentData) Reset()         { *m = CallbackCardPayPayme
 func (m *CallbackCardPayPaymentData) String() string { return proto.CompactTextString(m) }
 func (*CallbackCardPayPaymentData) ProtoMessage()    {}
 func (*CallbackCardPayPaymentData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{7}
+	return fileDescriptor_68d01ffc85afaf12, []int{7}
 }
+
 func (m *CallbackCardPayPaymentData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallbackCardPayPaymentData.Unmarshal(m, b)
 }
 func (m *CallbackCardPayPaymentData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CallbackCardPayPaymentData.Marshal(b, m, deterministic)
 }
-func (dst *CallbackCardPayPaymentData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallbackCardPayPaymentData.Merge(dst, src)
+func (m *CallbackCardPayPaymentData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackCardPayPaymentData.Merge(m, src)
 }
 func (m *CallbackCardPayPaymentData) XXX_Size() int {
 	return xxx_messageInfo_CallbackCardPayPaymentData.Size(m)
@@ -581,16 +591,17 @@ func (m *CardPayCallbackRecurringDataFilling) Reset()         { *m = CardPayCall
 func (m *CardPayCallbackRecurringDataFilling) String() string { return proto.CompactTextString(m) }
 func (*CardPayCallbackRecurringDataFilling) ProtoMessage()    {}
 func (*CardPayCallbackRecurringDataFilling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{8}
+	return fileDescriptor_68d01ffc85afaf12, []int{8}
 }
+
 func (m *CardPayCallbackRecurringDataFilling) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayCallbackRecurringDataFilling.Unmarshal(m, b)
 }
 func (m *CardPayCallbackRecurringDataFilling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayCallbackRecurringDataFilling.Marshal(b, m, deterministic)
 }
-func (dst *CardPayCallbackRecurringDataFilling) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayCallbackRecurringDataFilling.Merge(dst, src)
+func (m *CardPayCallbackRecurringDataFilling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayCallbackRecurringDataFilling.Merge(m, src)
 }
 func (m *CardPayCallbackRecurringDataFilling) XXX_Size() int {
 	return xxx_messageInfo_CardPayCallbackRecurringDataFilling.Size(m)
@@ -631,16 +642,17 @@ func (m *CardPayCallbackRecurringData) Reset()         { *m = CardPayCallbackRec
 func (m *CardPayCallbackRecurringData) String() string { return proto.CompactTextString(m) }
 func (*CardPayCallbackRecurringData) ProtoMessage()    {}
 func (*CardPayCallbackRecurringData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{9}
+	return fileDescriptor_68d01ffc85afaf12, []int{9}
 }
+
 func (m *CardPayCallbackRecurringData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayCallbackRecurringData.Unmarshal(m, b)
 }
 func (m *CardPayCallbackRecurringData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayCallbackRecurringData.Marshal(b, m, deterministic)
 }
-func (dst *CardPayCallbackRecurringData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayCallbackRecurringData.Merge(dst, src)
+func (m *CardPayCallbackRecurringData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayCallbackRecurringData.Merge(m, src)
 }
 func (m *CardPayCallbackRecurringData) XXX_Size() int {
 	return xxx_messageInfo_CardPayCallbackRecurringData.Size(m)
@@ -763,16 +775,17 @@ func (m *CardPayPaymentCallback) Reset()         { *m = CardPayPaymentCallback{}
 func (m *CardPayPaymentCallback) String() string { return proto.CompactTextString(m) }
 func (*CardPayPaymentCallback) ProtoMessage()    {}
 func (*CardPayPaymentCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{10}
+	return fileDescriptor_68d01ffc85afaf12, []int{10}
 }
+
 func (m *CardPayPaymentCallback) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayPaymentCallback.Unmarshal(m, b)
 }
 func (m *CardPayPaymentCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayPaymentCallback.Marshal(b, m, deterministic)
 }
-func (dst *CardPayPaymentCallback) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayPaymentCallback.Merge(dst, src)
+func (m *CardPayPaymentCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayPaymentCallback.Merge(m, src)
 }
 func (m *CardPayPaymentCallback) XXX_Size() int {
 	return xxx_messageInfo_CardPayPaymentCallback.Size(m)
@@ -871,16 +884,17 @@ func (m *CardPayRefundCallbackRefundData) Reset()         { *m = CardPayRefundCa
 func (m *CardPayRefundCallbackRefundData) String() string { return proto.CompactTextString(m) }
 func (*CardPayRefundCallbackRefundData) ProtoMessage()    {}
 func (*CardPayRefundCallbackRefundData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{11}
+	return fileDescriptor_68d01ffc85afaf12, []int{11}
 }
+
 func (m *CardPayRefundCallbackRefundData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayRefundCallbackRefundData.Unmarshal(m, b)
 }
 func (m *CardPayRefundCallbackRefundData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayRefundCallbackRefundData.Marshal(b, m, deterministic)
 }
-func (dst *CardPayRefundCallbackRefundData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayRefundCallbackRefundData.Merge(dst, src)
+func (m *CardPayRefundCallbackRefundData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayRefundCallbackRefundData.Merge(m, src)
 }
 func (m *CardPayRefundCallbackRefundData) XXX_Size() int {
 	return xxx_messageInfo_CardPayRefundCallbackRefundData.Size(m)
@@ -975,16 +989,17 @@ func (m *CardPayRefundCallbackPaymentData) Reset()         { *m = CardPayRefundC
 func (m *CardPayRefundCallbackPaymentData) String() string { return proto.CompactTextString(m) }
 func (*CardPayRefundCallbackPaymentData) ProtoMessage()    {}
 func (*CardPayRefundCallbackPaymentData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{12}
+	return fileDescriptor_68d01ffc85afaf12, []int{12}
 }
+
 func (m *CardPayRefundCallbackPaymentData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayRefundCallbackPaymentData.Unmarshal(m, b)
 }
 func (m *CardPayRefundCallbackPaymentData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayRefundCallbackPaymentData.Marshal(b, m, deterministic)
 }
-func (dst *CardPayRefundCallbackPaymentData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayRefundCallbackPaymentData.Merge(dst, src)
+func (m *CardPayRefundCallbackPaymentData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayRefundCallbackPaymentData.Merge(m, src)
 }
 func (m *CardPayRefundCallbackPaymentData) XXX_Size() int {
 	return xxx_messageInfo_CardPayRefundCallbackPaymentData.Size(m)
@@ -1033,16 +1048,17 @@ func (m *CardPayRefundCallback) Reset()         { *m = CardPayRefundCallback{} }
 func (m *CardPayRefundCallback) String() string { return proto.CompactTextString(m) }
 func (*CardPayRefundCallback) ProtoMessage()    {}
 func (*CardPayRefundCallback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cardpay_8f41f6a56ecf9396, []int{13}
+	return fileDescriptor_68d01ffc85afaf12, []int{13}
 }
+
 func (m *CardPayRefundCallback) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CardPayRefundCallback.Unmarshal(m, b)
 }
 func (m *CardPayRefundCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CardPayRefundCallback.Marshal(b, m, deterministic)
 }
-func (dst *CardPayRefundCallback) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CardPayRefundCallback.Merge(dst, src)
+func (m *CardPayRefundCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayRefundCallback.Merge(m, src)
 }
 func (m *CardPayRefundCallback) XXX_Size() int {
 	return xxx_messageInfo_CardPayRefundCallback.Size(m)
@@ -1109,6 +1125,173 @@ func (m *CardPayRefundCallback) GetEwalletAccount() *CardPayEWalletAccount {
 	return nil
 }
 
+type CardPayChargebackCallbackChargebackData struct {
+	// @inject_tag: validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,numeric,gt=0"
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty" validate:"required,numeric,gt=0"`
+	// @inject_tag: validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty" validate:"required,alpha,len=3"`
+	// @inject_tag: validate:"required"
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty" validate:"required"`
+	Created              string   `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	ReasonCode           string   `protobuf:"bytes,6,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceDueDate      string   `protobuf:"bytes,8,opt,name=evidence_due_date,json=evidenceDueDate,proto3" json:"evidence_due_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CardPayChargebackCallbackChargebackData) Reset() {
+	*m = CardPayChargebackCallbackChargebackData{}
+}
+func (m *CardPayChargebackCallbackChargebackData) String() string { return proto.CompactTextString(m) }
+func (*CardPayChargebackCallbackChargebackData) ProtoMessage()    {}
+func (*CardPayChargebackCallbackChargebackData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68d01ffc85afaf12, []int{14}
+}
+
+func (m *CardPayChargebackCallbackChargebackData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CardPayChargebackCallbackChargebackData.Unmarshal(m, b)
+}
+func (m *CardPayChargebackCallbackChargebackData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CardPayChargebackCallbackChargebackData.Marshal(b, m, deterministic)
+}
+func (m *CardPayChargebackCallbackChargebackData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayChargebackCallbackChargebackData.Merge(m, src)
+}
+func (m *CardPayChargebackCallbackChargebackData) XXX_Size() int {
+	return xxx_messageInfo_CardPayChargebackCallbackChargebackData.Size(m)
+}
+func (m *CardPayChargebackCallbackChargebackData) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardPayChargebackCallbackChargebackData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CardPayChargebackCallbackChargebackData proto.InternalMessageInfo
+
+func (m *CardPayChargebackCallbackChargebackData) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetCreated() string {
+	if m != nil {
+		return m.Created
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetReasonCode() string {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallbackChargebackData) GetEvidenceDueDate() string {
+	if m != nil {
+		return m.EvidenceDueDate
+	}
+	return ""
+}
+
+type CardPayChargebackCallback struct {
+	// @inject_tag: validate:"required"
+	MerchantOrder *CardPayMerchantOrder `protobuf:"bytes,1,opt,name=merchant_order,json=merchantOrder,proto3" json:"merchant_order,omitempty" validate:"required"`
+	// @inject_tag: validate:"required"
+	PaymentMethod string `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty" validate:"required"`
+	// @inject_tag: validate:"required"
+	ChargebackData       *CardPayChargebackCallbackChargebackData `protobuf:"bytes,3,opt,name=chargeback_data,json=chargebackData,proto3" json:"chargeback_data,omitempty" validate:"required"`
+	CallbackTime         string                                   `protobuf:"bytes,4,opt,name=callback_time,json=callbackTime,proto3" json:"callback_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CardPayChargebackCallback) Reset()         { *m = CardPayChargebackCallback{} }
+func (m *CardPayChargebackCallback) String() string { return proto.CompactTextString(m) }
+func (*CardPayChargebackCallback) ProtoMessage()    {}
+func (*CardPayChargebackCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68d01ffc85afaf12, []int{15}
+}
+
+func (m *CardPayChargebackCallback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CardPayChargebackCallback.Unmarshal(m, b)
+}
+func (m *CardPayChargebackCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CardPayChargebackCallback.Marshal(b, m, deterministic)
+}
+func (m *CardPayChargebackCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardPayChargebackCallback.Merge(m, src)
+}
+func (m *CardPayChargebackCallback) XXX_Size() int {
+	return xxx_messageInfo_CardPayChargebackCallback.Size(m)
+}
+func (m *CardPayChargebackCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardPayChargebackCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CardPayChargebackCallback proto.InternalMessageInfo
+
+func (m *CardPayChargebackCallback) GetMerchantOrder() *CardPayMerchantOrder {
+	if m != nil {
+		return m.MerchantOrder
+	}
+	return nil
+}
+
+func (m *CardPayChargebackCallback) GetPaymentMethod() string {
+	if m != nil {
+		return m.PaymentMethod
+	}
+	return ""
+}
+
+func (m *CardPayChargebackCallback) GetChargebackData() *CardPayChargebackCallbackChargebackData {
+	if m != nil {
+		return m.ChargebackData
+	}
+	return nil
+}
+
+func (m *CardPayChargebackCallback) GetCallbackTime() string {
+	if m != nil {
+		return m.CallbackTime
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CardPayAddress)(nil), "billing.CardPayAddress")
 	proto.RegisterType((*CardPayItem)(nil), "billing.CardPayItem")
@@ -1124,80 +1307,89 @@ func init() {
 	proto.RegisterType((*CardPayRefundCallbackRefundData)(nil), "billing.CardPayRefundCallbackRefundData")
 	proto.RegisterType((*CardPayRefundCallbackPaymentData)(nil), "billing.CardPayRefundCallbackPaymentData")
 	proto.RegisterType((*CardPayRefundCallback)(nil), "billing.CardPayRefundCallback")
-}
-
-func init() { proto.RegisterFile("billing/cardpay.proto", fileDescriptor_cardpay_8f41f6a56ecf9396) }
-
-var fileDescriptor_cardpay_8f41f6a56ecf9396 = []byte{
-	// 1120 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcb, 0x6e, 0x24, 0x35,
-	0x17, 0x56, 0xdf, 0xbb, 0x4f, 0xa5, 0x3b, 0x51, 0x4d, 0xd2, 0x7f, 0x69, 0x7e, 0x66, 0x08, 0x15,
-	0xa2, 0xc9, 0x20, 0x92, 0xa0, 0x0e, 0x2c, 0x11, 0x4a, 0x3a, 0x0c, 0x0a, 0x9a, 0x11, 0x51, 0x69,
-	0x24, 0x24, 0x24, 0xd4, 0x72, 0x6c, 0x4f, 0xda, 0x4a, 0xdd, 0xe4, 0x72, 0x83, 0x9a, 0x77, 0x60,
-	0xc7, 0x1a, 0x56, 0xbc, 0x03, 0x7b, 0xde, 0x81, 0xf7, 0xe0, 0x0d, 0x90, 0xed, 0xe3, 0x4a, 0x75,
-	0xe5, 0xa2, 0x68, 0x46, 0x62, 0xc5, 0xae, 0xce, 0x67, 0xfb, 0x9c, 0xcf, 0xe7, 0xf2, 0xb9, 0x1b,
-	0xb6, 0x2e, 0x44, 0x1c, 0x8b, 0xf4, 0xf2, 0x90, 0x12, 0xc9, 0x72, 0xb2, 0x3c, 0xc8, 0x65, 0xa6,
-	0x32, 0xbf, 0x87, 0x70, 0xf8, 0x4b, 0x03, 0x46, 0x53, 0x22, 0xd9, 0x39, 0x59, 0x1e, 0x33, 0x26,
-	0x79, 0x51, 0xf8, 0x01, 0xf4, 0x68, 0xb6, 0x48, 0x95, 0x5c, 0x06, 0x8d, 0xed, 0xc6, 0xde, 0x20,
-	0x72, 0xa6, 0xef, 0x43, 0x9b, 0x0a, 0xb5, 0x0c, 0x9a, 0x06, 0x36, 0xdf, 0xfe, 0x26, 0x74, 0xf2,
-	0x79, 0x96, 0xf2, 0xa0, 0x65, 0x40, 0x6b, 0x68, 0xb4, 0x50, 0x44, 0xf1, 0xa0, 0x6d, 0x51, 0x63,
-	0xf8, 0x63, 0xe8, 0x16, 0x4a, 0x72, 0xae, 0x82, 0x8e, 0x81, 0xd1, 0xf2, 0x37, 0xa0, 0xf5, 0x93,
-	0xc8, 0x83, 0xae, 0x01, 0xf5, 0x67, 0x98, 0x81, 0x87, 0xac, 0xce, 0x14, 0x4f, 0x74, 0xe0, 0x94,
-	0x24, 0x1c, 0xf9, 0x98, 0x6f, 0x7f, 0x1b, 0x3c, 0xc6, 0x0b, 0x2a, 0x45, 0xae, 0x44, 0x96, 0x22,
-	0xa7, 0x2a, 0xa4, 0x49, 0x18, 0xe6, 0x86, 0x5a, 0x27, 0xb2, 0x86, 0x21, 0x2c, 0x05, 0xb5, 0xd4,
-	0x1a, 0x91, 0x35, 0xc2, 0x3f, 0x1a, 0xb0, 0x89, 0x11, 0x5f, 0x71, 0x49, 0xe7, 0x24, 0x55, 0xdf,
-	0x48, 0xc6, 0xa5, 0x3f, 0x82, 0xa6, 0x60, 0x18, 0xb8, 0x29, 0xd8, 0x03, 0xc2, 0x7e, 0x04, 0x1d,
-	0xa1, 0x78, 0x52, 0x04, 0xad, 0xed, 0xd6, 0x9e, 0x37, 0xd9, 0x3c, 0xc0, 0x5c, 0x1f, 0x54, 0x6e,
-	0x14, 0xd9, 0x2d, 0xfe, 0x09, 0x6c, 0x14, 0x73, 0x91, 0xe7, 0x22, 0xbd, 0x9c, 0x11, 0x9b, 0x7f,
-	0xc3, 0xcb, 0x9b, 0xfc, 0xaf, 0x7e, 0x0c, 0xcb, 0x13, 0xad, 0xbb, 0x03, 0x08, 0x84, 0xbf, 0x35,
-	0xe0, 0xe9, 0x94, 0xc4, 0xf1, 0x05, 0xa1, 0x57, 0xb8, 0xf7, 0x84, 0xa4, 0xe6, 0xf3, 0x98, 0xda,
-	0x3b, 0x8f, 0xa1, 0x3b, 0xcf, 0x62, 0xc6, 0x25, 0x5e, 0x04, 0x2d, 0xff, 0x13, 0xd8, 0x14, 0x45,
-	0xb1, 0xd0, 0xd1, 0xb1, 0xc6, 0x33, 0x9a, 0x31, 0x8e, 0xb7, 0xf2, 0x71, 0x6d, 0x6a, 0x97, 0xa6,
-	0x19, 0xe3, 0xfe, 0x13, 0x80, 0x84, 0x14, 0x57, 0x9c, 0xcd, 0x72, 0x92, 0x62, 0xcd, 0x07, 0x16,
-	0x39, 0x27, 0x26, 0xe5, 0x2a, 0xbb, 0xe2, 0xa9, 0xab, 0xbb, 0x31, 0xc2, 0x3f, 0x1b, 0xf0, 0x61,
-	0x8d, 0xe1, 0x54, 0x2e, 0x73, 0x95, 0x4d, 0x17, 0x52, 0xf2, 0x94, 0x2e, 0x1d, 0xcf, 0x5d, 0x18,
-	0x51, 0xb3, 0x50, 0x26, 0xc3, 0xf2, 0x1d, 0x5a, 0xd4, 0x75, 0xe8, 0x04, 0xb6, 0x70, 0x9b, 0x92,
-	0x24, 0x2d, 0x08, 0xd5, 0x79, 0x9f, 0x09, 0x86, 0xbc, 0x1f, 0xd9, 0xc5, 0xd7, 0xd7, 0x6b, 0x67,
-	0x4c, 0x13, 0xcf, 0x25, 0x9d, 0x91, 0xa4, 0xec, 0x88, 0x41, 0x34, 0xc8, 0x25, 0x3d, 0x36, 0x80,
-	0xff, 0x01, 0xac, 0xe9, 0x65, 0x8a, 0x84, 0x90, 0xbf, 0x97, 0x4b, 0xea, 0x38, 0x86, 0x33, 0x58,
-	0x77, 0xe4, 0x17, 0x85, 0xca, 0x12, 0x2e, 0xf5, 0x75, 0x79, 0x42, 0x44, 0x8c, 0x34, 0xad, 0x61,
-	0x5a, 0x26, 0x47, 0x2e, 0x4d, 0x91, 0x63, 0x0b, 0xb5, 0xca, 0x16, 0x1a, 0x43, 0x37, 0xce, 0x28,
-	0x89, 0xdd, 0x74, 0xa0, 0x15, 0x3e, 0x83, 0x2d, 0x0c, 0xf0, 0xe5, 0xb7, 0x24, 0x8e, 0xb9, 0x72,
-	0x69, 0xa9, 0xf5, 0x60, 0xf8, 0x57, 0x13, 0x1e, 0xd7, 0xf2, 0x79, 0x4e, 0x96, 0x09, 0x4f, 0xd5,
-	0x29, 0x51, 0xe4, 0x46, 0xcb, 0x8e, 0xa1, 0x8b, 0xd7, 0x6e, 0x9a, 0x96, 0x47, 0xcb, 0xff, 0x3f,
-	0x0c, 0xc8, 0x42, 0xcd, 0x6d, 0xc9, 0x2d, 0xbd, 0xbe, 0x06, 0x4c, 0xa1, 0xb5, 0x0a, 0x48, 0x4e,
-	0x14, 0x67, 0xc8, 0xd2, 0x99, 0xfe, 0x63, 0xe8, 0x97, 0x69, 0xb2, 0x73, 0x5c, 0xda, 0x3a, 0x8d,
-	0x8c, 0xd3, 0x58, 0xa4, 0xdc, 0x7a, 0xed, 0xba, 0xf1, 0x30, 0x98, 0x71, 0xbc, 0x0b, 0x23, 0xb7,
-	0x45, 0x72, 0x52, 0x64, 0x69, 0xd0, 0xb3, 0x35, 0x46, 0x34, 0x32, 0x60, 0x7d, 0xce, 0xfa, 0x37,
-	0xe7, 0xec, 0x11, 0x74, 0x44, 0x31, 0x3b, 0x62, 0xc1, 0x60, 0xbb, 0xb1, 0xd7, 0x8f, 0xda, 0xa2,
-	0x38, 0x62, 0x46, 0x29, 0x32, 0xc5, 0x03, 0x40, 0xa5, 0xc8, 0x14, 0xd7, 0xf2, 0x22, 0x65, 0x1a,
-	0x78, 0x56, 0x5e, 0xa4, 0x4c, 0xad, 0x10, 0x11, 0xb5, 0x28, 0x82, 0x35, 0x27, 0x44, 0xda, 0x0a,
-	0x3f, 0x83, 0x1d, 0x57, 0x62, 0x4c, 0x6f, 0xc4, 0xf5, 0xdd, 0x44, 0x7a, 0xa9, 0x33, 0xfb, 0xc2,
-	0x4e, 0xe4, 0x8d, 0x7a, 0xfc, 0xda, 0x82, 0xf7, 0xee, 0x3b, 0xf7, 0x5f, 0x45, 0xde, 0xb6, 0x22,
-	0xfe, 0x29, 0x74, 0xdf, 0x08, 0x9d, 0xf4, 0x60, 0x68, 0x64, 0xf1, 0xe3, 0xba, 0x2c, 0xde, 0x57,
-	0xa8, 0x08, 0xcf, 0x86, 0x7f, 0xb7, 0x61, 0xbc, 0x3a, 0x28, 0xee, 0x98, 0x7f, 0x0a, 0xa3, 0x04,
-	0x05, 0x7f, 0x96, 0x49, 0x27, 0x91, 0xde, 0xe4, 0x49, 0x3d, 0xd0, 0xca, 0xb3, 0x10, 0x0d, 0x93,
-	0x95, 0x57, 0x62, 0x17, 0x46, 0xb9, 0x75, 0x3c, 0x4b, 0xb8, 0x9a, 0x67, 0x4e, 0x8a, 0x86, 0x88,
-	0xbe, 0x32, 0xa0, 0xbf, 0x03, 0x43, 0x8a, 0x81, 0x67, 0x4a, 0x24, 0xae, 0xc6, 0x6b, 0x0e, 0x7c,
-	0x2d, 0x12, 0xee, 0x7f, 0x0d, 0x6b, 0xfa, 0xb1, 0x9e, 0x11, 0x3b, 0xfd, 0xf8, 0x1e, 0x3c, 0xab,
-	0xf0, 0xb9, 0x4f, 0xeb, 0x23, 0x8f, 0x56, 0x84, 0x9f, 0xc1, 0xd8, 0x8a, 0xa1, 0xeb, 0x87, 0xd2,
-	0x6b, 0xc7, 0x78, 0xdd, 0xbf, 0xcb, 0xeb, 0xad, 0xfa, 0x1c, 0x6d, 0xad, 0x3a, 0x73, 0x51, 0x3e,
-	0xd5, 0xfd, 0x67, 0x25, 0xd1, 0xf4, 0x97, 0x37, 0x09, 0x6e, 0x94, 0x09, 0xd7, 0xa3, 0x72, 0xa7,
-	0xff, 0x15, 0xac, 0xf3, 0x1f, 0x8d, 0xce, 0x95, 0xa4, 0x7a, 0xe6, 0xf0, 0xd3, 0xfa, 0xe1, 0x55,
-	0x39, 0x8c, 0x46, 0x78, 0xcc, 0x85, 0x7f, 0x01, 0x6b, 0x2e, 0xf9, 0x8c, 0x28, 0x62, 0x3a, 0xd3,
-	0x9b, 0xec, 0xdc, 0x75, 0xb5, 0x8a, 0x54, 0x46, 0x5e, 0x5e, 0xd1, 0xcd, 0x97, 0x30, 0x92, 0xae,
-	0x8b, 0xac, 0xa7, 0x81, 0xf1, 0xb4, 0xfb, 0xa0, 0x9e, 0x8b, 0x86, 0xb2, 0x6a, 0x86, 0xbf, 0x37,
-	0xe1, 0x7d, 0xdc, 0x1f, 0xf1, 0x37, 0x8b, 0x94, 0x5d, 0x9f, 0xd2, 0x96, 0x89, 0x78, 0xad, 0x03,
-	0x8d, 0x15, 0x1d, 0xa8, 0x8c, 0x7a, 0xf3, 0xee, 0x51, 0x6f, 0xd5, 0x46, 0xdd, 0xaa, 0x4c, 0xbb,
-	0xaa, 0x32, 0x38, 0x53, 0x9d, 0x95, 0x99, 0x5a, 0x51, 0x99, 0x6e, 0x4d, 0x65, 0xea, 0x7a, 0xd1,
-	0x7b, 0x88, 0x5e, 0xf4, 0x6f, 0xd3, 0x8b, 0x5b, 0xd5, 0x00, 0x27, 0x1f, 0xca, 0xc9, 0x0f, 0xbf,
-	0x87, 0xed, 0x5b, 0xd3, 0x74, 0xdf, 0x8b, 0xf6, 0x1c, 0x36, 0xa4, 0x7e, 0x6b, 0x53, 0xf3, 0xbb,
-	0xa9, 0xaa, 0xa4, 0xeb, 0x25, 0x6e, 0x1f, 0xf6, 0xf0, 0xe7, 0x76, 0xf9, 0xaa, 0xae, 0xfa, 0xff,
-	0x77, 0x27, 0xff, 0x65, 0xad, 0x47, 0x5b, 0x26, 0xd4, 0xf3, 0x7a, 0xa8, 0x3b, 0x53, 0xb0, 0xda,
-	0xa9, 0x67, 0xe0, 0x49, 0xb3, 0xd3, 0x3a, 0xb3, 0x0a, 0xb1, 0x77, 0xbf, 0xb3, 0xeb, 0xb6, 0x8b,
-	0x40, 0x5e, 0xb7, 0xe0, 0x0d, 0x49, 0xea, 0x3c, 0x40, 0x92, 0xba, 0xef, 0x20, 0x49, 0x55, 0xb1,
-	0xe8, 0xbd, 0x8b, 0x58, 0xf4, 0xdf, 0x46, 0x2c, 0x4e, 0xbe, 0xf8, 0xee, 0xf3, 0x4b, 0xa1, 0xe6,
-	0x8b, 0x8b, 0x03, 0x9a, 0x25, 0x87, 0x39, 0x59, 0x16, 0x8b, 0x9c, 0xcb, 0xf2, 0x63, 0x1f, 0xbd,
-	0xed, 0x17, 0x5c, 0xfe, 0xa0, 0xf1, 0xab, 0xcb, 0x43, 0xf3, 0x97, 0xe9, 0x10, 0x17, 0x2e, 0xba,
-	0xc6, 0x3c, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x38, 0xd3, 0x88, 0x5a, 0x0d, 0x00, 0x00,
+	proto.RegisterType((*CardPayChargebackCallbackChargebackData)(nil), "billing.CardPayChargebackCallbackChargebackData")
+	proto.RegisterType((*CardPayChargebackCallback)(nil), "billing.CardPayChargebackCallback")
+}
+
+func init() { proto.RegisterFile("billing/cardpay.proto", fileDescriptor_68d01ffc85afaf12) }
+
+var fileDescriptor_68d01ffc85afaf12 = []byte{
+	// 1228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0x96, 0xff, 0xed, 0x9a, 0xb5, 0x1d, 0x3a, 0xbb, 0x66, 0x08, 0x24, 0x59, 0x26, 0x44, 0xd9,
+	0x44, 0x64, 0x37, 0x72, 0xe0, 0x88, 0xd0, 0xae, 0x97, 0xa0, 0x45, 0x89, 0x58, 0x8d, 0x22, 0x21,
+	0x90, 0xd0, 0xa8, 0xb7, 0xa7, 0x63, 0xb7, 0xd6, 0xf3, 0xa3, 0x9e, 0x76, 0x22, 0x73, 0xe6, 0xc0,
+	0x85, 0x1b, 0x67, 0x38, 0xf1, 0x0e, 0xdc, 0x79, 0x07, 0xde, 0x83, 0x37, 0x40, 0xfd, 0x37, 0x9e,
+	0x19, 0xef, 0x9a, 0x25, 0x91, 0x72, 0xe2, 0x36, 0x55, 0xdd, 0x5d, 0xf5, 0x75, 0x55, 0x7d, 0x5f,
+	0xdb, 0xb0, 0x73, 0xc6, 0xe6, 0x73, 0x16, 0x4f, 0x0f, 0x08, 0xe6, 0x61, 0x8a, 0x97, 0xfb, 0x29,
+	0x4f, 0x44, 0x82, 0x3a, 0xc6, 0xed, 0xfd, 0x52, 0x83, 0xc1, 0x04, 0xf3, 0xf0, 0x14, 0x2f, 0x0f,
+	0xc3, 0x90, 0xd3, 0x2c, 0x43, 0x2e, 0x74, 0x48, 0xb2, 0x88, 0x05, 0x5f, 0xba, 0xb5, 0xdd, 0xda,
+	0x5e, 0xcf, 0xb7, 0x26, 0x42, 0xd0, 0x24, 0x4c, 0x2c, 0xdd, 0xba, 0x72, 0xab, 0x6f, 0xb4, 0x0d,
+	0xad, 0x74, 0x96, 0xc4, 0xd4, 0x6d, 0x28, 0xa7, 0x36, 0xa4, 0x37, 0x13, 0x58, 0x50, 0xb7, 0xa9,
+	0xbd, 0xca, 0x40, 0x23, 0x68, 0x67, 0x82, 0x53, 0x2a, 0xdc, 0x96, 0x72, 0x1b, 0x0b, 0x5d, 0x83,
+	0xc6, 0x0f, 0x2c, 0x75, 0xdb, 0xca, 0x29, 0x3f, 0xbd, 0x04, 0x1c, 0x83, 0xea, 0x44, 0xd0, 0x48,
+	0x26, 0x8e, 0x71, 0x44, 0x0d, 0x1e, 0xf5, 0x8d, 0x76, 0xc1, 0x09, 0x69, 0x46, 0x38, 0x4b, 0x05,
+	0x4b, 0x62, 0x83, 0xa9, 0xe8, 0x92, 0x20, 0x14, 0x72, 0x05, 0xad, 0xe5, 0x6b, 0x43, 0x01, 0xe6,
+	0x8c, 0x68, 0x68, 0x35, 0x5f, 0x1b, 0xde, 0x1f, 0x35, 0xd8, 0x36, 0x19, 0x9f, 0x51, 0x4e, 0x66,
+	0x38, 0x16, 0x5f, 0xf3, 0x90, 0x72, 0x34, 0x80, 0x3a, 0x0b, 0x4d, 0xe2, 0x3a, 0x0b, 0xaf, 0x90,
+	0xf6, 0x01, 0xb4, 0x98, 0xa0, 0x51, 0xe6, 0x36, 0x76, 0x1b, 0x7b, 0xce, 0x78, 0x7b, 0xdf, 0xd4,
+	0x7a, 0xbf, 0x70, 0x23, 0x5f, 0x6f, 0x41, 0x47, 0x70, 0x2d, 0x9b, 0xb1, 0x34, 0x65, 0xf1, 0x34,
+	0xc0, 0xba, 0xfe, 0x0a, 0x97, 0x33, 0x7e, 0xb7, 0x7a, 0xcc, 0xb4, 0xc7, 0x1f, 0xda, 0x03, 0xc6,
+	0xe1, 0xfd, 0x56, 0x83, 0x5b, 0x13, 0x3c, 0x9f, 0x9f, 0x61, 0x72, 0x6e, 0xf6, 0x1e, 0xe1, 0x58,
+	0x7d, 0x1e, 0x12, 0x7d, 0xe7, 0x11, 0xb4, 0x67, 0xc9, 0x3c, 0xa4, 0xdc, 0x5c, 0xc4, 0x58, 0xe8,
+	0x11, 0x6c, 0xb3, 0x2c, 0x5b, 0xc8, 0xec, 0xa6, 0xc7, 0x01, 0x49, 0x42, 0x6a, 0x6e, 0x85, 0xcc,
+	0xda, 0x44, 0x2f, 0x4d, 0x92, 0x90, 0xa2, 0x9b, 0x00, 0x11, 0xce, 0xce, 0x69, 0x18, 0xa4, 0x38,
+	0x36, 0x3d, 0xef, 0x69, 0xcf, 0x29, 0x56, 0x25, 0x17, 0xc9, 0x39, 0x8d, 0x6d, 0xdf, 0x95, 0xe1,
+	0xfd, 0x59, 0x83, 0x8f, 0x2a, 0x08, 0x27, 0x7c, 0x99, 0x8a, 0x64, 0xb2, 0xe0, 0x9c, 0xc6, 0x64,
+	0x69, 0x71, 0xde, 0x85, 0x01, 0x51, 0x0b, 0x79, 0x31, 0x34, 0xde, 0xbe, 0xf6, 0xda, 0x09, 0x1d,
+	0xc3, 0x8e, 0xd9, 0x26, 0x38, 0x8e, 0x33, 0x4c, 0x64, 0xdd, 0x03, 0x16, 0x1a, 0xdc, 0xd7, 0xf5,
+	0xe2, 0xf3, 0xd5, 0xda, 0x49, 0x28, 0x81, 0xa7, 0x9c, 0x04, 0x38, 0xca, 0x27, 0xa2, 0xe7, 0xf7,
+	0x52, 0x4e, 0x0e, 0x95, 0x03, 0x7d, 0x08, 0x5b, 0x72, 0x99, 0x18, 0x40, 0x06, 0xbf, 0x93, 0x72,
+	0x62, 0x31, 0x7a, 0x01, 0x0c, 0x2d, 0xf8, 0x45, 0x26, 0x92, 0x88, 0x72, 0x79, 0x5d, 0x1a, 0x61,
+	0x36, 0x37, 0x30, 0xb5, 0xa1, 0x46, 0x26, 0x35, 0x58, 0xea, 0x2c, 0x35, 0x23, 0xd4, 0xc8, 0x47,
+	0x68, 0x04, 0xed, 0x79, 0x42, 0xf0, 0xdc, 0xb2, 0xc3, 0x58, 0xde, 0x3d, 0xd8, 0x31, 0x09, 0xbe,
+	0xf8, 0x06, 0xcf, 0xe7, 0x54, 0xd8, 0xb2, 0x54, 0x66, 0xd0, 0xfb, 0xab, 0x0e, 0x37, 0x2a, 0xf5,
+	0x3c, 0xc5, 0xcb, 0x88, 0xc6, 0xe2, 0x18, 0x0b, 0xbc, 0x36, 0xb2, 0x23, 0x68, 0x9b, 0x6b, 0xd7,
+	0xd5, 0xc8, 0x1b, 0x0b, 0xbd, 0x0f, 0x3d, 0xbc, 0x10, 0x33, 0xdd, 0x72, 0x0d, 0xaf, 0x2b, 0x1d,
+	0xaa, 0xd1, 0x52, 0x05, 0x38, 0xc5, 0x82, 0x86, 0x06, 0xa5, 0x35, 0xd1, 0x0d, 0xe8, 0xe6, 0x65,
+	0xd2, 0x3c, 0xce, 0x6d, 0x59, 0xc6, 0x90, 0x92, 0x39, 0x8b, 0xa9, 0x8e, 0xda, 0xb6, 0xf4, 0x50,
+	0x3e, 0x15, 0xf8, 0x2e, 0x0c, 0xec, 0x16, 0x4e, 0x71, 0x96, 0xc4, 0x6e, 0x47, 0xf7, 0xd8, 0x78,
+	0x7d, 0xe5, 0xac, 0xf2, 0xac, 0xbb, 0xce, 0xb3, 0xeb, 0xd0, 0x62, 0x59, 0xf0, 0x38, 0x74, 0x7b,
+	0xbb, 0xb5, 0xbd, 0xae, 0xdf, 0x64, 0xd9, 0xe3, 0x50, 0x29, 0x45, 0x22, 0xa8, 0x0b, 0x46, 0x29,
+	0x12, 0x41, 0xa5, 0xbc, 0x70, 0x1e, 0xbb, 0x8e, 0x96, 0x17, 0xce, 0x63, 0x2d, 0x44, 0x58, 0x2c,
+	0x32, 0x77, 0xcb, 0x0a, 0x91, 0xb4, 0xbc, 0x4f, 0xe1, 0x8e, 0x6d, 0xb1, 0x29, 0xaf, 0x4f, 0xe5,
+	0xdd, 0x58, 0x3c, 0x95, 0x95, 0x7d, 0xa2, 0x19, 0xb9, 0xd6, 0x8f, 0x5f, 0x1b, 0xf0, 0xc1, 0xa6,
+	0x73, 0xff, 0x77, 0xe4, 0x75, 0x3b, 0x82, 0x8e, 0xa1, 0xfd, 0x82, 0xc9, 0xa2, 0xbb, 0x7d, 0x25,
+	0x8b, 0x1f, 0x57, 0x65, 0x71, 0x53, 0xa3, 0x7c, 0x73, 0xd6, 0xfb, 0xbb, 0x09, 0xa3, 0x32, 0x51,
+	0xec, 0x31, 0x74, 0x0c, 0x83, 0xc8, 0x08, 0x7e, 0x90, 0x70, 0x2b, 0x91, 0xce, 0xf8, 0x66, 0x35,
+	0x51, 0xe9, 0x59, 0xf0, 0xfb, 0x51, 0xd1, 0x94, 0x25, 0x4c, 0x75, 0xe0, 0x20, 0xa2, 0x62, 0x96,
+	0x58, 0x29, 0xea, 0x1b, 0xef, 0x33, 0xe5, 0x44, 0x77, 0xa0, 0x4f, 0x4c, 0xe2, 0x40, 0xb0, 0xc8,
+	0xf6, 0x78, 0xcb, 0x3a, 0x9f, 0xb3, 0x88, 0xa2, 0xaf, 0x60, 0x4b, 0x3e, 0xd6, 0x01, 0xd6, 0xec,
+	0x37, 0xef, 0xc1, 0xbd, 0x02, 0x9e, 0x4d, 0x5a, 0xef, 0x3b, 0x64, 0x65, 0xa0, 0x10, 0x46, 0x5a,
+	0x0c, 0xed, 0x3c, 0xe4, 0x51, 0x5b, 0x2a, 0xea, 0xc3, 0xcb, 0xa2, 0x5e, 0xa8, 0xcf, 0xfe, 0x4e,
+	0x39, 0x98, 0xcd, 0xf2, 0x89, 0x9c, 0x3f, 0x2d, 0x89, 0x6a, 0xbe, 0x9c, 0xb1, 0xbb, 0xd6, 0x26,
+	0xb3, 0xee, 0xe7, 0x3b, 0xd1, 0x97, 0x30, 0xa4, 0xaf, 0x94, 0xce, 0xe5, 0xa0, 0x3a, 0xea, 0xf0,
+	0xad, 0xea, 0xe1, 0xb2, 0x1c, 0xfa, 0x03, 0xfa, 0xaa, 0x68, 0xa3, 0x27, 0xb0, 0x65, 0x8b, 0x1f,
+	0x62, 0x81, 0xd5, 0x64, 0x3a, 0xe3, 0x3b, 0x97, 0x5d, 0xad, 0x20, 0x95, 0xbe, 0x93, 0xae, 0x0c,
+	0xf4, 0x14, 0x06, 0xdc, 0x4e, 0x91, 0x8e, 0xd4, 0x53, 0x91, 0xee, 0x5e, 0x69, 0xe6, 0xfc, 0x3e,
+	0x2f, 0x9a, 0xde, 0xef, 0x75, 0xb8, 0x6d, 0xf6, 0xfb, 0xf4, 0xc5, 0x22, 0x0e, 0x57, 0xa7, 0xa4,
+	0xa5, 0x32, 0xae, 0x74, 0xa0, 0x56, 0xd2, 0x81, 0x02, 0xd5, 0xeb, 0x97, 0x53, 0xbd, 0x51, 0xa1,
+	0xba, 0x56, 0x99, 0x66, 0x51, 0x65, 0x0c, 0xa7, 0x5a, 0x25, 0x4e, 0x95, 0x54, 0xa6, 0x5d, 0x51,
+	0x99, 0xaa, 0x5e, 0x74, 0xae, 0xa2, 0x17, 0xdd, 0x8b, 0xf4, 0xe2, 0x42, 0x35, 0x30, 0xcc, 0x87,
+	0x9c, 0xf9, 0xde, 0xf7, 0xb0, 0x7b, 0x61, 0x99, 0x36, 0xbd, 0x68, 0xf7, 0xe1, 0x1a, 0x97, 0x6f,
+	0x6d, 0xac, 0x7e, 0x37, 0x15, 0x95, 0x74, 0x98, 0xfb, 0xf5, 0xc3, 0xee, 0xfd, 0xdc, 0x84, 0x9d,
+	0x0b, 0xe3, 0xbf, 0x5d, 0xe6, 0x3f, 0xad, 0xcc, 0x68, 0x43, 0xa5, 0xba, 0x5f, 0x4d, 0x75, 0x69,
+	0x09, 0xca, 0x93, 0x7a, 0x02, 0x0e, 0x57, 0x3b, 0x75, 0x30, 0xad, 0x10, 0x7b, 0x9b, 0x83, 0xad,
+	0xc6, 0xce, 0x07, 0x9e, 0x7f, 0xaf, 0x4b, 0x52, 0xeb, 0x0a, 0x92, 0xd4, 0x7e, 0x03, 0x49, 0x2a,
+	0x8a, 0x45, 0xe7, 0x4d, 0xc4, 0xa2, 0xfb, 0x3a, 0x62, 0xe1, 0xfd, 0x58, 0x87, 0x7b, 0x36, 0xcd,
+	0x0c, 0xf3, 0x29, 0xd5, 0xb8, 0x0d, 0xfe, 0xdc, 0xf3, 0x9f, 0x9e, 0xed, 0x4d, 0xa4, 0x5c, 0x91,
+	0xb0, 0x59, 0x22, 0x61, 0x81, 0xe2, 0xad, 0x32, 0xc5, 0x6f, 0xcb, 0xe6, 0x4a, 0x06, 0x15, 0x09,
+	0x0a, 0xda, 0xa5, 0xf8, 0x37, 0x82, 0x76, 0xe9, 0x9d, 0x36, 0x16, 0x7a, 0x00, 0xef, 0xd0, 0x97,
+	0x2c, 0xa4, 0x31, 0xa1, 0x41, 0xb8, 0xa0, 0x72, 0x36, 0xa8, 0xa1, 0xe6, 0xd0, 0x2e, 0x1c, 0x2f,
+	0xe8, 0x31, 0x16, 0xd4, 0xfb, 0xa9, 0x0e, 0xef, 0x5d, 0x5a, 0x86, 0xb7, 0x4b, 0x8d, 0x6f, 0x61,
+	0x48, 0x72, 0x08, 0x45, 0x76, 0x3c, 0x5a, 0x9b, 0x8b, 0x7f, 0x69, 0x98, 0x3f, 0x20, 0xe5, 0x06,
+	0xae, 0x0d, 0x77, 0x73, 0x7d, 0xb8, 0x8f, 0x3e, 0xff, 0xee, 0xb3, 0x29, 0x13, 0xb3, 0xc5, 0xd9,
+	0x3e, 0x49, 0xa2, 0x83, 0x14, 0x2f, 0xb3, 0x45, 0x4a, 0x79, 0xfe, 0xf1, 0xd0, 0x80, 0x78, 0x98,
+	0x51, 0xfe, 0x52, 0xfa, 0xcf, 0xa7, 0x07, 0xea, 0x4f, 0xf4, 0x81, 0x59, 0x38, 0x6b, 0x2b, 0xf3,
+	0xf1, 0x3f, 0x03, 0x00, 0x76, 0x6e, 0x9b, 0x8d, 0x6c, 0x0f, 0x00, 0x00,
 }
@@ -4,7 +4,9 @@ import (
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/paysuper/paysuper-billing-server/pkg"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"github.com/paysuper/paysuper-recurring-repository/pkg/constant"
+	"math"
 	"time"
 )
 
@@ -14,6 +16,126 @@ var (
 		constant.OrderStatusProjectInProgress:     true,
 		constant.OrderStatusProjectComplete:       true,
 		constant.OrderStatusProjectPending:        true,
+		pkg.OrderStatusRefundPartial:              true,
+		pkg.OrderStatusChargebackWon:              true,
+	}
+
+	orderChargebackAllowedStatuses = map[int32]bool{
+		constant.OrderStatusPaymentSystemComplete: true,
+		constant.OrderStatusProjectInProgress:     true,
+		constant.OrderStatusProjectComplete:       true,
+		constant.OrderStatusProjectPending:        true,
+		constant.OrderStatusProjectReject:         true,
+		pkg.OrderStatusPaymentSystemCaptured:      true,
+		pkg.OrderStatusRefundPartial:              true,
+		pkg.OrderStatusChargebackOpened:           true,
+		pkg.OrderStatusChargebackWon:              true,
+	}
+
+	subscriptionRenewableStatuses = map[int32]bool{
+		pkg.SubscriptionStatusTrial:   true,
+		pkg.SubscriptionStatusActive:  true,
+		pkg.SubscriptionStatusPastDue: true,
+	}
+
+	// statuses which order can get by result of payment in payment system
+	orderPaymentResultStatuses = []int32{
+		constant.OrderStatusPaymentSystemReject,
+		constant.OrderStatusPaymentSystemComplete,
+		constant.OrderStatusPaymentSystemDeclined,
+		constant.OrderStatusPaymentSystemCanceled,
+		pkg.OrderStatusPaymentSystemAuthorized,
+		pkg.OrderStatusPaymentSystemVoided,
+	}
+
+	// statuses which paid order can get by processing in project, refunds and chargebacks
+	orderPaidStatuses = []int32{
+		constant.OrderStatusProjectInProgress,
+		constant.OrderStatusProjectComplete,
+		constant.OrderStatusProjectPending,
+		constant.OrderStatusProjectReject,
+		constant.OrderStatusRefund,
+		constant.OrderStatusChargeback,
+		pkg.OrderStatusRefundPartial,
+		pkg.OrderStatusChargebackOpened,
+		pkg.OrderStatusChargebackWon,
+	}
+
+	// orderStatusTransitions is order state machine, it contain statuses to which order can be moved
+	// from each status. Statuses which are absent in map are final
+	orderStatusTransitions = map[int32][]int32{
+		constant.OrderStatusNew: append(
+			[]int32{
+				constant.OrderStatusPaymentSystemCreate,
+				constant.OrderStatusPaymentSystemRejectOnCreate,
+				pkg.OrderStatusCanceledByTimeout,
+			},
+			orderPaymentResultStatuses...,
+		),
+		constant.OrderStatusPaymentSystemCreate: append(
+			[]int32{constant.OrderStatusPaymentSystemRejectOnCreate, pkg.OrderStatusPaymentSystemStatusUnknown},
+			orderPaymentResultStatuses...,
+		),
+		constant.OrderStatusPaymentSystemRejectOnCreate: append(
+			[]int32{constant.OrderStatusPaymentSystemCreate, pkg.OrderStatusCanceledByTimeout},
+			orderPaymentResultStatuses...,
+		),
+		// notification about result of payment can be received after order was marked by sweeper
+		pkg.OrderStatusPaymentSystemStatusUnknown: orderPaymentResultStatuses,
+		constant.OrderStatusPaymentSystemDeclined: append(
+			[]int32{constant.OrderStatusPaymentSystemCreate, constant.OrderStatusPaymentSystemRejectOnCreate},
+			orderPaymentResultStatuses...,
+		),
+		constant.OrderStatusPaymentSystemCanceled: append(
+			[]int32{constant.OrderStatusPaymentSystemCreate, constant.OrderStatusPaymentSystemRejectOnCreate},
+			orderPaymentResultStatuses...,
+		),
+		// rejected notification of payment system can be followed by valid notification about result of payment
+		constant.OrderStatusPaymentSystemReject: orderPaymentResultStatuses,
+		pkg.OrderStatusPaymentSystemAuthorized: {
+			constant.OrderStatusPaymentSystemComplete,
+			constant.OrderStatusPaymentSystemDeclined,
+			constant.OrderStatusPaymentSystemCanceled,
+			pkg.OrderStatusPaymentSystemCaptured,
+			pkg.OrderStatusPaymentSystemVoided,
+		},
+		pkg.OrderStatusPaymentSystemCaptured: append(
+			[]int32{constant.OrderStatusPaymentSystemComplete},
+			orderPaidStatuses...,
+		),
+		constant.OrderStatusPaymentSystemComplete: orderPaidStatuses,
+		constant.OrderStatusProjectInProgress:     orderPaidStatuses,
+		constant.OrderStatusProjectPending:        orderPaidStatuses,
+		constant.OrderStatusProjectComplete: {
+			constant.OrderStatusRefund,
+			constant.OrderStatusChargeback,
+			pkg.OrderStatusRefundPartial,
+			pkg.OrderStatusChargebackOpened,
+			pkg.OrderStatusChargebackWon,
+		},
+		constant.OrderStatusProjectReject: {
+			constant.OrderStatusRefund,
+			constant.OrderStatusChargeback,
+			pkg.OrderStatusRefundPartial,
+			pkg.OrderStatusChargebackOpened,
+			pkg.OrderStatusChargebackWon,
+		},
+		pkg.OrderStatusRefundPartial: {
+			constant.OrderStatusRefund,
+			constant.OrderStatusChargeback,
+			pkg.OrderStatusChargebackOpened,
+			pkg.OrderStatusChargebackWon,
+		},
+		pkg.OrderStatusChargebackOpened: {
+			constant.OrderStatusChargeback,
+			pkg.OrderStatusChargebackWon,
+		},
+		pkg.OrderStatusChargebackWon: {
+			constant.OrderStatusRefund,
+			constant.OrderStatusChargeback,
+			pkg.OrderStatusRefundPartial,
+			pkg.OrderStatusChargebackOpened,
+		},
 	}
 )
 
@@ -56,7 +178,52 @@ func (m *PaymentMethodOrder) GetAccountingCurrency() *Currency {
 func (m *Order) HasEndedStatus() bool {
 	return m.Status == constant.OrderStatusPaymentSystemReject || m.Status == constant.OrderStatusProjectComplete ||
 		m.Status == constant.OrderStatusProjectReject || m.Status == constant.OrderStatusRefund ||
-		m.Status == constant.OrderStatusChargeback
+		m.Status == constant.OrderStatusChargeback || m.Status == pkg.OrderStatusPaymentSystemVoided ||
+		m.Status == pkg.OrderStatusCanceledByTimeout
+}
+
+// CanChangeStatusTo check that order can be moved from current status to status by order state machine.
+// Change to the same status is always allowed
+func (m *Order) CanChangeStatusTo(status int32) bool {
+	if m.Status == status {
+		return true
+	}
+
+	for _, v := range orderStatusTransitions[m.Status] {
+		if v == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Order) CanBeCaptured() bool {
+	return m.AuthorizeOnly == true && m.Status == pkg.OrderStatusPaymentSystemAuthorized
+}
+
+func (m *Order) CanBeVoided() bool {
+	return m.AuthorizeOnly == true && m.Status == pkg.OrderStatusPaymentSystemAuthorized
+}
+
+// GetChargeAmount return amount which payment system must send in payment notification. For captured
+// authorization it's captured amount, which can be less than authorized amount
+func (m *Order) GetChargeAmount() float64 {
+	if m.CapturedAmount > 0 {
+		return m.CapturedAmount
+	}
+
+	return m.TotalPaymentAmount
+}
+
+// GetChargeMoney return charge amount of order in minor units of payment currency
+func (m *Order) GetChargeMoney() money.Money {
+	return money.FromFloat(m.GetChargeAmount(), m.GetPaymentMethodOutcomeCurrency().GetCodeA3())
+}
+
+// GetPaidMoney return amount received by payment system in minor units of payment currency
+func (m *Order) GetPaidMoney() money.Money {
+	return money.FromFloat(m.PaymentMethodIncomeAmount, m.GetPaymentMethodIncomeCurrency().GetCodeA3())
 }
 
 func (m *Order) RefundAllowed() bool {
@@ -65,6 +232,12 @@ func (m *Order) RefundAllowed() bool {
 	return ok && v == true
 }
 
+func (m *Order) ChargebackAllowed() bool {
+	v, ok := orderChargebackAllowedStatuses[m.Status]
+
+	return ok && v == true
+}
+
 func (m *Order) FormInputTimeIsEnded() bool {
 	t, err := ptypes.Timestamp(m.ExpireDateToFormInput)
 
@@ -103,6 +276,100 @@ func (m *Project) NeedChangeStatusToDraft(req *Project) bool {
 	return false
 }
 
+func (m *Dispute) IsClosed() bool {
+	return m.Status == pkg.DisputeStatusWon || m.Status == pkg.DisputeStatusLost
+}
+
+// EvidenceAllowed return true if merchant still can attach evidence to dispute - dispute isn't closed and
+// due date of evidence submission isn't passed
+func (m *Dispute) EvidenceAllowed() bool {
+	if m.IsClosed() {
+		return false
+	}
+
+	if m.EvidenceDueAt == nil {
+		return true
+	}
+
+	t, err := ptypes.Timestamp(m.EvidenceDueAt)
+
+	return err == nil && t.After(time.Now())
+}
+
 func (m *OrderUser) IsIdentified() bool {
 	return m.Id != "" && bson.IsObjectIdHex(m.Id) == true
 }
+
+// IsRenewable return true if subscription must be charged by scheduler on payment date
+func (m *Subscription) IsRenewable() bool {
+	_, ok := subscriptionRenewableStatuses[m.Status]
+	return ok
+}
+
+func (m *Subscription) IsClosed() bool {
+	return m.Status == pkg.SubscriptionStatusCanceled || m.Status == pkg.SubscriptionStatusUnpaid
+}
+
+// GetRateDate return date at which currency rates applied to amounts of order must be effective
+func (m *Order) GetRateDate() time.Time {
+	if m.CreatedAt != nil {
+		if t, err := ptypes.Timestamp(m.CreatedAt); err == nil {
+			return t
+		}
+	}
+
+	return time.Now()
+}
+
+// IsEffective check version of system fees is effective on date. Version without end of effective period
+// is effective only until it is replaced by next version
+func (m *SystemFees) IsEffective(date time.Time) bool {
+	if m.EffectiveFrom != nil {
+		from, err := ptypes.Timestamp(m.EffectiveFrom)
+
+		if err != nil || date.Before(from) {
+			return false
+		}
+	}
+
+	if m.EffectiveTo == nil {
+		return m.IsActive
+	}
+
+	to, err := ptypes.Timestamp(m.EffectiveTo)
+
+	return err == nil && date.Before(to)
+}
+
+// GetTier return tier of plan with greatest volume threshold which isn't greater than volume
+func (m *CommissionPlan) GetTier(volume float64) *CommissionPlanTier {
+	var tier *CommissionPlanTier
+
+	for _, v := range m.Tiers {
+		if v.VolumeFrom <= volume && (tier == nil || v.VolumeFrom > tier.VolumeFrom) {
+			tier = v
+		}
+	}
+
+	return tier
+}
+
+// GetMoney return refund amount in minor units of refund currency
+func (m *Refund) GetMoney() money.Money {
+	return money.FromFloat(m.Amount, m.GetCurrency().GetCodeA3())
+}
+
+// GetMoneyRules return rounding rules of currency amounts, second value is false if rules not set for currency
+func (m *Currency) GetMoneyRules() (money.Rules, bool) {
+	if m.MinorUnit == nil {
+		return money.Rules{}, false
+	}
+
+	r := money.Rules{
+		Exponent:      m.MinorUnit.Exponent,
+		Mode:          money.RoundingMode(m.MinorUnit.RoundingMode),
+		CashIncrement: int64(math.Round(m.MinorUnit.CashIncrement * math.Pow10(int(m.MinorUnit.Exponent)))),
+	}
+
+	return r, true
+}
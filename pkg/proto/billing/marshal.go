@@ -18,6 +18,8 @@ type JsonRefund struct {
 	UpdatedAt  *timestamp.Timestamp `json:"updated_at"`
 	PayerData  *RefundPayerData     `json:"payer_data"`
 	SalesTax   float32              `json:"sales_tax"`
+	Items      []*RefundItem        `json:"items"`
+	TaxAmount  float64              `json:"tax_amount"`
 }
 
 func (m *Refund) MarshalJSON() ([]byte, error) {
@@ -35,6 +37,8 @@ func (m *Refund) MarshalJSON() ([]byte, error) {
 			UpdatedAt:  m.UpdatedAt,
 			PayerData:  m.PayerData,
 			SalesTax:   m.SalesTax,
+			Items:      m.Items,
+			TaxAmount:  m.TaxAmount,
 		},
 	)
 }
@@ -51,6 +51,13 @@ type MgoSystemFees struct {
 	UserId    bson.ObjectId `bson:"user_id"`
 	CreatedAt time.Time     `bson:"created_at"`
 	IsActive  bool          `bson:"is_active"`
+
+	Version           int32      `bson:"version"`
+	EffectiveFrom     time.Time  `bson:"effective_from"`
+	EffectiveTo       *time.Time `bson:"effective_to"`
+	ReactivatedFromId string     `bson:"reactivated_from_id"`
+	ClosedByUserId    string     `bson:"closed_by_user_id"`
+	ClosedAt          *time.Time `bson:"closed_at"`
 }
 
 type MgoProject struct {
@@ -79,6 +86,10 @@ type MgoProject struct {
 	UpdatedAt                time.Time       `bson:"updated_at"`
 	ProductsCount            int32           `bson:"products_count"`
 	IdString                 string          `bson:"id_string"`
+	CheckAccountRequired     bool            `bson:"check_account_required"`
+	Version                  int64           `bson:"version"`
+	OrderExpireGracePeriod   int64           `bson:"order_expire_grace_period"`
+	PaymentStatusGracePeriod int64           `bson:"payment_status_grace_period"`
 }
 
 type MgoMerchantLastPayout struct {
@@ -129,6 +140,7 @@ type MgoMerchant struct {
 	AgreementSentViaMail      bool                                 `bson:"agreement_sent_via_mail"`
 	MailTrackingLink          string                               `bson:"mail_tracking_link"`
 	S3AgreementName           string                               `bson:"s3_agreement_name"`
+	Version                   int64                                `bson:"version"`
 }
 
 type MgoCurrencyRate struct {
@@ -139,6 +151,7 @@ type MgoCurrencyRate struct {
 	Date         time.Time     `bson:"date"`
 	IsActive     bool          `bson:"is_active"`
 	CreatedAt    time.Time     `bson:"created_at"`
+	BatchId      bson.ObjectId `bson:"batch_id,omitempty"`
 }
 
 type MgoCommission struct {
@@ -231,13 +244,24 @@ type MgoOrder struct {
 	Amount                                  float64                `bson:"amount"`
 	Currency                                string                 `bson:"currency"`
 
-	Uuid                    string               `bson:"uuid"`
-	ExpireDateToFormInput   time.Time            `bson:"expire_date_to_form_input"`
-	Tax                     *OrderTax            `bson:"tax"`
-	TotalPaymentAmount      float64              `bson:"total_payment_amount"`
-	UserAddressDataRequired bool                 `bson:"user_address_data_required"`
-	BillingAddress          *OrderBillingAddress `bson:"billing_address"`
-	User                    *OrderUser           `bson:"user"`
+	Uuid                    string                 `bson:"uuid"`
+	ExpireDateToFormInput   time.Time              `bson:"expire_date_to_form_input"`
+	Tax                     *OrderTax              `bson:"tax"`
+	TotalPaymentAmount      float64                `bson:"total_payment_amount"`
+	UserAddressDataRequired bool                   `bson:"user_address_data_required"`
+	BillingAddress          *OrderBillingAddress   `bson:"billing_address"`
+	User                    *OrderUser             `bson:"user"`
+	AuthorizeOnly           bool                   `bson:"authorize_only"`
+	CapturedAmount          float64                `bson:"captured_amount"`
+	Metadata                map[string]string      `bson:"metadata"`
+	PrivateMetadata         map[string]string      `bson:"private_metadata"`
+	CurrencyRates           []*AppliedCurrencyRate `bson:"currency_rates"`
+	FxMarginAmount          *OrderFee              `bson:"fx_margin_amount"`
+	CommissionPlan          *OrderCommissionPlan   `bson:"commission_plan"`
+	SystemFees              *OrderSystemFees       `bson:"system_fees"`
+	AccountCheck            *OrderAccountCheck     `bson:"account_check"`
+	StatusHistory           []*OrderStatusChange   `bson:"status_history"`
+	Version                 int64                  `bson:"version"`
 }
 
 type MgoPaymentSystem struct {
@@ -288,17 +312,171 @@ type MgoRefundOrder struct {
 }
 
 type MgoRefund struct {
-	Id         bson.ObjectId    `bson:"_id"`
-	Order      *MgoRefundOrder  `bson:"order"`
-	ExternalId string           `bson:"external_id"`
-	Amount     float64          `bson:"amount"`
-	CreatorId  bson.ObjectId    `bson:"creator_id"`
-	Currency   *Currency        `bson:"currency"`
-	Status     int32            `bson:"status"`
-	CreatedAt  time.Time        `bson:"created_at"`
-	UpdatedAt  time.Time        `bson:"updated_at"`
-	PayerData  *RefundPayerData `bson:"payer_data"`
-	SalesTax   float32          `bson:"sales_tax"`
+	Id            bson.ObjectId          `bson:"_id"`
+	Order         *MgoRefundOrder        `bson:"order"`
+	ExternalId    string                 `bson:"external_id"`
+	Amount        float64                `bson:"amount"`
+	CreatorId     bson.ObjectId          `bson:"creator_id"`
+	Currency      *Currency              `bson:"currency"`
+	Status        int32                  `bson:"status"`
+	CreatedAt     time.Time              `bson:"created_at"`
+	UpdatedAt     time.Time              `bson:"updated_at"`
+	PayerData     *RefundPayerData       `bson:"payer_data"`
+	SalesTax      float32                `bson:"sales_tax"`
+	Items         []*RefundItem          `bson:"items"`
+	TaxAmount     float64                `bson:"tax_amount"`
+	CurrencyRates []*AppliedCurrencyRate `bson:"currency_rates"`
+	Version       int64                  `bson:"version"`
+}
+
+type MgoLedgerEntry struct {
+	Id                     bson.ObjectId `bson:"_id"`
+	JournalId              bson.ObjectId `bson:"journal_id"`
+	SourceType             string        `bson:"source_type"`
+	SourceId               bson.ObjectId `bson:"source_id"`
+	MerchantId             bson.ObjectId `bson:"merchant_id"`
+	OrderId                bson.ObjectId `bson:"order_id,omitempty"`
+	Account                string        `bson:"account"`
+	Side                   string        `bson:"side"`
+	Amount                 float64       `bson:"amount"`
+	Currency               string        `bson:"currency"`
+	AmountMerchantCurrency float64       `bson:"amount_merchant_currency"`
+	MerchantCurrency       string        `bson:"merchant_currency"`
+	AmountPspCurrency      float64       `bson:"amount_psp_currency"`
+	PspCurrency            string        `bson:"psp_currency"`
+	CreatedAt              time.Time     `bson:"created_at"`
+}
+
+type MgoPayout struct {
+	Id                bson.ObjectId `bson:"_id"`
+	MerchantId        bson.ObjectId `bson:"merchant_id"`
+	Currency          string        `bson:"currency"`
+	Status            int32         `bson:"status"`
+	PeriodFrom        time.Time     `bson:"period_from"`
+	PeriodTo          time.Time     `bson:"period_to"`
+	OrdersAmount      float64       `bson:"orders_amount"`
+	RefundsAmount     float64       `bson:"refunds_amount"`
+	FeesAmount        float64       `bson:"fees_amount"`
+	TaxAmount         float64       `bson:"tax_amount"`
+	ReserveAmount     float64       `bson:"reserve_amount"`
+	PreviousBalance   float64       `bson:"previous_balance"`
+	Amount            float64       `bson:"amount"`
+	TransactionId     string        `bson:"transaction_id"`
+	FailureReason     string        `bson:"failure_reason"`
+	CreatedAt         time.Time     `bson:"created_at"`
+	UpdatedAt         time.Time     `bson:"updated_at"`
+	ApprovedAt        time.Time     `bson:"approved_at"`
+	PaidAt            time.Time     `bson:"paid_at"`
+	ChargebacksAmount float64       `bson:"chargebacks_amount"`
+}
+
+type MgoDisputeEvidence struct {
+	Text      string                 `bson:"text"`
+	Files     []*DisputeEvidenceFile `bson:"files"`
+	CreatedAt time.Time              `bson:"created_at"`
+}
+
+type MgoDispute struct {
+	Id            bson.ObjectId         `bson:"_id"`
+	OrderId       bson.ObjectId         `bson:"order_id"`
+	MerchantId    bson.ObjectId         `bson:"merchant_id"`
+	ExternalId    string                `bson:"external_id"`
+	ReasonCode    string                `bson:"reason_code"`
+	Reason        string                `bson:"reason"`
+	Amount        float64               `bson:"amount"`
+	Currency      *Currency             `bson:"currency"`
+	Status        int32                 `bson:"status"`
+	EvidenceDueAt time.Time             `bson:"evidence_due_at"`
+	Evidence      []*MgoDisputeEvidence `bson:"evidence"`
+	FeeAmount     float64               `bson:"fee_amount"`
+	CreatedAt     time.Time             `bson:"created_at"`
+	UpdatedAt     time.Time             `bson:"updated_at"`
+	ClosedAt      time.Time             `bson:"closed_at"`
+}
+
+type MgoSubscription struct {
+	Id                 bson.ObjectId `bson:"_id"`
+	ProjectId          bson.ObjectId `bson:"project_id"`
+	MerchantId         bson.ObjectId `bson:"merchant_id"`
+	ProductId          bson.ObjectId `bson:"product_id"`
+	PlanId             string        `bson:"plan_id"`
+	CustomerId         bson.ObjectId `bson:"customer_id"`
+	StoredCardId       string        `bson:"stored_card_id"`
+	PaymentMethodId    bson.ObjectId `bson:"payment_method_id"`
+	Email              string        `bson:"email"`
+	Amount             float64       `bson:"amount"`
+	Currency           string        `bson:"currency"`
+	Interval           string        `bson:"interval"`
+	IntervalCount      int32         `bson:"interval_count"`
+	Status             int32         `bson:"status"`
+	TrialEndAt         time.Time     `bson:"trial_end_at"`
+	CurrentPeriodStart time.Time     `bson:"current_period_start"`
+	CurrentPeriodEnd   time.Time     `bson:"current_period_end"`
+	NextPaymentAt      time.Time     `bson:"next_payment_at"`
+	RetryAttempts      int32         `bson:"retry_attempts"`
+	PendingOrderId     string        `bson:"pending_order_id"`
+	LastOrderId        string        `bson:"last_order_id"`
+	FailureReason      string        `bson:"failure_reason"`
+	CancelAtPeriodEnd  bool          `bson:"cancel_at_period_end"`
+	CanceledAt         time.Time     `bson:"canceled_at"`
+	PausedAt           time.Time     `bson:"paused_at"`
+	CreatedAt          time.Time     `bson:"created_at"`
+	UpdatedAt          time.Time     `bson:"updated_at"`
+	PendingOrderAt     time.Time     `bson:"pending_order_at"`
+}
+
+type MgoCommissionPlan struct {
+	Id              bson.ObjectId         `bson:"_id"`
+	MerchantId      bson.ObjectId         `bson:"merchant_id"`
+	PaymentMethodId bson.ObjectId         `bson:"payment_method_id"`
+	Version         int32                 `bson:"version"`
+	Currency        string                `bson:"currency"`
+	Tiers           []*CommissionPlanTier `bson:"tiers"`
+	MinFee          float64               `bson:"min_fee"`
+	MaxFee          float64               `bson:"max_fee"`
+	EffectiveFrom   time.Time             `bson:"effective_from"`
+	EffectiveTo     *time.Time            `bson:"effective_to"`
+	UserId          string                `bson:"user_id"`
+	CreatedAt       time.Time             `bson:"created_at"`
+}
+
+type MgoOutboxMessage struct {
+	Id            bson.ObjectId `bson:"_id"`
+	Topic         string        `bson:"topic"`
+	Order         *Order        `bson:"order"`
+	Status        int32         `bson:"status"`
+	Attempts      int32         `bson:"attempts"`
+	LastError     string        `bson:"last_error"`
+	NextAttemptAt time.Time     `bson:"next_attempt_at"`
+	CreatedAt     time.Time     `bson:"created_at"`
+	UpdatedAt     time.Time     `bson:"updated_at"`
+	DeliveredAt   time.Time     `bson:"delivered_at"`
+	OrderVersion  int64         `bson:"order_version"`
+}
+
+type MgoWebhookDeliveryAttempt struct {
+	ResponseCode int32     `bson:"response_code"`
+	ResponseBody string    `bson:"response_body"`
+	Error        string    `bson:"error"`
+	Duration     int64     `bson:"duration"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+type MgoWebhookDelivery struct {
+	Id            bson.ObjectId                `bson:"_id"`
+	ProjectId     bson.ObjectId                `bson:"project_id"`
+	OrderId       bson.ObjectId                `bson:"order_id"`
+	RefundId      bson.ObjectId                `bson:"refund_id,omitempty"`
+	Event         string                       `bson:"event"`
+	Url           string                       `bson:"url"`
+	Payload       string                       `bson:"payload"`
+	Status        int32                        `bson:"status"`
+	Attempts      int32                        `bson:"attempts"`
+	History       []*MgoWebhookDeliveryAttempt `bson:"history"`
+	NextAttemptAt time.Time                    `bson:"next_attempt_at"`
+	CreatedAt     time.Time                    `bson:"created_at"`
+	UpdatedAt     time.Time                    `bson:"updated_at"`
+	DeliveredAt   time.Time                    `bson:"delivered_at"`
 }
 
 type MgoMerchantPaymentMethodHistory struct {
@@ -442,6 +620,10 @@ func (m *SystemFees) GetBSON() (interface{}, error) {
 		CardBrand: m.CardBrand,
 		IsActive:  m.IsActive,
 		UserId:    bson.ObjectIdHex(m.UserId),
+
+		Version:           m.Version,
+		ReactivatedFromId: m.ReactivatedFromId,
+		ClosedByUserId:    m.ClosedByUserId,
 	}
 
 	for _, f := range m.Fees {
@@ -480,6 +662,36 @@ func (m *SystemFees) GetBSON() (interface{}, error) {
 		st.CreatedAt = time.Now()
 	}
 
+	if m.EffectiveFrom != nil {
+		t, err := ptypes.Timestamp(m.EffectiveFrom)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.EffectiveFrom = t
+	}
+
+	if m.EffectiveTo != nil {
+		t, err := ptypes.Timestamp(m.EffectiveTo)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.EffectiveTo = &t
+	}
+
+	if m.ClosedAt != nil {
+		t, err := ptypes.Timestamp(m.ClosedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ClosedAt = &t
+	}
+
 	return st, nil
 }
 
@@ -530,6 +742,38 @@ func (m *SystemFees) SetBSON(raw bson.Raw) error {
 	if err != nil {
 		return err
 	}
+
+	m.Version = decoded.Version
+	m.ReactivatedFromId = decoded.ReactivatedFromId
+	m.ClosedByUserId = decoded.ClosedByUserId
+
+	// fees saved before effective periods were introduced are effective since creation
+	if decoded.EffectiveFrom.IsZero() {
+		decoded.EffectiveFrom = decoded.CreatedAt
+	}
+
+	m.EffectiveFrom, err = ptypes.TimestampProto(decoded.EffectiveFrom)
+
+	if err != nil {
+		return err
+	}
+
+	if decoded.EffectiveTo != nil {
+		m.EffectiveTo, err = ptypes.TimestampProto(*decoded.EffectiveTo)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if decoded.ClosedAt != nil {
+		m.ClosedAt, err = ptypes.TimestampProto(*decoded.ClosedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -554,6 +798,10 @@ func (m *Project) GetBSON() (interface{}, error) {
 		UrlRedirectFail:          m.UrlRedirectFail,
 		UrlRedirectSuccess:       m.UrlRedirectSuccess,
 		Status:                   m.Status,
+		CheckAccountRequired:     m.CheckAccountRequired,
+		Version:                  m.Version,
+		OrderExpireGracePeriod:   m.OrderExpireGracePeriod,
+		PaymentStatusGracePeriod: m.PaymentStatusGracePeriod,
 	}
 
 	if len(m.Name) > 0 {
@@ -629,6 +877,10 @@ func (m *Project) SetBSON(raw bson.Raw) error {
 	m.UrlRedirectFail = decoded.UrlRedirectFail
 	m.UrlRedirectSuccess = decoded.UrlRedirectSuccess
 	m.Status = decoded.Status
+	m.CheckAccountRequired = decoded.CheckAccountRequired
+	m.Version = decoded.Version
+	m.OrderExpireGracePeriod = decoded.OrderExpireGracePeriod
+	m.PaymentStatusGracePeriod = decoded.PaymentStatusGracePeriod
 
 	nameLen := len(decoded.Name)
 
@@ -677,6 +929,14 @@ func (m *CurrencyRate) GetBSON() (interface{}, error) {
 		st.Id = bson.ObjectIdHex(m.Id)
 	}
 
+	if len(m.BatchId) > 0 {
+		if bson.IsObjectIdHex(m.BatchId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.BatchId = bson.ObjectIdHex(m.BatchId)
+	}
+
 	if m.Date == nil {
 		return nil, fmt.Errorf(errorRequiredField, "Date", "CurrencyRate")
 	}
@@ -718,6 +978,10 @@ func (m *CurrencyRate) SetBSON(raw bson.Raw) error {
 	m.Rate = decoded.Rate
 	m.IsActive = decoded.IsActive
 
+	if decoded.BatchId != "" {
+		m.BatchId = decoded.BatchId.Hex()
+	}
+
 	m.Date, err = ptypes.TimestampProto(decoded.Date)
 
 	if err != nil {
@@ -867,6 +1131,17 @@ func (m *Order) GetBSON() (interface{}, error) {
 		UserAddressDataRequired: m.UserAddressDataRequired,
 		BillingAddress:          m.BillingAddress,
 		User:                    m.User,
+		AuthorizeOnly:           m.AuthorizeOnly,
+		CapturedAmount:          m.CapturedAmount,
+		Metadata:                m.Metadata,
+		PrivateMetadata:         m.PrivateMetadata,
+		CurrencyRates:           m.CurrencyRates,
+		FxMarginAmount:          m.FxMarginAmount,
+		CommissionPlan:          m.CommissionPlan,
+		SystemFees:              m.SystemFees,
+		AccountCheck:            m.AccountCheck,
+		StatusHistory:           m.StatusHistory,
+		Version:                 m.Version,
 	}
 
 	if m.PaymentMethod != nil {
@@ -1021,6 +1296,17 @@ func (m *Order) SetBSON(raw bson.Raw) error {
 	m.UserAddressDataRequired = decoded.UserAddressDataRequired
 	m.BillingAddress = decoded.BillingAddress
 	m.User = decoded.User
+	m.AuthorizeOnly = decoded.AuthorizeOnly
+	m.CapturedAmount = decoded.CapturedAmount
+	m.Metadata = decoded.Metadata
+	m.PrivateMetadata = decoded.PrivateMetadata
+	m.CurrencyRates = decoded.CurrencyRates
+	m.FxMarginAmount = decoded.FxMarginAmount
+	m.CommissionPlan = decoded.CommissionPlan
+	m.SystemFees = decoded.SystemFees
+	m.AccountCheck = decoded.AccountCheck
+	m.StatusHistory = decoded.StatusHistory
+	m.Version = decoded.Version
 
 	m.PaymentMethodOrderClosedAt, err = ptypes.TimestampProto(decoded.PaymentMethodOrderClosedAt)
 
@@ -1303,6 +1589,7 @@ func (m *Merchant) GetBSON() (interface{}, error) {
 		AgreementSentViaMail:      m.AgreementSentViaMail,
 		MailTrackingLink:          m.MailTrackingLink,
 		S3AgreementName:           m.S3AgreementName,
+		Version:                   m.Version,
 	}
 
 	if len(m.Id) <= 0 {
@@ -1415,6 +1702,7 @@ func (m *Merchant) SetBSON(raw bson.Raw) error {
 	m.AgreementSentViaMail = decoded.AgreementSentViaMail
 	m.MailTrackingLink = decoded.MailTrackingLink
 	m.S3AgreementName = decoded.S3AgreementName
+	m.Version = decoded.Version
 
 	m.FirstPaymentAt, err = ptypes.TimestampProto(decoded.FirstPaymentAt)
 
@@ -1553,13 +1841,17 @@ func (m *Refund) GetBSON() (interface{}, error) {
 			Id:   bson.ObjectIdHex(m.Order.Id),
 			Uuid: m.Order.Uuid,
 		},
-		ExternalId: m.ExternalId,
-		Amount:     m.Amount,
-		CreatorId:  bson.ObjectIdHex(m.CreatorId),
-		Currency:   m.Currency,
-		Status:     m.Status,
-		PayerData:  m.PayerData,
-		SalesTax:   m.SalesTax,
+		ExternalId:    m.ExternalId,
+		Amount:        m.Amount,
+		CreatorId:     bson.ObjectIdHex(m.CreatorId),
+		Currency:      m.Currency,
+		Status:        m.Status,
+		PayerData:     m.PayerData,
+		SalesTax:      m.SalesTax,
+		Items:         m.Items,
+		TaxAmount:     m.TaxAmount,
+		CurrencyRates: m.CurrencyRates,
+		Version:       m.Version,
 	}
 
 	if len(m.Id) <= 0 {
@@ -1619,6 +1911,10 @@ func (m *Refund) SetBSON(raw bson.Raw) error {
 	m.Status = decoded.Status
 	m.PayerData = decoded.PayerData
 	m.SalesTax = decoded.SalesTax
+	m.Items = decoded.Items
+	m.TaxAmount = decoded.TaxAmount
+	m.CurrencyRates = decoded.CurrencyRates
+	m.Version = decoded.Version
 
 	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 
@@ -1635,82 +1931,49 @@ func (m *Refund) SetBSON(raw bson.Raw) error {
 	return nil
 }
 
-func (m *PaymentFormPaymentMethod) IsBankCard() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBankCard
-}
-
-func (m *PaymentMethod) IsBankCard() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBankCard
-}
-
-func (m *PaymentMethodOrder) IsBankCard() bool {
-	return m.Group == constant.PaymentSystemGroupAliasBankCard
-}
-
-func (m *MerchantPaymentMethodHistory) SetBSON(raw bson.Raw) error {
-	decoded := new(MgoMerchantPaymentMethodHistory)
-	err := raw.Unmarshal(decoded)
-
-	if err != nil {
-		return err
-	}
-
-	m.Id = decoded.Id.Hex()
-	m.MerchantId = decoded.MerchantId.Hex()
-	m.UserId = decoded.UserId.Hex()
-	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
-	if err != nil {
-		return err
-	}
-
-	m.PaymentMethod = &MerchantPaymentMethod{
-		PaymentMethod: &MerchantPaymentMethodIdentification{
-			Id:   bson.ObjectId(decoded.PaymentMethod.PaymentMethod.Id).Hex(),
-			Name: decoded.PaymentMethod.PaymentMethod.Name,
-		},
-		Commission:  decoded.PaymentMethod.Commission,
-		Integration: decoded.PaymentMethod.Integration,
-		IsActive:    decoded.PaymentMethod.IsActive,
+func (m *LedgerEntry) GetBSON() (interface{}, error) {
+	st := &MgoLedgerEntry{
+		SourceType:             m.SourceType,
+		Account:                m.Account,
+		Side:                   m.Side,
+		Amount:                 m.Amount,
+		Currency:               m.Currency,
+		AmountMerchantCurrency: m.AmountMerchantCurrency,
+		MerchantCurrency:       m.MerchantCurrency,
+		AmountPspCurrency:      m.AmountPspCurrency,
+		PspCurrency:            m.PspCurrency,
 	}
 
-	return nil
-}
-
-func (p *MerchantPaymentMethodHistory) GetBSON() (interface{}, error) {
-	st := &MgoMerchantPaymentMethodHistory{}
-
-	if len(p.Id) <= 0 {
+	if len(m.Id) <= 0 {
 		st.Id = bson.NewObjectId()
 	} else {
-		if bson.IsObjectIdHex(p.Id) == false {
+		if bson.IsObjectIdHex(m.Id) == false {
 			return nil, errors.New(errorInvalidObjectId)
 		}
 
-		st.Id = bson.ObjectIdHex(p.Id)
+		st.Id = bson.ObjectIdHex(m.Id)
 	}
 
-	if len(p.MerchantId) <= 0 {
+	if bson.IsObjectIdHex(m.JournalId) == false || bson.IsObjectIdHex(m.SourceId) == false ||
+		bson.IsObjectIdHex(m.MerchantId) == false {
 		return nil, errors.New(errorInvalidObjectId)
-	} else {
-		if bson.IsObjectIdHex(p.MerchantId) == false {
-			return nil, errors.New(errorInvalidObjectId)
-		}
-
-		st.MerchantId = bson.ObjectIdHex(p.MerchantId)
 	}
 
-	if len(p.UserId) <= 0 {
-		return nil, errors.New(errorInvalidObjectId)
-	} else {
-		if bson.IsObjectIdHex(p.UserId) == false {
+	st.JournalId = bson.ObjectIdHex(m.JournalId)
+	st.SourceId = bson.ObjectIdHex(m.SourceId)
+	st.MerchantId = bson.ObjectIdHex(m.MerchantId)
+
+	// journal entries of payouts aren't linked to any order
+	if m.OrderId != "" {
+		if bson.IsObjectIdHex(m.OrderId) == false {
 			return nil, errors.New(errorInvalidObjectId)
 		}
 
-		st.UserId = bson.ObjectIdHex(p.UserId)
+		st.OrderId = bson.ObjectIdHex(m.OrderId)
 	}
 
-	if p.CreatedAt != nil {
-		t, err := ptypes.Timestamp(p.CreatedAt)
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
 
 		if err != nil {
 			return nil, err
@@ -1721,82 +1984,71 @@ func (p *MerchantPaymentMethodHistory) GetBSON() (interface{}, error) {
 		st.CreatedAt = time.Now()
 	}
 
-	st.PaymentMethod = &MgoMerchantPaymentMethod{
-		PaymentMethod: &MgoMerchantPaymentMethodIdentification{
-			Id:   bson.ObjectIdHex(p.PaymentMethod.PaymentMethod.Id),
-			Name: p.PaymentMethod.PaymentMethod.Name,
-		},
-		Commission:  p.PaymentMethod.Commission,
-		Integration: p.PaymentMethod.Integration,
-		IsActive:    p.PaymentMethod.IsActive,
-	}
-
 	return st, nil
 }
 
-func (m *Customer) GetBSON() (interface{}, error) {
-	st := &MgoCustomer{
-		Id:                    bson.ObjectIdHex(m.Id),
-		TechEmail:             m.TechEmail,
-		ExternalId:            m.ExternalId,
-		Email:                 m.Email,
-		EmailVerified:         m.EmailVerified,
-		Phone:                 m.Phone,
-		PhoneVerified:         m.PhoneVerified,
-		Name:                  m.Name,
-		Ip:                    m.Ip,
-		Locale:                m.Locale,
-		AcceptLanguage:        m.AcceptLanguage,
-		UserAgent:             m.UserAgent,
-		Address:               m.Address,
-		Metadata:              m.Metadata,
-		Identity:              []*MgoCustomerIdentity{},
-		IpHistory:             []*MgoCustomerIpHistory{},
-		AddressHistory:        []*MgoCustomerAddressHistory{},
-		LocaleHistory:         []*MgoCustomerStringValueHistory{},
-		AcceptLanguageHistory: []*MgoCustomerStringValueHistory{},
+func (m *LedgerEntry) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoLedgerEntry)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
 	}
 
-	for _, v := range m.Identity {
-		mgoIdentity := &MgoCustomerIdentity{
-			MerchantId: bson.ObjectIdHex(v.MerchantId),
-			ProjectId:  bson.ObjectIdHex(v.ProjectId),
-			Type:       v.Type,
-			Value:      v.Value,
-			Verified:   v.Verified,
-		}
+	m.Id = decoded.Id.Hex()
+	m.JournalId = decoded.JournalId.Hex()
+	m.SourceType = decoded.SourceType
+	m.SourceId = decoded.SourceId.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.OrderId = decoded.OrderId.Hex()
+	m.Account = decoded.Account
+	m.Side = decoded.Side
+	m.Amount = decoded.Amount
+	m.Currency = decoded.Currency
+	m.AmountMerchantCurrency = decoded.AmountMerchantCurrency
+	m.MerchantCurrency = decoded.MerchantCurrency
+	m.AmountPspCurrency = decoded.AmountPspCurrency
+	m.PspCurrency = decoded.PspCurrency
 
-		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
-		st.Identity = append(st.Identity, mgoIdentity)
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
 	}
 
-	for _, v := range m.IpHistory {
-		mgoIdentity := &MgoCustomerIpHistory{Ip: v.Ip}
-		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
-		st.IpHistory = append(st.IpHistory, mgoIdentity)
+	return nil
+}
+
+func (m *OutboxMessage) GetBSON() (interface{}, error) {
+	st := &MgoOutboxMessage{
+		Topic:        m.Topic,
+		Order:        m.Order,
+		Status:       m.Status,
+		Attempts:     m.Attempts,
+		LastError:    m.LastError,
+		OrderVersion: m.OrderVersion,
 	}
 
-	for _, v := range m.AddressHistory {
-		mgoIdentity := &MgoCustomerAddressHistory{
-			Country:    v.Country,
-			City:       v.City,
-			PostalCode: v.PostalCode,
-			State:      v.State,
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
 		}
-		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
-		st.AddressHistory = append(st.AddressHistory, mgoIdentity)
-	}
 
-	for _, v := range m.LocaleHistory {
-		mgoIdentity := &MgoCustomerStringValueHistory{Value: v.Value}
-		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
-		st.LocaleHistory = append(st.LocaleHistory, mgoIdentity)
+		st.Id = bson.ObjectIdHex(m.Id)
 	}
 
-	for _, v := range m.AcceptLanguageHistory {
-		mgoIdentity := &MgoCustomerStringValueHistory{Value: v.Value}
-		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
-		st.AcceptLanguageHistory = append(st.AcceptLanguageHistory, mgoIdentity)
+	if m.NextAttemptAt != nil {
+		t, err := ptypes.Timestamp(m.NextAttemptAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.NextAttemptAt = t
+	} else {
+		st.NextAttemptAt = time.Now()
 	}
 
 	if m.CreatedAt != nil {
@@ -1823,11 +2075,21 @@ func (m *Customer) GetBSON() (interface{}, error) {
 		st.UpdatedAt = time.Now()
 	}
 
+	if m.DeliveredAt != nil {
+		t, err := ptypes.Timestamp(m.DeliveredAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.DeliveredAt = t
+	}
+
 	return st, nil
 }
 
-func (m *Customer) SetBSON(raw bson.Raw) error {
-	decoded := new(MgoCustomer)
+func (m *OutboxMessage) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoOutboxMessage)
 	err := raw.Unmarshal(decoded)
 
 	if err != nil {
@@ -1835,21 +2097,981 @@ func (m *Customer) SetBSON(raw bson.Raw) error {
 	}
 
 	m.Id = decoded.Id.Hex()
-	m.TechEmail = decoded.TechEmail
-	m.ExternalId = decoded.ExternalId
-	m.Email = decoded.Email
-	m.EmailVerified = decoded.EmailVerified
-	m.Phone = decoded.Phone
-	m.PhoneVerified = decoded.PhoneVerified
-	m.Name = decoded.Name
-	m.Ip = decoded.Ip
-	m.Locale = decoded.Locale
-	m.AcceptLanguage = decoded.AcceptLanguage
-	m.UserAgent = decoded.UserAgent
-	m.Address = decoded.Address
-	m.Identity = []*CustomerIdentity{}
-	m.IpHistory = []*CustomerIpHistory{}
-	m.AddressHistory = []*CustomerAddressHistory{}
+	m.Topic = decoded.Topic
+	m.Order = decoded.Order
+	m.Status = decoded.Status
+	m.Attempts = decoded.Attempts
+	m.LastError = decoded.LastError
+	m.OrderVersion = decoded.OrderVersion
+
+	m.NextAttemptAt, err = ptypes.TimestampProto(decoded.NextAttemptAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	if !decoded.DeliveredAt.IsZero() {
+		m.DeliveredAt, err = ptypes.TimestampProto(decoded.DeliveredAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) GetBSON() (interface{}, error) {
+	st := &MgoWebhookDelivery{
+		Event:     m.Event,
+		Url:       m.Url,
+		Payload:   m.Payload,
+		Status:    m.Status,
+		Attempts:  m.Attempts,
+		History:   []*MgoWebhookDeliveryAttempt{},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if bson.IsObjectIdHex(m.ProjectId) == false || bson.IsObjectIdHex(m.OrderId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+
+	st.ProjectId = bson.ObjectIdHex(m.ProjectId)
+	st.OrderId = bson.ObjectIdHex(m.OrderId)
+
+	if m.RefundId != "" {
+		if bson.IsObjectIdHex(m.RefundId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.RefundId = bson.ObjectIdHex(m.RefundId)
+	}
+
+	for _, v := range m.History {
+		attempt := &MgoWebhookDeliveryAttempt{
+			ResponseCode: v.ResponseCode,
+			ResponseBody: v.ResponseBody,
+			Error:        v.Error,
+			Duration:     v.Duration,
+		}
+
+		if v.CreatedAt != nil {
+			t, err := ptypes.Timestamp(v.CreatedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attempt.CreatedAt = t
+		}
+
+		st.History = append(st.History, attempt)
+	}
+
+	if m.NextAttemptAt != nil {
+		t, err := ptypes.Timestamp(m.NextAttemptAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.NextAttemptAt = t
+	} else {
+		st.NextAttemptAt = time.Now()
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	}
+
+	if m.DeliveredAt != nil {
+		t, err := ptypes.Timestamp(m.DeliveredAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.DeliveredAt = t
+	}
+
+	return st, nil
+}
+
+func (m *WebhookDelivery) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoWebhookDelivery)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.OrderId = decoded.OrderId.Hex()
+	m.Event = decoded.Event
+	m.Url = decoded.Url
+	m.Payload = decoded.Payload
+	m.Status = decoded.Status
+	m.Attempts = decoded.Attempts
+
+	if decoded.RefundId != "" {
+		m.RefundId = decoded.RefundId.Hex()
+	}
+
+	for _, v := range decoded.History {
+		attempt := &WebhookDeliveryAttempt{
+			ResponseCode: v.ResponseCode,
+			ResponseBody: v.ResponseBody,
+			Error:        v.Error,
+			Duration:     v.Duration,
+		}
+
+		attempt.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+
+		m.History = append(m.History, attempt)
+	}
+
+	m.NextAttemptAt, err = ptypes.TimestampProto(decoded.NextAttemptAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	if !decoded.DeliveredAt.IsZero() {
+		m.DeliveredAt, err = ptypes.TimestampProto(decoded.DeliveredAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Payout) GetBSON() (interface{}, error) {
+	st := &MgoPayout{
+		Currency:          m.Currency,
+		Status:            m.Status,
+		OrdersAmount:      m.OrdersAmount,
+		RefundsAmount:     m.RefundsAmount,
+		FeesAmount:        m.FeesAmount,
+		TaxAmount:         m.TaxAmount,
+		ReserveAmount:     m.ReserveAmount,
+		PreviousBalance:   m.PreviousBalance,
+		Amount:            m.Amount,
+		TransactionId:     m.TransactionId,
+		FailureReason:     m.FailureReason,
+		ChargebacksAmount: m.ChargebacksAmount,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if bson.IsObjectIdHex(m.MerchantId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+
+	st.MerchantId = bson.ObjectIdHex(m.MerchantId)
+
+	if m.PeriodFrom != nil {
+		t, err := ptypes.Timestamp(m.PeriodFrom)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.PeriodFrom = t
+	}
+
+	if m.PeriodTo != nil {
+		t, err := ptypes.Timestamp(m.PeriodTo)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.PeriodTo = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	if m.ApprovedAt != nil {
+		t, err := ptypes.Timestamp(m.ApprovedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ApprovedAt = t
+	}
+
+	if m.PaidAt != nil {
+		t, err := ptypes.Timestamp(m.PaidAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.PaidAt = t
+	}
+
+	return st, nil
+}
+
+func (m *Payout) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoPayout)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.Currency = decoded.Currency
+	m.Status = decoded.Status
+	m.OrdersAmount = decoded.OrdersAmount
+	m.RefundsAmount = decoded.RefundsAmount
+	m.FeesAmount = decoded.FeesAmount
+	m.TaxAmount = decoded.TaxAmount
+	m.ReserveAmount = decoded.ReserveAmount
+	m.PreviousBalance = decoded.PreviousBalance
+	m.Amount = decoded.Amount
+	m.TransactionId = decoded.TransactionId
+	m.FailureReason = decoded.FailureReason
+	m.ChargebacksAmount = decoded.ChargebacksAmount
+
+	m.PeriodFrom, err = ptypes.TimestampProto(decoded.PeriodFrom)
+
+	if err != nil {
+		return err
+	}
+
+	m.PeriodTo, err = ptypes.TimestampProto(decoded.PeriodTo)
+
+	if err != nil {
+		return err
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	if !decoded.ApprovedAt.IsZero() {
+		m.ApprovedAt, err = ptypes.TimestampProto(decoded.ApprovedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.PaidAt.IsZero() {
+		m.PaidAt, err = ptypes.TimestampProto(decoded.PaidAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Dispute) GetBSON() (interface{}, error) {
+	st := &MgoDispute{
+		ExternalId: m.ExternalId,
+		ReasonCode: m.ReasonCode,
+		Reason:     m.Reason,
+		Amount:     m.Amount,
+		Currency:   m.Currency,
+		Status:     m.Status,
+		FeeAmount:  m.FeeAmount,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if bson.IsObjectIdHex(m.OrderId) == false || bson.IsObjectIdHex(m.MerchantId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+
+	st.OrderId = bson.ObjectIdHex(m.OrderId)
+	st.MerchantId = bson.ObjectIdHex(m.MerchantId)
+
+	if m.EvidenceDueAt != nil {
+		t, err := ptypes.Timestamp(m.EvidenceDueAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.EvidenceDueAt = t
+	}
+
+	for _, v := range m.Evidence {
+		evidence := &MgoDisputeEvidence{Text: v.Text, Files: v.Files}
+
+		if v.CreatedAt != nil {
+			t, err := ptypes.Timestamp(v.CreatedAt)
+
+			if err != nil {
+				return nil, err
+			}
+
+			evidence.CreatedAt = t
+		} else {
+			evidence.CreatedAt = time.Now()
+		}
+
+		st.Evidence = append(st.Evidence, evidence)
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	if m.ClosedAt != nil {
+		t, err := ptypes.Timestamp(m.ClosedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.ClosedAt = t
+	}
+
+	return st, nil
+}
+
+func (m *Dispute) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoDispute)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.OrderId = decoded.OrderId.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ExternalId = decoded.ExternalId
+	m.ReasonCode = decoded.ReasonCode
+	m.Reason = decoded.Reason
+	m.Amount = decoded.Amount
+	m.Currency = decoded.Currency
+	m.Status = decoded.Status
+	m.FeeAmount = decoded.FeeAmount
+
+	if !decoded.EvidenceDueAt.IsZero() {
+		m.EvidenceDueAt, err = ptypes.TimestampProto(decoded.EvidenceDueAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, v := range decoded.Evidence {
+		evidence := &DisputeEvidence{Text: v.Text, Files: v.Files}
+		evidence.CreatedAt, err = ptypes.TimestampProto(v.CreatedAt)
+
+		if err != nil {
+			return err
+		}
+
+		m.Evidence = append(m.Evidence, evidence)
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	if !decoded.ClosedAt.IsZero() {
+		m.ClosedAt, err = ptypes.TimestampProto(decoded.ClosedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Subscription) GetBSON() (interface{}, error) {
+	st := &MgoSubscription{
+		PlanId:            m.PlanId,
+		StoredCardId:      m.StoredCardId,
+		Email:             m.Email,
+		Amount:            m.Amount,
+		Currency:          m.Currency,
+		Interval:          m.Interval,
+		IntervalCount:     m.IntervalCount,
+		Status:            m.Status,
+		RetryAttempts:     m.RetryAttempts,
+		PendingOrderId:    m.PendingOrderId,
+		LastOrderId:       m.LastOrderId,
+		FailureReason:     m.FailureReason,
+		CancelAtPeriodEnd: m.CancelAtPeriodEnd,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if bson.IsObjectIdHex(m.ProjectId) == false || bson.IsObjectIdHex(m.MerchantId) == false ||
+		bson.IsObjectIdHex(m.ProductId) == false || bson.IsObjectIdHex(m.CustomerId) == false ||
+		bson.IsObjectIdHex(m.PaymentMethodId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+
+	st.ProjectId = bson.ObjectIdHex(m.ProjectId)
+	st.MerchantId = bson.ObjectIdHex(m.MerchantId)
+	st.ProductId = bson.ObjectIdHex(m.ProductId)
+	st.CustomerId = bson.ObjectIdHex(m.CustomerId)
+	st.PaymentMethodId = bson.ObjectIdHex(m.PaymentMethodId)
+
+	if m.TrialEndAt != nil {
+		t, err := ptypes.Timestamp(m.TrialEndAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.TrialEndAt = t
+	}
+
+	if m.CurrentPeriodStart != nil {
+		t, err := ptypes.Timestamp(m.CurrentPeriodStart)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CurrentPeriodStart = t
+	}
+
+	if m.CurrentPeriodEnd != nil {
+		t, err := ptypes.Timestamp(m.CurrentPeriodEnd)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CurrentPeriodEnd = t
+	}
+
+	if m.NextPaymentAt != nil {
+		t, err := ptypes.Timestamp(m.NextPaymentAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.NextPaymentAt = t
+	}
+
+	if m.CanceledAt != nil {
+		t, err := ptypes.Timestamp(m.CanceledAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CanceledAt = t
+	}
+
+	if m.PausedAt != nil {
+		t, err := ptypes.Timestamp(m.PausedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.PausedAt = t
+	}
+
+	if m.PendingOrderAt != nil {
+		t, err := ptypes.Timestamp(m.PendingOrderAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.PendingOrderAt = t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *Subscription) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoSubscription)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.ProjectId = decoded.ProjectId.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.ProductId = decoded.ProductId.Hex()
+	m.PlanId = decoded.PlanId
+	m.CustomerId = decoded.CustomerId.Hex()
+	m.StoredCardId = decoded.StoredCardId
+	m.PaymentMethodId = decoded.PaymentMethodId.Hex()
+	m.Email = decoded.Email
+	m.Amount = decoded.Amount
+	m.Currency = decoded.Currency
+	m.Interval = decoded.Interval
+	m.IntervalCount = decoded.IntervalCount
+	m.Status = decoded.Status
+	m.RetryAttempts = decoded.RetryAttempts
+	m.PendingOrderId = decoded.PendingOrderId
+	m.LastOrderId = decoded.LastOrderId
+	m.FailureReason = decoded.FailureReason
+	m.CancelAtPeriodEnd = decoded.CancelAtPeriodEnd
+
+	if !decoded.TrialEndAt.IsZero() {
+		m.TrialEndAt, err = ptypes.TimestampProto(decoded.TrialEndAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.CurrentPeriodStart.IsZero() {
+		m.CurrentPeriodStart, err = ptypes.TimestampProto(decoded.CurrentPeriodStart)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.CurrentPeriodEnd.IsZero() {
+		m.CurrentPeriodEnd, err = ptypes.TimestampProto(decoded.CurrentPeriodEnd)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.NextPaymentAt.IsZero() {
+		m.NextPaymentAt, err = ptypes.TimestampProto(decoded.NextPaymentAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.CanceledAt.IsZero() {
+		m.CanceledAt, err = ptypes.TimestampProto(decoded.CanceledAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.PausedAt.IsZero() {
+		m.PausedAt, err = ptypes.TimestampProto(decoded.PausedAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !decoded.PendingOrderAt.IsZero() {
+		m.PendingOrderAt, err = ptypes.TimestampProto(decoded.PendingOrderAt)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	m.UpdatedAt, err = ptypes.TimestampProto(decoded.UpdatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PaymentFormPaymentMethod) IsBankCard() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBankCard
+}
+
+func (m *PaymentMethod) IsBankCard() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBankCard
+}
+
+func (m *PaymentMethodOrder) IsBankCard() bool {
+	return m.Group == constant.PaymentSystemGroupAliasBankCard
+}
+
+func (m *MerchantPaymentMethodHistory) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoMerchantPaymentMethodHistory)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.UserId = decoded.UserId.Hex()
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	m.PaymentMethod = &MerchantPaymentMethod{
+		PaymentMethod: &MerchantPaymentMethodIdentification{
+			Id:   bson.ObjectId(decoded.PaymentMethod.PaymentMethod.Id).Hex(),
+			Name: decoded.PaymentMethod.PaymentMethod.Name,
+		},
+		Commission:  decoded.PaymentMethod.Commission,
+		Integration: decoded.PaymentMethod.Integration,
+		IsActive:    decoded.PaymentMethod.IsActive,
+	}
+
+	return nil
+}
+
+func (p *MerchantPaymentMethodHistory) GetBSON() (interface{}, error) {
+	st := &MgoMerchantPaymentMethodHistory{}
+
+	if len(p.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(p.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(p.Id)
+	}
+
+	if len(p.MerchantId) <= 0 {
+		return nil, errors.New(errorInvalidObjectId)
+	} else {
+		if bson.IsObjectIdHex(p.MerchantId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.MerchantId = bson.ObjectIdHex(p.MerchantId)
+	}
+
+	if len(p.UserId) <= 0 {
+		return nil, errors.New(errorInvalidObjectId)
+	} else {
+		if bson.IsObjectIdHex(p.UserId) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.UserId = bson.ObjectIdHex(p.UserId)
+	}
+
+	if p.CreatedAt != nil {
+		t, err := ptypes.Timestamp(p.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	st.PaymentMethod = &MgoMerchantPaymentMethod{
+		PaymentMethod: &MgoMerchantPaymentMethodIdentification{
+			Id:   bson.ObjectIdHex(p.PaymentMethod.PaymentMethod.Id),
+			Name: p.PaymentMethod.PaymentMethod.Name,
+		},
+		Commission:  p.PaymentMethod.Commission,
+		Integration: p.PaymentMethod.Integration,
+		IsActive:    p.PaymentMethod.IsActive,
+	}
+
+	return st, nil
+}
+
+func (m *Customer) GetBSON() (interface{}, error) {
+	st := &MgoCustomer{
+		Id:                    bson.ObjectIdHex(m.Id),
+		TechEmail:             m.TechEmail,
+		ExternalId:            m.ExternalId,
+		Email:                 m.Email,
+		EmailVerified:         m.EmailVerified,
+		Phone:                 m.Phone,
+		PhoneVerified:         m.PhoneVerified,
+		Name:                  m.Name,
+		Ip:                    m.Ip,
+		Locale:                m.Locale,
+		AcceptLanguage:        m.AcceptLanguage,
+		UserAgent:             m.UserAgent,
+		Address:               m.Address,
+		Metadata:              m.Metadata,
+		Identity:              []*MgoCustomerIdentity{},
+		IpHistory:             []*MgoCustomerIpHistory{},
+		AddressHistory:        []*MgoCustomerAddressHistory{},
+		LocaleHistory:         []*MgoCustomerStringValueHistory{},
+		AcceptLanguageHistory: []*MgoCustomerStringValueHistory{},
+	}
+
+	for _, v := range m.Identity {
+		mgoIdentity := &MgoCustomerIdentity{
+			MerchantId: bson.ObjectIdHex(v.MerchantId),
+			ProjectId:  bson.ObjectIdHex(v.ProjectId),
+			Type:       v.Type,
+			Value:      v.Value,
+			Verified:   v.Verified,
+		}
+
+		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
+		st.Identity = append(st.Identity, mgoIdentity)
+	}
+
+	for _, v := range m.IpHistory {
+		mgoIdentity := &MgoCustomerIpHistory{Ip: v.Ip}
+		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
+		st.IpHistory = append(st.IpHistory, mgoIdentity)
+	}
+
+	for _, v := range m.AddressHistory {
+		mgoIdentity := &MgoCustomerAddressHistory{
+			Country:    v.Country,
+			City:       v.City,
+			PostalCode: v.PostalCode,
+			State:      v.State,
+		}
+		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
+		st.AddressHistory = append(st.AddressHistory, mgoIdentity)
+	}
+
+	for _, v := range m.LocaleHistory {
+		mgoIdentity := &MgoCustomerStringValueHistory{Value: v.Value}
+		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
+		st.LocaleHistory = append(st.LocaleHistory, mgoIdentity)
+	}
+
+	for _, v := range m.AcceptLanguageHistory {
+		mgoIdentity := &MgoCustomerStringValueHistory{Value: v.Value}
+		mgoIdentity.CreatedAt, _ = ptypes.Timestamp(v.CreatedAt)
+		st.AcceptLanguageHistory = append(st.AcceptLanguageHistory, mgoIdentity)
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.UpdatedAt != nil {
+		t, err := ptypes.Timestamp(m.UpdatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.UpdatedAt = t
+	} else {
+		st.UpdatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *Customer) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoCustomer)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.TechEmail = decoded.TechEmail
+	m.ExternalId = decoded.ExternalId
+	m.Email = decoded.Email
+	m.EmailVerified = decoded.EmailVerified
+	m.Phone = decoded.Phone
+	m.PhoneVerified = decoded.PhoneVerified
+	m.Name = decoded.Name
+	m.Ip = decoded.Ip
+	m.Locale = decoded.Locale
+	m.AcceptLanguage = decoded.AcceptLanguage
+	m.UserAgent = decoded.UserAgent
+	m.Address = decoded.Address
+	m.Identity = []*CustomerIdentity{}
+	m.IpHistory = []*CustomerIpHistory{}
+	m.AddressHistory = []*CustomerAddressHistory{}
 	m.LocaleHistory = []*CustomerStringValueHistory{}
 	m.AcceptLanguageHistory = []*CustomerStringValueHistory{}
 	m.Metadata = decoded.Metadata
@@ -1910,3 +3132,107 @@ func (m *Customer) SetBSON(raw bson.Raw) error {
 
 	return nil
 }
+
+func (m *CommissionPlan) GetBSON() (interface{}, error) {
+	st := &MgoCommissionPlan{
+		Version:  m.Version,
+		Currency: m.Currency,
+		Tiers:    m.Tiers,
+		MinFee:   m.MinFee,
+		MaxFee:   m.MaxFee,
+		UserId:   m.UserId,
+	}
+
+	if len(m.Id) <= 0 {
+		st.Id = bson.NewObjectId()
+	} else {
+		if bson.IsObjectIdHex(m.Id) == false {
+			return nil, errors.New(errorInvalidObjectId)
+		}
+
+		st.Id = bson.ObjectIdHex(m.Id)
+	}
+
+	if bson.IsObjectIdHex(m.MerchantId) == false || bson.IsObjectIdHex(m.PaymentMethodId) == false {
+		return nil, errors.New(errorInvalidObjectId)
+	}
+
+	st.MerchantId = bson.ObjectIdHex(m.MerchantId)
+	st.PaymentMethodId = bson.ObjectIdHex(m.PaymentMethodId)
+
+	if m.EffectiveFrom != nil {
+		t, err := ptypes.Timestamp(m.EffectiveFrom)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.EffectiveFrom = t
+	}
+
+	// empty date of end of plan saved as null to find plans without end of effective period
+	if m.EffectiveTo != nil {
+		t, err := ptypes.Timestamp(m.EffectiveTo)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.EffectiveTo = &t
+	}
+
+	if m.CreatedAt != nil {
+		t, err := ptypes.Timestamp(m.CreatedAt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		st.CreatedAt = t
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	return st, nil
+}
+
+func (m *CommissionPlan) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoCommissionPlan)
+	err := raw.Unmarshal(decoded)
+
+	if err != nil {
+		return err
+	}
+
+	m.Id = decoded.Id.Hex()
+	m.MerchantId = decoded.MerchantId.Hex()
+	m.PaymentMethodId = decoded.PaymentMethodId.Hex()
+	m.Version = decoded.Version
+	m.Currency = decoded.Currency
+	m.Tiers = decoded.Tiers
+	m.MinFee = decoded.MinFee
+	m.MaxFee = decoded.MaxFee
+	m.UserId = decoded.UserId
+
+	m.EffectiveFrom, err = ptypes.TimestampProto(decoded.EffectiveFrom)
+
+	if err != nil {
+		return err
+	}
+
+	if decoded.EffectiveTo != nil {
+		m.EffectiveTo, err = ptypes.TimestampProto(*decoded.EffectiveTo)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
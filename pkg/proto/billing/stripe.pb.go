@@ -0,0 +1,827 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: billing/stripe.proto
+
+package billing
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type StripeLastPaymentError struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DeclineCode          string   `protobuf:"bytes,2,opt,name=decline_code,json=declineCode,proto3" json:"decline_code,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeLastPaymentError) Reset()         { *m = StripeLastPaymentError{} }
+func (m *StripeLastPaymentError) String() string { return proto.CompactTextString(m) }
+func (*StripeLastPaymentError) ProtoMessage()    {}
+func (*StripeLastPaymentError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{0}
+}
+
+func (m *StripeLastPaymentError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeLastPaymentError.Unmarshal(m, b)
+}
+func (m *StripeLastPaymentError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeLastPaymentError.Marshal(b, m, deterministic)
+}
+func (m *StripeLastPaymentError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeLastPaymentError.Merge(m, src)
+}
+func (m *StripeLastPaymentError) XXX_Size() int {
+	return xxx_messageInfo_StripeLastPaymentError.Size(m)
+}
+func (m *StripeLastPaymentError) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeLastPaymentError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeLastPaymentError proto.InternalMessageInfo
+
+func (m *StripeLastPaymentError) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *StripeLastPaymentError) GetDeclineCode() string {
+	if m != nil {
+		return m.DeclineCode
+	}
+	return ""
+}
+
+func (m *StripeLastPaymentError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *StripeLastPaymentError) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type StripeCardDetails struct {
+	Brand                string   `protobuf:"bytes,1,opt,name=brand,proto3" json:"brand,omitempty"`
+	Country              string   `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	Last4                string   `protobuf:"bytes,3,opt,name=last4,proto3" json:"last4,omitempty"`
+	ExpMonth             int32    `protobuf:"varint,4,opt,name=exp_month,json=expMonth,proto3" json:"exp_month,omitempty"`
+	ExpYear              int32    `protobuf:"varint,5,opt,name=exp_year,json=expYear,proto3" json:"exp_year,omitempty"`
+	Fingerprint          string   `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeCardDetails) Reset()         { *m = StripeCardDetails{} }
+func (m *StripeCardDetails) String() string { return proto.CompactTextString(m) }
+func (*StripeCardDetails) ProtoMessage()    {}
+func (*StripeCardDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{1}
+}
+
+func (m *StripeCardDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeCardDetails.Unmarshal(m, b)
+}
+func (m *StripeCardDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeCardDetails.Marshal(b, m, deterministic)
+}
+func (m *StripeCardDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeCardDetails.Merge(m, src)
+}
+func (m *StripeCardDetails) XXX_Size() int {
+	return xxx_messageInfo_StripeCardDetails.Size(m)
+}
+func (m *StripeCardDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeCardDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeCardDetails proto.InternalMessageInfo
+
+func (m *StripeCardDetails) GetBrand() string {
+	if m != nil {
+		return m.Brand
+	}
+	return ""
+}
+
+func (m *StripeCardDetails) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+func (m *StripeCardDetails) GetLast4() string {
+	if m != nil {
+		return m.Last4
+	}
+	return ""
+}
+
+func (m *StripeCardDetails) GetExpMonth() int32 {
+	if m != nil {
+		return m.ExpMonth
+	}
+	return 0
+}
+
+func (m *StripeCardDetails) GetExpYear() int32 {
+	if m != nil {
+		return m.ExpYear
+	}
+	return 0
+}
+
+func (m *StripeCardDetails) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
+}
+
+type StripePaymentMethodDetails struct {
+	Type                 string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Card                 *StripeCardDetails `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripePaymentMethodDetails) Reset()         { *m = StripePaymentMethodDetails{} }
+func (m *StripePaymentMethodDetails) String() string { return proto.CompactTextString(m) }
+func (*StripePaymentMethodDetails) ProtoMessage()    {}
+func (*StripePaymentMethodDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{2}
+}
+
+func (m *StripePaymentMethodDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripePaymentMethodDetails.Unmarshal(m, b)
+}
+func (m *StripePaymentMethodDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripePaymentMethodDetails.Marshal(b, m, deterministic)
+}
+func (m *StripePaymentMethodDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripePaymentMethodDetails.Merge(m, src)
+}
+func (m *StripePaymentMethodDetails) XXX_Size() int {
+	return xxx_messageInfo_StripePaymentMethodDetails.Size(m)
+}
+func (m *StripePaymentMethodDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripePaymentMethodDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripePaymentMethodDetails proto.InternalMessageInfo
+
+func (m *StripePaymentMethodDetails) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StripePaymentMethodDetails) GetCard() *StripeCardDetails {
+	if m != nil {
+		return m.Card
+	}
+	return nil
+}
+
+type StripeCharge struct {
+	Id                   string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               int64                       `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string                      `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               string                      `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PaymentMethodDetails *StripePaymentMethodDetails `protobuf:"bytes,5,opt,name=payment_method_details,json=paymentMethodDetails,proto3" json:"payment_method_details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                       `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeCharge) Reset()         { *m = StripeCharge{} }
+func (m *StripeCharge) String() string { return proto.CompactTextString(m) }
+func (*StripeCharge) ProtoMessage()    {}
+func (*StripeCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{3}
+}
+
+func (m *StripeCharge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeCharge.Unmarshal(m, b)
+}
+func (m *StripeCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeCharge.Marshal(b, m, deterministic)
+}
+func (m *StripeCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeCharge.Merge(m, src)
+}
+func (m *StripeCharge) XXX_Size() int {
+	return xxx_messageInfo_StripeCharge.Size(m)
+}
+func (m *StripeCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeCharge proto.InternalMessageInfo
+
+func (m *StripeCharge) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StripeCharge) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *StripeCharge) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *StripeCharge) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StripeCharge) GetPaymentMethodDetails() *StripePaymentMethodDetails {
+	if m != nil {
+		return m.PaymentMethodDetails
+	}
+	return nil
+}
+
+type StripeChargeList struct {
+	Data                 []*StripeCharge `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32           `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeChargeList) Reset()         { *m = StripeChargeList{} }
+func (m *StripeChargeList) String() string { return proto.CompactTextString(m) }
+func (*StripeChargeList) ProtoMessage()    {}
+func (*StripeChargeList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{4}
+}
+
+func (m *StripeChargeList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeChargeList.Unmarshal(m, b)
+}
+func (m *StripeChargeList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeChargeList.Marshal(b, m, deterministic)
+}
+func (m *StripeChargeList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeChargeList.Merge(m, src)
+}
+func (m *StripeChargeList) XXX_Size() int {
+	return xxx_messageInfo_StripeChargeList.Size(m)
+}
+func (m *StripeChargeList) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeChargeList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeChargeList proto.InternalMessageInfo
+
+func (m *StripeChargeList) GetData() []*StripeCharge {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type StripePaymentIntent struct {
+	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               int64                   `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string                  `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               string                  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ClientSecret         string                  `protobuf:"bytes,5,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	Metadata             map[string]string       `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LastPaymentError     *StripeLastPaymentError `protobuf:"bytes,7,opt,name=last_payment_error,json=lastPaymentError,proto3" json:"last_payment_error,omitempty"`
+	Charges              *StripeChargeList       `protobuf:"bytes,8,opt,name=charges,proto3" json:"charges,omitempty"`
+	Created              int64                   `protobuf:"varint,9,opt,name=created,proto3" json:"created,omitempty"`
+	AmountReceived       int64                   `protobuf:"varint,10,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	AmountCapturable     int64                   `protobuf:"varint,11,opt,name=amount_capturable,json=amountCapturable,proto3" json:"amount_capturable,omitempty"`
+	CaptureMethod        string                  `protobuf:"bytes,12,opt,name=capture_method,json=captureMethod,proto3" json:"capture_method,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripePaymentIntent) Reset()         { *m = StripePaymentIntent{} }
+func (m *StripePaymentIntent) String() string { return proto.CompactTextString(m) }
+func (*StripePaymentIntent) ProtoMessage()    {}
+func (*StripePaymentIntent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{5}
+}
+
+func (m *StripePaymentIntent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripePaymentIntent.Unmarshal(m, b)
+}
+func (m *StripePaymentIntent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripePaymentIntent.Marshal(b, m, deterministic)
+}
+func (m *StripePaymentIntent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripePaymentIntent.Merge(m, src)
+}
+func (m *StripePaymentIntent) XXX_Size() int {
+	return xxx_messageInfo_StripePaymentIntent.Size(m)
+}
+func (m *StripePaymentIntent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripePaymentIntent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripePaymentIntent proto.InternalMessageInfo
+
+func (m *StripePaymentIntent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StripePaymentIntent) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *StripePaymentIntent) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *StripePaymentIntent) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StripePaymentIntent) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+func (m *StripePaymentIntent) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StripePaymentIntent) GetLastPaymentError() *StripeLastPaymentError {
+	if m != nil {
+		return m.LastPaymentError
+	}
+	return nil
+}
+
+func (m *StripePaymentIntent) GetCharges() *StripeChargeList {
+	if m != nil {
+		return m.Charges
+	}
+	return nil
+}
+
+func (m *StripePaymentIntent) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *StripePaymentIntent) GetAmountReceived() int64 {
+	if m != nil {
+		return m.AmountReceived
+	}
+	return 0
+}
+
+func (m *StripePaymentIntent) GetAmountCapturable() int64 {
+	if m != nil {
+		return m.AmountCapturable
+	}
+	return 0
+}
+
+func (m *StripePaymentIntent) GetCaptureMethod() string {
+	if m != nil {
+		return m.CaptureMethod
+	}
+	return ""
+}
+
+type StripePaymentEventData struct {
+	// @inject_tag: validate:"required"
+	Object               *StripePaymentIntent `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripePaymentEventData) Reset()         { *m = StripePaymentEventData{} }
+func (m *StripePaymentEventData) String() string { return proto.CompactTextString(m) }
+func (*StripePaymentEventData) ProtoMessage()    {}
+func (*StripePaymentEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{6}
+}
+
+func (m *StripePaymentEventData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripePaymentEventData.Unmarshal(m, b)
+}
+func (m *StripePaymentEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripePaymentEventData.Marshal(b, m, deterministic)
+}
+func (m *StripePaymentEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripePaymentEventData.Merge(m, src)
+}
+func (m *StripePaymentEventData) XXX_Size() int {
+	return xxx_messageInfo_StripePaymentEventData.Size(m)
+}
+func (m *StripePaymentEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripePaymentEventData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripePaymentEventData proto.InternalMessageInfo
+
+func (m *StripePaymentEventData) GetObject() *StripePaymentIntent {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type StripePaymentCallback struct {
+	// @inject_tag: validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required"`
+	// @inject_tag: validate:"required"
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty" validate:"required"`
+	Created int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// @inject_tag: validate:"required"
+	Data                 *StripePaymentEventData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripePaymentCallback) Reset()         { *m = StripePaymentCallback{} }
+func (m *StripePaymentCallback) String() string { return proto.CompactTextString(m) }
+func (*StripePaymentCallback) ProtoMessage()    {}
+func (*StripePaymentCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{7}
+}
+
+func (m *StripePaymentCallback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripePaymentCallback.Unmarshal(m, b)
+}
+func (m *StripePaymentCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripePaymentCallback.Marshal(b, m, deterministic)
+}
+func (m *StripePaymentCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripePaymentCallback.Merge(m, src)
+}
+func (m *StripePaymentCallback) XXX_Size() int {
+	return xxx_messageInfo_StripePaymentCallback.Size(m)
+}
+func (m *StripePaymentCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripePaymentCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripePaymentCallback proto.InternalMessageInfo
+
+func (m *StripePaymentCallback) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StripePaymentCallback) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StripePaymentCallback) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *StripePaymentCallback) GetData() *StripePaymentEventData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type StripeRefund struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount               int64             `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string            `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status               string            `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PaymentIntent        string            `protobuf:"bytes,5,opt,name=payment_intent,json=paymentIntent,proto3" json:"payment_intent,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FailureReason        string            `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	Created              int64             `protobuf:"varint,8,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeRefund) Reset()         { *m = StripeRefund{} }
+func (m *StripeRefund) String() string { return proto.CompactTextString(m) }
+func (*StripeRefund) ProtoMessage()    {}
+func (*StripeRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{8}
+}
+
+func (m *StripeRefund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeRefund.Unmarshal(m, b)
+}
+func (m *StripeRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeRefund.Marshal(b, m, deterministic)
+}
+func (m *StripeRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeRefund.Merge(m, src)
+}
+func (m *StripeRefund) XXX_Size() int {
+	return xxx_messageInfo_StripeRefund.Size(m)
+}
+func (m *StripeRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeRefund proto.InternalMessageInfo
+
+func (m *StripeRefund) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StripeRefund) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *StripeRefund) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *StripeRefund) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StripeRefund) GetPaymentIntent() string {
+	if m != nil {
+		return m.PaymentIntent
+	}
+	return ""
+}
+
+func (m *StripeRefund) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *StripeRefund) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *StripeRefund) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+type StripeRefundEventData struct {
+	// @inject_tag: validate:"required"
+	Object               *StripeRefund `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32         `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeRefundEventData) Reset()         { *m = StripeRefundEventData{} }
+func (m *StripeRefundEventData) String() string { return proto.CompactTextString(m) }
+func (*StripeRefundEventData) ProtoMessage()    {}
+func (*StripeRefundEventData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{9}
+}
+
+func (m *StripeRefundEventData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeRefundEventData.Unmarshal(m, b)
+}
+func (m *StripeRefundEventData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeRefundEventData.Marshal(b, m, deterministic)
+}
+func (m *StripeRefundEventData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeRefundEventData.Merge(m, src)
+}
+func (m *StripeRefundEventData) XXX_Size() int {
+	return xxx_messageInfo_StripeRefundEventData.Size(m)
+}
+func (m *StripeRefundEventData) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeRefundEventData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeRefundEventData proto.InternalMessageInfo
+
+func (m *StripeRefundEventData) GetObject() *StripeRefund {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+type StripeRefundCallback struct {
+	// @inject_tag: validate:"required"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required"`
+	// @inject_tag: validate:"required"
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty" validate:"required"`
+	Created int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// @inject_tag: validate:"required"
+	Data                 *StripeRefundEventData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *StripeRefundCallback) Reset()         { *m = StripeRefundCallback{} }
+func (m *StripeRefundCallback) String() string { return proto.CompactTextString(m) }
+func (*StripeRefundCallback) ProtoMessage()    {}
+func (*StripeRefundCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_579bcdb8220965c0, []int{10}
+}
+
+func (m *StripeRefundCallback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StripeRefundCallback.Unmarshal(m, b)
+}
+func (m *StripeRefundCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StripeRefundCallback.Marshal(b, m, deterministic)
+}
+func (m *StripeRefundCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StripeRefundCallback.Merge(m, src)
+}
+func (m *StripeRefundCallback) XXX_Size() int {
+	return xxx_messageInfo_StripeRefundCallback.Size(m)
+}
+func (m *StripeRefundCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_StripeRefundCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StripeRefundCallback proto.InternalMessageInfo
+
+func (m *StripeRefundCallback) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StripeRefundCallback) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StripeRefundCallback) GetCreated() int64 {
+	if m != nil {
+		return m.Created
+	}
+	return 0
+}
+
+func (m *StripeRefundCallback) GetData() *StripeRefundEventData {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StripeLastPaymentError)(nil), "billing.StripeLastPaymentError")
+	proto.RegisterType((*StripeCardDetails)(nil), "billing.StripeCardDetails")
+	proto.RegisterType((*StripePaymentMethodDetails)(nil), "billing.StripePaymentMethodDetails")
+	proto.RegisterType((*StripeCharge)(nil), "billing.StripeCharge")
+	proto.RegisterType((*StripeChargeList)(nil), "billing.StripeChargeList")
+	proto.RegisterType((*StripePaymentIntent)(nil), "billing.StripePaymentIntent")
+	proto.RegisterMapType((map[string]string)(nil), "billing.StripePaymentIntent.MetadataEntry")
+	proto.RegisterType((*StripePaymentEventData)(nil), "billing.StripePaymentEventData")
+	proto.RegisterType((*StripePaymentCallback)(nil), "billing.StripePaymentCallback")
+	proto.RegisterType((*StripeRefund)(nil), "billing.StripeRefund")
+	proto.RegisterMapType((map[string]string)(nil), "billing.StripeRefund.MetadataEntry")
+	proto.RegisterType((*StripeRefundEventData)(nil), "billing.StripeRefundEventData")
+	proto.RegisterType((*StripeRefundCallback)(nil), "billing.StripeRefundCallback")
+}
+
+func init() { proto.RegisterFile("billing/stripe.proto", fileDescriptor_579bcdb8220965c0) }
+
+var fileDescriptor_579bcdb8220965c0 = []byte{
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5d, 0x6f, 0xeb, 0x44,
+	0x10, 0x95, 0x93, 0x34, 0x1f, 0x93, 0x0f, 0x72, 0x97, 0xa4, 0xf2, 0x0d, 0x08, 0x8a, 0xaf, 0x2a,
+	0x2e, 0xa0, 0x26, 0x52, 0x7a, 0x1f, 0x10, 0xe8, 0xea, 0x4a, 0xa4, 0xad, 0x84, 0xd4, 0x20, 0xe4,
+	0x3e, 0x95, 0x97, 0xb0, 0xb1, 0xa7, 0x89, 0xa9, 0x63, 0x5b, 0xeb, 0x75, 0xd4, 0x3c, 0xf0, 0x8e,
+	0xf8, 0x3b, 0x48, 0xfc, 0x03, 0xde, 0xf9, 0x49, 0x68, 0x67, 0xd7, 0x69, 0xe2, 0x06, 0x5e, 0xb8,
+	0x7d, 0xdb, 0x39, 0x33, 0x99, 0x9d, 0x39, 0x7b, 0x8e, 0x15, 0xe8, 0xcd, 0x83, 0x30, 0x0c, 0xa2,
+	0xc5, 0x28, 0x95, 0x22, 0x48, 0x70, 0x98, 0x88, 0x58, 0xc6, 0xac, 0x66, 0x50, 0xe7, 0x57, 0x38,
+	0xbe, 0xa1, 0xc4, 0x35, 0x4f, 0xe5, 0x8f, 0x7c, 0xb3, 0xc2, 0x48, 0x5e, 0x0a, 0x11, 0x0b, 0xc6,
+	0xa0, 0xe2, 0xc5, 0x3e, 0xda, 0xd6, 0x89, 0xf5, 0xba, 0xe1, 0xd2, 0x99, 0x7d, 0x06, 0x2d, 0x1f,
+	0xbd, 0x30, 0x88, 0x70, 0x46, 0xb9, 0x12, 0xe5, 0x9a, 0x06, 0x9b, 0xa8, 0x12, 0x1b, 0x6a, 0x2b,
+	0x4c, 0x53, 0xbe, 0x40, 0xbb, 0x4c, 0xd9, 0x3c, 0x54, 0x0d, 0xe5, 0x26, 0x41, 0xbb, 0xa2, 0x1b,
+	0xaa, 0xb3, 0xf3, 0x87, 0x05, 0x2f, 0xf4, 0xfd, 0x13, 0x2e, 0xfc, 0x0b, 0x94, 0x3c, 0x08, 0x53,
+	0xd6, 0x83, 0xa3, 0xb9, 0xe0, 0x91, 0x6f, 0xee, 0xd6, 0x81, 0xea, 0xec, 0xc5, 0x59, 0x24, 0xc5,
+	0xc6, 0xdc, 0x9b, 0x87, 0xaa, 0x3e, 0xe4, 0xa9, 0x7c, 0x63, 0x6e, 0xd4, 0x01, 0xfb, 0x08, 0x1a,
+	0xf8, 0x90, 0xcc, 0x56, 0x71, 0x24, 0x97, 0x74, 0xe9, 0x91, 0x5b, 0xc7, 0x87, 0x64, 0xaa, 0x62,
+	0xf6, 0x12, 0xd4, 0x79, 0xb6, 0x41, 0x2e, 0xec, 0x23, 0xca, 0xd5, 0xf0, 0x21, 0xb9, 0x45, 0x2e,
+	0xd8, 0x09, 0x34, 0xef, 0x82, 0x68, 0x81, 0x22, 0x11, 0x41, 0x24, 0xed, 0xaa, 0xde, 0x71, 0x07,
+	0x72, 0x7e, 0x86, 0x81, 0x1e, 0xda, 0x10, 0x36, 0x45, 0xb9, 0x8c, 0xb7, 0xd3, 0xe7, 0x7b, 0x5a,
+	0x8f, 0x7b, 0xb2, 0x21, 0x54, 0x3c, 0x2e, 0x7c, 0x1a, 0xbc, 0x39, 0x1e, 0x0c, 0x0d, 0xfd, 0xc3,
+	0x27, 0xbb, 0xbb, 0x54, 0xe7, 0xfc, 0x65, 0x41, 0xcb, 0xe4, 0x96, 0x5c, 0x2c, 0x90, 0x75, 0xa0,
+	0x14, 0xe4, 0x7c, 0x94, 0x02, 0x9f, 0x1d, 0x43, 0x95, 0xaf, 0xd4, 0xfa, 0xd4, 0xb2, 0xec, 0x9a,
+	0x88, 0x0d, 0xa0, 0xee, 0x65, 0x42, 0x60, 0xe4, 0x6d, 0x0c, 0x1b, 0xdb, 0x58, 0xfd, 0x26, 0x95,
+	0x5c, 0x66, 0xa9, 0x79, 0x02, 0x13, 0xb1, 0x5b, 0x38, 0x4e, 0xf4, 0x22, 0xb3, 0x15, 0x6d, 0x32,
+	0xf3, 0xf5, 0x30, 0xc4, 0x4c, 0x73, 0xfc, 0xaa, 0x30, 0xee, 0xa1, 0xad, 0xdd, 0x5e, 0x72, 0x00,
+	0x75, 0xde, 0x42, 0x77, 0x77, 0x8d, 0xeb, 0x20, 0x95, 0xec, 0x0b, 0xa8, 0xf8, 0x5c, 0x72, 0xdb,
+	0x3a, 0x29, 0xbf, 0x6e, 0x8e, 0xfb, 0x45, 0x2e, 0xa8, 0xd0, 0xa5, 0x12, 0xe7, 0xcf, 0x0a, 0x7c,
+	0xb8, 0x77, 0xe7, 0xf7, 0x91, 0xc4, 0x48, 0x3e, 0x2b, 0x1b, 0xaf, 0xa0, 0xed, 0x85, 0x81, 0x22,
+	0x23, 0x45, 0x4f, 0xa0, 0x24, 0x12, 0x1a, 0x6e, 0x4b, 0x83, 0x37, 0x84, 0xb1, 0x2b, 0xa8, 0xaf,
+	0x50, 0x72, 0xda, 0xa3, 0x4a, 0x7b, 0x7c, 0x79, 0x98, 0x24, 0x3d, 0xf0, 0x70, 0x6a, 0x8a, 0x2f,
+	0x95, 0x5e, 0xdd, 0xed, 0x6f, 0xd9, 0x14, 0x98, 0x12, 0xeb, 0x2c, 0xe7, 0x1f, 0x95, 0xf5, 0xec,
+	0x1a, 0xd1, 0xfe, 0x69, 0xa1, 0x63, 0xd1, 0xa1, 0x6e, 0x37, 0x2c, 0x20, 0xec, 0x1c, 0x6a, 0x1e,
+	0xf1, 0x97, 0xda, 0x75, 0xea, 0xf1, 0xf2, 0x20, 0xbb, 0xea, 0x19, 0xdc, 0xbc, 0x92, 0x7c, 0x25,
+	0x90, 0x4b, 0xf4, 0xed, 0x06, 0xb1, 0x97, 0x87, 0xec, 0x73, 0xf8, 0x40, 0x13, 0x39, 0x13, 0xe8,
+	0x61, 0xb0, 0x46, 0xdf, 0x06, 0xaa, 0xe8, 0x68, 0xd8, 0x35, 0x28, 0xfb, 0x0a, 0x5e, 0x98, 0x42,
+	0x8f, 0x27, 0x32, 0x13, 0x7c, 0x1e, 0xa2, 0xdd, 0xa4, 0xd2, 0xae, 0x4e, 0x4c, 0xb6, 0x38, 0x3b,
+	0x85, 0x8e, 0xae, 0x42, 0x23, 0x37, 0xbb, 0x45, 0x0c, 0xb7, 0x0d, 0xaa, 0x15, 0x34, 0xf8, 0x16,
+	0xda, 0x7b, 0xac, 0xb1, 0x2e, 0x94, 0xef, 0x71, 0x63, 0x5e, 0x5d, 0x1d, 0x95, 0xef, 0xd7, 0x3c,
+	0xcc, 0xf2, 0xef, 0x90, 0x0e, 0xbe, 0x29, 0x7d, 0x6d, 0x39, 0x3f, 0xe4, 0x9f, 0xb5, 0x9c, 0x9e,
+	0x35, 0x46, 0xf2, 0x42, 0x31, 0xfe, 0x06, 0xaa, 0xf1, 0xfc, 0x17, 0xf4, 0x24, 0x35, 0x6a, 0x8e,
+	0x3f, 0xfe, 0xaf, 0x77, 0x73, 0x4d, 0xad, 0xf3, 0xbb, 0x05, 0xfd, 0xbd, 0xfc, 0x84, 0x87, 0xe1,
+	0x9c, 0x7b, 0xf7, 0x4f, 0xa4, 0x98, 0xbb, 0xbf, 0xb4, 0xe3, 0xfe, 0x1d, 0x86, 0xcb, 0xfb, 0x0c,
+	0x9f, 0x1b, 0x2f, 0x54, 0x0e, 0xbe, 0x78, 0x71, 0x78, 0xe3, 0x8a, 0xbf, 0x4b, 0xf9, 0xc7, 0xc1,
+	0xc5, 0xbb, 0x2c, 0xf2, 0x9f, 0xd5, 0x0e, 0xa7, 0xd0, 0xc9, 0xc5, 0x19, 0x10, 0x27, 0xc6, 0x0f,
+	0xed, 0x64, 0xcf, 0x91, 0xef, 0x9e, 0x18, 0xa2, 0xf8, 0xd5, 0xd0, 0xb3, 0xfe, 0xab, 0x13, 0x4e,
+	0xa1, 0x73, 0xc7, 0x83, 0x50, 0xa9, 0x42, 0x20, 0x4f, 0xe3, 0x88, 0x5c, 0xd0, 0x70, 0xdb, 0x06,
+	0x75, 0x09, 0xdc, 0xa5, 0xb2, 0xbe, 0x47, 0xe5, 0xff, 0xd3, 0xcb, 0x15, 0xf4, 0x77, 0xa7, 0x7c,
+	0x94, 0xcb, 0x59, 0x41, 0x2e, 0xfd, 0x83, 0x5b, 0x6d, 0x75, 0xf2, 0x9b, 0x05, 0xbd, 0xdd, 0xc4,
+	0x7b, 0x92, 0xc9, 0x78, 0x4f, 0x26, 0x9f, 0x1c, 0x9c, 0xa1, 0xa0, 0x92, 0xef, 0xde, 0xfd, 0xf4,
+	0x76, 0x11, 0xc8, 0x65, 0x36, 0x1f, 0x7a, 0xf1, 0x6a, 0x94, 0xf0, 0x4d, 0x9a, 0x25, 0x28, 0xb6,
+	0x87, 0x33, 0xd3, 0xe3, 0x2c, 0x45, 0xb1, 0x56, 0xf8, 0xfd, 0x62, 0x44, 0xff, 0x0d, 0x46, 0x26,
+	0x31, 0xaf, 0x52, 0x78, 0xfe, 0xcf, 0x00, 0x81, 0xf1, 0xa3, 0x1a, 0x42, 0x08, 0x00, 0x00,
+}
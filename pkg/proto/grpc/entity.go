@@ -10,6 +10,7 @@ var (
 	productNoNameInLanguage            = "no name in language %s"
 	productNoDescriptionInLanguage     = "no description in language %s"
 	productNoLongDescriptionInLanguage = "no long description in language %s"
+	productPlanNotFound                = "subscription plan %s not found"
 )
 
 func (m *MerchantPaymentMethodRequest) GetPerTransactionCurrency() string {
@@ -66,3 +67,21 @@ func (p *Product) GetLocalizedLongDescription(lang string) (string, error) {
 	}
 	return v, nil
 }
+
+func (p *Product) GetPlan(id string) (*SubscriptionPlan, error) {
+	for _, plan := range p.Plans {
+		if plan.Id == id {
+			return plan, nil
+		}
+	}
+	return nil, errors.New(fmt.Sprintf(productPlanNotFound, id))
+}
+
+func (p *SubscriptionPlan) GetPriceInCurrency(currency string) (float64, error) {
+	for _, price := range p.Prices {
+		if price.Currency == currency {
+			return price.Amount, nil
+		}
+	}
+	return 0, errors.New(fmt.Sprintf(productNoPriceInCurrency, currency))
+}
@@ -18,6 +18,9 @@ It has these top-level messages:
 	PaymentNotifyRequest
 	PaymentNotifyResponse
 	ConvertRateRequest
+	ImportCurrencyRatesRequest
+	ImportCurrencyRate
+	ImportCurrencyRatesResponse
 	ConvertRateResponse
 	OnboardingBanking
 	OnboardingRequest
@@ -37,6 +40,7 @@ It has these top-level messages:
 	MerchantGetMerchantResponse
 	GetNotificationRequest
 	CreateRefundRequest
+	CreateRefundItem
 	CreateRefundResponse
 	ListRefundsRequest
 	ListRefundsResponse
@@ -56,6 +60,7 @@ It has these top-level messages:
 	ChangeMerchantDataResponse
 	SetMerchantS3AgreementRequest
 	Product
+	SubscriptionPlan
 	ProductPrice
 	ListProductsRequest
 	GetProductsForOrderRequest
@@ -70,6 +75,47 @@ It has these top-level messages:
 	TokenResponse
 	CheckProjectRequestSignatureRequest
 	CheckProjectRequestSignatureResponse
+	CaptureOrderRequest
+	VoidOrderRequest
+	OrderOperationResponse
+	ListOutboxMessagesRequest
+	ListOutboxMessagesResponse
+	ReplayOutboxMessagesRequest
+	ReplayOutboxMessagesResponse
+	GetOrderStatusHistoryRequest
+	GetOrderStatusHistoryResponse
+	ListWebhookDeliveriesRequest
+	ListWebhookDeliveriesResponse
+	ResendWebhookDeliveryRequest
+	ResendWebhookDeliveryResponse
+	GetMerchantBalanceRequest
+	GetMerchantBalanceResponse
+	ListLedgerEntriesRequest
+	ListLedgerEntriesResponse
+	ListPayoutsRequest
+	ListPayoutsResponse
+	PayoutRequest
+	MarkPayoutPaidRequest
+	MarkPayoutFailedRequest
+	PayoutResponse
+	ListDisputesRequest
+	ListDisputesResponse
+	DisputeRequest
+	AddDisputeEvidenceRequest
+	DisputeResponse
+	CreateSubscriptionRequest
+	SubscriptionRequest
+	CancelSubscriptionRequest
+	ListSubscriptionsRequest
+	ListSubscriptionsResponse
+	SubscriptionResponse
+	CommissionPlanResponse
+	ListCommissionPlansRequest
+	ListCommissionPlansResponse
+	ListSystemFeesHistoryRequest
+	ListSystemFeesHistoryResponse
+	ReactivateSystemFeesRequest
+	SystemFeesResponse
 */
 package grpc
 
@@ -113,6 +159,7 @@ type BillingService interface {
 	UpdateOrder(ctx context.Context, in *billing.Order, opts ...client.CallOption) (*EmptyResponse, error)
 	UpdateMerchant(ctx context.Context, in *billing.Merchant, opts ...client.CallOption) (*EmptyResponse, error)
 	GetConvertRate(ctx context.Context, in *ConvertRateRequest, opts ...client.CallOption) (*ConvertRateResponse, error)
+	ImportCurrencyRates(ctx context.Context, in *ImportCurrencyRatesRequest, opts ...client.CallOption) (*ImportCurrencyRatesResponse, error)
 	GetMerchantBy(ctx context.Context, in *GetMerchantByRequest, opts ...client.CallOption) (*MerchantGetMerchantResponse, error)
 	ListMerchants(ctx context.Context, in *MerchantListingRequest, opts ...client.CallOption) (*MerchantListingResponse, error)
 	ChangeMerchant(ctx context.Context, in *OnboardingRequest, opts ...client.CallOption) (*billing.Merchant, error)
@@ -141,12 +188,39 @@ type BillingService interface {
 	AddSystemFees(ctx context.Context, in *billing.AddSystemFeesRequest, opts ...client.CallOption) (*EmptyResponse, error)
 	GetSystemFeesForPayment(ctx context.Context, in *billing.GetSystemFeesRequest, opts ...client.CallOption) (*billing.FeeSet, error)
 	GetActualSystemFeesList(ctx context.Context, in *EmptyRequest, opts ...client.CallOption) (*billing.SystemFeesList, error)
+	ListSystemFeesHistory(ctx context.Context, in *ListSystemFeesHistoryRequest, opts ...client.CallOption) (*ListSystemFeesHistoryResponse, error)
+	ReactivateSystemFees(ctx context.Context, in *ReactivateSystemFeesRequest, opts ...client.CallOption) (*SystemFeesResponse, error)
 	ChangeProject(ctx context.Context, in *billing.Project, opts ...client.CallOption) (*ChangeProjectResponse, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...client.CallOption) (*ChangeProjectResponse, error)
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...client.CallOption) (*ListProjectsResponse, error)
 	DeleteProject(ctx context.Context, in *GetProjectRequest, opts ...client.CallOption) (*ChangeProjectResponse, error)
 	CreateToken(ctx context.Context, in *TokenRequest, opts ...client.CallOption) (*TokenResponse, error)
 	CheckProjectRequestSignature(ctx context.Context, in *CheckProjectRequestSignatureRequest, opts ...client.CallOption) (*CheckProjectRequestSignatureResponse, error)
+	CaptureOrder(ctx context.Context, in *CaptureOrderRequest, opts ...client.CallOption) (*OrderOperationResponse, error)
+	VoidOrder(ctx context.Context, in *VoidOrderRequest, opts ...client.CallOption) (*OrderOperationResponse, error)
+	GetOrderStatusHistory(ctx context.Context, in *GetOrderStatusHistoryRequest, opts ...client.CallOption) (*GetOrderStatusHistoryResponse, error)
+	ListOutboxMessages(ctx context.Context, in *ListOutboxMessagesRequest, opts ...client.CallOption) (*ListOutboxMessagesResponse, error)
+	ReplayOutboxMessages(ctx context.Context, in *ReplayOutboxMessagesRequest, opts ...client.CallOption) (*ReplayOutboxMessagesResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...client.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ResendWebhookDelivery(ctx context.Context, in *ResendWebhookDeliveryRequest, opts ...client.CallOption) (*ResendWebhookDeliveryResponse, error)
+	GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, opts ...client.CallOption) (*GetMerchantBalanceResponse, error)
+	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...client.CallOption) (*ListLedgerEntriesResponse, error)
+	ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...client.CallOption) (*ListPayoutsResponse, error)
+	ApprovePayout(ctx context.Context, in *PayoutRequest, opts ...client.CallOption) (*PayoutResponse, error)
+	MarkPayoutPaid(ctx context.Context, in *MarkPayoutPaidRequest, opts ...client.CallOption) (*PayoutResponse, error)
+	MarkPayoutFailed(ctx context.Context, in *MarkPayoutFailedRequest, opts ...client.CallOption) (*PayoutResponse, error)
+	ProcessChargebackCallback(ctx context.Context, in *CallbackRequest, opts ...client.CallOption) (*PaymentNotifyResponse, error)
+	ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...client.CallOption) (*ListDisputesResponse, error)
+	GetDispute(ctx context.Context, in *DisputeRequest, opts ...client.CallOption) (*DisputeResponse, error)
+	AddDisputeEvidence(ctx context.Context, in *AddDisputeEvidenceRequest, opts ...client.CallOption) (*DisputeResponse, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	GetSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...client.CallOption) (*ListSubscriptionsResponse, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	PauseSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	ResumeSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error)
+	AddCommissionPlan(ctx context.Context, in *billing.CommissionPlan, opts ...client.CallOption) (*CommissionPlanResponse, error)
+	ListCommissionPlans(ctx context.Context, in *ListCommissionPlansRequest, opts ...client.CallOption) (*ListCommissionPlansResponse, error)
 }
 
 type billingService struct {
@@ -247,6 +321,16 @@ func (c *billingService) GetConvertRate(ctx context.Context, in *ConvertRateRequ
 	return out, nil
 }
 
+func (c *billingService) ImportCurrencyRates(ctx context.Context, in *ImportCurrencyRatesRequest, opts ...client.CallOption) (*ImportCurrencyRatesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ImportCurrencyRates", in)
+	out := new(ImportCurrencyRatesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) GetMerchantBy(ctx context.Context, in *GetMerchantByRequest, opts ...client.CallOption) (*MerchantGetMerchantResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.GetMerchantBy", in)
 	out := new(MerchantGetMerchantResponse)
@@ -527,6 +611,26 @@ func (c *billingService) GetActualSystemFeesList(ctx context.Context, in *EmptyR
 	return out, nil
 }
 
+func (c *billingService) ListSystemFeesHistory(ctx context.Context, in *ListSystemFeesHistoryRequest, opts ...client.CallOption) (*ListSystemFeesHistoryResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListSystemFeesHistory", in)
+	out := new(ListSystemFeesHistoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ReactivateSystemFees(ctx context.Context, in *ReactivateSystemFeesRequest, opts ...client.CallOption) (*SystemFeesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ReactivateSystemFees", in)
+	out := new(SystemFeesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *billingService) ChangeProject(ctx context.Context, in *billing.Project, opts ...client.CallOption) (*ChangeProjectResponse, error) {
 	req := c.c.NewRequest(c.name, "BillingService.ChangeProject", in)
 	out := new(ChangeProjectResponse)
@@ -587,6 +691,256 @@ func (c *billingService) CheckProjectRequestSignature(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *billingService) CaptureOrder(ctx context.Context, in *CaptureOrderRequest, opts ...client.CallOption) (*OrderOperationResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CaptureOrder", in)
+	out := new(OrderOperationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) VoidOrder(ctx context.Context, in *VoidOrderRequest, opts ...client.CallOption) (*OrderOperationResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.VoidOrder", in)
+	out := new(OrderOperationResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetOrderStatusHistory(ctx context.Context, in *GetOrderStatusHistoryRequest, opts ...client.CallOption) (*GetOrderStatusHistoryResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetOrderStatusHistory", in)
+	out := new(GetOrderStatusHistoryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListOutboxMessages(ctx context.Context, in *ListOutboxMessagesRequest, opts ...client.CallOption) (*ListOutboxMessagesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListOutboxMessages", in)
+	out := new(ListOutboxMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ReplayOutboxMessages(ctx context.Context, in *ReplayOutboxMessagesRequest, opts ...client.CallOption) (*ReplayOutboxMessagesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ReplayOutboxMessages", in)
+	out := new(ReplayOutboxMessagesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...client.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListWebhookDeliveries", in)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ResendWebhookDelivery(ctx context.Context, in *ResendWebhookDeliveryRequest, opts ...client.CallOption) (*ResendWebhookDeliveryResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ResendWebhookDelivery", in)
+	out := new(ResendWebhookDeliveryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, opts ...client.CallOption) (*GetMerchantBalanceResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetMerchantBalance", in)
+	out := new(GetMerchantBalanceResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...client.CallOption) (*ListLedgerEntriesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListLedgerEntries", in)
+	out := new(ListLedgerEntriesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...client.CallOption) (*ListPayoutsResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListPayouts", in)
+	out := new(ListPayoutsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ApprovePayout(ctx context.Context, in *PayoutRequest, opts ...client.CallOption) (*PayoutResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ApprovePayout", in)
+	out := new(PayoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) MarkPayoutPaid(ctx context.Context, in *MarkPayoutPaidRequest, opts ...client.CallOption) (*PayoutResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.MarkPayoutPaid", in)
+	out := new(PayoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) MarkPayoutFailed(ctx context.Context, in *MarkPayoutFailedRequest, opts ...client.CallOption) (*PayoutResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.MarkPayoutFailed", in)
+	out := new(PayoutResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ProcessChargebackCallback(ctx context.Context, in *CallbackRequest, opts ...client.CallOption) (*PaymentNotifyResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ProcessChargebackCallback", in)
+	out := new(PaymentNotifyResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListDisputes(ctx context.Context, in *ListDisputesRequest, opts ...client.CallOption) (*ListDisputesResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListDisputes", in)
+	out := new(ListDisputesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetDispute(ctx context.Context, in *DisputeRequest, opts ...client.CallOption) (*DisputeResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetDispute", in)
+	out := new(DisputeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) AddDisputeEvidence(ctx context.Context, in *AddDisputeEvidenceRequest, opts ...client.CallOption) (*DisputeResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.AddDisputeEvidence", in)
+	out := new(DisputeResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CreateSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) GetSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.GetSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...client.CallOption) (*ListSubscriptionsResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListSubscriptions", in)
+	out := new(ListSubscriptionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.CancelSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) PauseSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.PauseSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ResumeSubscription(ctx context.Context, in *SubscriptionRequest, opts ...client.CallOption) (*SubscriptionResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ResumeSubscription", in)
+	out := new(SubscriptionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) AddCommissionPlan(ctx context.Context, in *billing.CommissionPlan, opts ...client.CallOption) (*CommissionPlanResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.AddCommissionPlan", in)
+	out := new(CommissionPlanResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billingService) ListCommissionPlans(ctx context.Context, in *ListCommissionPlansRequest, opts ...client.CallOption) (*ListCommissionPlansResponse, error) {
+	req := c.c.NewRequest(c.name, "BillingService.ListCommissionPlans", in)
+	out := new(ListCommissionPlansResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for BillingService service
 
 type BillingServiceHandler interface {
@@ -598,6 +952,7 @@ type BillingServiceHandler interface {
 	UpdateOrder(context.Context, *billing.Order, *EmptyResponse) error
 	UpdateMerchant(context.Context, *billing.Merchant, *EmptyResponse) error
 	GetConvertRate(context.Context, *ConvertRateRequest, *ConvertRateResponse) error
+	ImportCurrencyRates(context.Context, *ImportCurrencyRatesRequest, *ImportCurrencyRatesResponse) error
 	GetMerchantBy(context.Context, *GetMerchantByRequest, *MerchantGetMerchantResponse) error
 	ListMerchants(context.Context, *MerchantListingRequest, *MerchantListingResponse) error
 	ChangeMerchant(context.Context, *OnboardingRequest, *billing.Merchant) error
@@ -626,12 +981,39 @@ type BillingServiceHandler interface {
 	AddSystemFees(context.Context, *billing.AddSystemFeesRequest, *EmptyResponse) error
 	GetSystemFeesForPayment(context.Context, *billing.GetSystemFeesRequest, *billing.FeeSet) error
 	GetActualSystemFeesList(context.Context, *EmptyRequest, *billing.SystemFeesList) error
+	ListSystemFeesHistory(context.Context, *ListSystemFeesHistoryRequest, *ListSystemFeesHistoryResponse) error
+	ReactivateSystemFees(context.Context, *ReactivateSystemFeesRequest, *SystemFeesResponse) error
 	ChangeProject(context.Context, *billing.Project, *ChangeProjectResponse) error
 	GetProject(context.Context, *GetProjectRequest, *ChangeProjectResponse) error
 	ListProjects(context.Context, *ListProjectsRequest, *ListProjectsResponse) error
 	DeleteProject(context.Context, *GetProjectRequest, *ChangeProjectResponse) error
 	CreateToken(context.Context, *TokenRequest, *TokenResponse) error
 	CheckProjectRequestSignature(context.Context, *CheckProjectRequestSignatureRequest, *CheckProjectRequestSignatureResponse) error
+	CaptureOrder(context.Context, *CaptureOrderRequest, *OrderOperationResponse) error
+	VoidOrder(context.Context, *VoidOrderRequest, *OrderOperationResponse) error
+	GetOrderStatusHistory(context.Context, *GetOrderStatusHistoryRequest, *GetOrderStatusHistoryResponse) error
+	ListOutboxMessages(context.Context, *ListOutboxMessagesRequest, *ListOutboxMessagesResponse) error
+	ReplayOutboxMessages(context.Context, *ReplayOutboxMessagesRequest, *ReplayOutboxMessagesResponse) error
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest, *ListWebhookDeliveriesResponse) error
+	ResendWebhookDelivery(context.Context, *ResendWebhookDeliveryRequest, *ResendWebhookDeliveryResponse) error
+	GetMerchantBalance(context.Context, *GetMerchantBalanceRequest, *GetMerchantBalanceResponse) error
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest, *ListLedgerEntriesResponse) error
+	ListPayouts(context.Context, *ListPayoutsRequest, *ListPayoutsResponse) error
+	ApprovePayout(context.Context, *PayoutRequest, *PayoutResponse) error
+	MarkPayoutPaid(context.Context, *MarkPayoutPaidRequest, *PayoutResponse) error
+	MarkPayoutFailed(context.Context, *MarkPayoutFailedRequest, *PayoutResponse) error
+	ProcessChargebackCallback(context.Context, *CallbackRequest, *PaymentNotifyResponse) error
+	ListDisputes(context.Context, *ListDisputesRequest, *ListDisputesResponse) error
+	GetDispute(context.Context, *DisputeRequest, *DisputeResponse) error
+	AddDisputeEvidence(context.Context, *AddDisputeEvidenceRequest, *DisputeResponse) error
+	CreateSubscription(context.Context, *CreateSubscriptionRequest, *SubscriptionResponse) error
+	GetSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest, *ListSubscriptionsResponse) error
+	CancelSubscription(context.Context, *CancelSubscriptionRequest, *SubscriptionResponse) error
+	PauseSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	ResumeSubscription(context.Context, *SubscriptionRequest, *SubscriptionResponse) error
+	AddCommissionPlan(context.Context, *billing.CommissionPlan, *CommissionPlanResponse) error
+	ListCommissionPlans(context.Context, *ListCommissionPlansRequest, *ListCommissionPlansResponse) error
 }
 
 func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler, opts ...server.HandlerOption) error {
@@ -644,6 +1026,7 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		UpdateOrder(ctx context.Context, in *billing.Order, out *EmptyResponse) error
 		UpdateMerchant(ctx context.Context, in *billing.Merchant, out *EmptyResponse) error
 		GetConvertRate(ctx context.Context, in *ConvertRateRequest, out *ConvertRateResponse) error
+		ImportCurrencyRates(ctx context.Context, in *ImportCurrencyRatesRequest, out *ImportCurrencyRatesResponse) error
 		GetMerchantBy(ctx context.Context, in *GetMerchantByRequest, out *MerchantGetMerchantResponse) error
 		ListMerchants(ctx context.Context, in *MerchantListingRequest, out *MerchantListingResponse) error
 		ChangeMerchant(ctx context.Context, in *OnboardingRequest, out *billing.Merchant) error
@@ -672,12 +1055,39 @@ func RegisterBillingServiceHandler(s server.Server, hdlr BillingServiceHandler,
 		AddSystemFees(ctx context.Context, in *billing.AddSystemFeesRequest, out *EmptyResponse) error
 		GetSystemFeesForPayment(ctx context.Context, in *billing.GetSystemFeesRequest, out *billing.FeeSet) error
 		GetActualSystemFeesList(ctx context.Context, in *EmptyRequest, out *billing.SystemFeesList) error
+		ListSystemFeesHistory(ctx context.Context, in *ListSystemFeesHistoryRequest, out *ListSystemFeesHistoryResponse) error
+		ReactivateSystemFees(ctx context.Context, in *ReactivateSystemFeesRequest, out *SystemFeesResponse) error
 		ChangeProject(ctx context.Context, in *billing.Project, out *ChangeProjectResponse) error
 		GetProject(ctx context.Context, in *GetProjectRequest, out *ChangeProjectResponse) error
 		ListProjects(ctx context.Context, in *ListProjectsRequest, out *ListProjectsResponse) error
 		DeleteProject(ctx context.Context, in *GetProjectRequest, out *ChangeProjectResponse) error
 		CreateToken(ctx context.Context, in *TokenRequest, out *TokenResponse) error
 		CheckProjectRequestSignature(ctx context.Context, in *CheckProjectRequestSignatureRequest, out *CheckProjectRequestSignatureResponse) error
+		CaptureOrder(ctx context.Context, in *CaptureOrderRequest, out *OrderOperationResponse) error
+		VoidOrder(ctx context.Context, in *VoidOrderRequest, out *OrderOperationResponse) error
+		GetOrderStatusHistory(ctx context.Context, in *GetOrderStatusHistoryRequest, out *GetOrderStatusHistoryResponse) error
+		ListOutboxMessages(ctx context.Context, in *ListOutboxMessagesRequest, out *ListOutboxMessagesResponse) error
+		ReplayOutboxMessages(ctx context.Context, in *ReplayOutboxMessagesRequest, out *ReplayOutboxMessagesResponse) error
+		ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, out *ListWebhookDeliveriesResponse) error
+		ResendWebhookDelivery(ctx context.Context, in *ResendWebhookDeliveryRequest, out *ResendWebhookDeliveryResponse) error
+		GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, out *GetMerchantBalanceResponse) error
+		ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, out *ListLedgerEntriesResponse) error
+		ListPayouts(ctx context.Context, in *ListPayoutsRequest, out *ListPayoutsResponse) error
+		ApprovePayout(ctx context.Context, in *PayoutRequest, out *PayoutResponse) error
+		MarkPayoutPaid(ctx context.Context, in *MarkPayoutPaidRequest, out *PayoutResponse) error
+		MarkPayoutFailed(ctx context.Context, in *MarkPayoutFailedRequest, out *PayoutResponse) error
+		ProcessChargebackCallback(ctx context.Context, in *CallbackRequest, out *PaymentNotifyResponse) error
+		ListDisputes(ctx context.Context, in *ListDisputesRequest, out *ListDisputesResponse) error
+		GetDispute(ctx context.Context, in *DisputeRequest, out *DisputeResponse) error
+		AddDisputeEvidence(ctx context.Context, in *AddDisputeEvidenceRequest, out *DisputeResponse) error
+		CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, out *SubscriptionResponse) error
+		GetSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, out *ListSubscriptionsResponse) error
+		CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, out *SubscriptionResponse) error
+		PauseSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		ResumeSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error
+		AddCommissionPlan(ctx context.Context, in *billing.CommissionPlan, out *CommissionPlanResponse) error
+		ListCommissionPlans(ctx context.Context, in *ListCommissionPlansRequest, out *ListCommissionPlansResponse) error
 	}
 	type BillingService struct {
 		billingService
@@ -722,6 +1132,10 @@ func (h *billingServiceHandler) GetConvertRate(ctx context.Context, in *ConvertR
 	return h.BillingServiceHandler.GetConvertRate(ctx, in, out)
 }
 
+func (h *billingServiceHandler) ImportCurrencyRates(ctx context.Context, in *ImportCurrencyRatesRequest, out *ImportCurrencyRatesResponse) error {
+	return h.BillingServiceHandler.ImportCurrencyRates(ctx, in, out)
+}
+
 func (h *billingServiceHandler) GetMerchantBy(ctx context.Context, in *GetMerchantByRequest, out *MerchantGetMerchantResponse) error {
 	return h.BillingServiceHandler.GetMerchantBy(ctx, in, out)
 }
@@ -834,6 +1248,14 @@ func (h *billingServiceHandler) GetActualSystemFeesList(ctx context.Context, in
 	return h.BillingServiceHandler.GetActualSystemFeesList(ctx, in, out)
 }
 
+func (h *billingServiceHandler) ListSystemFeesHistory(ctx context.Context, in *ListSystemFeesHistoryRequest, out *ListSystemFeesHistoryResponse) error {
+	return h.BillingServiceHandler.ListSystemFeesHistory(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ReactivateSystemFees(ctx context.Context, in *ReactivateSystemFeesRequest, out *SystemFeesResponse) error {
+	return h.BillingServiceHandler.ReactivateSystemFees(ctx, in, out)
+}
+
 func (h *billingServiceHandler) ChangeProject(ctx context.Context, in *billing.Project, out *ChangeProjectResponse) error {
 	return h.BillingServiceHandler.ChangeProject(ctx, in, out)
 }
@@ -857,3 +1279,103 @@ func (h *billingServiceHandler) CreateToken(ctx context.Context, in *TokenReques
 func (h *billingServiceHandler) CheckProjectRequestSignature(ctx context.Context, in *CheckProjectRequestSignatureRequest, out *CheckProjectRequestSignatureResponse) error {
 	return h.BillingServiceHandler.CheckProjectRequestSignature(ctx, in, out)
 }
+
+func (h *billingServiceHandler) CaptureOrder(ctx context.Context, in *CaptureOrderRequest, out *OrderOperationResponse) error {
+	return h.BillingServiceHandler.CaptureOrder(ctx, in, out)
+}
+
+func (h *billingServiceHandler) VoidOrder(ctx context.Context, in *VoidOrderRequest, out *OrderOperationResponse) error {
+	return h.BillingServiceHandler.VoidOrder(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetOrderStatusHistory(ctx context.Context, in *GetOrderStatusHistoryRequest, out *GetOrderStatusHistoryResponse) error {
+	return h.BillingServiceHandler.GetOrderStatusHistory(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListOutboxMessages(ctx context.Context, in *ListOutboxMessagesRequest, out *ListOutboxMessagesResponse) error {
+	return h.BillingServiceHandler.ListOutboxMessages(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ReplayOutboxMessages(ctx context.Context, in *ReplayOutboxMessagesRequest, out *ReplayOutboxMessagesResponse) error {
+	return h.BillingServiceHandler.ReplayOutboxMessages(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, out *ListWebhookDeliveriesResponse) error {
+	return h.BillingServiceHandler.ListWebhookDeliveries(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ResendWebhookDelivery(ctx context.Context, in *ResendWebhookDeliveryRequest, out *ResendWebhookDeliveryResponse) error {
+	return h.BillingServiceHandler.ResendWebhookDelivery(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetMerchantBalance(ctx context.Context, in *GetMerchantBalanceRequest, out *GetMerchantBalanceResponse) error {
+	return h.BillingServiceHandler.GetMerchantBalance(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, out *ListLedgerEntriesResponse) error {
+	return h.BillingServiceHandler.ListLedgerEntries(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListPayouts(ctx context.Context, in *ListPayoutsRequest, out *ListPayoutsResponse) error {
+	return h.BillingServiceHandler.ListPayouts(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ApprovePayout(ctx context.Context, in *PayoutRequest, out *PayoutResponse) error {
+	return h.BillingServiceHandler.ApprovePayout(ctx, in, out)
+}
+
+func (h *billingServiceHandler) MarkPayoutPaid(ctx context.Context, in *MarkPayoutPaidRequest, out *PayoutResponse) error {
+	return h.BillingServiceHandler.MarkPayoutPaid(ctx, in, out)
+}
+
+func (h *billingServiceHandler) MarkPayoutFailed(ctx context.Context, in *MarkPayoutFailedRequest, out *PayoutResponse) error {
+	return h.BillingServiceHandler.MarkPayoutFailed(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ProcessChargebackCallback(ctx context.Context, in *CallbackRequest, out *PaymentNotifyResponse) error {
+	return h.BillingServiceHandler.ProcessChargebackCallback(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListDisputes(ctx context.Context, in *ListDisputesRequest, out *ListDisputesResponse) error {
+	return h.BillingServiceHandler.ListDisputes(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetDispute(ctx context.Context, in *DisputeRequest, out *DisputeResponse) error {
+	return h.BillingServiceHandler.GetDispute(ctx, in, out)
+}
+
+func (h *billingServiceHandler) AddDisputeEvidence(ctx context.Context, in *AddDisputeEvidenceRequest, out *DisputeResponse) error {
+	return h.BillingServiceHandler.AddDisputeEvidence(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.CreateSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) GetSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.GetSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, out *ListSubscriptionsResponse) error {
+	return h.BillingServiceHandler.ListSubscriptions(ctx, in, out)
+}
+
+func (h *billingServiceHandler) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.CancelSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) PauseSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.PauseSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ResumeSubscription(ctx context.Context, in *SubscriptionRequest, out *SubscriptionResponse) error {
+	return h.BillingServiceHandler.ResumeSubscription(ctx, in, out)
+}
+
+func (h *billingServiceHandler) AddCommissionPlan(ctx context.Context, in *billing.CommissionPlan, out *CommissionPlanResponse) error {
+	return h.BillingServiceHandler.AddCommissionPlan(ctx, in, out)
+}
+
+func (h *billingServiceHandler) ListCommissionPlans(ctx context.Context, in *ListCommissionPlansRequest, out *ListCommissionPlansResponse) error {
+	return h.BillingServiceHandler.ListCommissionPlans(ctx, in, out)
+}
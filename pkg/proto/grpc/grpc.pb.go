@@ -1,13 +1,15 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // source: grpc/grpc.proto
 
-package grpc // import "github.com/paysuper/paysuper-billing-server/pkg/proto/grpc"
+package grpc
 
-import proto "github.com/golang/protobuf/proto"
-import fmt "fmt"
-import math "math"
-import timestamp "github.com/golang/protobuf/ptypes/timestamp"
-import billing "github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	billing "github.com/paysuper/paysuper-billing-server/pkg/proto/billing"
+	math "math"
+)
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
@@ -18,7 +20,7 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type EmptyRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -30,16 +32,17 @@ func (m *EmptyRequest) Reset()         { *m = EmptyRequest{} }
 func (m *EmptyRequest) String() string { return proto.CompactTextString(m) }
 func (*EmptyRequest) ProtoMessage()    {}
 func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{0}
+	return fileDescriptor_81ea47a3f88c2082, []int{0}
 }
+
 func (m *EmptyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmptyRequest.Unmarshal(m, b)
 }
 func (m *EmptyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EmptyRequest.Marshal(b, m, deterministic)
 }
-func (dst *EmptyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EmptyRequest.Merge(dst, src)
+func (m *EmptyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyRequest.Merge(m, src)
 }
 func (m *EmptyRequest) XXX_Size() int {
 	return xxx_messageInfo_EmptyRequest.Size(m)
@@ -60,16 +63,17 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{1}
+	return fileDescriptor_81ea47a3f88c2082, []int{1}
 }
+
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EmptyResponse.Unmarshal(m, b)
 }
 func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
 }
-func (dst *EmptyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EmptyResponse.Merge(dst, src)
+func (m *EmptyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyResponse.Merge(m, src)
 }
 func (m *EmptyResponse) XXX_Size() int {
 	return xxx_messageInfo_EmptyResponse.Size(m)
@@ -81,29 +85,32 @@ func (m *EmptyResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
 
 type PaymentCreateRequest struct {
-	Data                 map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Ip                   string            `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
-	AcceptLanguage       string            `protobuf:"bytes,4,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"`
-	UserAgent            string            `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+	Data           map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ip             string            `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	AcceptLanguage string            `protobuf:"bytes,4,opt,name=accept_language,json=acceptLanguage,proto3" json:"accept_language,omitempty"`
+	UserAgent      string            `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// @inject_tag: validate:"omitempty,max=255"
+	IdempotencyKey       string   `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty" validate:"omitempty,max=255"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *PaymentCreateRequest) Reset()         { *m = PaymentCreateRequest{} }
 func (m *PaymentCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentCreateRequest) ProtoMessage()    {}
 func (*PaymentCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{2}
+	return fileDescriptor_81ea47a3f88c2082, []int{2}
 }
+
 func (m *PaymentCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentCreateRequest.Unmarshal(m, b)
 }
 func (m *PaymentCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentCreateRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentCreateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentCreateRequest.Merge(dst, src)
+func (m *PaymentCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentCreateRequest.Merge(m, src)
 }
 func (m *PaymentCreateRequest) XXX_Size() int {
 	return xxx_messageInfo_PaymentCreateRequest.Size(m)
@@ -142,6 +149,13 @@ func (m *PaymentCreateRequest) GetUserAgent() string {
 	return ""
 }
 
+func (m *PaymentCreateRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
 type PaymentCreateResponse struct {
 	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -156,16 +170,17 @@ func (m *PaymentCreateResponse) Reset()         { *m = PaymentCreateResponse{} }
 func (m *PaymentCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentCreateResponse) ProtoMessage()    {}
 func (*PaymentCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{3}
+	return fileDescriptor_81ea47a3f88c2082, []int{3}
 }
+
 func (m *PaymentCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentCreateResponse.Unmarshal(m, b)
 }
 func (m *PaymentCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentCreateResponse.Marshal(b, m, deterministic)
 }
-func (dst *PaymentCreateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentCreateResponse.Merge(dst, src)
+func (m *PaymentCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentCreateResponse.Merge(m, src)
 }
 func (m *PaymentCreateResponse) XXX_Size() int {
 	return xxx_messageInfo_PaymentCreateResponse.Size(m)
@@ -221,16 +236,17 @@ func (m *PaymentFormJsonDataRequest) Reset()         { *m = PaymentFormJsonDataR
 func (m *PaymentFormJsonDataRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonDataRequest) ProtoMessage()    {}
 func (*PaymentFormJsonDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{4}
+	return fileDescriptor_81ea47a3f88c2082, []int{4}
 }
+
 func (m *PaymentFormJsonDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonDataRequest.Unmarshal(m, b)
 }
 func (m *PaymentFormJsonDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormJsonDataRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormJsonDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormJsonDataRequest.Merge(dst, src)
+func (m *PaymentFormJsonDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormJsonDataRequest.Merge(m, src)
 }
 func (m *PaymentFormJsonDataRequest) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormJsonDataRequest.Size(m)
@@ -305,16 +321,17 @@ func (m *PaymentFormJsonDataProject) Reset()         { *m = PaymentFormJsonDataP
 func (m *PaymentFormJsonDataProject) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonDataProject) ProtoMessage()    {}
 func (*PaymentFormJsonDataProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{5}
+	return fileDescriptor_81ea47a3f88c2082, []int{5}
 }
+
 func (m *PaymentFormJsonDataProject) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonDataProject.Unmarshal(m, b)
 }
 func (m *PaymentFormJsonDataProject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormJsonDataProject.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormJsonDataProject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormJsonDataProject.Merge(dst, src)
+func (m *PaymentFormJsonDataProject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormJsonDataProject.Merge(m, src)
 }
 func (m *PaymentFormJsonDataProject) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormJsonDataProject.Size(m)
@@ -377,16 +394,17 @@ func (m *PaymentFormJsonDataResponse) Reset()         { *m = PaymentFormJsonData
 func (m *PaymentFormJsonDataResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormJsonDataResponse) ProtoMessage()    {}
 func (*PaymentFormJsonDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{6}
+	return fileDescriptor_81ea47a3f88c2082, []int{6}
 }
+
 func (m *PaymentFormJsonDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormJsonDataResponse.Unmarshal(m, b)
 }
 func (m *PaymentFormJsonDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormJsonDataResponse.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormJsonDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormJsonDataResponse.Merge(dst, src)
+func (m *PaymentFormJsonDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormJsonDataResponse.Merge(m, src)
 }
 func (m *PaymentFormJsonDataResponse) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormJsonDataResponse.Size(m)
@@ -515,16 +533,17 @@ func (m *PaymentNotifyRequest) Reset()         { *m = PaymentNotifyRequest{} }
 func (m *PaymentNotifyRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentNotifyRequest) ProtoMessage()    {}
 func (*PaymentNotifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{7}
+	return fileDescriptor_81ea47a3f88c2082, []int{7}
 }
+
 func (m *PaymentNotifyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentNotifyRequest.Unmarshal(m, b)
 }
 func (m *PaymentNotifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentNotifyRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentNotifyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentNotifyRequest.Merge(dst, src)
+func (m *PaymentNotifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentNotifyRequest.Merge(m, src)
 }
 func (m *PaymentNotifyRequest) XXX_Size() int {
 	return xxx_messageInfo_PaymentNotifyRequest.Size(m)
@@ -568,16 +587,17 @@ func (m *PaymentNotifyResponse) Reset()         { *m = PaymentNotifyResponse{} }
 func (m *PaymentNotifyResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentNotifyResponse) ProtoMessage()    {}
 func (*PaymentNotifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{8}
+	return fileDescriptor_81ea47a3f88c2082, []int{8}
 }
+
 func (m *PaymentNotifyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentNotifyResponse.Unmarshal(m, b)
 }
 func (m *PaymentNotifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentNotifyResponse.Marshal(b, m, deterministic)
 }
-func (dst *PaymentNotifyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentNotifyResponse.Merge(dst, src)
+func (m *PaymentNotifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentNotifyResponse.Merge(m, src)
 }
 func (m *PaymentNotifyResponse) XXX_Size() int {
 	return xxx_messageInfo_PaymentNotifyResponse.Size(m)
@@ -603,27 +623,29 @@ func (m *PaymentNotifyResponse) GetError() string {
 }
 
 type ConvertRateRequest struct {
-	From                 int32    `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
-	To                   int32    `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	From                 int32                `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int32                `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *ConvertRateRequest) Reset()         { *m = ConvertRateRequest{} }
 func (m *ConvertRateRequest) String() string { return proto.CompactTextString(m) }
 func (*ConvertRateRequest) ProtoMessage()    {}
 func (*ConvertRateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{9}
+	return fileDescriptor_81ea47a3f88c2082, []int{9}
 }
+
 func (m *ConvertRateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConvertRateRequest.Unmarshal(m, b)
 }
 func (m *ConvertRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ConvertRateRequest.Marshal(b, m, deterministic)
 }
-func (dst *ConvertRateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConvertRateRequest.Merge(dst, src)
+func (m *ConvertRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertRateRequest.Merge(m, src)
 }
 func (m *ConvertRateRequest) XXX_Size() int {
 	return xxx_messageInfo_ConvertRateRequest.Size(m)
@@ -648,6 +670,183 @@ func (m *ConvertRateRequest) GetTo() int32 {
 	return 0
 }
 
+func (m *ConvertRateRequest) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type ImportCurrencyRatesRequest struct {
+	// @inject_tag: validate:"required"
+	Date *timestamp.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,min=1,dive"
+	Rates                []*ImportCurrencyRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty" validate:"required,min=1,dive"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ImportCurrencyRatesRequest) Reset()         { *m = ImportCurrencyRatesRequest{} }
+func (m *ImportCurrencyRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportCurrencyRatesRequest) ProtoMessage()    {}
+func (*ImportCurrencyRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{10}
+}
+
+func (m *ImportCurrencyRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCurrencyRatesRequest.Unmarshal(m, b)
+}
+func (m *ImportCurrencyRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCurrencyRatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportCurrencyRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCurrencyRatesRequest.Merge(m, src)
+}
+func (m *ImportCurrencyRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportCurrencyRatesRequest.Size(m)
+}
+func (m *ImportCurrencyRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCurrencyRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCurrencyRatesRequest proto.InternalMessageInfo
+
+func (m *ImportCurrencyRatesRequest) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ImportCurrencyRatesRequest) GetRates() []*ImportCurrencyRate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+type ImportCurrencyRate struct {
+	// @inject_tag: validate:"required,numeric,gt=0"
+	CurrencyFrom int32 `protobuf:"varint,1,opt,name=currency_from,json=currencyFrom,proto3" json:"currency_from,omitempty" validate:"required,numeric,gt=0"`
+	// @inject_tag: validate:"required,numeric,gt=0"
+	CurrencyTo int32 `protobuf:"varint,2,opt,name=currency_to,json=currencyTo,proto3" json:"currency_to,omitempty" validate:"required,numeric,gt=0"`
+	// @inject_tag: validate:"required,numeric,gt=0"
+	Rate                 float64  `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty" validate:"required,numeric,gt=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ImportCurrencyRate) Reset()         { *m = ImportCurrencyRate{} }
+func (m *ImportCurrencyRate) String() string { return proto.CompactTextString(m) }
+func (*ImportCurrencyRate) ProtoMessage()    {}
+func (*ImportCurrencyRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{11}
+}
+
+func (m *ImportCurrencyRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCurrencyRate.Unmarshal(m, b)
+}
+func (m *ImportCurrencyRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCurrencyRate.Marshal(b, m, deterministic)
+}
+func (m *ImportCurrencyRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCurrencyRate.Merge(m, src)
+}
+func (m *ImportCurrencyRate) XXX_Size() int {
+	return xxx_messageInfo_ImportCurrencyRate.Size(m)
+}
+func (m *ImportCurrencyRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCurrencyRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCurrencyRate proto.InternalMessageInfo
+
+func (m *ImportCurrencyRate) GetCurrencyFrom() int32 {
+	if m != nil {
+		return m.CurrencyFrom
+	}
+	return 0
+}
+
+func (m *ImportCurrencyRate) GetCurrencyTo() int32 {
+	if m != nil {
+		return m.CurrencyTo
+	}
+	return 0
+}
+
+func (m *ImportCurrencyRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type ImportCurrencyRatesResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	MissingPairs         []string `protobuf:"bytes,4,rep,name=missing_pairs,json=missingPairs,proto3" json:"missing_pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ImportCurrencyRatesResponse) Reset()         { *m = ImportCurrencyRatesResponse{} }
+func (m *ImportCurrencyRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportCurrencyRatesResponse) ProtoMessage()    {}
+func (*ImportCurrencyRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{12}
+}
+
+func (m *ImportCurrencyRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportCurrencyRatesResponse.Unmarshal(m, b)
+}
+func (m *ImportCurrencyRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportCurrencyRatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportCurrencyRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportCurrencyRatesResponse.Merge(m, src)
+}
+func (m *ImportCurrencyRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportCurrencyRatesResponse.Size(m)
+}
+func (m *ImportCurrencyRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportCurrencyRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportCurrencyRatesResponse proto.InternalMessageInfo
+
+func (m *ImportCurrencyRatesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ImportCurrencyRatesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ImportCurrencyRatesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ImportCurrencyRatesResponse) GetMissingPairs() []string {
+	if m != nil {
+		return m.MissingPairs
+	}
+	return nil
+}
+
 type ConvertRateResponse struct {
 	Rate                 float64  `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -659,16 +858,17 @@ func (m *ConvertRateResponse) Reset()         { *m = ConvertRateResponse{} }
 func (m *ConvertRateResponse) String() string { return proto.CompactTextString(m) }
 func (*ConvertRateResponse) ProtoMessage()    {}
 func (*ConvertRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{10}
+	return fileDescriptor_81ea47a3f88c2082, []int{13}
 }
+
 func (m *ConvertRateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConvertRateResponse.Unmarshal(m, b)
 }
 func (m *ConvertRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ConvertRateResponse.Marshal(b, m, deterministic)
 }
-func (dst *ConvertRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ConvertRateResponse.Merge(dst, src)
+func (m *ConvertRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertRateResponse.Merge(m, src)
 }
 func (m *ConvertRateResponse) XXX_Size() int {
 	return xxx_messageInfo_ConvertRateResponse.Size(m)
@@ -687,7 +887,7 @@ func (m *ConvertRateResponse) GetRate() float64 {
 }
 
 type OnboardingBanking struct {
-	// @inject_tag: validate:"omitempty,len=3"
+	//@inject_tag: validate:"omitempty,len=3"
 	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty" validate:"omitempty,len=3"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
@@ -703,16 +903,17 @@ func (m *OnboardingBanking) Reset()         { *m = OnboardingBanking{} }
 func (m *OnboardingBanking) String() string { return proto.CompactTextString(m) }
 func (*OnboardingBanking) ProtoMessage()    {}
 func (*OnboardingBanking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{11}
+	return fileDescriptor_81ea47a3f88c2082, []int{14}
 }
+
 func (m *OnboardingBanking) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OnboardingBanking.Unmarshal(m, b)
 }
 func (m *OnboardingBanking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_OnboardingBanking.Marshal(b, m, deterministic)
 }
-func (dst *OnboardingBanking) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OnboardingBanking.Merge(dst, src)
+func (m *OnboardingBanking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnboardingBanking.Merge(m, src)
 }
 func (m *OnboardingBanking) XXX_Size() int {
 	return xxx_messageInfo_OnboardingBanking.Size(m)
@@ -768,12 +969,12 @@ func (m *OnboardingBanking) GetDetails() string {
 type OnboardingRequest struct {
 	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"omitempty,hexadecimal,len=24"`
-	// @inject_tag: validate:"required"
+	//@inject_tag: validate:"required"
 	User            *billing.MerchantUser `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty" validate:"required"`
 	Name            string                `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	AlternativeName string                `protobuf:"bytes,4,opt,name=alternative_name,json=alternativeName,proto3" json:"alternative_name,omitempty"`
 	Website         string                `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
-	// @inject_tag: validate:"omitempty,len=2"
+	//@inject_tag: validate:"omitempty,len=2"
 	Country            string `protobuf:"bytes,6,opt,name=country,proto3" json:"country,omitempty" validate:"omitempty,len=2"`
 	State              string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
 	Zip                string `protobuf:"bytes,8,opt,name=zip,proto3" json:"zip,omitempty"`
@@ -795,16 +996,17 @@ func (m *OnboardingRequest) Reset()         { *m = OnboardingRequest{} }
 func (m *OnboardingRequest) String() string { return proto.CompactTextString(m) }
 func (*OnboardingRequest) ProtoMessage()    {}
 func (*OnboardingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{12}
+	return fileDescriptor_81ea47a3f88c2082, []int{15}
 }
+
 func (m *OnboardingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OnboardingRequest.Unmarshal(m, b)
 }
 func (m *OnboardingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_OnboardingRequest.Marshal(b, m, deterministic)
 }
-func (dst *OnboardingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OnboardingRequest.Merge(dst, src)
+func (m *OnboardingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnboardingRequest.Merge(m, src)
 }
 func (m *OnboardingRequest) XXX_Size() int {
 	return xxx_messageInfo_OnboardingRequest.Size(m)
@@ -931,16 +1133,17 @@ func (m *FindByIdRequest) Reset()         { *m = FindByIdRequest{} }
 func (m *FindByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*FindByIdRequest) ProtoMessage()    {}
 func (*FindByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{13}
+	return fileDescriptor_81ea47a3f88c2082, []int{16}
 }
+
 func (m *FindByIdRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FindByIdRequest.Unmarshal(m, b)
 }
 func (m *FindByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_FindByIdRequest.Marshal(b, m, deterministic)
 }
-func (dst *FindByIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindByIdRequest.Merge(dst, src)
+func (m *FindByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindByIdRequest.Merge(m, src)
 }
 func (m *FindByIdRequest) XXX_Size() int {
 	return xxx_messageInfo_FindByIdRequest.Size(m)
@@ -987,16 +1190,17 @@ func (m *MerchantListingRequest) Reset()         { *m = MerchantListingRequest{}
 func (m *MerchantListingRequest) String() string { return proto.CompactTextString(m) }
 func (*MerchantListingRequest) ProtoMessage()    {}
 func (*MerchantListingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{14}
+	return fileDescriptor_81ea47a3f88c2082, []int{17}
 }
+
 func (m *MerchantListingRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantListingRequest.Unmarshal(m, b)
 }
 func (m *MerchantListingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MerchantListingRequest.Marshal(b, m, deterministic)
 }
-func (dst *MerchantListingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantListingRequest.Merge(dst, src)
+func (m *MerchantListingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantListingRequest.Merge(m, src)
 }
 func (m *MerchantListingRequest) XXX_Size() int {
 	return xxx_messageInfo_MerchantListingRequest.Size(m)
@@ -1089,16 +1293,17 @@ func (m *MerchantListingResponse) Reset()         { *m = MerchantListingResponse
 func (m *MerchantListingResponse) String() string { return proto.CompactTextString(m) }
 func (*MerchantListingResponse) ProtoMessage()    {}
 func (*MerchantListingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{15}
+	return fileDescriptor_81ea47a3f88c2082, []int{18}
 }
+
 func (m *MerchantListingResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantListingResponse.Unmarshal(m, b)
 }
 func (m *MerchantListingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MerchantListingResponse.Marshal(b, m, deterministic)
 }
-func (dst *MerchantListingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantListingResponse.Merge(dst, src)
+func (m *MerchantListingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantListingResponse.Merge(m, src)
 }
 func (m *MerchantListingResponse) XXX_Size() int {
 	return xxx_messageInfo_MerchantListingResponse.Size(m)
@@ -1139,16 +1344,17 @@ func (m *MerchantChangeStatusRequest) Reset()         { *m = MerchantChangeStatu
 func (m *MerchantChangeStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*MerchantChangeStatusRequest) ProtoMessage()    {}
 func (*MerchantChangeStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{16}
+	return fileDescriptor_81ea47a3f88c2082, []int{19}
 }
+
 func (m *MerchantChangeStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantChangeStatusRequest.Unmarshal(m, b)
 }
 func (m *MerchantChangeStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MerchantChangeStatusRequest.Marshal(b, m, deterministic)
 }
-func (dst *MerchantChangeStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantChangeStatusRequest.Merge(dst, src)
+func (m *MerchantChangeStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantChangeStatusRequest.Merge(m, src)
 }
 func (m *MerchantChangeStatusRequest) XXX_Size() int {
 	return xxx_messageInfo_MerchantChangeStatusRequest.Size(m)
@@ -1204,16 +1410,17 @@ func (m *NotificationRequest) Reset()         { *m = NotificationRequest{} }
 func (m *NotificationRequest) String() string { return proto.CompactTextString(m) }
 func (*NotificationRequest) ProtoMessage()    {}
 func (*NotificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{17}
+	return fileDescriptor_81ea47a3f88c2082, []int{20}
 }
+
 func (m *NotificationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NotificationRequest.Unmarshal(m, b)
 }
 func (m *NotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_NotificationRequest.Marshal(b, m, deterministic)
 }
-func (dst *NotificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NotificationRequest.Merge(dst, src)
+func (m *NotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationRequest.Merge(m, src)
 }
 func (m *NotificationRequest) XXX_Size() int {
 	return xxx_messageInfo_NotificationRequest.Size(m)
@@ -1264,16 +1471,17 @@ func (m *Notifications) Reset()         { *m = Notifications{} }
 func (m *Notifications) String() string { return proto.CompactTextString(m) }
 func (*Notifications) ProtoMessage()    {}
 func (*Notifications) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{18}
+	return fileDescriptor_81ea47a3f88c2082, []int{21}
 }
+
 func (m *Notifications) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Notifications.Unmarshal(m, b)
 }
 func (m *Notifications) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Notifications.Marshal(b, m, deterministic)
 }
-func (dst *Notifications) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Notifications.Merge(dst, src)
+func (m *Notifications) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notifications.Merge(m, src)
 }
 func (m *Notifications) XXX_Size() int {
 	return xxx_messageInfo_Notifications.Size(m)
@@ -1320,16 +1528,17 @@ func (m *ListingNotificationRequest) Reset()         { *m = ListingNotificationR
 func (m *ListingNotificationRequest) String() string { return proto.CompactTextString(m) }
 func (*ListingNotificationRequest) ProtoMessage()    {}
 func (*ListingNotificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{19}
+	return fileDescriptor_81ea47a3f88c2082, []int{22}
 }
+
 func (m *ListingNotificationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListingNotificationRequest.Unmarshal(m, b)
 }
 func (m *ListingNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListingNotificationRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListingNotificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListingNotificationRequest.Merge(dst, src)
+func (m *ListingNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingNotificationRequest.Merge(m, src)
 }
 func (m *ListingNotificationRequest) XXX_Size() int {
 	return xxx_messageInfo_ListingNotificationRequest.Size(m)
@@ -1393,16 +1602,17 @@ func (m *ListingMerchantPaymentMethod) Reset()         { *m = ListingMerchantPay
 func (m *ListingMerchantPaymentMethod) String() string { return proto.CompactTextString(m) }
 func (*ListingMerchantPaymentMethod) ProtoMessage()    {}
 func (*ListingMerchantPaymentMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{20}
+	return fileDescriptor_81ea47a3f88c2082, []int{23}
 }
+
 func (m *ListingMerchantPaymentMethod) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListingMerchantPaymentMethod.Unmarshal(m, b)
 }
 func (m *ListingMerchantPaymentMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListingMerchantPaymentMethod.Marshal(b, m, deterministic)
 }
-func (dst *ListingMerchantPaymentMethod) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListingMerchantPaymentMethod.Merge(dst, src)
+func (m *ListingMerchantPaymentMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingMerchantPaymentMethod.Merge(m, src)
 }
 func (m *ListingMerchantPaymentMethod) XXX_Size() int {
 	return xxx_messageInfo_ListingMerchantPaymentMethod.Size(m)
@@ -1434,16 +1644,17 @@ func (m *GetMerchantPaymentMethodRequest) Reset()         { *m = GetMerchantPaym
 func (m *GetMerchantPaymentMethodRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantPaymentMethodRequest) ProtoMessage()    {}
 func (*GetMerchantPaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{21}
+	return fileDescriptor_81ea47a3f88c2082, []int{24}
 }
+
 func (m *GetMerchantPaymentMethodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantPaymentMethodRequest.Unmarshal(m, b)
 }
 func (m *GetMerchantPaymentMethodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetMerchantPaymentMethodRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantPaymentMethodRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantPaymentMethodRequest.Merge(dst, src)
+func (m *GetMerchantPaymentMethodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantPaymentMethodRequest.Merge(m, src)
 }
 func (m *GetMerchantPaymentMethodRequest) XXX_Size() int {
 	return xxx_messageInfo_GetMerchantPaymentMethodRequest.Size(m)
@@ -1481,16 +1692,17 @@ func (m *GetMerchantPaymentMethodResponse) Reset()         { *m = GetMerchantPay
 func (m *GetMerchantPaymentMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantPaymentMethodResponse) ProtoMessage()    {}
 func (*GetMerchantPaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{22}
+	return fileDescriptor_81ea47a3f88c2082, []int{25}
 }
+
 func (m *GetMerchantPaymentMethodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantPaymentMethodResponse.Unmarshal(m, b)
 }
 func (m *GetMerchantPaymentMethodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetMerchantPaymentMethodResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantPaymentMethodResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantPaymentMethodResponse.Merge(dst, src)
+func (m *GetMerchantPaymentMethodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantPaymentMethodResponse.Merge(m, src)
 }
 func (m *GetMerchantPaymentMethodResponse) XXX_Size() int {
 	return xxx_messageInfo_GetMerchantPaymentMethodResponse.Size(m)
@@ -1538,16 +1750,17 @@ func (m *ListMerchantPaymentMethodsRequest) Reset()         { *m = ListMerchantP
 func (m *ListMerchantPaymentMethodsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMerchantPaymentMethodsRequest) ProtoMessage()    {}
 func (*ListMerchantPaymentMethodsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{23}
+	return fileDescriptor_81ea47a3f88c2082, []int{26}
 }
+
 func (m *ListMerchantPaymentMethodsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListMerchantPaymentMethodsRequest.Unmarshal(m, b)
 }
 func (m *ListMerchantPaymentMethodsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListMerchantPaymentMethodsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListMerchantPaymentMethodsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListMerchantPaymentMethodsRequest.Merge(dst, src)
+func (m *ListMerchantPaymentMethodsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMerchantPaymentMethodsRequest.Merge(m, src)
 }
 func (m *ListMerchantPaymentMethodsRequest) XXX_Size() int {
 	return xxx_messageInfo_ListMerchantPaymentMethodsRequest.Size(m)
@@ -1601,16 +1814,17 @@ func (m *MerchantPaymentMethodRequest) Reset()         { *m = MerchantPaymentMet
 func (m *MerchantPaymentMethodRequest) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodRequest) ProtoMessage()    {}
 func (*MerchantPaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{24}
+	return fileDescriptor_81ea47a3f88c2082, []int{27}
 }
+
 func (m *MerchantPaymentMethodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantPaymentMethodRequest.Unmarshal(m, b)
 }
 func (m *MerchantPaymentMethodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MerchantPaymentMethodRequest.Marshal(b, m, deterministic)
 }
-func (dst *MerchantPaymentMethodRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethodRequest.Merge(dst, src)
+func (m *MerchantPaymentMethodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethodRequest.Merge(m, src)
 }
 func (m *MerchantPaymentMethodRequest) XXX_Size() int {
 	return xxx_messageInfo_MerchantPaymentMethodRequest.Size(m)
@@ -1676,16 +1890,17 @@ func (m *MerchantPaymentMethodResponse) Reset()         { *m = MerchantPaymentMe
 func (m *MerchantPaymentMethodResponse) String() string { return proto.CompactTextString(m) }
 func (*MerchantPaymentMethodResponse) ProtoMessage()    {}
 func (*MerchantPaymentMethodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{25}
+	return fileDescriptor_81ea47a3f88c2082, []int{28}
 }
+
 func (m *MerchantPaymentMethodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantPaymentMethodResponse.Unmarshal(m, b)
 }
 func (m *MerchantPaymentMethodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MerchantPaymentMethodResponse.Marshal(b, m, deterministic)
 }
-func (dst *MerchantPaymentMethodResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantPaymentMethodResponse.Merge(dst, src)
+func (m *MerchantPaymentMethodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantPaymentMethodResponse.Merge(m, src)
 }
 func (m *MerchantPaymentMethodResponse) XXX_Size() int {
 	return xxx_messageInfo_MerchantPaymentMethodResponse.Size(m)
@@ -1730,16 +1945,17 @@ func (m *MerchantGetMerchantResponse) Reset()         { *m = MerchantGetMerchant
 func (m *MerchantGetMerchantResponse) String() string { return proto.CompactTextString(m) }
 func (*MerchantGetMerchantResponse) ProtoMessage()    {}
 func (*MerchantGetMerchantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{26}
+	return fileDescriptor_81ea47a3f88c2082, []int{29}
 }
+
 func (m *MerchantGetMerchantResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerchantGetMerchantResponse.Unmarshal(m, b)
 }
 func (m *MerchantGetMerchantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_MerchantGetMerchantResponse.Marshal(b, m, deterministic)
 }
-func (dst *MerchantGetMerchantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MerchantGetMerchantResponse.Merge(dst, src)
+func (m *MerchantGetMerchantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantGetMerchantResponse.Merge(m, src)
 }
 func (m *MerchantGetMerchantResponse) XXX_Size() int {
 	return xxx_messageInfo_MerchantGetMerchantResponse.Size(m)
@@ -1783,16 +1999,17 @@ func (m *GetNotificationRequest) Reset()         { *m = GetNotificationRequest{}
 func (m *GetNotificationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNotificationRequest) ProtoMessage()    {}
 func (*GetNotificationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{27}
+	return fileDescriptor_81ea47a3f88c2082, []int{30}
 }
+
 func (m *GetNotificationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNotificationRequest.Unmarshal(m, b)
 }
 func (m *GetNotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetNotificationRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetNotificationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNotificationRequest.Merge(dst, src)
+func (m *GetNotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNotificationRequest.Merge(m, src)
 }
 func (m *GetNotificationRequest) XXX_Size() int {
 	return xxx_messageInfo_GetNotificationRequest.Size(m)
@@ -1820,29 +2037,34 @@ func (m *GetNotificationRequest) GetNotificationId() string {
 type CreateRefundRequest struct {
 	// @inject_tag: validate:"required,uuid"
 	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
-	// @inject_tag: validate:"required,numeric,gt=0"
-	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty" validate:"required,numeric,gt=0"`
-	CreatorId            string   `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	// @inject_tag: validate:"omitempty,numeric,gt=0"
+	Amount    float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty" validate:"omitempty,numeric,gt=0"`
+	CreatorId string  `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Reason    string  `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// @inject_tag: validate:"omitempty,max=255"
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty" validate:"omitempty,max=255"`
+	// @inject_tag: validate:"omitempty,dive"
+	Items                []*CreateRefundItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty" validate:"omitempty,dive"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *CreateRefundRequest) Reset()         { *m = CreateRefundRequest{} }
 func (m *CreateRefundRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateRefundRequest) ProtoMessage()    {}
 func (*CreateRefundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{28}
+	return fileDescriptor_81ea47a3f88c2082, []int{31}
 }
+
 func (m *CreateRefundRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRefundRequest.Unmarshal(m, b)
 }
 func (m *CreateRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CreateRefundRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateRefundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateRefundRequest.Merge(dst, src)
+func (m *CreateRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRefundRequest.Merge(m, src)
 }
 func (m *CreateRefundRequest) XXX_Size() int {
 	return xxx_messageInfo_CreateRefundRequest.Size(m)
@@ -1881,6 +2103,69 @@ func (m *CreateRefundRequest) GetReason() string {
 	return ""
 }
 
+func (m *CreateRefundRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *CreateRefundRequest) GetItems() []*CreateRefundItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CreateRefundItem struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,numeric,gt=0"
+	Quantity             int32    `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty" validate:"required,numeric,gt=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CreateRefundItem) Reset()         { *m = CreateRefundItem{} }
+func (m *CreateRefundItem) String() string { return proto.CompactTextString(m) }
+func (*CreateRefundItem) ProtoMessage()    {}
+func (*CreateRefundItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{32}
+}
+
+func (m *CreateRefundItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRefundItem.Unmarshal(m, b)
+}
+func (m *CreateRefundItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRefundItem.Marshal(b, m, deterministic)
+}
+func (m *CreateRefundItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRefundItem.Merge(m, src)
+}
+func (m *CreateRefundItem) XXX_Size() int {
+	return xxx_messageInfo_CreateRefundItem.Size(m)
+}
+func (m *CreateRefundItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRefundItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRefundItem proto.InternalMessageInfo
+
+func (m *CreateRefundItem) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *CreateRefundItem) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
 type CreateRefundResponse struct {
 	Status               int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Message              string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -1894,16 +2179,17 @@ func (m *CreateRefundResponse) Reset()         { *m = CreateRefundResponse{} }
 func (m *CreateRefundResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateRefundResponse) ProtoMessage()    {}
 func (*CreateRefundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{29}
+	return fileDescriptor_81ea47a3f88c2082, []int{33}
 }
+
 func (m *CreateRefundResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateRefundResponse.Unmarshal(m, b)
 }
 func (m *CreateRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CreateRefundResponse.Marshal(b, m, deterministic)
 }
-func (dst *CreateRefundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateRefundResponse.Merge(dst, src)
+func (m *CreateRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRefundResponse.Merge(m, src)
 }
 func (m *CreateRefundResponse) XXX_Size() int {
 	return xxx_messageInfo_CreateRefundResponse.Size(m)
@@ -1951,16 +2237,17 @@ func (m *ListRefundsRequest) Reset()         { *m = ListRefundsRequest{} }
 func (m *ListRefundsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefundsRequest) ProtoMessage()    {}
 func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{30}
+	return fileDescriptor_81ea47a3f88c2082, []int{34}
 }
+
 func (m *ListRefundsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRefundsRequest.Unmarshal(m, b)
 }
 func (m *ListRefundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListRefundsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListRefundsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRefundsRequest.Merge(dst, src)
+func (m *ListRefundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRefundsRequest.Merge(m, src)
 }
 func (m *ListRefundsRequest) XXX_Size() int {
 	return xxx_messageInfo_ListRefundsRequest.Size(m)
@@ -2004,16 +2291,17 @@ func (m *ListRefundsResponse) Reset()         { *m = ListRefundsResponse{} }
 func (m *ListRefundsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListRefundsResponse) ProtoMessage()    {}
 func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{31}
+	return fileDescriptor_81ea47a3f88c2082, []int{35}
 }
+
 func (m *ListRefundsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRefundsResponse.Unmarshal(m, b)
 }
 func (m *ListRefundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListRefundsResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListRefundsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListRefundsResponse.Merge(dst, src)
+func (m *ListRefundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRefundsResponse.Merge(m, src)
 }
 func (m *ListRefundsResponse) XXX_Size() int {
 	return xxx_messageInfo_ListRefundsResponse.Size(m)
@@ -2052,16 +2340,17 @@ func (m *GetRefundRequest) Reset()         { *m = GetRefundRequest{} }
 func (m *GetRefundRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRefundRequest) ProtoMessage()    {}
 func (*GetRefundRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{32}
+	return fileDescriptor_81ea47a3f88c2082, []int{36}
 }
+
 func (m *GetRefundRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRefundRequest.Unmarshal(m, b)
 }
 func (m *GetRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetRefundRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetRefundRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRefundRequest.Merge(dst, src)
+func (m *GetRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRefundRequest.Merge(m, src)
 }
 func (m *GetRefundRequest) XXX_Size() int {
 	return xxx_messageInfo_GetRefundRequest.Size(m)
@@ -2099,16 +2388,17 @@ func (m *CallbackRequest) Reset()         { *m = CallbackRequest{} }
 func (m *CallbackRequest) String() string { return proto.CompactTextString(m) }
 func (*CallbackRequest) ProtoMessage()    {}
 func (*CallbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{33}
+	return fileDescriptor_81ea47a3f88c2082, []int{37}
 }
+
 func (m *CallbackRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallbackRequest.Unmarshal(m, b)
 }
 func (m *CallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CallbackRequest.Marshal(b, m, deterministic)
 }
-func (dst *CallbackRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CallbackRequest.Merge(dst, src)
+func (m *CallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackRequest.Merge(m, src)
 }
 func (m *CallbackRequest) XXX_Size() int {
 	return xxx_messageInfo_CallbackRequest.Size(m)
@@ -2153,16 +2443,17 @@ func (m *PaymentFormDataChangedRequest) Reset()         { *m = PaymentFormDataCh
 func (m *PaymentFormDataChangedRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormDataChangedRequest) ProtoMessage()    {}
 func (*PaymentFormDataChangedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{34}
+	return fileDescriptor_81ea47a3f88c2082, []int{38}
 }
+
 func (m *PaymentFormDataChangedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormDataChangedRequest.Unmarshal(m, b)
 }
 func (m *PaymentFormDataChangedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormDataChangedRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormDataChangedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormDataChangedRequest.Merge(dst, src)
+func (m *PaymentFormDataChangedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormDataChangedRequest.Merge(m, src)
 }
 func (m *PaymentFormDataChangedRequest) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormDataChangedRequest.Size(m)
@@ -2211,16 +2502,17 @@ func (m *PaymentFormUserChangeLangRequest) Reset()         { *m = PaymentFormUse
 func (m *PaymentFormUserChangeLangRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormUserChangeLangRequest) ProtoMessage()    {}
 func (*PaymentFormUserChangeLangRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{35}
+	return fileDescriptor_81ea47a3f88c2082, []int{39}
 }
+
 func (m *PaymentFormUserChangeLangRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormUserChangeLangRequest.Unmarshal(m, b)
 }
 func (m *PaymentFormUserChangeLangRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormUserChangeLangRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormUserChangeLangRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormUserChangeLangRequest.Merge(dst, src)
+func (m *PaymentFormUserChangeLangRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormUserChangeLangRequest.Merge(m, src)
 }
 func (m *PaymentFormUserChangeLangRequest) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormUserChangeLangRequest.Size(m)
@@ -2289,16 +2581,17 @@ func (m *PaymentFormUserChangePaymentAccountRequest) String() string {
 }
 func (*PaymentFormUserChangePaymentAccountRequest) ProtoMessage() {}
 func (*PaymentFormUserChangePaymentAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{36}
+	return fileDescriptor_81ea47a3f88c2082, []int{40}
 }
+
 func (m *PaymentFormUserChangePaymentAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormUserChangePaymentAccountRequest.Unmarshal(m, b)
 }
 func (m *PaymentFormUserChangePaymentAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormUserChangePaymentAccountRequest.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormUserChangePaymentAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormUserChangePaymentAccountRequest.Merge(dst, src)
+func (m *PaymentFormUserChangePaymentAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormUserChangePaymentAccountRequest.Merge(m, src)
 }
 func (m *PaymentFormUserChangePaymentAccountRequest) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormUserChangePaymentAccountRequest.Size(m)
@@ -2367,16 +2660,17 @@ func (m *UserIpData) Reset()         { *m = UserIpData{} }
 func (m *UserIpData) String() string { return proto.CompactTextString(m) }
 func (*UserIpData) ProtoMessage()    {}
 func (*UserIpData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{37}
+	return fileDescriptor_81ea47a3f88c2082, []int{41}
 }
+
 func (m *UserIpData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserIpData.Unmarshal(m, b)
 }
 func (m *UserIpData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_UserIpData.Marshal(b, m, deterministic)
 }
-func (dst *UserIpData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserIpData.Merge(dst, src)
+func (m *UserIpData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIpData.Merge(m, src)
 }
 func (m *UserIpData) XXX_Size() int {
 	return xxx_messageInfo_UserIpData.Size(m)
@@ -2421,16 +2715,17 @@ func (m *PaymentFormDataChangeResponseItem) Reset()         { *m = PaymentFormDa
 func (m *PaymentFormDataChangeResponseItem) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormDataChangeResponseItem) ProtoMessage()    {}
 func (*PaymentFormDataChangeResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{38}
+	return fileDescriptor_81ea47a3f88c2082, []int{42}
 }
+
 func (m *PaymentFormDataChangeResponseItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormDataChangeResponseItem.Unmarshal(m, b)
 }
 func (m *PaymentFormDataChangeResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormDataChangeResponseItem.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormDataChangeResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormDataChangeResponseItem.Merge(dst, src)
+func (m *PaymentFormDataChangeResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormDataChangeResponseItem.Merge(m, src)
 }
 func (m *PaymentFormDataChangeResponseItem) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormDataChangeResponseItem.Size(m)
@@ -2468,16 +2763,17 @@ func (m *PaymentFormDataChangeResponse) Reset()         { *m = PaymentFormDataCh
 func (m *PaymentFormDataChangeResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentFormDataChangeResponse) ProtoMessage()    {}
 func (*PaymentFormDataChangeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{39}
+	return fileDescriptor_81ea47a3f88c2082, []int{43}
 }
+
 func (m *PaymentFormDataChangeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentFormDataChangeResponse.Unmarshal(m, b)
 }
 func (m *PaymentFormDataChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_PaymentFormDataChangeResponse.Marshal(b, m, deterministic)
 }
-func (dst *PaymentFormDataChangeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentFormDataChangeResponse.Merge(dst, src)
+func (m *PaymentFormDataChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentFormDataChangeResponse.Merge(m, src)
 }
 func (m *PaymentFormDataChangeResponse) XXX_Size() int {
 	return xxx_messageInfo_PaymentFormDataChangeResponse.Size(m)
@@ -2527,16 +2823,17 @@ func (m *ProcessBillingAddressRequest) Reset()         { *m = ProcessBillingAddr
 func (m *ProcessBillingAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*ProcessBillingAddressRequest) ProtoMessage()    {}
 func (*ProcessBillingAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{40}
+	return fileDescriptor_81ea47a3f88c2082, []int{44}
 }
+
 func (m *ProcessBillingAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessBillingAddressRequest.Unmarshal(m, b)
 }
 func (m *ProcessBillingAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ProcessBillingAddressRequest.Marshal(b, m, deterministic)
 }
-func (dst *ProcessBillingAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcessBillingAddressRequest.Merge(dst, src)
+func (m *ProcessBillingAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessBillingAddressRequest.Merge(m, src)
 }
 func (m *ProcessBillingAddressRequest) XXX_Size() int {
 	return xxx_messageInfo_ProcessBillingAddressRequest.Size(m)
@@ -2593,16 +2890,17 @@ func (m *ProcessBillingAddressResponseItem) Reset()         { *m = ProcessBillin
 func (m *ProcessBillingAddressResponseItem) String() string { return proto.CompactTextString(m) }
 func (*ProcessBillingAddressResponseItem) ProtoMessage()    {}
 func (*ProcessBillingAddressResponseItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{41}
+	return fileDescriptor_81ea47a3f88c2082, []int{45}
 }
+
 func (m *ProcessBillingAddressResponseItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessBillingAddressResponseItem.Unmarshal(m, b)
 }
 func (m *ProcessBillingAddressResponseItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ProcessBillingAddressResponseItem.Marshal(b, m, deterministic)
 }
-func (dst *ProcessBillingAddressResponseItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcessBillingAddressResponseItem.Merge(dst, src)
+func (m *ProcessBillingAddressResponseItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessBillingAddressResponseItem.Merge(m, src)
 }
 func (m *ProcessBillingAddressResponseItem) XXX_Size() int {
 	return xxx_messageInfo_ProcessBillingAddressResponseItem.Size(m)
@@ -2654,16 +2952,17 @@ func (m *ProcessBillingAddressResponse) Reset()         { *m = ProcessBillingAdd
 func (m *ProcessBillingAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*ProcessBillingAddressResponse) ProtoMessage()    {}
 func (*ProcessBillingAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{42}
+	return fileDescriptor_81ea47a3f88c2082, []int{46}
 }
+
 func (m *ProcessBillingAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProcessBillingAddressResponse.Unmarshal(m, b)
 }
 func (m *ProcessBillingAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ProcessBillingAddressResponse.Marshal(b, m, deterministic)
 }
-func (dst *ProcessBillingAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProcessBillingAddressResponse.Merge(dst, src)
+func (m *ProcessBillingAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessBillingAddressResponse.Merge(m, src)
 }
 func (m *ProcessBillingAddressResponse) XXX_Size() int {
 	return xxx_messageInfo_ProcessBillingAddressResponse.Size(m)
@@ -2707,16 +3006,17 @@ func (m *GetMerchantByRequest) Reset()         { *m = GetMerchantByRequest{} }
 func (m *GetMerchantByRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMerchantByRequest) ProtoMessage()    {}
 func (*GetMerchantByRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{43}
+	return fileDescriptor_81ea47a3f88c2082, []int{47}
 }
+
 func (m *GetMerchantByRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMerchantByRequest.Unmarshal(m, b)
 }
 func (m *GetMerchantByRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetMerchantByRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetMerchantByRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMerchantByRequest.Merge(dst, src)
+func (m *GetMerchantByRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantByRequest.Merge(m, src)
 }
 func (m *GetMerchantByRequest) XXX_Size() int {
 	return xxx_messageInfo_GetMerchantByRequest.Size(m)
@@ -2759,16 +3059,17 @@ func (m *ChangeMerchantDataRequest) Reset()         { *m = ChangeMerchantDataReq
 func (m *ChangeMerchantDataRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeMerchantDataRequest) ProtoMessage()    {}
 func (*ChangeMerchantDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{44}
+	return fileDescriptor_81ea47a3f88c2082, []int{48}
 }
+
 func (m *ChangeMerchantDataRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeMerchantDataRequest.Unmarshal(m, b)
 }
 func (m *ChangeMerchantDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ChangeMerchantDataRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChangeMerchantDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeMerchantDataRequest.Merge(dst, src)
+func (m *ChangeMerchantDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMerchantDataRequest.Merge(m, src)
 }
 func (m *ChangeMerchantDataRequest) XXX_Size() int {
 	return xxx_messageInfo_ChangeMerchantDataRequest.Size(m)
@@ -2834,16 +3135,17 @@ func (m *ChangeMerchantDataResponse) Reset()         { *m = ChangeMerchantDataRe
 func (m *ChangeMerchantDataResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeMerchantDataResponse) ProtoMessage()    {}
 func (*ChangeMerchantDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{45}
+	return fileDescriptor_81ea47a3f88c2082, []int{49}
 }
+
 func (m *ChangeMerchantDataResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeMerchantDataResponse.Unmarshal(m, b)
 }
 func (m *ChangeMerchantDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ChangeMerchantDataResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChangeMerchantDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeMerchantDataResponse.Merge(dst, src)
+func (m *ChangeMerchantDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeMerchantDataResponse.Merge(m, src)
 }
 func (m *ChangeMerchantDataResponse) XXX_Size() int {
 	return xxx_messageInfo_ChangeMerchantDataResponse.Size(m)
@@ -2889,16 +3191,17 @@ func (m *SetMerchantS3AgreementRequest) Reset()         { *m = SetMerchantS3Agre
 func (m *SetMerchantS3AgreementRequest) String() string { return proto.CompactTextString(m) }
 func (*SetMerchantS3AgreementRequest) ProtoMessage()    {}
 func (*SetMerchantS3AgreementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{46}
+	return fileDescriptor_81ea47a3f88c2082, []int{50}
 }
+
 func (m *SetMerchantS3AgreementRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMerchantS3AgreementRequest.Unmarshal(m, b)
 }
 func (m *SetMerchantS3AgreementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SetMerchantS3AgreementRequest.Marshal(b, m, deterministic)
 }
-func (dst *SetMerchantS3AgreementRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetMerchantS3AgreementRequest.Merge(dst, src)
+func (m *SetMerchantS3AgreementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMerchantS3AgreementRequest.Merge(m, src)
 }
 func (m *SetMerchantS3AgreementRequest) XXX_Size() int {
 	return xxx_messageInfo_SetMerchantS3AgreementRequest.Size(m)
@@ -2924,61 +3227,64 @@ func (m *SetMerchantS3AgreementRequest) GetS3AgreementName() string {
 }
 
 type Product struct {
-	// @inject_tag: validate:"omitempty,hexadecimal,len=24" json:"id" bson:"_id"
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"id" bson:"_id"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24" bson:"_id"`
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"-" bson:"merchant_id"
+	//@inject_tag: validate:"required,hexadecimal,len=24" json:"-" bson:"merchant_id"
 	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"-" validate:"required,hexadecimal,len=24" bson:"merchant_id"`
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"project_id" bson:"project_id"
+	//@inject_tag: validate:"required,hexadecimal,len=24" json:"project_id" bson:"project_id"
 	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"required,hexadecimal,len=24" bson:"project_id"`
-	// @inject_tag: validate:"required" json:"object"
+	//@inject_tag: validate:"required" json:"object"
 	Object string `protobuf:"bytes,4,opt,name=object,proto3" json:"object" validate:"required"`
-	// @inject_tag: validate:"required" json:"type"
+	//@inject_tag: validate:"required" json:"type"
 	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type" validate:"required"`
-	// @inject_tag: validate:"required" json:"sku" bson:"sku"
+	//@inject_tag: validate:"required" json:"sku" bson:"sku"
 	Sku string `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku" validate:"required" bson:"sku"`
-	// @inject_tag: validate:"required" json:"name"
+	//@inject_tag: validate:"required" json:"name"
 	Name map[string]string `protobuf:"bytes,7,rep,name=name,proto3" json:"name" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required"`
-	// @inject_tag: validate:"required,alpha,len=3" json:"default_currency"
+	//@inject_tag: validate:"required,alpha,len=3" json:"default_currency"
 	DefaultCurrency string `protobuf:"bytes,8,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency" validate:"required,alpha,len=3"`
-	// @inject_tag: validate:"required" json:"enabled"
+	//@inject_tag: validate:"required" json:"enabled"
 	Enabled bool `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled" validate:"required"`
-	// @inject_tag: validate:"required,min=1,dive" json:"prices"
+	//@inject_tag: validate:"required,min=1,dive" json:"prices"
 	Prices []*ProductPrice `protobuf:"bytes,10,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive"`
-	// @inject_tag: validate:"required" json:"description"
+	//@inject_tag: validate:"required" json:"description"
 	Description map[string]string `protobuf:"bytes,11,rep,name=description,proto3" json:"description" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"required"`
-	// @inject_tag: validate:"omitempty" json:"long_description"
+	//@inject_tag: validate:"omitempty" json:"long_description"
 	LongDescription map[string]string `protobuf:"bytes,12,rep,name=long_description,json=longDescription,proto3" json:"long_description" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" validate:"omitempty"`
-	// @inject_tag: json:"created_at"
+	//@inject_tag: json:"created_at"
 	CreatedAt *timestamp.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	// @inject_tag: json:"updated_at"
+	//@inject_tag: json:"updated_at"
 	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	// @inject_tag: validate:"dive,omitempty,uri" json:"images"
+	//@inject_tag: validate:"dive,omitempty,uri" json:"images"
 	Images []string `protobuf:"bytes,15,rep,name=images,proto3" json:"images" validate:"dive,omitempty,uri"`
-	// @inject_tag: validate:"omitempty,url" json:"url"
+	//@inject_tag: validate:"omitempty,url" json:"url"
 	Url string `protobuf:"bytes,16,opt,name=url,proto3" json:"url" validate:"omitempty,url"`
-	// @inject_tag: json:"metadata"
+	//@inject_tag: json:"metadata"
 	Metadata map[string]string `protobuf:"bytes,17,rep,name=metadata,proto3" json:"metadata" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// @inject_tag: json:"-" bson:"deleted"
-	Deleted              bool     `protobuf:"varint,18,opt,name=deleted,proto3" json:"-" bson:"deleted"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+	//@inject_tag: json:"-" bson:"deleted"
+	Deleted bool `protobuf:"varint,18,opt,name=deleted,proto3" json:"-" bson:"deleted"`
+	//@inject_tag: validate:"omitempty,dive" json:"plans" bson:"plans"
+	Plans                []*SubscriptionPlan `protobuf:"bytes,19,rep,name=plans,proto3" json:"plans" validate:"omitempty,dive" bson:"plans"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
 func (m *Product) Reset()         { *m = Product{} }
 func (m *Product) String() string { return proto.CompactTextString(m) }
 func (*Product) ProtoMessage()    {}
 func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{47}
+	return fileDescriptor_81ea47a3f88c2082, []int{51}
 }
+
 func (m *Product) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Product.Unmarshal(m, b)
 }
 func (m *Product) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_Product.Marshal(b, m, deterministic)
 }
-func (dst *Product) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Product.Merge(dst, src)
+func (m *Product) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Product.Merge(m, src)
 }
 func (m *Product) XXX_Size() int {
 	return xxx_messageInfo_Product.Size(m)
@@ -3115,86 +3421,189 @@ func (m *Product) GetDeleted() bool {
 	return false
 }
 
-type ProductPrice struct {
-	// @inject_tag: validate:"required,numeric,gt=0" json:"amount"
-	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount" validate:"required,numeric,gt=0"`
-	// @inject_tag: validate:"required,alpha,len=3" json:"currency"
-	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+func (m *Product) GetPlans() []*SubscriptionPlan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+type SubscriptionPlan struct {
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"id" bson:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"omitempty,hexadecimal,len=24" bson:"id"`
+	//@inject_tag: validate:"required" json:"name" bson:"name"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name" validate:"required" bson:"name"`
+	//@inject_tag: validate:"required,oneof=day week month year" json:"interval" bson:"interval"
+	Interval string `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval" validate:"required,oneof=day week month year" bson:"interval"`
+	//@inject_tag: validate:"required,numeric,gt=0" json:"interval_count" bson:"interval_count"
+	IntervalCount int32 `protobuf:"varint,4,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count" validate:"required,numeric,gt=0" bson:"interval_count"`
+	//@inject_tag: validate:"omitempty,numeric,gte=0" json:"trial_days" bson:"trial_days"
+	TrialDays int32 `protobuf:"varint,5,opt,name=trial_days,json=trialDays,proto3" json:"trial_days" validate:"omitempty,numeric,gte=0" bson:"trial_days"`
+	//@inject_tag: validate:"required,min=1,dive" json:"prices" bson:"prices"
+	Prices []*ProductPrice `protobuf:"bytes,6,rep,name=prices,proto3" json:"prices" validate:"required,min=1,dive" bson:"prices"`
+	//@inject_tag: json:"enabled" bson:"enabled"
+	Enabled              bool     `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled" bson:"enabled"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (m *ProductPrice) Reset()         { *m = ProductPrice{} }
-func (m *ProductPrice) String() string { return proto.CompactTextString(m) }
-func (*ProductPrice) ProtoMessage()    {}
-func (*ProductPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{48}
+func (m *SubscriptionPlan) Reset()         { *m = SubscriptionPlan{} }
+func (m *SubscriptionPlan) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionPlan) ProtoMessage()    {}
+func (*SubscriptionPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{52}
 }
-func (m *ProductPrice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProductPrice.Unmarshal(m, b)
+
+func (m *SubscriptionPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionPlan.Unmarshal(m, b)
 }
-func (m *ProductPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProductPrice.Marshal(b, m, deterministic)
+func (m *SubscriptionPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionPlan.Marshal(b, m, deterministic)
 }
-func (dst *ProductPrice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProductPrice.Merge(dst, src)
+func (m *SubscriptionPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionPlan.Merge(m, src)
 }
-func (m *ProductPrice) XXX_Size() int {
-	return xxx_messageInfo_ProductPrice.Size(m)
+func (m *SubscriptionPlan) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionPlan.Size(m)
 }
-func (m *ProductPrice) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProductPrice.DiscardUnknown(m)
+func (m *SubscriptionPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProductPrice proto.InternalMessageInfo
+var xxx_messageInfo_SubscriptionPlan proto.InternalMessageInfo
 
-func (m *ProductPrice) GetAmount() float64 {
+func (m *SubscriptionPlan) GetId() string {
 	if m != nil {
-		return m.Amount
+		return m.Id
 	}
-	return 0
+	return ""
 }
 
-func (m *ProductPrice) GetCurrency() string {
+func (m *SubscriptionPlan) GetName() string {
 	if m != nil {
-		return m.Currency
+		return m.Name
 	}
 	return ""
 }
 
-type ListProductsRequest struct {
-	// @inject_tag: json:"name"
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	// @inject_tag: json:"sku"
-	Sku string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku"`
-	// @inject_tag: validate:"required,numeric,gt=0" json:"limit"
-	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit" validate:"required,numeric,gt=0"`
-	// @inject_tag: validate:"omitempty,numeric,gte=0" json:"offset"
-	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset" validate:"omitempty,numeric,gte=0"`
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"merchant_id" bson:"merchant_id"
-	MerchantId string `protobuf:"bytes,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24" bson:"merchant_id"`
-	// @inject_tag: validate:"omitempty,hexadecimal,len=24" json:"project_id" bson:"project_id"
-	ProjectId            string   `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"omitempty,hexadecimal,len=24" bson:"project_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
-	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
-}
+func (m *SubscriptionPlan) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *SubscriptionPlan) GetIntervalCount() int32 {
+	if m != nil {
+		return m.IntervalCount
+	}
+	return 0
+}
+
+func (m *SubscriptionPlan) GetTrialDays() int32 {
+	if m != nil {
+		return m.TrialDays
+	}
+	return 0
+}
+
+func (m *SubscriptionPlan) GetPrices() []*ProductPrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *SubscriptionPlan) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type ProductPrice struct {
+	// @inject_tag: validate:"required,numeric,gt=0" json:"amount"
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount" validate:"required,numeric,gt=0"`
+	//@inject_tag: validate:"required,alpha,len=3" json:"currency"
+	Currency             string   `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency" validate:"required,alpha,len=3"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ProductPrice) Reset()         { *m = ProductPrice{} }
+func (m *ProductPrice) String() string { return proto.CompactTextString(m) }
+func (*ProductPrice) ProtoMessage()    {}
+func (*ProductPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{53}
+}
+
+func (m *ProductPrice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProductPrice.Unmarshal(m, b)
+}
+func (m *ProductPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProductPrice.Marshal(b, m, deterministic)
+}
+func (m *ProductPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProductPrice.Merge(m, src)
+}
+func (m *ProductPrice) XXX_Size() int {
+	return xxx_messageInfo_ProductPrice.Size(m)
+}
+func (m *ProductPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProductPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProductPrice proto.InternalMessageInfo
+
+func (m *ProductPrice) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ProductPrice) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+type ListProductsRequest struct {
+	//@inject_tag: json:"name"
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	//@inject_tag: json:"sku"
+	Sku string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku"`
+	// @inject_tag: validate:"required,numeric,gt=0" json:"limit"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit" validate:"required,numeric,gt=0"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0" json:"offset"
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset" validate:"omitempty,numeric,gte=0"`
+	//@inject_tag: validate:"required,hexadecimal,len=24" json:"merchant_id" bson:"merchant_id"
+	MerchantId string `protobuf:"bytes,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"required,hexadecimal,len=24" bson:"merchant_id"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"project_id" bson:"project_id"
+	ProjectId            string   `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"omitempty,hexadecimal,len=24" bson:"project_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
 
 func (m *ListProductsRequest) Reset()         { *m = ListProductsRequest{} }
 func (m *ListProductsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProductsRequest) ProtoMessage()    {}
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{49}
+	return fileDescriptor_81ea47a3f88c2082, []int{54}
 }
+
 func (m *ListProductsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProductsRequest.Unmarshal(m, b)
 }
 func (m *ListProductsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListProductsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListProductsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListProductsRequest.Merge(dst, src)
+func (m *ListProductsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProductsRequest.Merge(m, src)
 }
 func (m *ListProductsRequest) XXX_Size() int {
 	return xxx_messageInfo_ListProductsRequest.Size(m)
@@ -3248,9 +3657,9 @@ func (m *ListProductsRequest) GetProjectId() string {
 }
 
 type GetProductsForOrderRequest struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"project_id" bson:"project_id"
+	//@inject_tag: validate:"required,hexadecimal,len=24" json:"project_id" bson:"project_id"
 	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id" validate:"required,hexadecimal,len=24" bson:"project_id"`
-	// @inject_tag: validate:"required,dive,hexadecimal,len=24" json:"ids" bson:"ids"
+	//@inject_tag: validate:"required,dive,hexadecimal,len=24" json:"ids" bson:"ids"
 	Ids                  []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids" validate:"required,dive,hexadecimal,len=24" bson:"ids"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3261,16 +3670,17 @@ func (m *GetProductsForOrderRequest) Reset()         { *m = GetProductsForOrderR
 func (m *GetProductsForOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProductsForOrderRequest) ProtoMessage()    {}
 func (*GetProductsForOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{50}
+	return fileDescriptor_81ea47a3f88c2082, []int{55}
 }
+
 func (m *GetProductsForOrderRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProductsForOrderRequest.Unmarshal(m, b)
 }
 func (m *GetProductsForOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetProductsForOrderRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetProductsForOrderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProductsForOrderRequest.Merge(dst, src)
+func (m *GetProductsForOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProductsForOrderRequest.Merge(m, src)
 }
 func (m *GetProductsForOrderRequest) XXX_Size() int {
 	return xxx_messageInfo_GetProductsForOrderRequest.Size(m)
@@ -3313,16 +3723,17 @@ func (m *ListProductsResponse) Reset()         { *m = ListProductsResponse{} }
 func (m *ListProductsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListProductsResponse) ProtoMessage()    {}
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{51}
+	return fileDescriptor_81ea47a3f88c2082, []int{56}
 }
+
 func (m *ListProductsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProductsResponse.Unmarshal(m, b)
 }
 func (m *ListProductsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListProductsResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListProductsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListProductsResponse.Merge(dst, src)
+func (m *ListProductsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProductsResponse.Merge(m, src)
 }
 func (m *ListProductsResponse) XXX_Size() int {
 	return xxx_messageInfo_ListProductsResponse.Size(m)
@@ -3362,9 +3773,9 @@ func (m *ListProductsResponse) GetProducts() []*Product {
 }
 
 type RequestProduct struct {
-	// @inject_tag: validate:"required,hexadecimal,len=24"
+	//@inject_tag: validate:"required,hexadecimal,len=24"
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
-	// @inject_tag: validate:"hexadecimal,len=24" json:"merchant_id" bson:"merchant_id"
+	//@inject_tag: validate:"hexadecimal,len=24" json:"merchant_id" bson:"merchant_id"
 	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"hexadecimal,len=24" bson:"merchant_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3375,16 +3786,17 @@ func (m *RequestProduct) Reset()         { *m = RequestProduct{} }
 func (m *RequestProduct) String() string { return proto.CompactTextString(m) }
 func (*RequestProduct) ProtoMessage()    {}
 func (*RequestProduct) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{52}
+	return fileDescriptor_81ea47a3f88c2082, []int{57}
 }
+
 func (m *RequestProduct) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RequestProduct.Unmarshal(m, b)
 }
 func (m *RequestProduct) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_RequestProduct.Marshal(b, m, deterministic)
 }
-func (dst *RequestProduct) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RequestProduct.Merge(dst, src)
+func (m *RequestProduct) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestProduct.Merge(m, src)
 }
 func (m *RequestProduct) XXX_Size() int {
 	return xxx_messageInfo_RequestProduct.Size(m)
@@ -3410,9 +3822,9 @@ func (m *RequestProduct) GetMerchantId() string {
 }
 
 type I18NTextSearchable struct {
-	// @inject_tag: validate:"required,alpha,len=2" json:"lang"
+	//@inject_tag: validate:"required,alpha,len=2" json:"lang"
 	Lang string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang" validate:"required,alpha,len=2"`
-	// @inject_tag: validate:"required" json:"value"
+	//@inject_tag: validate:"required" json:"value"
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value" validate:"required"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3423,16 +3835,17 @@ func (m *I18NTextSearchable) Reset()         { *m = I18NTextSearchable{} }
 func (m *I18NTextSearchable) String() string { return proto.CompactTextString(m) }
 func (*I18NTextSearchable) ProtoMessage()    {}
 func (*I18NTextSearchable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{53}
+	return fileDescriptor_81ea47a3f88c2082, []int{58}
 }
+
 func (m *I18NTextSearchable) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_I18NTextSearchable.Unmarshal(m, b)
 }
 func (m *I18NTextSearchable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_I18NTextSearchable.Marshal(b, m, deterministic)
 }
-func (dst *I18NTextSearchable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_I18NTextSearchable.Merge(dst, src)
+func (m *I18NTextSearchable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_I18NTextSearchable.Merge(m, src)
 }
 func (m *I18NTextSearchable) XXX_Size() int {
 	return xxx_messageInfo_I18NTextSearchable.Size(m)
@@ -3470,16 +3883,17 @@ func (m *ChangeProjectResponse) Reset()         { *m = ChangeProjectResponse{} }
 func (m *ChangeProjectResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeProjectResponse) ProtoMessage()    {}
 func (*ChangeProjectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{54}
+	return fileDescriptor_81ea47a3f88c2082, []int{59}
 }
+
 func (m *ChangeProjectResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangeProjectResponse.Unmarshal(m, b)
 }
 func (m *ChangeProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ChangeProjectResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChangeProjectResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeProjectResponse.Merge(dst, src)
+func (m *ChangeProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeProjectResponse.Merge(m, src)
 }
 func (m *ChangeProjectResponse) XXX_Size() int {
 	return xxx_messageInfo_ChangeProjectResponse.Size(m)
@@ -3512,9 +3926,9 @@ func (m *ChangeProjectResponse) GetItem() *billing.Project {
 }
 
 type GetProjectRequest struct {
-	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
 	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
-	// @inject_tag: validate:"required,hexadecimal,len=24"
+	//@inject_tag: validate:"required,hexadecimal,len=24"
 	ProjectId            string   `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty" validate:"required,hexadecimal,len=24"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3525,16 +3939,17 @@ func (m *GetProjectRequest) Reset()         { *m = GetProjectRequest{} }
 func (m *GetProjectRequest) String() string { return proto.CompactTextString(m) }
 func (*GetProjectRequest) ProtoMessage()    {}
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{55}
+	return fileDescriptor_81ea47a3f88c2082, []int{60}
 }
+
 func (m *GetProjectRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetProjectRequest.Unmarshal(m, b)
 }
 func (m *GetProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_GetProjectRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetProjectRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProjectRequest.Merge(dst, src)
+func (m *GetProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProjectRequest.Merge(m, src)
 }
 func (m *GetProjectRequest) XXX_Size() int {
 	return xxx_messageInfo_GetProjectRequest.Size(m)
@@ -3560,7 +3975,7 @@ func (m *GetProjectRequest) GetProjectId() string {
 }
 
 type ListProjectsRequest struct {
-	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	//@inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
 	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
 	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
 	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
@@ -3581,16 +3996,17 @@ func (m *ListProjectsRequest) Reset()         { *m = ListProjectsRequest{} }
 func (m *ListProjectsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsRequest) ProtoMessage()    {}
 func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{56}
+	return fileDescriptor_81ea47a3f88c2082, []int{61}
 }
+
 func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsRequest.Unmarshal(m, b)
 }
 func (m *ListProjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListProjectsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListProjectsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListProjectsRequest.Merge(dst, src)
+func (m *ListProjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsRequest.Merge(m, src)
 }
 func (m *ListProjectsRequest) XXX_Size() int {
 	return xxx_messageInfo_ListProjectsRequest.Size(m)
@@ -3644,9 +4060,9 @@ func (m *ListProjectsRequest) GetSort() []string {
 }
 
 type ListProjectsResponse struct {
-	// @inject_tag: json:"count"
+	//@inject_tag: json:"count"
 	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
-	// @inject_tag: json:"items"
+	//@inject_tag: json:"items"
 	Items                []*billing.Project `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3657,16 +4073,17 @@ func (m *ListProjectsResponse) Reset()         { *m = ListProjectsResponse{} }
 func (m *ListProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListProjectsResponse) ProtoMessage()    {}
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{57}
+	return fileDescriptor_81ea47a3f88c2082, []int{62}
 }
+
 func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListProjectsResponse.Unmarshal(m, b)
 }
 func (m *ListProjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_ListProjectsResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListProjectsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListProjectsResponse.Merge(dst, src)
+func (m *ListProjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsResponse.Merge(m, src)
 }
 func (m *ListProjectsResponse) XXX_Size() int {
 	return xxx_messageInfo_ListProjectsResponse.Size(m)
@@ -3692,9 +4109,9 @@ func (m *ListProjectsResponse) GetItems() []*billing.Project {
 }
 
 type TokenRequest struct {
-	// @inject_tag: validate:"required"
+	//@inject_tag: validate:"required"
 	User *billing.TokenUser `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty" validate:"required"`
-	// @inject_tag: validate:"required"
+	//@inject_tag: validate:"required"
 	Settings             *billing.TokenSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty" validate:"required"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
 	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
@@ -3705,16 +4122,17 @@ func (m *TokenRequest) Reset()         { *m = TokenRequest{} }
 func (m *TokenRequest) String() string { return proto.CompactTextString(m) }
 func (*TokenRequest) ProtoMessage()    {}
 func (*TokenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{58}
+	return fileDescriptor_81ea47a3f88c2082, []int{63}
 }
+
 func (m *TokenRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenRequest.Unmarshal(m, b)
 }
 func (m *TokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenRequest.Marshal(b, m, deterministic)
 }
-func (dst *TokenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenRequest.Merge(dst, src)
+func (m *TokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRequest.Merge(m, src)
 }
 func (m *TokenRequest) XXX_Size() int {
 	return xxx_messageInfo_TokenRequest.Size(m)
@@ -3752,16 +4170,17 @@ func (m *TokenResponse) Reset()         { *m = TokenResponse{} }
 func (m *TokenResponse) String() string { return proto.CompactTextString(m) }
 func (*TokenResponse) ProtoMessage()    {}
 func (*TokenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{59}
+	return fileDescriptor_81ea47a3f88c2082, []int{64}
 }
+
 func (m *TokenResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TokenResponse.Unmarshal(m, b)
 }
 func (m *TokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TokenResponse.Marshal(b, m, deterministic)
 }
-func (dst *TokenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenResponse.Merge(dst, src)
+func (m *TokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenResponse.Merge(m, src)
 }
 func (m *TokenResponse) XXX_Size() int {
 	return xxx_messageInfo_TokenResponse.Size(m)
@@ -3806,16 +4225,17 @@ func (m *CheckProjectRequestSignatureRequest) Reset()         { *m = CheckProjec
 func (m *CheckProjectRequestSignatureRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckProjectRequestSignatureRequest) ProtoMessage()    {}
 func (*CheckProjectRequestSignatureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{60}
+	return fileDescriptor_81ea47a3f88c2082, []int{65}
 }
+
 func (m *CheckProjectRequestSignatureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckProjectRequestSignatureRequest.Unmarshal(m, b)
 }
 func (m *CheckProjectRequestSignatureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CheckProjectRequestSignatureRequest.Marshal(b, m, deterministic)
 }
-func (dst *CheckProjectRequestSignatureRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckProjectRequestSignatureRequest.Merge(dst, src)
+func (m *CheckProjectRequestSignatureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckProjectRequestSignatureRequest.Merge(m, src)
 }
 func (m *CheckProjectRequestSignatureRequest) XXX_Size() int {
 	return xxx_messageInfo_CheckProjectRequestSignatureRequest.Size(m)
@@ -3859,16 +4279,17 @@ func (m *CheckProjectRequestSignatureResponse) Reset()         { *m = CheckProje
 func (m *CheckProjectRequestSignatureResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckProjectRequestSignatureResponse) ProtoMessage()    {}
 func (*CheckProjectRequestSignatureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_grpc_3a6a8ac063faa9bb, []int{61}
+	return fileDescriptor_81ea47a3f88c2082, []int{66}
 }
+
 func (m *CheckProjectRequestSignatureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckProjectRequestSignatureResponse.Unmarshal(m, b)
 }
 func (m *CheckProjectRequestSignatureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_CheckProjectRequestSignatureResponse.Marshal(b, m, deterministic)
 }
-func (dst *CheckProjectRequestSignatureResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CheckProjectRequestSignatureResponse.Merge(dst, src)
+func (m *CheckProjectRequestSignatureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckProjectRequestSignatureResponse.Merge(m, src)
 }
 func (m *CheckProjectRequestSignatureResponse) XXX_Size() int {
 	return xxx_messageInfo_CheckProjectRequestSignatureResponse.Size(m)
@@ -3893,305 +4314,2836 @@ func (m *CheckProjectRequestSignatureResponse) GetMessage() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EmptyRequest)(nil), "grpc.EmptyRequest")
-	proto.RegisterType((*EmptyResponse)(nil), "grpc.EmptyResponse")
-	proto.RegisterType((*PaymentCreateRequest)(nil), "grpc.PaymentCreateRequest")
-	proto.RegisterMapType((map[string]string)(nil), "grpc.PaymentCreateRequest.DataEntry")
-	proto.RegisterType((*PaymentCreateResponse)(nil), "grpc.PaymentCreateResponse")
-	proto.RegisterType((*PaymentFormJsonDataRequest)(nil), "grpc.PaymentFormJsonDataRequest")
-	proto.RegisterType((*PaymentFormJsonDataProject)(nil), "grpc.PaymentFormJsonDataProject")
-	proto.RegisterType((*PaymentFormJsonDataResponse)(nil), "grpc.PaymentFormJsonDataResponse")
-	proto.RegisterType((*PaymentNotifyRequest)(nil), "grpc.PaymentNotifyRequest")
-	proto.RegisterType((*PaymentNotifyResponse)(nil), "grpc.PaymentNotifyResponse")
-	proto.RegisterType((*ConvertRateRequest)(nil), "grpc.ConvertRateRequest")
-	proto.RegisterType((*ConvertRateResponse)(nil), "grpc.ConvertRateResponse")
-	proto.RegisterType((*OnboardingBanking)(nil), "grpc.OnboardingBanking")
-	proto.RegisterType((*OnboardingRequest)(nil), "grpc.OnboardingRequest")
-	proto.RegisterType((*FindByIdRequest)(nil), "grpc.FindByIdRequest")
-	proto.RegisterType((*MerchantListingRequest)(nil), "grpc.MerchantListingRequest")
-	proto.RegisterType((*MerchantListingResponse)(nil), "grpc.MerchantListingResponse")
-	proto.RegisterType((*MerchantChangeStatusRequest)(nil), "grpc.MerchantChangeStatusRequest")
-	proto.RegisterType((*NotificationRequest)(nil), "grpc.NotificationRequest")
-	proto.RegisterType((*Notifications)(nil), "grpc.Notifications")
-	proto.RegisterType((*ListingNotificationRequest)(nil), "grpc.ListingNotificationRequest")
-	proto.RegisterType((*ListingMerchantPaymentMethod)(nil), "grpc.ListingMerchantPaymentMethod")
-	proto.RegisterType((*GetMerchantPaymentMethodRequest)(nil), "grpc.GetMerchantPaymentMethodRequest")
-	proto.RegisterType((*GetMerchantPaymentMethodResponse)(nil), "grpc.GetMerchantPaymentMethodResponse")
-	proto.RegisterType((*ListMerchantPaymentMethodsRequest)(nil), "grpc.ListMerchantPaymentMethodsRequest")
-	proto.RegisterType((*MerchantPaymentMethodRequest)(nil), "grpc.MerchantPaymentMethodRequest")
-	proto.RegisterType((*MerchantPaymentMethodResponse)(nil), "grpc.MerchantPaymentMethodResponse")
-	proto.RegisterType((*MerchantGetMerchantResponse)(nil), "grpc.MerchantGetMerchantResponse")
-	proto.RegisterType((*GetNotificationRequest)(nil), "grpc.GetNotificationRequest")
-	proto.RegisterType((*CreateRefundRequest)(nil), "grpc.CreateRefundRequest")
-	proto.RegisterType((*CreateRefundResponse)(nil), "grpc.CreateRefundResponse")
-	proto.RegisterType((*ListRefundsRequest)(nil), "grpc.ListRefundsRequest")
-	proto.RegisterType((*ListRefundsResponse)(nil), "grpc.ListRefundsResponse")
-	proto.RegisterType((*GetRefundRequest)(nil), "grpc.GetRefundRequest")
-	proto.RegisterType((*CallbackRequest)(nil), "grpc.CallbackRequest")
-	proto.RegisterType((*PaymentFormDataChangedRequest)(nil), "grpc.PaymentFormDataChangedRequest")
-	proto.RegisterType((*PaymentFormUserChangeLangRequest)(nil), "grpc.PaymentFormUserChangeLangRequest")
-	proto.RegisterType((*PaymentFormUserChangePaymentAccountRequest)(nil), "grpc.PaymentFormUserChangePaymentAccountRequest")
-	proto.RegisterType((*UserIpData)(nil), "grpc.UserIpData")
-	proto.RegisterType((*PaymentFormDataChangeResponseItem)(nil), "grpc.PaymentFormDataChangeResponseItem")
-	proto.RegisterType((*PaymentFormDataChangeResponse)(nil), "grpc.PaymentFormDataChangeResponse")
-	proto.RegisterType((*ProcessBillingAddressRequest)(nil), "grpc.ProcessBillingAddressRequest")
-	proto.RegisterType((*ProcessBillingAddressResponseItem)(nil), "grpc.ProcessBillingAddressResponseItem")
-	proto.RegisterType((*ProcessBillingAddressResponse)(nil), "grpc.ProcessBillingAddressResponse")
-	proto.RegisterType((*GetMerchantByRequest)(nil), "grpc.GetMerchantByRequest")
-	proto.RegisterType((*ChangeMerchantDataRequest)(nil), "grpc.ChangeMerchantDataRequest")
-	proto.RegisterType((*ChangeMerchantDataResponse)(nil), "grpc.ChangeMerchantDataResponse")
-	proto.RegisterType((*SetMerchantS3AgreementRequest)(nil), "grpc.SetMerchantS3AgreementRequest")
-	proto.RegisterType((*Product)(nil), "grpc.Product")
-	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.DescriptionEntry")
-	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.LongDescriptionEntry")
-	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.MetadataEntry")
-	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.NameEntry")
-	proto.RegisterType((*ProductPrice)(nil), "grpc.ProductPrice")
-	proto.RegisterType((*ListProductsRequest)(nil), "grpc.ListProductsRequest")
-	proto.RegisterType((*GetProductsForOrderRequest)(nil), "grpc.GetProductsForOrderRequest")
-	proto.RegisterType((*ListProductsResponse)(nil), "grpc.ListProductsResponse")
-	proto.RegisterType((*RequestProduct)(nil), "grpc.RequestProduct")
-	proto.RegisterType((*I18NTextSearchable)(nil), "grpc.I18NTextSearchable")
-	proto.RegisterType((*ChangeProjectResponse)(nil), "grpc.ChangeProjectResponse")
-	proto.RegisterType((*GetProjectRequest)(nil), "grpc.GetProjectRequest")
-	proto.RegisterType((*ListProjectsRequest)(nil), "grpc.ListProjectsRequest")
-	proto.RegisterType((*ListProjectsResponse)(nil), "grpc.ListProjectsResponse")
-	proto.RegisterType((*TokenRequest)(nil), "grpc.TokenRequest")
-	proto.RegisterType((*TokenResponse)(nil), "grpc.TokenResponse")
-	proto.RegisterType((*CheckProjectRequestSignatureRequest)(nil), "grpc.CheckProjectRequestSignatureRequest")
-	proto.RegisterType((*CheckProjectRequestSignatureResponse)(nil), "grpc.CheckProjectRequestSignatureResponse")
+type CaptureOrderRequest struct {
+	// @inject_tag: validate:"required,uuid"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0"
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CaptureOrderRequest) Reset()         { *m = CaptureOrderRequest{} }
+func (m *CaptureOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CaptureOrderRequest) ProtoMessage()    {}
+func (*CaptureOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{67}
+}
+
+func (m *CaptureOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaptureOrderRequest.Unmarshal(m, b)
+}
+func (m *CaptureOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaptureOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *CaptureOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaptureOrderRequest.Merge(m, src)
+}
+func (m *CaptureOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CaptureOrderRequest.Size(m)
+}
+func (m *CaptureOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaptureOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaptureOrderRequest proto.InternalMessageInfo
+
+func (m *CaptureOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("grpc/grpc.proto", fileDescriptor_grpc_3a6a8ac063faa9bb) }
-
-var fileDescriptor_grpc_3a6a8ac063faa9bb = []byte{
-	// 3619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x5b, 0x6f, 0x1b, 0x47,
-	0x77, 0xe2, 0x4d, 0x97, 0x43, 0x91, 0x94, 0x46, 0x37, 0x86, 0xb2, 0x6a, 0x7b, 0x1d, 0x5f, 0xbf,
-	0x58, 0x6e, 0xe4, 0x14, 0x76, 0x9c, 0x20, 0x88, 0x2c, 0x5b, 0xaa, 0x12, 0xcb, 0x56, 0x57, 0x76,
-	0x9a, 0x16, 0x08, 0x88, 0xd1, 0xee, 0x88, 0xdc, 0x70, 0xb9, 0xcb, 0xec, 0x0c, 0x95, 0xb0, 0x7d,
-	0xe8, 0x53, 0x80, 0xe6, 0xa5, 0x40, 0x81, 0xfe, 0x85, 0x3e, 0xb4, 0xaf, 0x6d, 0xd0, 0xf7, 0xfe,
-	0x88, 0x3e, 0xf4, 0xad, 0xfd, 0x13, 0x7d, 0x2d, 0xe6, 0xb6, 0xbb, 0x43, 0x2e, 0x49, 0x49, 0x4e,
-	0x80, 0xef, 0xc5, 0xde, 0x99, 0x39, 0x73, 0xe6, 0xdc, 0x67, 0xce, 0x39, 0x14, 0xd4, 0x5a, 0x51,
-	0xcf, 0x79, 0xc4, 0xff, 0xd9, 0xee, 0x45, 0x21, 0x0b, 0x51, 0x91, 0x7f, 0x37, 0xae, 0xb7, 0xc2,
-	0xb0, 0xe5, 0x93, 0x47, 0x62, 0xee, 0xb4, 0x7f, 0xf6, 0x88, 0x79, 0x5d, 0x42, 0x19, 0xee, 0xf6,
-	0x24, 0x58, 0x63, 0xed, 0xd4, 0xf3, 0x7d, 0x2f, 0x68, 0x3d, 0x52, 0xff, 0xcb, 0x69, 0xab, 0x0a,
-	0x8b, 0x2f, 0xbb, 0x3d, 0x36, 0xb0, 0xc9, 0x0f, 0x7d, 0x42, 0x99, 0x55, 0x83, 0x8a, 0x1a, 0xd3,
-	0x5e, 0x18, 0x50, 0x62, 0xfd, 0x4f, 0x0e, 0x56, 0x8f, 0xf1, 0xa0, 0x4b, 0x02, 0xb6, 0x17, 0x11,
-	0xcc, 0x88, 0x82, 0x44, 0x4f, 0xa1, 0xe8, 0x62, 0x86, 0xeb, 0xb9, 0x1b, 0x85, 0x7b, 0xe5, 0x9d,
-	0x0f, 0xb7, 0x05, 0x49, 0x59, 0x90, 0xdb, 0x2f, 0x30, 0xc3, 0x2f, 0x03, 0x16, 0x0d, 0x6c, 0xb1,
-	0x03, 0x55, 0x21, 0xef, 0xf5, 0xea, 0x85, 0x1b, 0xb9, 0x7b, 0x0b, 0x76, 0xde, 0xeb, 0xa1, 0xbb,
-	0x50, 0xc3, 0x8e, 0x43, 0x7a, 0xac, 0xe9, 0xe3, 0xa0, 0xd5, 0xc7, 0x2d, 0x52, 0x2f, 0x8a, 0xc5,
-	0xaa, 0x9c, 0x7e, 0xa5, 0x66, 0xd1, 0x16, 0x40, 0x9f, 0x92, 0xa8, 0x89, 0x5b, 0x24, 0x60, 0xf5,
-	0x92, 0x80, 0x59, 0xe0, 0x33, 0xbb, 0x7c, 0xa2, 0xf1, 0x04, 0x16, 0xe2, 0xa3, 0xd0, 0x12, 0x14,
-	0x3a, 0x64, 0x50, 0xcf, 0x09, 0x20, 0xfe, 0x89, 0x56, 0xa1, 0x74, 0x8e, 0xfd, 0x3e, 0xa9, 0xe7,
-	0xc5, 0x9c, 0x1c, 0x3c, 0xcb, 0x3f, 0xcd, 0x59, 0xff, 0x98, 0x83, 0xb5, 0x21, 0xca, 0x25, 0xf7,
-	0x68, 0x1d, 0x66, 0x29, 0xc3, 0xac, 0x4f, 0x05, 0xa2, 0x92, 0xad, 0x46, 0xa8, 0x0e, 0x73, 0x5d,
-	0x42, 0x29, 0x27, 0x55, 0x62, 0xd3, 0x43, 0x74, 0x13, 0x16, 0x23, 0xe2, 0x7a, 0x11, 0x71, 0x58,
-	0xb3, 0x1f, 0xf9, 0x8a, 0xcd, 0xb2, 0x9e, 0x7b, 0x17, 0xf9, 0xe8, 0x16, 0x54, 0x02, 0x42, 0xdc,
-	0xa6, 0x9e, 0x13, 0xdc, 0xce, 0xdb, 0x8b, 0x7c, 0xd2, 0x56, 0x73, 0xd6, 0x7f, 0xe6, 0xa0, 0xa1,
-	0x68, 0xda, 0x0f, 0xa3, 0xee, 0x57, 0x34, 0x0c, 0x38, 0x73, 0x5a, 0xfa, 0x1f, 0xc0, 0x7c, 0x18,
-	0xb9, 0x24, 0x6a, 0x7a, 0xae, 0xe2, 0x71, 0x4e, 0x8c, 0x0f, 0x5d, 0x41, 0xb3, 0xd3, 0x26, 0x5d,
-	0x4d, 0x9a, 0x1a, 0x21, 0x04, 0xc5, 0x76, 0x48, 0x99, 0xa2, 0x48, 0x7c, 0x73, 0x58, 0x3f, 0x74,
-	0xb0, 0xaf, 0x25, 0xae, 0x46, 0x4a, 0x45, 0xa5, 0x58, 0x45, 0xa6, 0xe4, 0x67, 0x87, 0x24, 0xcf,
-	0xd1, 0x38, 0x61, 0xd8, 0xf1, 0x48, 0x7d, 0x4e, 0xa2, 0x91, 0x23, 0xcb, 0xcf, 0xe4, 0xe1, 0x38,
-	0x0a, 0xbf, 0x27, 0x0e, 0xe3, 0x04, 0x05, 0xb8, 0x4b, 0x14, 0xfd, 0xe2, 0x1b, 0x5d, 0x87, 0x72,
-	0x3f, 0xf2, 0x9b, 0xb4, 0xef, 0x38, 0x84, 0x52, 0xc5, 0x01, 0xf4, 0x23, 0xff, 0x44, 0xce, 0x70,
-	0xc6, 0x39, 0xc0, 0x19, 0xf6, 0xb4, 0x6c, 0xe7, 0xfa, 0x91, 0xbf, 0x8f, 0x3d, 0xdf, 0xfa, 0xb5,
-	0x08, 0x9b, 0x99, 0x22, 0x53, 0xca, 0xe4, 0x4c, 0x69, 0x69, 0xe5, 0x3d, 0x97, 0x2b, 0x11, 0x3b,
-	0x4e, 0xd8, 0x0f, 0x98, 0x56, 0xa2, 0x1a, 0xa2, 0x0d, 0x98, 0x6b, 0x63, 0xda, 0x3c, 0xc7, 0x52,
-	0x5a, 0xf3, 0xf6, 0x6c, 0x1b, 0xd3, 0x6f, 0x30, 0xe3, 0x56, 0xc5, 0x27, 0xb9, 0xb0, 0x72, 0x36,
-	0xff, 0xe4, 0xac, 0xe3, 0xae, 0xc0, 0x51, 0x12, 0x93, 0x6a, 0xc4, 0xed, 0x80, 0x85, 0x0c, 0xfb,
-	0x4d, 0xb5, 0x3a, 0x2b, 0x56, 0xcb, 0x62, 0x6e, 0x57, 0x82, 0x34, 0x60, 0xde, 0xe9, 0x47, 0x11,
-	0x09, 0x9c, 0x81, 0x92, 0x5b, 0x3c, 0x46, 0xcf, 0x60, 0xae, 0x27, 0xc5, 0x54, 0x9f, 0xbf, 0x91,
-	0xbb, 0x57, 0xde, 0xb9, 0x61, 0x38, 0x58, 0x86, 0x38, 0x6d, 0xbd, 0x01, 0x7d, 0x05, 0xb5, 0x9e,
-	0x04, 0x6b, 0x76, 0x09, 0x6b, 0x87, 0x2e, 0xad, 0x2f, 0x08, 0x27, 0xbd, 0xb9, 0xad, 0x9d, 0x3f,
-	0x85, 0x46, 0x7d, 0x1e, 0x09, 0x48, 0xbb, 0xda, 0x4b, 0x0f, 0x29, 0x7a, 0x02, 0x75, 0x2f, 0xf0,
-	0xbd, 0x80, 0x34, 0xcf, 0xc2, 0xa8, 0xdb, 0x34, 0x4c, 0x1b, 0x04, 0xcd, 0x6b, 0x72, 0x9d, 0xa3,
-	0xb2, 0x53, 0x46, 0xbe, 0x0a, 0x25, 0x16, 0x76, 0x48, 0x50, 0x2f, 0x4b, 0x6f, 0x13, 0x03, 0xf4,
-	0x19, 0x34, 0xa4, 0x1d, 0xb9, 0x6e, 0x44, 0x28, 0x6d, 0xf2, 0x78, 0xd0, 0x8c, 0xc8, 0x0f, 0x7d,
-	0x2f, 0x22, 0x6e, 0x7d, 0x51, 0xc8, 0x7a, 0x43, 0xd8, 0x95, 0x04, 0xd0, 0x26, 0xcf, 0x97, 0xd1,
-	0x3d, 0x28, 0x79, 0x8c, 0x74, 0x69, 0xbd, 0x22, 0xb8, 0x41, 0x31, 0x37, 0x6f, 0x84, 0xe5, 0x33,
-	0xd2, 0xb5, 0x25, 0x40, 0xca, 0x1e, 0xab, 0x69, 0x7b, 0xe4, 0x44, 0x91, 0x2e, 0xb7, 0x9c, 0x9a,
-	0x24, 0x4a, 0x0c, 0x2c, 0x2f, 0x8e, 0x70, 0xaf, 0x43, 0xe6, 0x9d, 0x0d, 0x2e, 0xe0, 0x63, 0x75,
-	0x98, 0x8b, 0x24, 0x94, 0x30, 0x9d, 0x45, 0x5b, 0x0f, 0xd1, 0x35, 0x58, 0xa0, 0x5e, 0x2b, 0xc0,
-	0xac, 0x1f, 0x11, 0x65, 0xa0, 0xc9, 0x84, 0xf5, 0x32, 0x0e, 0x34, 0xfa, 0xa8, 0x29, 0x81, 0x86,
-	0x53, 0x1c, 0x45, 0x61, 0xa4, 0x83, 0x96, 0x18, 0x58, 0x4f, 0x01, 0xed, 0x85, 0xc1, 0x39, 0x89,
-	0x98, 0x9d, 0x8a, 0xc8, 0x08, 0x8a, 0x67, 0x51, 0xd8, 0x55, 0x18, 0xc4, 0x37, 0xb7, 0x79, 0x16,
-	0x8a, 0xcd, 0x25, 0x3b, 0xcf, 0x42, 0xeb, 0x3e, 0xac, 0x18, 0x3b, 0xd5, 0xf1, 0x08, 0x8a, 0x11,
-	0x66, 0xd2, 0x15, 0x73, 0xb6, 0xf8, 0xb6, 0xfe, 0x3d, 0x07, 0xcb, 0x6f, 0x82, 0xd3, 0x10, 0x47,
-	0xae, 0x17, 0xb4, 0x9e, 0xe3, 0xa0, 0xe3, 0x05, 0x2d, 0xc3, 0x68, 0x73, 0x43, 0x46, 0xab, 0x1d,
-	0x3a, 0x9f, 0x72, 0x68, 0xee, 0x64, 0x52, 0x97, 0xda, 0x5d, 0xd5, 0x10, 0xdd, 0x86, 0xaa, 0xf2,
-	0xb7, 0x66, 0xd0, 0xef, 0x9e, 0x92, 0x48, 0xc5, 0xa0, 0x8a, 0x9a, 0x7d, 0x2d, 0x26, 0xb9, 0x04,
-	0xe8, 0x8f, 0xde, 0x99, 0x8e, 0xf7, 0x72, 0xc0, 0xd1, 0xba, 0x84, 0x61, 0xcf, 0xa7, 0x2a, 0x1a,
-	0xe9, 0xa1, 0xf5, 0x7f, 0x85, 0x34, 0xd9, 0x5a, 0x36, 0xc3, 0xbe, 0x7f, 0x1f, 0x8a, 0xdc, 0xcc,
-	0x04, 0xa9, 0xe5, 0x9d, 0xb5, 0xd8, 0x94, 0x8e, 0x48, 0xe4, 0xb4, 0x71, 0xc0, 0xde, 0x51, 0x12,
-	0xd9, 0x02, 0x24, 0xe6, 0xaa, 0x90, 0xe2, 0xea, 0x3e, 0x2c, 0x61, 0x9f, 0x91, 0x28, 0xc0, 0xcc,
-	0x3b, 0x27, 0x4d, 0xb1, 0x2e, 0xa9, 0xaf, 0xa5, 0xe6, 0x5f, 0x2b, 0x01, 0xfc, 0x48, 0x4e, 0xa9,
-	0xc7, 0x88, 0xe2, 0x40, 0x0f, 0xf9, 0x8a, 0x60, 0x34, 0x1a, 0x68, 0x1e, 0xd4, 0x50, 0xf0, 0xcc,
-	0xb8, 0x3e, 0xe6, 0x14, 0xcf, 0x7c, 0xc0, 0x83, 0xcf, 0xdf, 0x78, 0x3d, 0x11, 0x0f, 0x16, 0x6c,
-	0xfe, 0xc9, 0x49, 0x73, 0x3c, 0x36, 0xa8, 0x2f, 0x48, 0xd2, 0xf8, 0x77, 0x5a, 0xe0, 0x60, 0x0a,
-	0xfc, 0x21, 0x20, 0xed, 0x77, 0xd8, 0x75, 0x3d, 0xe6, 0x85, 0x01, 0xf6, 0x95, 0x7f, 0x2e, 0xab,
-	0x95, 0xdd, 0x78, 0x01, 0x3d, 0x82, 0x95, 0x88, 0xb4, 0x3c, 0xca, 0x22, 0xcc, 0x67, 0xb4, 0x92,
-	0x16, 0x05, 0x3c, 0x4a, 0x2f, 0x29, 0x4d, 0xad, 0xc1, 0x2c, 0xc3, 0x3f, 0x71, 0x6f, 0xa9, 0x28,
-	0x9f, 0xc7, 0x3f, 0x1d, 0xba, 0xe8, 0x13, 0x98, 0x77, 0xc2, 0x80, 0x61, 0x87, 0x51, 0xe1, 0x8e,
-	0xe5, 0x9d, 0xfa, 0x88, 0xb8, 0xf7, 0x24, 0x80, 0x1d, 0x43, 0xa2, 0x8f, 0x61, 0xee, 0x54, 0x9a,
-	0x9c, 0x70, 0xd6, 0xf2, 0xce, 0x86, 0x0c, 0x80, 0x23, 0x16, 0x69, 0x6b, 0x38, 0xeb, 0x26, 0xd4,
-	0xf6, 0xbd, 0xc0, 0x7d, 0x3e, 0x38, 0x74, 0xc7, 0xa8, 0xdd, 0xfa, 0xef, 0x3c, 0xac, 0xeb, 0x33,
-	0x5f, 0x79, 0x94, 0xa5, 0x2c, 0x24, 0xeb, 0x36, 0xda, 0x84, 0x05, 0x8f, 0x36, 0xb9, 0xfb, 0x12,
-	0x57, 0x39, 0xd1, 0xbc, 0x47, 0x4f, 0xc4, 0x18, 0x7d, 0x0c, 0x6b, 0x3e, 0xa6, 0xac, 0xd9, 0xc3,
-	0x83, 0xb0, 0xcf, 0x78, 0x28, 0x23, 0x4d, 0xe1, 0x7f, 0xdc, 0x50, 0x0a, 0x36, 0xe2, 0x8b, 0xc7,
-	0x62, 0xed, 0x05, 0x66, 0x64, 0x9f, 0x7b, 0xe3, 0x43, 0x58, 0x19, 0xd9, 0xc2, 0x42, 0x61, 0x39,
-	0x05, 0x7b, 0xc9, 0xdc, 0xf0, 0x36, 0x44, 0x1f, 0x01, 0x4a, 0x83, 0x1b, 0xf7, 0x4c, 0x0a, 0x5a,
-	0x5d, 0x27, 0x08, 0x8a, 0x34, 0x8c, 0xf8, 0x4d, 0x53, 0xe0, 0x0c, 0xf0, 0x6f, 0x6e, 0x48, 0xbe,
-	0xd7, 0xf5, 0x98, 0x30, 0xa4, 0x92, 0x2d, 0x07, 0x3c, 0xd8, 0x84, 0x67, 0x67, 0x94, 0xc8, 0xbb,
-	0xa5, 0x64, 0xab, 0x11, 0xbf, 0xb3, 0x7e, 0xe8, 0x7b, 0x4e, 0xa7, 0x49, 0x09, 0x8e, 0x9c, 0xb6,
-	0x32, 0xab, 0xb2, 0x98, 0x3b, 0x11, 0x53, 0xdc, 0xfd, 0x65, 0x64, 0x22, 0xdc, 0xbc, 0x0a, 0x5c,
-	0x20, 0x7a, 0x6c, 0x7d, 0x0b, 0x1b, 0x23, 0xb2, 0x55, 0xf1, 0x65, 0x15, 0x4a, 0xf2, 0xa2, 0x95,
-	0xb1, 0x49, 0x0e, 0xd0, 0x5d, 0x1d, 0xd0, 0xf3, 0x22, 0xa0, 0x2f, 0x8f, 0x98, 0x85, 0x8a, 0xe7,
-	0xd6, 0x2f, 0x39, 0xd8, 0x8c, 0x4d, 0xa5, 0x8d, 0x83, 0x16, 0x39, 0x11, 0x87, 0x6a, 0xdd, 0x5d,
-	0x87, 0x72, 0x57, 0x2d, 0x27, 0xc1, 0x1a, 0xf4, 0xd4, 0xa1, 0xcb, 0x2f, 0x74, 0x71, 0xef, 0x78,
-	0xae, 0x7e, 0x14, 0xf1, 0xa1, 0x7a, 0x2c, 0xc9, 0xb8, 0x5b, 0x18, 0xf7, 0xc0, 0x2b, 0x1a, 0x0f,
-	0x3c, 0xeb, 0xef, 0x60, 0x45, 0xc4, 0x6e, 0xcf, 0x11, 0xb6, 0xff, 0xfe, 0x24, 0xf0, 0x9b, 0xd2,
-	0x63, 0xbe, 0x0e, 0x30, 0x72, 0x30, 0x81, 0x00, 0x1b, 0x2a, 0x69, 0x02, 0xe8, 0x18, 0xe1, 0xfe,
-	0xc1, 0x14, 0x6e, 0x12, 0xe2, 0x0c, 0xea, 0x95, 0x80, 0x7f, 0xcd, 0x41, 0x43, 0xe9, 0xec, 0xb7,
-	0x65, 0x4e, 0x79, 0xd0, 0x80, 0x32, 0xd2, 0x55, 0x22, 0xe6, 0x1e, 0x24, 0xc6, 0x89, 0x75, 0x16,
-	0xb3, 0xad, 0xb3, 0x64, 0x58, 0x67, 0x86, 0x7d, 0x5b, 0x2d, 0xb8, 0xa6, 0xc8, 0xd6, 0xe6, 0x61,
-	0x3c, 0x67, 0xd0, 0xc1, 0xe8, 0x53, 0x48, 0xe6, 0x2b, 0x7f, 0x32, 0x62, 0x6b, 0x13, 0xdf, 0x41,
-	0x56, 0x00, 0xd7, 0x0f, 0x08, 0xcb, 0x86, 0xbd, 0xa8, 0x90, 0x1e, 0xc0, 0xb2, 0x49, 0x4c, 0x22,
-	0xae, 0x9a, 0x71, 0xdc, 0xa1, 0x6b, 0xfd, 0x7d, 0x0e, 0x6e, 0x8c, 0x3f, 0xf0, 0xca, 0xd9, 0xc9,
-	0x0e, 0x14, 0x3d, 0xad, 0x89, 0xe9, 0x42, 0x10, 0xb0, 0x9c, 0x94, 0x9b, 0x5c, 0xc8, 0x99, 0x30,
-	0x17, 0x77, 0xc1, 0x6d, 0x58, 0x19, 0xe2, 0x3e, 0xf5, 0x56, 0x58, 0x36, 0xf8, 0x17, 0xf7, 0xa6,
-	0x56, 0x77, 0x21, 0xa5, 0xee, 0xff, 0xcd, 0xc3, 0xb5, 0xcb, 0xe8, 0x20, 0x3f, 0x42, 0xc5, 0x09,
-	0x54, 0x4d, 0x2a, 0x94, 0x28, 0x3e, 0x9a, 0x2c, 0x8a, 0x43, 0x97, 0x04, 0x29, 0xb7, 0xa8, 0x18,
-	0xe4, 0xa2, 0x43, 0x00, 0x27, 0xec, 0x76, 0x3d, 0x4a, 0xbd, 0x30, 0x10, 0xc6, 0x5c, 0xde, 0xb9,
-	0x3f, 0x19, 0xe1, 0x5e, 0x0c, 0x4f, 0xed, 0xd4, 0x66, 0xf4, 0x35, 0x94, 0xbd, 0x80, 0x91, 0x96,
-	0xbc, 0x58, 0x85, 0x07, 0x4c, 0xc5, 0x75, 0x98, 0x6c, 0xb0, 0xd3, 0xbb, 0x95, 0xf3, 0x61, 0x87,
-	0xbf, 0x45, 0xc4, 0x13, 0x63, 0x9e, 0x3b, 0xdf, 0xae, 0x18, 0xa7, 0x5d, 0x76, 0x2e, 0xed, 0xb2,
-	0xd6, 0xcf, 0x39, 0xd8, 0xfa, 0x63, 0xb0, 0xbb, 0xf3, 0x24, 0xe6, 0xa7, 0x3c, 0xe1, 0x3d, 0x88,
-	0xb8, 0x6d, 0x10, 0x91, 0x71, 0xdb, 0xc8, 0x73, 0x4f, 0x61, 0xfd, 0x80, 0xb0, 0x2b, 0x85, 0xc1,
-	0xbb, 0x50, 0x0b, 0x52, 0xfb, 0x12, 0x13, 0xac, 0xa6, 0xa7, 0x0f, 0x5d, 0x7e, 0x89, 0xe8, 0x4a,
-	0xc3, 0x59, 0x3f, 0x70, 0x2f, 0x96, 0xd5, 0xab, 0xfb, 0x3f, 0x6f, 0xe4, 0x99, 0x5b, 0x00, 0x0e,
-	0xc7, 0x14, 0x8a, 0x4d, 0x2a, 0xe1, 0x50, 0x33, 0x72, 0x5b, 0x44, 0x30, 0x55, 0x66, 0xb9, 0x60,
-	0xab, 0x91, 0xd5, 0x85, 0x55, 0x93, 0x80, 0x2b, 0x4b, 0xf5, 0x96, 0x21, 0xd5, 0x5a, 0x2c, 0x55,
-	0x85, 0x58, 0xca, 0xf4, 0x3b, 0x40, 0x3c, 0x84, 0xc8, 0x39, 0x7a, 0x01, 0x76, 0x2f, 0xf5, 0x70,
-	0xb1, 0x6c, 0x58, 0x31, 0xd0, 0x4f, 0x7c, 0x75, 0xdc, 0x36, 0x2f, 0xc6, 0x11, 0x8a, 0xd5, 0x95,
-	0xf8, 0x15, 0x2c, 0x1d, 0x10, 0x76, 0x61, 0xfd, 0x6c, 0xc2, 0x42, 0x24, 0x60, 0x13, 0xa5, 0xcf,
-	0xcb, 0x89, 0x43, 0xd7, 0xfa, 0x0e, 0x6a, 0x7b, 0xd8, 0xf7, 0x4f, 0xb1, 0xd3, 0xd1, 0xa8, 0xea,
-	0x30, 0xd7, 0xc6, 0x81, 0xeb, 0x93, 0x48, 0x63, 0x52, 0x43, 0x1e, 0xf8, 0x4e, 0x43, 0x77, 0xa0,
-	0x12, 0x4b, 0xf1, 0x3d, 0x25, 0xab, 0x6c, 0xc3, 0x56, 0x2a, 0xa1, 0xe7, 0x39, 0xb3, 0x7c, 0x24,
-	0x5d, 0x84, 0x6e, 0x04, 0x45, 0x1f, 0x07, 0x2d, 0x9d, 0xb3, 0xf1, 0xef, 0x74, 0x61, 0xa4, 0x60,
-	0x14, 0x46, 0xac, 0x7f, 0xc9, 0xc1, 0x8d, 0xd4, 0x51, 0x3c, 0x4b, 0x92, 0x47, 0xbd, 0xc2, 0xc9,
-	0x4b, 0xfa, 0x92, 0xa7, 0xfd, 0x4e, 0xe5, 0x40, 0xeb, 0xbf, 0x72, 0xf0, 0x20, 0x93, 0x56, 0x35,
-	0xb9, 0x2b, 0x79, 0xba, 0x98, 0x6e, 0x87, 0x2f, 0xec, 0xf9, 0xae, 0xba, 0x17, 0xc6, 0x0b, 0x4b,
-	0x31, 0x56, 0x9c, 0xc4, 0x58, 0xe9, 0x02, 0x8c, 0x0d, 0x57, 0xdb, 0xac, 0x57, 0x00, 0x9c, 0x99,
-	0xc3, 0x1e, 0xd7, 0x74, 0x3a, 0x8b, 0xcc, 0x99, 0x59, 0xa4, 0xce, 0x0e, 0xf3, 0xa9, 0xec, 0x50,
-	0xe5, 0x90, 0x85, 0x38, 0x87, 0xb4, 0xfe, 0x29, 0x07, 0x37, 0x33, 0xad, 0x47, 0xbb, 0xd1, 0x21,
-	0x7f, 0xaa, 0x4d, 0x2e, 0xdc, 0xe4, 0x26, 0x17, 0x6e, 0x76, 0x60, 0x51, 0x5e, 0x35, 0x3d, 0xb1,
-	0x4f, 0x25, 0xdd, 0x4b, 0x32, 0xa1, 0x4b, 0x58, 0xb1, 0x05, 0xd7, 0xf2, 0xdb, 0xfa, 0x87, 0xdc,
-	0x18, 0xa3, 0x7e, 0x8f, 0x50, 0xf5, 0x99, 0x11, 0xaa, 0xee, 0x8e, 0x54, 0xd4, 0xb2, 0x79, 0x57,
-	0x21, 0x6c, 0x00, 0xd7, 0x8e, 0xa3, 0xd0, 0x21, 0x94, 0x3e, 0x97, 0xf1, 0x42, 0x71, 0x7a, 0xb1,
-	0x6a, 0x91, 0x56, 0x51, 0x3e, 0x5b, 0x45, 0x85, 0x51, 0x15, 0x15, 0x13, 0x15, 0xfd, 0xc2, 0x55,
-	0x94, 0x7d, 0x76, 0x4a, 0x45, 0xa9, 0xa2, 0x65, 0x3e, 0xab, 0x68, 0x59, 0xc8, 0x2a, 0x5a, 0x16,
-	0x27, 0x16, 0x2d, 0x4b, 0x23, 0x45, 0x4b, 0xa9, 0x97, 0x49, 0xb4, 0xfc, 0x66, 0x7a, 0x99, 0xc6,
-	0xb0, 0xd2, 0xcb, 0x31, 0xac, 0xa6, 0x9e, 0x07, 0xcf, 0x07, 0xef, 0x9d, 0xb3, 0x58, 0xff, 0x9a,
-	0x87, 0x0f, 0xa4, 0x19, 0x68, 0xac, 0xe9, 0xca, 0xfb, 0x54, 0xbc, 0xb7, 0xa1, 0x8a, 0x5b, 0x11,
-	0x21, 0xe2, 0x91, 0xc9, 0x06, 0x3d, 0xa2, 0x2a, 0x07, 0x95, 0x78, 0xf6, 0xed, 0xa0, 0x47, 0xd0,
-	0x27, 0xb0, 0xce, 0xd5, 0x15, 0xe3, 0x32, 0xe3, 0xfb, 0xbc, 0xbd, 0xda, 0xc6, 0x54, 0x9f, 0x7f,
-	0xa2, 0xd7, 0x78, 0x0e, 0xc1, 0x77, 0xf5, 0x68, 0x2f, 0xb5, 0x41, 0xf6, 0x0f, 0x6a, 0x6d, 0x4c,
-	0x8f, 0x69, 0x2f, 0x81, 0xfd, 0x33, 0xd8, 0x48, 0x08, 0xa1, 0xfc, 0x9f, 0x73, 0x0f, 0x37, 0x45,
-	0xfd, 0xb3, 0x24, 0x8f, 0x88, 0x97, 0x4f, 0x48, 0xc0, 0xbe, 0xf1, 0xf0, 0x11, 0xf6, 0x7c, 0xf4,
-	0x11, 0x20, 0x0e, 0xd3, 0x64, 0x11, 0x76, 0x3a, 0x5e, 0xd0, 0x6a, 0xfa, 0x5e, 0xd0, 0x51, 0x51,
-	0x68, 0x89, 0xaf, 0xbc, 0x55, 0x0b, 0xaf, 0xbc, 0xa0, 0x63, 0xf5, 0xa1, 0x91, 0x25, 0xab, 0xdf,
-	0xfb, 0x91, 0xe6, 0xc3, 0xd6, 0x49, 0xa2, 0xf5, 0x93, 0xc7, 0xbb, 0x9a, 0x93, 0xcb, 0x64, 0x63,
-	0xf4, 0x71, 0x33, 0x11, 0x50, 0x2a, 0x1b, 0xa9, 0xd1, 0x04, 0x1f, 0xcf, 0x45, 0xac, 0x7f, 0x9b,
-	0x83, 0xb9, 0xe3, 0x28, 0x74, 0xfb, 0xce, 0x68, 0x25, 0x71, 0x6a, 0xca, 0xb1, 0x05, 0xa0, 0x2a,
-	0xf3, 0xa9, 0x17, 0x9a, 0x9a, 0x91, 0x2f, 0xb4, 0xf0, 0xf4, 0x7b, 0xdd, 0x06, 0x5a, 0xb0, 0xd5,
-	0x88, 0x87, 0x06, 0x61, 0x3c, 0xf2, 0x8a, 0x10, 0xdf, 0xdc, 0x93, 0x69, 0xa7, 0xaf, 0x74, 0xc1,
-	0x3f, 0xd1, 0x1f, 0x54, 0xd5, 0x6a, 0x4e, 0xbc, 0x65, 0x36, 0x62, 0xd7, 0xe1, 0xa4, 0x6e, 0x73,
-	0xda, 0x55, 0xe3, 0x4d, 0x57, 0x2d, 0x5d, 0x72, 0x86, 0xfb, 0x3e, 0x6b, 0xc6, 0x35, 0x5c, 0x59,
-	0x4d, 0xac, 0xa9, 0xf9, 0x3d, 0x5d, 0xca, 0xad, 0xc3, 0x1c, 0x09, 0xf0, 0xa9, 0x4f, 0x5c, 0x51,
-	0x05, 0x9a, 0xb7, 0xf5, 0x10, 0x3d, 0x80, 0xd9, 0x5e, 0xe4, 0x39, 0xaa, 0xfe, 0x53, 0xde, 0x41,
-	0xc6, 0x99, 0xc7, 0x7c, 0xc9, 0x56, 0x10, 0xe8, 0x4b, 0x28, 0xbb, 0x84, 0x3a, 0x91, 0xd7, 0x13,
-	0xd9, 0x4c, 0x59, 0xa5, 0xde, 0x06, 0x91, 0x2f, 0x12, 0x00, 0x49, 0x6b, 0x7a, 0x0b, 0x3a, 0x82,
-	0x25, 0x3f, 0x0c, 0x5a, 0xcd, 0x34, 0x9a, 0x45, 0x81, 0xc6, 0x32, 0xd1, 0xbc, 0x0a, 0x83, 0xd6,
-	0x08, 0xaa, 0x9a, 0x6f, 0xce, 0xa2, 0x4f, 0xd5, 0x6b, 0x99, 0xb8, 0x4d, 0xcc, 0x44, 0x99, 0xb2,
-	0xbc, 0xd3, 0xd8, 0x96, 0xbd, 0xd3, 0x6d, 0xdd, 0x3b, 0xdd, 0x7e, 0xab, 0x7b, 0xa7, 0xea, 0x25,
-	0x4d, 0xdc, 0x5d, 0xc6, 0xb7, 0xf6, 0x7b, 0xae, 0xde, 0x5a, 0x9d, 0xbe, 0x55, 0x41, 0xef, 0x8a,
-	0x70, 0xeb, 0x75, 0x71, 0x8b, 0xd0, 0x7a, 0x4d, 0x24, 0xb3, 0x6a, 0xc4, 0xd5, 0xd9, 0x8f, 0xfc,
-	0xfa, 0x92, 0x54, 0x67, 0x3f, 0xf2, 0xd1, 0x13, 0xe0, 0x0f, 0x0b, 0x2c, 0x6e, 0xc9, 0x65, 0xc1,
-	0xe6, 0xa6, 0xc9, 0xe6, 0x91, 0x5a, 0x95, 0xfc, 0xc5, 0xc0, 0xb2, 0x1e, 0xee, 0x13, 0x46, 0xdc,
-	0x3a, 0x92, 0xfa, 0x52, 0xc3, 0xc6, 0x13, 0x58, 0x88, 0xed, 0xe0, 0x32, 0x5d, 0xd1, 0xc6, 0x17,
-	0xb0, 0x34, 0x2c, 0xd0, 0x4b, 0xed, 0x7f, 0x0e, 0xab, 0x59, 0x4a, 0xb9, 0x14, 0x8e, 0xcf, 0xa0,
-	0x62, 0x70, 0x7c, 0xa9, 0xb6, 0xee, 0x73, 0x58, 0x4c, 0x5b, 0x65, 0xea, 0xd6, 0xcb, 0x19, 0xb7,
-	0x5e, 0xba, 0xa5, 0x91, 0x37, 0x5b, 0x1a, 0xfc, 0xc1, 0x2b, 0x52, 0x0b, 0x85, 0x88, 0x4e, 0xaa,
-	0x16, 0x2b, 0xef, 0xcc, 0x27, 0xde, 0x19, 0x67, 0x31, 0x85, 0xec, 0x2c, 0xa6, 0x68, 0x14, 0xb8,
-	0x86, 0x22, 0x49, 0x69, 0x4a, 0x24, 0x99, 0x1d, 0x8a, 0x24, 0xd6, 0x11, 0x34, 0x0e, 0x48, 0x4c,
-	0xe9, 0x7e, 0x18, 0x89, 0xbe, 0x98, 0xa6, 0xd8, 0xdc, 0x9c, 0x1b, 0x0e, 0x43, 0x4b, 0x50, 0xf0,
-	0x5c, 0x99, 0x13, 0x2d, 0xd8, 0xfc, 0xd3, 0xfa, 0x39, 0x07, 0xab, 0x26, 0xeb, 0x49, 0x5a, 0x25,
-	0xb9, 0xca, 0x65, 0x73, 0x95, 0x37, 0xb8, 0x12, 0x8d, 0x40, 0x86, 0x7d, 0x2d, 0x03, 0x31, 0x40,
-	0xf7, 0x61, 0xbe, 0xa7, 0xf0, 0xd6, 0x4b, 0xc2, 0xd0, 0x2b, 0x86, 0xa1, 0xdb, 0xf1, 0xb2, 0xb5,
-	0x0b, 0x55, 0xc5, 0xc3, 0x55, 0x43, 0xb0, 0xf5, 0x05, 0xa0, 0xc3, 0x8f, 0x9f, 0xbe, 0x7e, 0x4b,
-	0x7e, 0x62, 0xb2, 0x8e, 0xcd, 0x43, 0x59, 0x9c, 0x8c, 0xe4, 0x52, 0xc9, 0x48, 0xa6, 0x35, 0x59,
-	0x21, 0xac, 0xa9, 0xd4, 0x41, 0xf5, 0x5a, 0xaf, 0x7e, 0xbf, 0x7d, 0x68, 0xdc, 0x6f, 0x4b, 0x49,
-	0x47, 0x56, 0x61, 0x96, 0xd7, 0xdb, 0x09, 0x2c, 0x4b, 0x55, 0xca, 0xd3, 0x2e, 0x78, 0xa5, 0x99,
-	0x2a, 0xce, 0x0f, 0xdb, 0xc7, 0x7f, 0x24, 0xb6, 0xcc, 0x67, 0x2e, 0x5e, 0xba, 0x8b, 0x15, 0x9e,
-	0xcf, 0x56, 0x78, 0x61, 0x62, 0x17, 0xa1, 0x38, 0xb9, 0x8b, 0x50, 0x32, 0xbb, 0x08, 0x99, 0x65,
-	0xde, 0xb7, 0xb1, 0x25, 0x2a, 0xc2, 0x27, 0x26, 0xf8, 0x77, 0xcc, 0x04, 0x7f, 0x54, 0xc6, 0x2a,
-	0xc3, 0xff, 0x1e, 0x16, 0xdf, 0x86, 0x1d, 0x12, 0x97, 0x77, 0xee, 0xa8, 0x9e, 0x60, 0x4e, 0xa8,
-	0x26, 0x69, 0x2f, 0x0b, 0xa0, 0x54, 0x43, 0x70, 0x07, 0xe6, 0x29, 0x61, 0xcc, 0x0b, 0x5a, 0x54,
-	0xa5, 0x32, 0xeb, 0x26, 0xec, 0x89, 0x5a, 0xb5, 0x63, 0x38, 0xeb, 0x2f, 0xa1, 0xa2, 0xce, 0xba,
-	0xb2, 0xe5, 0xc4, 0x1d, 0xf5, 0x42, 0xaa, 0xa3, 0x6e, 0x9d, 0xc3, 0xad, 0xbd, 0x36, 0x71, 0x3a,
-	0xa6, 0xad, 0xc4, 0x8f, 0xc0, 0x54, 0xbc, 0x12, 0x45, 0x05, 0x65, 0xeb, 0xa2, 0xa8, 0x30, 0xd9,
-	0x5c, 0xa6, 0xd4, 0x1c, 0xbe, 0x85, 0x0f, 0x27, 0x9f, 0x7b, 0x55, 0x3e, 0x77, 0xfe, 0xb9, 0x01,
-	0x55, 0xf5, 0xea, 0x3f, 0x21, 0xd1, 0x39, 0x8f, 0xdc, 0x7b, 0x80, 0x44, 0x2c, 0x93, 0x25, 0x2b,
-	0x95, 0x19, 0xa0, 0x4d, 0xf3, 0x07, 0x00, 0xc6, 0x8f, 0x8e, 0x1a, 0x55, 0x73, 0xd1, 0x9a, 0x41,
-	0xce, 0xb8, 0x1f, 0xa3, 0x08, 0x64, 0xe3, 0x7f, 0x5f, 0xa1, 0x31, 0xde, 0x9c, 0x00, 0xa1, 0x7e,
-	0x2c, 0x35, 0x83, 0xfe, 0x62, 0xe8, 0xd7, 0x52, 0x1a, 0x7d, 0x63, 0xfc, 0xef, 0xa3, 0x1a, 0x9b,
-	0x99, 0x6b, 0x31, 0xca, 0x13, 0x58, 0xd7, 0x4b, 0xaa, 0x86, 0x94, 0x8d, 0xd4, 0xf8, 0xf1, 0xc2,
-	0x10, 0x52, 0xf3, 0xd7, 0x06, 0xd6, 0x0c, 0xfa, 0x14, 0x16, 0x6d, 0x72, 0xda, 0xf7, 0x7c, 0x77,
-	0x0f, 0x3b, 0x6d, 0x82, 0xd4, 0x2b, 0x2e, 0xfd, 0x5b, 0xb0, 0xc6, 0x8a, 0x31, 0x17, 0x6f, 0xfd,
-	0x04, 0xca, 0xef, 0xc4, 0xd3, 0x46, 0x08, 0x16, 0x0d, 0x09, 0x7a, 0xdc, 0xae, 0x67, 0x50, 0x95,
-	0xbb, 0xf4, 0x9b, 0x1d, 0x8d, 0xbe, 0xed, 0xc7, 0xed, 0x3d, 0x80, 0xea, 0x01, 0x61, 0xa9, 0xdf,
-	0x2d, 0xa0, 0xba, 0x04, 0x1c, 0xfd, 0x11, 0x44, 0xe3, 0x83, 0x8c, 0x95, 0x18, 0xd1, 0x31, 0x54,
-	0x8c, 0x5c, 0x51, 0x4b, 0x30, 0x2b, 0x81, 0xd4, 0xfa, 0x9e, 0x50, 0x83, 0xb6, 0x66, 0xd0, 0x6b,
-	0xa8, 0xa4, 0x7b, 0x23, 0x14, 0x5d, 0x33, 0x77, 0x99, 0x4d, 0xe6, 0xc6, 0xd6, 0x98, 0xd5, 0x18,
-	0xdf, 0x17, 0x50, 0x35, 0xd3, 0x29, 0x34, 0xd2, 0xf7, 0xd6, 0xb8, 0x46, 0xe5, 0x27, 0xe8, 0x59,
-	0x35, 0xf7, 0xcb, 0x46, 0x29, 0x1a, 0x62, 0x26, 0xa3, 0x89, 0x9a, 0x8d, 0xef, 0xaf, 0x00, 0x8d,
-	0xa6, 0x77, 0xe8, 0xba, 0x12, 0xf2, 0xb8, 0x24, 0xb9, 0x71, 0x63, 0x3c, 0x40, 0xcc, 0x2a, 0x86,
-	0xf5, 0xec, 0x14, 0x0e, 0xdd, 0x92, 0xbb, 0x27, 0x26, 0x78, 0x17, 0x3a, 0xe2, 0xcf, 0x01, 0x49,
-	0x77, 0x4a, 0x57, 0xf3, 0x91, 0x32, 0x91, 0x8c, 0x0a, 0x7f, 0x23, 0xbb, 0x4b, 0x2a, 0x30, 0xd5,
-	0x86, 0x9a, 0x02, 0x5a, 0xd3, 0xd9, 0xbd, 0x82, 0xf1, 0x98, 0x5e, 0xc1, 0x32, 0x57, 0xbb, 0xd9,
-	0xc2, 0x55, 0xcc, 0x8c, 0x6f, 0xc1, 0x6a, 0xd7, 0x30, 0xb6, 0x59, 0x33, 0xe8, 0x0d, 0xac, 0x1f,
-	0xe1, 0xa8, 0x93, 0x9e, 0xde, 0xa5, 0x36, 0xc1, 0xee, 0x55, 0xc9, 0xeb, 0xc8, 0x46, 0x70, 0x76,
-	0xb3, 0x0f, 0xdd, 0x4d, 0xe8, 0x9c, 0xd8, 0x0e, 0x6c, 0x58, 0x06, 0x43, 0x99, 0xb0, 0xe2, 0xb0,
-	0xfa, 0xb8, 0x26, 0x27, 0xba, 0x3d, 0xe2, 0x9a, 0x59, 0x1d, 0xbf, 0xc6, 0x9d, 0x69, 0x60, 0xb1,
-	0x31, 0xb4, 0x61, 0xd3, 0x34, 0x16, 0xf3, 0x3c, 0xcb, 0xf4, 0x90, 0xcc, 0xc3, 0x6e, 0x4d, 0x84,
-	0x49, 0xc5, 0xab, 0xc5, 0x74, 0x73, 0x45, 0x1b, 0x5c, 0x46, 0xc7, 0xa7, 0xd1, 0xc8, 0x5a, 0x8a,
-	0x11, 0xbd, 0x80, 0x72, 0xaa, 0xaf, 0xa1, 0xa3, 0xde, 0x68, 0x27, 0x45, 0x47, 0xbd, 0x8c, 0x26,
-	0x88, 0x35, 0x83, 0x76, 0x61, 0x21, 0xee, 0x64, 0xa0, 0xf5, 0x58, 0x5e, 0x97, 0x21, 0xe4, 0x08,
-	0xd6, 0xd4, 0xa5, 0x23, 0x97, 0xf4, 0x4d, 0x84, 0xd6, 0xd4, 0x36, 0xb3, 0xbb, 0x31, 0xed, 0xf6,
-	0xe9, 0x18, 0x57, 0xb1, 0xae, 0x7b, 0xab, 0xa6, 0x05, 0xba, 0x33, 0x72, 0xd1, 0x66, 0xf6, 0x19,
-	0xb4, 0x36, 0x26, 0x16, 0x70, 0xad, 0x19, 0xf4, 0xb7, 0x46, 0xcb, 0xc2, 0x2c, 0xfe, 0xeb, 0x23,
-	0xff, 0x74, 0xc2, 0x91, 0x99, 0xed, 0x82, 0x8b, 0x1e, 0x7e, 0x1a, 0x0b, 0xce, 0x2c, 0x64, 0x22,
-	0x6b, 0x62, 0x95, 0xd3, 0x3c, 0x63, 0x52, 0x25, 0xd4, 0x9a, 0x41, 0x4f, 0x60, 0x4d, 0xaa, 0xed,
-	0x4d, 0x24, 0xaf, 0x58, 0x9d, 0x27, 0x99, 0x29, 0x55, 0xc3, 0x1c, 0x4a, 0x3b, 0x4d, 0x27, 0x78,
-	0x28, 0x65, 0x45, 0x43, 0xf9, 0xae, 0x36, 0x8f, 0xac, 0x7c, 0xd0, 0x9a, 0x41, 0x8f, 0x01, 0x92,
-	0xcc, 0x13, 0xad, 0x4a, 0x58, 0x33, 0x69, 0x1b, 0x3d, 0xfd, 0x73, 0xa8, 0xbc, 0x10, 0x35, 0x8a,
-	0xc9, 0xfb, 0xc6, 0xbc, 0x09, 0xde, 0xc1, 0x4a, 0x46, 0xb2, 0xab, 0x03, 0xe9, 0xf8, 0x3c, 0x78,
-	0x0a, 0x27, 0x2f, 0xa1, 0xb2, 0xeb, 0xba, 0xf2, 0xf7, 0x29, 0xfb, 0x84, 0x50, 0xb4, 0x15, 0x07,
-	0x4a, 0x63, 0x7e, 0xca, 0x1b, 0xe9, 0x6b, 0xd8, 0x38, 0x20, 0x2c, 0x01, 0xdf, 0x0f, 0x23, 0x65,
-	0x29, 0x29, 0x84, 0x06, 0x84, 0x46, 0x98, 0xb4, 0x23, 0xf7, 0x09, 0x39, 0x21, 0x4c, 0xdc, 0x3d,
-	0x1c, 0xd9, 0xae, 0xc3, 0xfa, 0xd8, 0x4f, 0x36, 0x70, 0x06, 0x32, 0x9f, 0x6d, 0x1b, 0x31, 0x06,
-	0x13, 0xd8, 0x9a, 0x41, 0x5f, 0x42, 0xc5, 0xc8, 0x63, 0xd1, 0x48, 0x6e, 0xa4, 0x3d, 0x37, 0x33,
-	0xdd, 0x15, 0x11, 0x09, 0x92, 0xc4, 0x54, 0xbf, 0x4d, 0x46, 0x52, 0xd5, 0x69, 0x58, 0x12, 0xc3,
-	0x13, 0xf9, 0xdc, 0x90, 0xe1, 0xa5, 0x93, 0xd3, 0x21, 0x75, 0x19, 0xe9, 0x9f, 0x40, 0x94, 0xd8,
-	0xd0, 0x7b, 0x51, 0xf4, 0x14, 0xca, 0xd2, 0x87, 0x44, 0x96, 0xa6, 0xe5, 0x9a, 0x4e, 0x0f, 0xb5,
-	0xaa, 0x8d, 0x34, 0xce, 0x9a, 0x41, 0x3f, 0xc2, 0xb5, 0x49, 0x89, 0x10, 0xba, 0xaf, 0x0f, 0x9e,
-	0x9a, 0xa4, 0x35, 0x1e, 0x5c, 0x04, 0x54, 0x1f, 0xfc, 0xfc, 0xf3, 0xbf, 0x7e, 0xd6, 0xf2, 0x58,
-	0xbb, 0x7f, 0xba, 0xed, 0x84, 0xdd, 0x47, 0x3d, 0x3c, 0xa0, 0xfd, 0x1e, 0x89, 0xe2, 0x8f, 0x87,
-	0x4a, 0xb3, 0x0f, 0x29, 0x89, 0xce, 0xf9, 0x7c, 0xa7, 0x25, 0xff, 0x3a, 0x44, 0xfc, 0xf1, 0xc8,
-	0xe9, 0xac, 0xf8, 0x7e, 0xfc, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0x15, 0x39, 0xc4, 0x50,
-	0x32, 0x00, 0x00,
+func (m *CaptureOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type VoidOrderRequest struct {
+	// @inject_tag: validate:"required,uuid"
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *VoidOrderRequest) Reset()         { *m = VoidOrderRequest{} }
+func (m *VoidOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*VoidOrderRequest) ProtoMessage()    {}
+func (*VoidOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{68}
+}
+
+func (m *VoidOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoidOrderRequest.Unmarshal(m, b)
+}
+func (m *VoidOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoidOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *VoidOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoidOrderRequest.Merge(m, src)
+}
+func (m *VoidOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_VoidOrderRequest.Size(m)
+}
+func (m *VoidOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoidOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoidOrderRequest proto.InternalMessageInfo
+
+func (m *VoidOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type OrderOperationResponse struct {
+	Status               int32          `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string         `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Order `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *OrderOperationResponse) Reset()         { *m = OrderOperationResponse{} }
+func (m *OrderOperationResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderOperationResponse) ProtoMessage()    {}
+func (*OrderOperationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{69}
+}
+
+func (m *OrderOperationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderOperationResponse.Unmarshal(m, b)
+}
+func (m *OrderOperationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderOperationResponse.Marshal(b, m, deterministic)
+}
+func (m *OrderOperationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderOperationResponse.Merge(m, src)
+}
+func (m *OrderOperationResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderOperationResponse.Size(m)
+}
+func (m *OrderOperationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderOperationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderOperationResponse proto.InternalMessageInfo
+
+func (m *OrderOperationResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *OrderOperationResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *OrderOperationResponse) GetItem() *billing.Order {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListOutboxMessagesRequest struct {
+	// @inject_tag: query:"status" validate:"omitempty,numeric,gte=0"
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty" query:"status" validate:"omitempty,numeric,gte=0"`
+	// @inject_tag: query:"order_id" validate:"omitempty,hexadecimal,len=24"
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" query:"order_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOutboxMessagesRequest) Reset()         { *m = ListOutboxMessagesRequest{} }
+func (m *ListOutboxMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOutboxMessagesRequest) ProtoMessage()    {}
+func (*ListOutboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{70}
+}
+
+func (m *ListOutboxMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOutboxMessagesRequest.Unmarshal(m, b)
+}
+func (m *ListOutboxMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOutboxMessagesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListOutboxMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOutboxMessagesRequest.Merge(m, src)
+}
+func (m *ListOutboxMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOutboxMessagesRequest.Size(m)
+}
+func (m *ListOutboxMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOutboxMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOutboxMessagesRequest proto.InternalMessageInfo
+
+func (m *ListOutboxMessagesRequest) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListOutboxMessagesRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ListOutboxMessagesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListOutboxMessagesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListOutboxMessagesResponse struct {
+	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.OutboxMessage `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListOutboxMessagesResponse) Reset()         { *m = ListOutboxMessagesResponse{} }
+func (m *ListOutboxMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOutboxMessagesResponse) ProtoMessage()    {}
+func (*ListOutboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{71}
+}
+
+func (m *ListOutboxMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOutboxMessagesResponse.Unmarshal(m, b)
+}
+func (m *ListOutboxMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOutboxMessagesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListOutboxMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOutboxMessagesResponse.Merge(m, src)
+}
+func (m *ListOutboxMessagesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOutboxMessagesResponse.Size(m)
+}
+func (m *ListOutboxMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOutboxMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOutboxMessagesResponse proto.InternalMessageInfo
+
+func (m *ListOutboxMessagesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListOutboxMessagesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListOutboxMessagesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListOutboxMessagesResponse) GetItems() []*billing.OutboxMessage {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ReplayOutboxMessagesRequest struct {
+	// @inject_tag: validate:"omitempty,dive,hexadecimal,len=24"
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty" validate:"omitempty,dive,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ReplayOutboxMessagesRequest) Reset()         { *m = ReplayOutboxMessagesRequest{} }
+func (m *ReplayOutboxMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReplayOutboxMessagesRequest) ProtoMessage()    {}
+func (*ReplayOutboxMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{72}
+}
+
+func (m *ReplayOutboxMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayOutboxMessagesRequest.Unmarshal(m, b)
+}
+func (m *ReplayOutboxMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayOutboxMessagesRequest.Marshal(b, m, deterministic)
+}
+func (m *ReplayOutboxMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayOutboxMessagesRequest.Merge(m, src)
+}
+func (m *ReplayOutboxMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_ReplayOutboxMessagesRequest.Size(m)
+}
+func (m *ReplayOutboxMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayOutboxMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayOutboxMessagesRequest proto.InternalMessageInfo
+
+func (m *ReplayOutboxMessagesRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type ReplayOutboxMessagesResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ReplayOutboxMessagesResponse) Reset()         { *m = ReplayOutboxMessagesResponse{} }
+func (m *ReplayOutboxMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*ReplayOutboxMessagesResponse) ProtoMessage()    {}
+func (*ReplayOutboxMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{73}
+}
+
+func (m *ReplayOutboxMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplayOutboxMessagesResponse.Unmarshal(m, b)
+}
+func (m *ReplayOutboxMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplayOutboxMessagesResponse.Marshal(b, m, deterministic)
+}
+func (m *ReplayOutboxMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayOutboxMessagesResponse.Merge(m, src)
+}
+func (m *ReplayOutboxMessagesResponse) XXX_Size() int {
+	return xxx_messageInfo_ReplayOutboxMessagesResponse.Size(m)
+}
+func (m *ReplayOutboxMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayOutboxMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayOutboxMessagesResponse proto.InternalMessageInfo
+
+func (m *ReplayOutboxMessagesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReplayOutboxMessagesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ReplayOutboxMessagesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetOrderStatusHistoryRequest struct {
+	// @inject_tag: validate:"required,uuid"
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" validate:"required,uuid"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetOrderStatusHistoryRequest) Reset()         { *m = GetOrderStatusHistoryRequest{} }
+func (m *GetOrderStatusHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderStatusHistoryRequest) ProtoMessage()    {}
+func (*GetOrderStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{74}
+}
+
+func (m *GetOrderStatusHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderStatusHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetOrderStatusHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderStatusHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderStatusHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderStatusHistoryRequest.Merge(m, src)
+}
+func (m *GetOrderStatusHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderStatusHistoryRequest.Size(m)
+}
+func (m *GetOrderStatusHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderStatusHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderStatusHistoryRequest proto.InternalMessageInfo
+
+func (m *GetOrderStatusHistoryRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type GetOrderStatusHistoryResponse struct {
+	Status               int32                        `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CurrentStatus        int32                        `protobuf:"varint,3,opt,name=current_status,json=currentStatus,proto3" json:"current_status,omitempty"`
+	Items                []*billing.OrderStatusChange `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                        `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetOrderStatusHistoryResponse) Reset()         { *m = GetOrderStatusHistoryResponse{} }
+func (m *GetOrderStatusHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderStatusHistoryResponse) ProtoMessage()    {}
+func (*GetOrderStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{75}
+}
+
+func (m *GetOrderStatusHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderStatusHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetOrderStatusHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderStatusHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderStatusHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderStatusHistoryResponse.Merge(m, src)
+}
+func (m *GetOrderStatusHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderStatusHistoryResponse.Size(m)
+}
+func (m *GetOrderStatusHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderStatusHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderStatusHistoryResponse proto.InternalMessageInfo
+
+func (m *GetOrderStatusHistoryResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetOrderStatusHistoryResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetOrderStatusHistoryResponse) GetCurrentStatus() int32 {
+	if m != nil {
+		return m.CurrentStatus
+	}
+	return 0
+}
+
+func (m *GetOrderStatusHistoryResponse) GetItems() []*billing.OrderStatusChange {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	// @inject_tag: query:"order_id" validate:"required,hexadecimal,len=24"
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" query:"order_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListWebhookDeliveriesRequest) Reset()         { *m = ListWebhookDeliveriesRequest{} }
+func (m *ListWebhookDeliveriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookDeliveriesRequest) ProtoMessage()    {}
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{76}
+}
+
+func (m *ListWebhookDeliveriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookDeliveriesRequest.Unmarshal(m, b)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookDeliveriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookDeliveriesRequest.Merge(m, src)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookDeliveriesRequest.Size(m)
+}
+func (m *ListWebhookDeliveriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookDeliveriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookDeliveriesRequest proto.InternalMessageInfo
+
+func (m *ListWebhookDeliveriesRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListWebhookDeliveriesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Status               int32                      `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                      `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.WebhookDelivery `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                      `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListWebhookDeliveriesResponse) Reset()         { *m = ListWebhookDeliveriesResponse{} }
+func (m *ListWebhookDeliveriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookDeliveriesResponse) ProtoMessage()    {}
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{77}
+}
+
+func (m *ListWebhookDeliveriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookDeliveriesResponse.Unmarshal(m, b)
+}
+func (m *ListWebhookDeliveriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookDeliveriesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListWebhookDeliveriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookDeliveriesResponse.Merge(m, src)
+}
+func (m *ListWebhookDeliveriesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookDeliveriesResponse.Size(m)
+}
+func (m *ListWebhookDeliveriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookDeliveriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookDeliveriesResponse proto.InternalMessageInfo
+
+func (m *ListWebhookDeliveriesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListWebhookDeliveriesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListWebhookDeliveriesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListWebhookDeliveriesResponse) GetItems() []*billing.WebhookDelivery {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ResendWebhookDeliveryRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ResendWebhookDeliveryRequest) Reset()         { *m = ResendWebhookDeliveryRequest{} }
+func (m *ResendWebhookDeliveryRequest) String() string { return proto.CompactTextString(m) }
+func (*ResendWebhookDeliveryRequest) ProtoMessage()    {}
+func (*ResendWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{78}
+}
+
+func (m *ResendWebhookDeliveryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResendWebhookDeliveryRequest.Unmarshal(m, b)
+}
+func (m *ResendWebhookDeliveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResendWebhookDeliveryRequest.Marshal(b, m, deterministic)
+}
+func (m *ResendWebhookDeliveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResendWebhookDeliveryRequest.Merge(m, src)
+}
+func (m *ResendWebhookDeliveryRequest) XXX_Size() int {
+	return xxx_messageInfo_ResendWebhookDeliveryRequest.Size(m)
+}
+func (m *ResendWebhookDeliveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResendWebhookDeliveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResendWebhookDeliveryRequest proto.InternalMessageInfo
+
+func (m *ResendWebhookDeliveryRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ResendWebhookDeliveryResponse struct {
+	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.WebhookDelivery `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ResendWebhookDeliveryResponse) Reset()         { *m = ResendWebhookDeliveryResponse{} }
+func (m *ResendWebhookDeliveryResponse) String() string { return proto.CompactTextString(m) }
+func (*ResendWebhookDeliveryResponse) ProtoMessage()    {}
+func (*ResendWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{79}
+}
+
+func (m *ResendWebhookDeliveryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResendWebhookDeliveryResponse.Unmarshal(m, b)
+}
+func (m *ResendWebhookDeliveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResendWebhookDeliveryResponse.Marshal(b, m, deterministic)
+}
+func (m *ResendWebhookDeliveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResendWebhookDeliveryResponse.Merge(m, src)
+}
+func (m *ResendWebhookDeliveryResponse) XXX_Size() int {
+	return xxx_messageInfo_ResendWebhookDeliveryResponse.Size(m)
+}
+func (m *ResendWebhookDeliveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResendWebhookDeliveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResendWebhookDeliveryResponse proto.InternalMessageInfo
+
+func (m *ResendWebhookDeliveryResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ResendWebhookDeliveryResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ResendWebhookDeliveryResponse) GetItem() *billing.WebhookDelivery {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type GetMerchantBalanceRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantBalanceRequest) Reset()         { *m = GetMerchantBalanceRequest{} }
+func (m *GetMerchantBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantBalanceRequest) ProtoMessage()    {}
+func (*GetMerchantBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{80}
+}
+
+func (m *GetMerchantBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantBalanceRequest.Unmarshal(m, b)
+}
+func (m *GetMerchantBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMerchantBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantBalanceRequest.Merge(m, src)
+}
+func (m *GetMerchantBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantBalanceRequest.Size(m)
+}
+func (m *GetMerchantBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantBalanceRequest proto.InternalMessageInfo
+
+func (m *GetMerchantBalanceRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+type GetMerchantBalanceResponse struct {
+	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.MerchantBalance `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *GetMerchantBalanceResponse) Reset()         { *m = GetMerchantBalanceResponse{} }
+func (m *GetMerchantBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMerchantBalanceResponse) ProtoMessage()    {}
+func (*GetMerchantBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{81}
+}
+
+func (m *GetMerchantBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMerchantBalanceResponse.Unmarshal(m, b)
+}
+func (m *GetMerchantBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMerchantBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMerchantBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMerchantBalanceResponse.Merge(m, src)
+}
+func (m *GetMerchantBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMerchantBalanceResponse.Size(m)
+}
+func (m *GetMerchantBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMerchantBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMerchantBalanceResponse proto.InternalMessageInfo
+
+func (m *GetMerchantBalanceResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetMerchantBalanceResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *GetMerchantBalanceResponse) GetItem() *billing.MerchantBalance {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListLedgerEntriesRequest struct {
+	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"order_id" validate:"omitempty,hexadecimal,len=24"
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" query:"order_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"account"
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty" query:"account"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListLedgerEntriesRequest) Reset()         { *m = ListLedgerEntriesRequest{} }
+func (m *ListLedgerEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLedgerEntriesRequest) ProtoMessage()    {}
+func (*ListLedgerEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{82}
+}
+
+func (m *ListLedgerEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLedgerEntriesRequest.Unmarshal(m, b)
+}
+func (m *ListLedgerEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLedgerEntriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLedgerEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLedgerEntriesRequest.Merge(m, src)
+}
+func (m *ListLedgerEntriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLedgerEntriesRequest.Size(m)
+}
+func (m *ListLedgerEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLedgerEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLedgerEntriesRequest proto.InternalMessageInfo
+
+func (m *ListLedgerEntriesRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListLedgerEntriesRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ListLedgerEntriesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *ListLedgerEntriesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListLedgerEntriesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListLedgerEntriesResponse struct {
+	Status               int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.LedgerEntry `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListLedgerEntriesResponse) Reset()         { *m = ListLedgerEntriesResponse{} }
+func (m *ListLedgerEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLedgerEntriesResponse) ProtoMessage()    {}
+func (*ListLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{83}
+}
+
+func (m *ListLedgerEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLedgerEntriesResponse.Unmarshal(m, b)
+}
+func (m *ListLedgerEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLedgerEntriesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLedgerEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLedgerEntriesResponse.Merge(m, src)
+}
+func (m *ListLedgerEntriesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLedgerEntriesResponse.Size(m)
+}
+func (m *ListLedgerEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLedgerEntriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLedgerEntriesResponse proto.InternalMessageInfo
+
+func (m *ListLedgerEntriesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListLedgerEntriesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListLedgerEntriesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListLedgerEntriesResponse) GetItems() []*billing.LedgerEntry {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListPayoutsRequest struct {
+	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"status[]" validate:"omitempty,dive,numeric,gte=0"
+	Status []int32 `protobuf:"varint,2,rep,packed,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,numeric,gte=0"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListPayoutsRequest) Reset()         { *m = ListPayoutsRequest{} }
+func (m *ListPayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPayoutsRequest) ProtoMessage()    {}
+func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{84}
+}
+
+func (m *ListPayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPayoutsRequest.Unmarshal(m, b)
+}
+func (m *ListPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPayoutsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPayoutsRequest.Merge(m, src)
+}
+func (m *ListPayoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPayoutsRequest.Size(m)
+}
+func (m *ListPayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPayoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPayoutsRequest proto.InternalMessageInfo
+
+func (m *ListPayoutsRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListPayoutsRequest) GetStatus() []int32 {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListPayoutsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListPayoutsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListPayoutsResponse struct {
+	Status               int32             `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32             `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.Payout `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListPayoutsResponse) Reset()         { *m = ListPayoutsResponse{} }
+func (m *ListPayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPayoutsResponse) ProtoMessage()    {}
+func (*ListPayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{85}
+}
+
+func (m *ListPayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPayoutsResponse.Unmarshal(m, b)
+}
+func (m *ListPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPayoutsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPayoutsResponse.Merge(m, src)
+}
+func (m *ListPayoutsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPayoutsResponse.Size(m)
+}
+func (m *ListPayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPayoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPayoutsResponse proto.InternalMessageInfo
+
+func (m *ListPayoutsResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListPayoutsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListPayoutsResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListPayoutsResponse) GetItems() []*billing.Payout {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type PayoutRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PayoutId             string   `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PayoutRequest) Reset()         { *m = PayoutRequest{} }
+func (m *PayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*PayoutRequest) ProtoMessage()    {}
+func (*PayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{86}
+}
+
+func (m *PayoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutRequest.Unmarshal(m, b)
+}
+func (m *PayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutRequest.Marshal(b, m, deterministic)
+}
+func (m *PayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutRequest.Merge(m, src)
+}
+func (m *PayoutRequest) XXX_Size() int {
+	return xxx_messageInfo_PayoutRequest.Size(m)
+}
+func (m *PayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutRequest proto.InternalMessageInfo
+
+func (m *PayoutRequest) GetPayoutId() string {
+	if m != nil {
+		return m.PayoutId
+	}
+	return ""
+}
+
+type MarkPayoutPaidRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PayoutId string `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required"
+	TransactionId        string   `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MarkPayoutPaidRequest) Reset()         { *m = MarkPayoutPaidRequest{} }
+func (m *MarkPayoutPaidRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkPayoutPaidRequest) ProtoMessage()    {}
+func (*MarkPayoutPaidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{87}
+}
+
+func (m *MarkPayoutPaidRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkPayoutPaidRequest.Unmarshal(m, b)
+}
+func (m *MarkPayoutPaidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkPayoutPaidRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkPayoutPaidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkPayoutPaidRequest.Merge(m, src)
+}
+func (m *MarkPayoutPaidRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkPayoutPaidRequest.Size(m)
+}
+func (m *MarkPayoutPaidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkPayoutPaidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkPayoutPaidRequest proto.InternalMessageInfo
+
+func (m *MarkPayoutPaidRequest) GetPayoutId() string {
+	if m != nil {
+		return m.PayoutId
+	}
+	return ""
+}
+
+func (m *MarkPayoutPaidRequest) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+type MarkPayoutFailedRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PayoutId string `protobuf:"bytes,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required"
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MarkPayoutFailedRequest) Reset()         { *m = MarkPayoutFailedRequest{} }
+func (m *MarkPayoutFailedRequest) String() string { return proto.CompactTextString(m) }
+func (*MarkPayoutFailedRequest) ProtoMessage()    {}
+func (*MarkPayoutFailedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{88}
+}
+
+func (m *MarkPayoutFailedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarkPayoutFailedRequest.Unmarshal(m, b)
+}
+func (m *MarkPayoutFailedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarkPayoutFailedRequest.Marshal(b, m, deterministic)
+}
+func (m *MarkPayoutFailedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkPayoutFailedRequest.Merge(m, src)
+}
+func (m *MarkPayoutFailedRequest) XXX_Size() int {
+	return xxx_messageInfo_MarkPayoutFailedRequest.Size(m)
+}
+func (m *MarkPayoutFailedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkPayoutFailedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkPayoutFailedRequest proto.InternalMessageInfo
+
+func (m *MarkPayoutFailedRequest) GetPayoutId() string {
+	if m != nil {
+		return m.PayoutId
+	}
+	return ""
+}
+
+func (m *MarkPayoutFailedRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type PayoutResponse struct {
+	Status               int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Payout `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32           `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PayoutResponse) Reset()         { *m = PayoutResponse{} }
+func (m *PayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*PayoutResponse) ProtoMessage()    {}
+func (*PayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{89}
+}
+
+func (m *PayoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutResponse.Unmarshal(m, b)
+}
+func (m *PayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutResponse.Marshal(b, m, deterministic)
+}
+func (m *PayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutResponse.Merge(m, src)
+}
+func (m *PayoutResponse) XXX_Size() int {
+	return xxx_messageInfo_PayoutResponse.Size(m)
+}
+func (m *PayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutResponse proto.InternalMessageInfo
+
+func (m *PayoutResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PayoutResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PayoutResponse) GetItem() *billing.Payout {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListDisputesRequest struct {
+	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"order_id" validate:"omitempty,hexadecimal,len=24"
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" query:"order_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"status[]" validate:"omitempty,dive,numeric,gte=0"
+	Status []int32 `protobuf:"varint,3,rep,packed,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,numeric,gte=0"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListDisputesRequest) Reset()         { *m = ListDisputesRequest{} }
+func (m *ListDisputesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDisputesRequest) ProtoMessage()    {}
+func (*ListDisputesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{90}
+}
+
+func (m *ListDisputesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDisputesRequest.Unmarshal(m, b)
+}
+func (m *ListDisputesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDisputesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDisputesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDisputesRequest.Merge(m, src)
+}
+func (m *ListDisputesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDisputesRequest.Size(m)
+}
+func (m *ListDisputesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDisputesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDisputesRequest proto.InternalMessageInfo
+
+func (m *ListDisputesRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListDisputesRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ListDisputesRequest) GetStatus() []int32 {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDisputesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDisputesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDisputesResponse struct {
+	Status               int32              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32              `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.Dispute `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32              `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListDisputesResponse) Reset()         { *m = ListDisputesResponse{} }
+func (m *ListDisputesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDisputesResponse) ProtoMessage()    {}
+func (*ListDisputesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{91}
+}
+
+func (m *ListDisputesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDisputesResponse.Unmarshal(m, b)
+}
+func (m *ListDisputesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDisputesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDisputesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDisputesResponse.Merge(m, src)
+}
+func (m *ListDisputesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDisputesResponse.Size(m)
+}
+func (m *ListDisputesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDisputesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDisputesResponse proto.InternalMessageInfo
+
+func (m *ListDisputesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListDisputesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListDisputesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListDisputesResponse) GetItems() []*billing.Dispute {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type DisputeRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	DisputeId string `protobuf:"bytes,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *DisputeRequest) Reset()         { *m = DisputeRequest{} }
+func (m *DisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*DisputeRequest) ProtoMessage()    {}
+func (*DisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{92}
+}
+
+func (m *DisputeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeRequest.Unmarshal(m, b)
+}
+func (m *DisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeRequest.Marshal(b, m, deterministic)
+}
+func (m *DisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeRequest.Merge(m, src)
+}
+func (m *DisputeRequest) XXX_Size() int {
+	return xxx_messageInfo_DisputeRequest.Size(m)
+}
+func (m *DisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeRequest proto.InternalMessageInfo
+
+func (m *DisputeRequest) GetDisputeId() string {
+	if m != nil {
+		return m.DisputeId
+	}
+	return ""
+}
+
+func (m *DisputeRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+type AddDisputeEvidenceRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	DisputeId string `protobuf:"bytes,1,opt,name=dispute_id,json=disputeId,proto3" json:"dispute_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"required,hexadecimal,len=24"`
+	Text       string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// @inject_tag: validate:"omitempty,dive"
+	Files                []*billing.DisputeEvidenceFile `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty" validate:"omitempty,dive"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *AddDisputeEvidenceRequest) Reset()         { *m = AddDisputeEvidenceRequest{} }
+func (m *AddDisputeEvidenceRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDisputeEvidenceRequest) ProtoMessage()    {}
+func (*AddDisputeEvidenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{93}
+}
+
+func (m *AddDisputeEvidenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDisputeEvidenceRequest.Unmarshal(m, b)
+}
+func (m *AddDisputeEvidenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDisputeEvidenceRequest.Marshal(b, m, deterministic)
+}
+func (m *AddDisputeEvidenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDisputeEvidenceRequest.Merge(m, src)
+}
+func (m *AddDisputeEvidenceRequest) XXX_Size() int {
+	return xxx_messageInfo_AddDisputeEvidenceRequest.Size(m)
+}
+func (m *AddDisputeEvidenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDisputeEvidenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDisputeEvidenceRequest proto.InternalMessageInfo
+
+func (m *AddDisputeEvidenceRequest) GetDisputeId() string {
+	if m != nil {
+		return m.DisputeId
+	}
+	return ""
+}
+
+func (m *AddDisputeEvidenceRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *AddDisputeEvidenceRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *AddDisputeEvidenceRequest) GetFiles() []*billing.DisputeEvidenceFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+type DisputeResponse struct {
+	Status               int32            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Dispute `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32            `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *DisputeResponse) Reset()         { *m = DisputeResponse{} }
+func (m *DisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*DisputeResponse) ProtoMessage()    {}
+func (*DisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{94}
+}
+
+func (m *DisputeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeResponse.Unmarshal(m, b)
+}
+func (m *DisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeResponse.Marshal(b, m, deterministic)
+}
+func (m *DisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeResponse.Merge(m, src)
+}
+func (m *DisputeResponse) XXX_Size() int {
+	return xxx_messageInfo_DisputeResponse.Size(m)
+}
+func (m *DisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeResponse proto.InternalMessageInfo
+
+func (m *DisputeResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *DisputeResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DisputeResponse) GetItem() *billing.Dispute {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type CreateSubscriptionRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	ProductId string `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	CustomerId string `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	StoredCardId string `protobuf:"bytes,4,opt,name=stored_card_id,json=storedCardId,proto3" json:"stored_card_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,alpha,len=3"
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty" validate:"required,alpha,len=3"`
+	// @inject_tag: validate:"omitempty,email"
+	Email                string   `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty" validate:"omitempty,email"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CreateSubscriptionRequest) Reset()         { *m = CreateSubscriptionRequest{} }
+func (m *CreateSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSubscriptionRequest) ProtoMessage()    {}
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{95}
+}
+
+func (m *CreateSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSubscriptionRequest.Unmarshal(m, b)
+}
+func (m *CreateSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSubscriptionRequest.Merge(m, src)
+}
+func (m *CreateSubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSubscriptionRequest.Size(m)
+}
+func (m *CreateSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CreateSubscriptionRequest) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetStoredCardId() string {
+	if m != nil {
+		return m.StoredCardId
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *CreateSubscriptionRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type SubscriptionRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *SubscriptionRequest) Reset()         { *m = SubscriptionRequest{} }
+func (m *SubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionRequest) ProtoMessage()    {}
+func (*SubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{96}
+}
+
+func (m *SubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionRequest.Unmarshal(m, b)
+}
+func (m *SubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionRequest.Merge(m, src)
+}
+func (m *SubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionRequest.Size(m)
+}
+func (m *SubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionRequest proto.InternalMessageInfo
+
+func (m *SubscriptionRequest) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *SubscriptionRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+type CancelSubscriptionRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId           string   `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	AtPeriodEnd          bool     `protobuf:"varint,3,opt,name=at_period_end,json=atPeriodEnd,proto3" json:"at_period_end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CancelSubscriptionRequest) Reset()         { *m = CancelSubscriptionRequest{} }
+func (m *CancelSubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelSubscriptionRequest) ProtoMessage()    {}
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{97}
+}
+
+func (m *CancelSubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelSubscriptionRequest.Unmarshal(m, b)
+}
+func (m *CancelSubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelSubscriptionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelSubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelSubscriptionRequest.Merge(m, src)
+}
+func (m *CancelSubscriptionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelSubscriptionRequest.Size(m)
+}
+func (m *CancelSubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelSubscriptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelSubscriptionRequest proto.InternalMessageInfo
+
+func (m *CancelSubscriptionRequest) GetSubscriptionId() string {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return ""
+}
+
+func (m *CancelSubscriptionRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *CancelSubscriptionRequest) GetAtPeriodEnd() bool {
+	if m != nil {
+		return m.AtPeriodEnd
+	}
+	return false
+}
+
+type ListSubscriptionsRequest struct {
+	// @inject_tag: query:"merchant_id" validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"customer_id" validate:"omitempty,hexadecimal,len=24"
+	CustomerId string `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty" query:"customer_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"product_id" validate:"omitempty,hexadecimal,len=24"
+	ProductId string `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty" query:"product_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"status[]" validate:"omitempty,dive,numeric,gte=0"
+	Status []int32 `protobuf:"varint,4,rep,packed,name=status,proto3" json:"status,omitempty" query:"status[]" validate:"omitempty,dive,numeric,gte=0"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListSubscriptionsRequest) Reset()         { *m = ListSubscriptionsRequest{} }
+func (m *ListSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsRequest) ProtoMessage()    {}
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{98}
+}
+
+func (m *ListSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsRequest.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsRequest.Merge(m, src)
+}
+func (m *ListSubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsRequest.Size(m)
+}
+func (m *ListSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsRequest proto.InternalMessageInfo
+
+func (m *ListSubscriptionsRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetCustomerId() string {
+	if m != nil {
+		return m.CustomerId
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetProductId() string {
+	if m != nil {
+		return m.ProductId
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsRequest) GetStatus() []int32 {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListSubscriptionsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListSubscriptionsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListSubscriptionsResponse struct {
+	Status               int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.Subscription `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListSubscriptionsResponse) Reset()         { *m = ListSubscriptionsResponse{} }
+func (m *ListSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSubscriptionsResponse) ProtoMessage()    {}
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{99}
+}
+
+func (m *ListSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSubscriptionsResponse.Unmarshal(m, b)
+}
+func (m *ListSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSubscriptionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSubscriptionsResponse.Merge(m, src)
+}
+func (m *ListSubscriptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSubscriptionsResponse.Size(m)
+}
+func (m *ListSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSubscriptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSubscriptionsResponse proto.InternalMessageInfo
+
+func (m *ListSubscriptionsResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListSubscriptionsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListSubscriptionsResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListSubscriptionsResponse) GetItems() []*billing.Subscription {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type SubscriptionResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.Subscription `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *SubscriptionResponse) Reset()         { *m = SubscriptionResponse{} }
+func (m *SubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionResponse) ProtoMessage()    {}
+func (*SubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{100}
+}
+
+func (m *SubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionResponse.Unmarshal(m, b)
+}
+func (m *SubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionResponse.Merge(m, src)
+}
+func (m *SubscriptionResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionResponse.Size(m)
+}
+func (m *SubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionResponse proto.InternalMessageInfo
+
+func (m *SubscriptionResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *SubscriptionResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SubscriptionResponse) GetItem() *billing.Subscription {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type CommissionPlanResponse struct {
+	Status               int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.CommissionPlan `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                   `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CommissionPlanResponse) Reset()         { *m = CommissionPlanResponse{} }
+func (m *CommissionPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*CommissionPlanResponse) ProtoMessage()    {}
+func (*CommissionPlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{101}
+}
+
+func (m *CommissionPlanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommissionPlanResponse.Unmarshal(m, b)
+}
+func (m *CommissionPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommissionPlanResponse.Marshal(b, m, deterministic)
+}
+func (m *CommissionPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionPlanResponse.Merge(m, src)
+}
+func (m *CommissionPlanResponse) XXX_Size() int {
+	return xxx_messageInfo_CommissionPlanResponse.Size(m)
+}
+func (m *CommissionPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionPlanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionPlanResponse proto.InternalMessageInfo
+
+func (m *CommissionPlanResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *CommissionPlanResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CommissionPlanResponse) GetItem() *billing.CommissionPlan {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ListCommissionPlansRequest struct {
+	// @inject_tag: query:"merchant_id" validate:"required,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" query:"merchant_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"payment_method_id" validate:"omitempty,hexadecimal,len=24"
+	PaymentMethodId string `protobuf:"bytes,2,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty" query:"payment_method_id" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListCommissionPlansRequest) Reset()         { *m = ListCommissionPlansRequest{} }
+func (m *ListCommissionPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCommissionPlansRequest) ProtoMessage()    {}
+func (*ListCommissionPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{102}
+}
+
+func (m *ListCommissionPlansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCommissionPlansRequest.Unmarshal(m, b)
+}
+func (m *ListCommissionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCommissionPlansRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCommissionPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommissionPlansRequest.Merge(m, src)
+}
+func (m *ListCommissionPlansRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCommissionPlansRequest.Size(m)
+}
+func (m *ListCommissionPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommissionPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCommissionPlansRequest proto.InternalMessageInfo
+
+func (m *ListCommissionPlansRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListCommissionPlansRequest) GetPaymentMethodId() string {
+	if m != nil {
+		return m.PaymentMethodId
+	}
+	return ""
+}
+
+func (m *ListCommissionPlansRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCommissionPlansRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListCommissionPlansResponse struct {
+	Status               int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                     `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.CommissionPlan `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                    `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                     `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListCommissionPlansResponse) Reset()         { *m = ListCommissionPlansResponse{} }
+func (m *ListCommissionPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCommissionPlansResponse) ProtoMessage()    {}
+func (*ListCommissionPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{103}
+}
+
+func (m *ListCommissionPlansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCommissionPlansResponse.Unmarshal(m, b)
+}
+func (m *ListCommissionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCommissionPlansResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCommissionPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCommissionPlansResponse.Merge(m, src)
+}
+func (m *ListCommissionPlansResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCommissionPlansResponse.Size(m)
+}
+func (m *ListCommissionPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCommissionPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCommissionPlansResponse proto.InternalMessageInfo
+
+func (m *ListCommissionPlansResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListCommissionPlansResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListCommissionPlansResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListCommissionPlansResponse) GetItems() []*billing.CommissionPlan {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListSystemFeesHistoryRequest struct {
+	// @inject_tag: query:"method_id" validate:"required,hexadecimal,len=24"
+	MethodId string `protobuf:"bytes,1,opt,name=method_id,json=methodId,proto3" json:"method_id,omitempty" query:"method_id" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: query:"region" validate:"omitempty,alpha"
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty" query:"region" validate:"omitempty,alpha"`
+	// @inject_tag: query:"card_brand" validate:"omitempty,alpha"
+	CardBrand string `protobuf:"bytes,3,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty" query:"card_brand" validate:"omitempty,alpha"`
+	// @inject_tag: query:"limit" validate:"omitempty,numeric,gt=0"
+	Limit int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty" query:"limit" validate:"omitempty,numeric,gt=0"`
+	// @inject_tag: query:"offset" validate:"omitempty,numeric,gte=0"
+	Offset               int32    `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty" query:"offset" validate:"omitempty,numeric,gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListSystemFeesHistoryRequest) Reset()         { *m = ListSystemFeesHistoryRequest{} }
+func (m *ListSystemFeesHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSystemFeesHistoryRequest) ProtoMessage()    {}
+func (*ListSystemFeesHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{104}
+}
+
+func (m *ListSystemFeesHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSystemFeesHistoryRequest.Unmarshal(m, b)
+}
+func (m *ListSystemFeesHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSystemFeesHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSystemFeesHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSystemFeesHistoryRequest.Merge(m, src)
+}
+func (m *ListSystemFeesHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSystemFeesHistoryRequest.Size(m)
+}
+func (m *ListSystemFeesHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSystemFeesHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSystemFeesHistoryRequest proto.InternalMessageInfo
+
+func (m *ListSystemFeesHistoryRequest) GetMethodId() string {
+	if m != nil {
+		return m.MethodId
+	}
+	return ""
+}
+
+func (m *ListSystemFeesHistoryRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *ListSystemFeesHistoryRequest) GetCardBrand() string {
+	if m != nil {
+		return m.CardBrand
+	}
+	return ""
+}
+
+func (m *ListSystemFeesHistoryRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListSystemFeesHistoryRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListSystemFeesHistoryResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string                `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Count                int32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*billing.SystemFees `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListSystemFeesHistoryResponse) Reset()         { *m = ListSystemFeesHistoryResponse{} }
+func (m *ListSystemFeesHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSystemFeesHistoryResponse) ProtoMessage()    {}
+func (*ListSystemFeesHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{105}
+}
+
+func (m *ListSystemFeesHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSystemFeesHistoryResponse.Unmarshal(m, b)
+}
+func (m *ListSystemFeesHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSystemFeesHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSystemFeesHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSystemFeesHistoryResponse.Merge(m, src)
+}
+func (m *ListSystemFeesHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSystemFeesHistoryResponse.Size(m)
+}
+func (m *ListSystemFeesHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSystemFeesHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSystemFeesHistoryResponse proto.InternalMessageInfo
+
+func (m *ListSystemFeesHistoryResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListSystemFeesHistoryResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListSystemFeesHistoryResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ListSystemFeesHistoryResponse) GetItems() []*billing.SystemFees {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ReactivateSystemFeesRequest struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" validate:"required,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,hexadecimal,len=24"
+	UserId               string               `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" validate:"required,hexadecimal,len=24"`
+	EffectiveFrom        *timestamp.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ReactivateSystemFeesRequest) Reset()         { *m = ReactivateSystemFeesRequest{} }
+func (m *ReactivateSystemFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*ReactivateSystemFeesRequest) ProtoMessage()    {}
+func (*ReactivateSystemFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{106}
+}
+
+func (m *ReactivateSystemFeesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReactivateSystemFeesRequest.Unmarshal(m, b)
+}
+func (m *ReactivateSystemFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReactivateSystemFeesRequest.Marshal(b, m, deterministic)
+}
+func (m *ReactivateSystemFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactivateSystemFeesRequest.Merge(m, src)
+}
+func (m *ReactivateSystemFeesRequest) XXX_Size() int {
+	return xxx_messageInfo_ReactivateSystemFeesRequest.Size(m)
+}
+func (m *ReactivateSystemFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactivateSystemFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactivateSystemFeesRequest proto.InternalMessageInfo
+
+func (m *ReactivateSystemFeesRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReactivateSystemFeesRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ReactivateSystemFeesRequest) GetEffectiveFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveFrom
+	}
+	return nil
+}
+
+type SystemFeesResponse struct {
+	Status               int32               `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message              string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Item                 *billing.SystemFees `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *SystemFeesResponse) Reset()         { *m = SystemFeesResponse{} }
+func (m *SystemFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*SystemFeesResponse) ProtoMessage()    {}
+func (*SystemFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81ea47a3f88c2082, []int{107}
+}
+
+func (m *SystemFeesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemFeesResponse.Unmarshal(m, b)
+}
+func (m *SystemFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemFeesResponse.Marshal(b, m, deterministic)
+}
+func (m *SystemFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemFeesResponse.Merge(m, src)
+}
+func (m *SystemFeesResponse) XXX_Size() int {
+	return xxx_messageInfo_SystemFeesResponse.Size(m)
+}
+func (m *SystemFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemFeesResponse proto.InternalMessageInfo
+
+func (m *SystemFeesResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *SystemFeesResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SystemFeesResponse) GetItem() *billing.SystemFees {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EmptyRequest)(nil), "grpc.EmptyRequest")
+	proto.RegisterType((*EmptyResponse)(nil), "grpc.EmptyResponse")
+	proto.RegisterType((*PaymentCreateRequest)(nil), "grpc.PaymentCreateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "grpc.PaymentCreateRequest.DataEntry")
+	proto.RegisterType((*PaymentCreateResponse)(nil), "grpc.PaymentCreateResponse")
+	proto.RegisterType((*PaymentFormJsonDataRequest)(nil), "grpc.PaymentFormJsonDataRequest")
+	proto.RegisterType((*PaymentFormJsonDataProject)(nil), "grpc.PaymentFormJsonDataProject")
+	proto.RegisterType((*PaymentFormJsonDataResponse)(nil), "grpc.PaymentFormJsonDataResponse")
+	proto.RegisterType((*PaymentNotifyRequest)(nil), "grpc.PaymentNotifyRequest")
+	proto.RegisterType((*PaymentNotifyResponse)(nil), "grpc.PaymentNotifyResponse")
+	proto.RegisterType((*ConvertRateRequest)(nil), "grpc.ConvertRateRequest")
+	proto.RegisterType((*ImportCurrencyRatesRequest)(nil), "grpc.ImportCurrencyRatesRequest")
+	proto.RegisterType((*ImportCurrencyRate)(nil), "grpc.ImportCurrencyRate")
+	proto.RegisterType((*ImportCurrencyRatesResponse)(nil), "grpc.ImportCurrencyRatesResponse")
+	proto.RegisterType((*ConvertRateResponse)(nil), "grpc.ConvertRateResponse")
+	proto.RegisterType((*OnboardingBanking)(nil), "grpc.OnboardingBanking")
+	proto.RegisterType((*OnboardingRequest)(nil), "grpc.OnboardingRequest")
+	proto.RegisterType((*FindByIdRequest)(nil), "grpc.FindByIdRequest")
+	proto.RegisterType((*MerchantListingRequest)(nil), "grpc.MerchantListingRequest")
+	proto.RegisterType((*MerchantListingResponse)(nil), "grpc.MerchantListingResponse")
+	proto.RegisterType((*MerchantChangeStatusRequest)(nil), "grpc.MerchantChangeStatusRequest")
+	proto.RegisterType((*NotificationRequest)(nil), "grpc.NotificationRequest")
+	proto.RegisterType((*Notifications)(nil), "grpc.Notifications")
+	proto.RegisterType((*ListingNotificationRequest)(nil), "grpc.ListingNotificationRequest")
+	proto.RegisterType((*ListingMerchantPaymentMethod)(nil), "grpc.ListingMerchantPaymentMethod")
+	proto.RegisterType((*GetMerchantPaymentMethodRequest)(nil), "grpc.GetMerchantPaymentMethodRequest")
+	proto.RegisterType((*GetMerchantPaymentMethodResponse)(nil), "grpc.GetMerchantPaymentMethodResponse")
+	proto.RegisterType((*ListMerchantPaymentMethodsRequest)(nil), "grpc.ListMerchantPaymentMethodsRequest")
+	proto.RegisterType((*MerchantPaymentMethodRequest)(nil), "grpc.MerchantPaymentMethodRequest")
+	proto.RegisterType((*MerchantPaymentMethodResponse)(nil), "grpc.MerchantPaymentMethodResponse")
+	proto.RegisterType((*MerchantGetMerchantResponse)(nil), "grpc.MerchantGetMerchantResponse")
+	proto.RegisterType((*GetNotificationRequest)(nil), "grpc.GetNotificationRequest")
+	proto.RegisterType((*CreateRefundRequest)(nil), "grpc.CreateRefundRequest")
+	proto.RegisterType((*CreateRefundItem)(nil), "grpc.CreateRefundItem")
+	proto.RegisterType((*CreateRefundResponse)(nil), "grpc.CreateRefundResponse")
+	proto.RegisterType((*ListRefundsRequest)(nil), "grpc.ListRefundsRequest")
+	proto.RegisterType((*ListRefundsResponse)(nil), "grpc.ListRefundsResponse")
+	proto.RegisterType((*GetRefundRequest)(nil), "grpc.GetRefundRequest")
+	proto.RegisterType((*CallbackRequest)(nil), "grpc.CallbackRequest")
+	proto.RegisterType((*PaymentFormDataChangedRequest)(nil), "grpc.PaymentFormDataChangedRequest")
+	proto.RegisterType((*PaymentFormUserChangeLangRequest)(nil), "grpc.PaymentFormUserChangeLangRequest")
+	proto.RegisterType((*PaymentFormUserChangePaymentAccountRequest)(nil), "grpc.PaymentFormUserChangePaymentAccountRequest")
+	proto.RegisterType((*UserIpData)(nil), "grpc.UserIpData")
+	proto.RegisterType((*PaymentFormDataChangeResponseItem)(nil), "grpc.PaymentFormDataChangeResponseItem")
+	proto.RegisterType((*PaymentFormDataChangeResponse)(nil), "grpc.PaymentFormDataChangeResponse")
+	proto.RegisterType((*ProcessBillingAddressRequest)(nil), "grpc.ProcessBillingAddressRequest")
+	proto.RegisterType((*ProcessBillingAddressResponseItem)(nil), "grpc.ProcessBillingAddressResponseItem")
+	proto.RegisterType((*ProcessBillingAddressResponse)(nil), "grpc.ProcessBillingAddressResponse")
+	proto.RegisterType((*GetMerchantByRequest)(nil), "grpc.GetMerchantByRequest")
+	proto.RegisterType((*ChangeMerchantDataRequest)(nil), "grpc.ChangeMerchantDataRequest")
+	proto.RegisterType((*ChangeMerchantDataResponse)(nil), "grpc.ChangeMerchantDataResponse")
+	proto.RegisterType((*SetMerchantS3AgreementRequest)(nil), "grpc.SetMerchantS3AgreementRequest")
+	proto.RegisterType((*Product)(nil), "grpc.Product")
+	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.DescriptionEntry")
+	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.LongDescriptionEntry")
+	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.MetadataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "grpc.Product.NameEntry")
+	proto.RegisterType((*SubscriptionPlan)(nil), "grpc.SubscriptionPlan")
+	proto.RegisterType((*ProductPrice)(nil), "grpc.ProductPrice")
+	proto.RegisterType((*ListProductsRequest)(nil), "grpc.ListProductsRequest")
+	proto.RegisterType((*GetProductsForOrderRequest)(nil), "grpc.GetProductsForOrderRequest")
+	proto.RegisterType((*ListProductsResponse)(nil), "grpc.ListProductsResponse")
+	proto.RegisterType((*RequestProduct)(nil), "grpc.RequestProduct")
+	proto.RegisterType((*I18NTextSearchable)(nil), "grpc.I18NTextSearchable")
+	proto.RegisterType((*ChangeProjectResponse)(nil), "grpc.ChangeProjectResponse")
+	proto.RegisterType((*GetProjectRequest)(nil), "grpc.GetProjectRequest")
+	proto.RegisterType((*ListProjectsRequest)(nil), "grpc.ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "grpc.ListProjectsResponse")
+	proto.RegisterType((*TokenRequest)(nil), "grpc.TokenRequest")
+	proto.RegisterType((*TokenResponse)(nil), "grpc.TokenResponse")
+	proto.RegisterType((*CheckProjectRequestSignatureRequest)(nil), "grpc.CheckProjectRequestSignatureRequest")
+	proto.RegisterType((*CheckProjectRequestSignatureResponse)(nil), "grpc.CheckProjectRequestSignatureResponse")
+	proto.RegisterType((*CaptureOrderRequest)(nil), "grpc.CaptureOrderRequest")
+	proto.RegisterType((*VoidOrderRequest)(nil), "grpc.VoidOrderRequest")
+	proto.RegisterType((*OrderOperationResponse)(nil), "grpc.OrderOperationResponse")
+	proto.RegisterType((*ListOutboxMessagesRequest)(nil), "grpc.ListOutboxMessagesRequest")
+	proto.RegisterType((*ListOutboxMessagesResponse)(nil), "grpc.ListOutboxMessagesResponse")
+	proto.RegisterType((*ReplayOutboxMessagesRequest)(nil), "grpc.ReplayOutboxMessagesRequest")
+	proto.RegisterType((*ReplayOutboxMessagesResponse)(nil), "grpc.ReplayOutboxMessagesResponse")
+	proto.RegisterType((*GetOrderStatusHistoryRequest)(nil), "grpc.GetOrderStatusHistoryRequest")
+	proto.RegisterType((*GetOrderStatusHistoryResponse)(nil), "grpc.GetOrderStatusHistoryResponse")
+	proto.RegisterType((*ListWebhookDeliveriesRequest)(nil), "grpc.ListWebhookDeliveriesRequest")
+	proto.RegisterType((*ListWebhookDeliveriesResponse)(nil), "grpc.ListWebhookDeliveriesResponse")
+	proto.RegisterType((*ResendWebhookDeliveryRequest)(nil), "grpc.ResendWebhookDeliveryRequest")
+	proto.RegisterType((*ResendWebhookDeliveryResponse)(nil), "grpc.ResendWebhookDeliveryResponse")
+	proto.RegisterType((*GetMerchantBalanceRequest)(nil), "grpc.GetMerchantBalanceRequest")
+	proto.RegisterType((*GetMerchantBalanceResponse)(nil), "grpc.GetMerchantBalanceResponse")
+	proto.RegisterType((*ListLedgerEntriesRequest)(nil), "grpc.ListLedgerEntriesRequest")
+	proto.RegisterType((*ListLedgerEntriesResponse)(nil), "grpc.ListLedgerEntriesResponse")
+	proto.RegisterType((*ListPayoutsRequest)(nil), "grpc.ListPayoutsRequest")
+	proto.RegisterType((*ListPayoutsResponse)(nil), "grpc.ListPayoutsResponse")
+	proto.RegisterType((*PayoutRequest)(nil), "grpc.PayoutRequest")
+	proto.RegisterType((*MarkPayoutPaidRequest)(nil), "grpc.MarkPayoutPaidRequest")
+	proto.RegisterType((*MarkPayoutFailedRequest)(nil), "grpc.MarkPayoutFailedRequest")
+	proto.RegisterType((*PayoutResponse)(nil), "grpc.PayoutResponse")
+	proto.RegisterType((*ListDisputesRequest)(nil), "grpc.ListDisputesRequest")
+	proto.RegisterType((*ListDisputesResponse)(nil), "grpc.ListDisputesResponse")
+	proto.RegisterType((*DisputeRequest)(nil), "grpc.DisputeRequest")
+	proto.RegisterType((*AddDisputeEvidenceRequest)(nil), "grpc.AddDisputeEvidenceRequest")
+	proto.RegisterType((*DisputeResponse)(nil), "grpc.DisputeResponse")
+	proto.RegisterType((*CreateSubscriptionRequest)(nil), "grpc.CreateSubscriptionRequest")
+	proto.RegisterType((*SubscriptionRequest)(nil), "grpc.SubscriptionRequest")
+	proto.RegisterType((*CancelSubscriptionRequest)(nil), "grpc.CancelSubscriptionRequest")
+	proto.RegisterType((*ListSubscriptionsRequest)(nil), "grpc.ListSubscriptionsRequest")
+	proto.RegisterType((*ListSubscriptionsResponse)(nil), "grpc.ListSubscriptionsResponse")
+	proto.RegisterType((*SubscriptionResponse)(nil), "grpc.SubscriptionResponse")
+	proto.RegisterType((*CommissionPlanResponse)(nil), "grpc.CommissionPlanResponse")
+	proto.RegisterType((*ListCommissionPlansRequest)(nil), "grpc.ListCommissionPlansRequest")
+	proto.RegisterType((*ListCommissionPlansResponse)(nil), "grpc.ListCommissionPlansResponse")
+	proto.RegisterType((*ListSystemFeesHistoryRequest)(nil), "grpc.ListSystemFeesHistoryRequest")
+	proto.RegisterType((*ListSystemFeesHistoryResponse)(nil), "grpc.ListSystemFeesHistoryResponse")
+	proto.RegisterType((*ReactivateSystemFeesRequest)(nil), "grpc.ReactivateSystemFeesRequest")
+	proto.RegisterType((*SystemFeesResponse)(nil), "grpc.SystemFeesResponse")
+}
+
+func init() { proto.RegisterFile("grpc/grpc.proto", fileDescriptor_81ea47a3f88c2082) }
+
+var fileDescriptor_81ea47a3f88c2082 = []byte{
+	// 5238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0x4b, 0x6f, 0x24, 0x47,
+	0x72, 0xf0, 0x74, 0x37, 0x9b, 0x8f, 0x20, 0xbb, 0xc9, 0x49, 0x3e, 0xa6, 0x59, 0x1c, 0xee, 0x70,
+	0x6a, 0x24, 0x8d, 0x46, 0x9a, 0xe1, 0xec, 0x52, 0xfb, 0x41, 0x4f, 0x08, 0xcb, 0xe1, 0x3c, 0x44,
+	0x69, 0x1e, 0xdc, 0xe2, 0x48, 0xbb, 0xfb, 0xd9, 0x8b, 0x46, 0xb2, 0x2b, 0xd9, 0x2c, 0xb1, 0xba,
+	0xaa, 0x55, 0x95, 0x4d, 0xa9, 0xbd, 0x0b, 0xec, 0xc5, 0x36, 0xac, 0x3d, 0xf8, 0xb1, 0x58, 0x60,
+	0x6f, 0x86, 0x01, 0x9f, 0xbc, 0x67, 0x2f, 0x7c, 0x34, 0xec, 0xb3, 0xcf, 0x3e, 0xf8, 0x60, 0xc0,
+	0xfe, 0x13, 0xbe, 0x1a, 0xf9, 0xaa, 0xca, 0xec, 0xce, 0xea, 0x6e, 0x72, 0x28, 0xc0, 0x97, 0x99,
+	0xce, 0xcc, 0xc8, 0xc8, 0x88, 0x8c, 0xc8, 0xc8, 0xc8, 0x88, 0x28, 0xc2, 0x62, 0x3b, 0xe9, 0xb6,
+	0xee, 0xb3, 0x7f, 0xb6, 0xbb, 0x49, 0x4c, 0x63, 0x34, 0xc5, 0x7e, 0x3b, 0x37, 0xda, 0x71, 0xdc,
+	0x0e, 0xc9, 0x7d, 0xde, 0x77, 0xd4, 0x3b, 0xbe, 0x4f, 0x83, 0x0e, 0x49, 0x29, 0xee, 0x74, 0x05,
+	0x98, 0xb3, 0x7a, 0x14, 0x84, 0x61, 0x10, 0xb5, 0xef, 0xcb, 0xff, 0x45, 0xb7, 0x5b, 0x87, 0x85,
+	0x47, 0x9d, 0x2e, 0xed, 0x7b, 0xe4, 0xab, 0x1e, 0x49, 0xa9, 0xbb, 0x08, 0x35, 0xd9, 0x4e, 0xbb,
+	0x71, 0x94, 0x12, 0xf7, 0xd7, 0x65, 0x58, 0x39, 0xc0, 0xfd, 0x0e, 0x89, 0xe8, 0x5e, 0x42, 0x30,
+	0x25, 0x12, 0x12, 0xbd, 0x07, 0x53, 0x3e, 0xa6, 0xb8, 0x51, 0xda, 0xaa, 0xbc, 0x39, 0xbf, 0xf3,
+	0xda, 0x36, 0x27, 0xc9, 0x06, 0xb9, 0xfd, 0x10, 0x53, 0xfc, 0x28, 0xa2, 0x49, 0xdf, 0xe3, 0x33,
+	0x50, 0x1d, 0xca, 0x41, 0xb7, 0x51, 0xd9, 0x2a, 0xbd, 0x39, 0xe7, 0x95, 0x83, 0x2e, 0xba, 0x0d,
+	0x8b, 0xb8, 0xd5, 0x22, 0x5d, 0xda, 0x0c, 0x71, 0xd4, 0xee, 0xe1, 0x36, 0x69, 0x4c, 0xf1, 0xc1,
+	0xba, 0xe8, 0x7e, 0x2a, 0x7b, 0xd1, 0x26, 0x40, 0x2f, 0x25, 0x49, 0x13, 0xb7, 0x49, 0x44, 0x1b,
+	0x55, 0x0e, 0x33, 0xc7, 0x7a, 0x76, 0x59, 0x07, 0xc3, 0x13, 0xf8, 0xa4, 0xd3, 0x8d, 0x29, 0x89,
+	0x5a, 0xfd, 0xe6, 0x29, 0xe9, 0x37, 0xa6, 0x05, 0x1e, 0xad, 0xfb, 0x33, 0xd2, 0x77, 0xde, 0x85,
+	0xb9, 0x8c, 0x26, 0xb4, 0x04, 0x15, 0x06, 0x59, 0xe2, 0x90, 0xec, 0x27, 0x5a, 0x81, 0xea, 0x19,
+	0x0e, 0x7b, 0xa4, 0x51, 0xe6, 0x7d, 0xa2, 0xf1, 0x41, 0xf9, 0xbd, 0x92, 0xfb, 0x37, 0x25, 0x58,
+	0x1d, 0x60, 0x51, 0x6c, 0x13, 0x5a, 0x83, 0xe9, 0x94, 0x62, 0xda, 0x4b, 0x39, 0xa2, 0xaa, 0x27,
+	0x5b, 0xa8, 0x01, 0x33, 0x1d, 0x92, 0xa6, 0xb8, 0xad, 0xb0, 0xa9, 0x26, 0xba, 0x09, 0x0b, 0x09,
+	0xf1, 0x83, 0x84, 0xb4, 0x68, 0xb3, 0x97, 0x84, 0x72, 0x3f, 0xe6, 0x55, 0xdf, 0xe7, 0x49, 0x88,
+	0x6e, 0x41, 0x2d, 0x22, 0xc4, 0x6f, 0xaa, 0x3e, 0xbe, 0x2d, 0xb3, 0xde, 0x02, 0xeb, 0xf4, 0x64,
+	0x9f, 0xfb, 0xaf, 0x25, 0x70, 0x24, 0x4d, 0x8f, 0xe3, 0xa4, 0xf3, 0x69, 0x1a, 0x47, 0x8c, 0x39,
+	0x25, 0xa6, 0x75, 0x98, 0x8d, 0x13, 0x9f, 0x24, 0xcd, 0xc0, 0x97, 0x3c, 0xce, 0xf0, 0xf6, 0xbe,
+	0xcf, 0x69, 0x6e, 0x9d, 0x90, 0x8e, 0x22, 0x4d, 0xb6, 0x10, 0x82, 0xa9, 0x93, 0x38, 0xa5, 0x92,
+	0x22, 0xfe, 0x9b, 0xc1, 0x86, 0x71, 0x0b, 0x87, 0x4a, 0x34, 0xb2, 0x25, 0x65, 0x59, 0xcd, 0x64,
+	0x69, 0x8a, 0x68, 0x7a, 0x50, 0x44, 0x6b, 0x30, 0xdd, 0x8a, 0xe3, 0xd3, 0x80, 0x34, 0x66, 0x04,
+	0x1a, 0xd1, 0x72, 0x43, 0x2b, 0x0f, 0x07, 0x49, 0xfc, 0x25, 0x69, 0x51, 0x46, 0x50, 0x84, 0x3b,
+	0x44, 0xd2, 0xcf, 0x7f, 0xa3, 0x1b, 0x30, 0xdf, 0x4b, 0xc2, 0x66, 0xda, 0x6b, 0xb5, 0x48, 0x9a,
+	0x4a, 0x0e, 0xa0, 0x97, 0x84, 0x87, 0xa2, 0x87, 0x31, 0xce, 0x00, 0x8e, 0x71, 0xa0, 0xf6, 0x76,
+	0xa6, 0x97, 0x84, 0x8f, 0x71, 0x10, 0xba, 0x7f, 0x98, 0x82, 0x0d, 0xeb, 0x96, 0x49, 0x61, 0x32,
+	0xa6, 0xd4, 0x6e, 0x95, 0x03, 0x9f, 0x09, 0x11, 0xb7, 0x5a, 0x71, 0x2f, 0xa2, 0x4a, 0x88, 0xb2,
+	0x89, 0xae, 0xc1, 0xcc, 0x09, 0x4e, 0x9b, 0x67, 0x58, 0xec, 0xd6, 0xac, 0x37, 0x7d, 0x82, 0xd3,
+	0x2f, 0x30, 0x65, 0x5a, 0xc5, 0x3a, 0xd9, 0x66, 0x95, 0x3c, 0xf6, 0x93, 0xb1, 0x8e, 0x3b, 0x1c,
+	0x47, 0x95, 0x77, 0xca, 0x16, 0xd3, 0x03, 0x1a, 0x53, 0x1c, 0x36, 0xe5, 0xe8, 0x34, 0x1f, 0x9d,
+	0xe7, 0x7d, 0xbb, 0x02, 0xc4, 0x81, 0xd9, 0x56, 0x2f, 0x49, 0x98, 0xfa, 0xca, 0x7d, 0xcb, 0xda,
+	0xe8, 0x03, 0x98, 0xe9, 0x8a, 0x6d, 0x6a, 0xcc, 0x6e, 0x95, 0xde, 0x9c, 0xdf, 0xd9, 0x32, 0x4e,
+	0xa2, 0x65, 0x3b, 0x3d, 0x35, 0x01, 0x7d, 0x0a, 0x8b, 0x5d, 0x01, 0xd6, 0xec, 0x10, 0x7a, 0x12,
+	0xfb, 0x69, 0x63, 0x8e, 0x9f, 0xe6, 0x9b, 0xdb, 0xca, 0x4a, 0x68, 0x68, 0xe4, 0xcf, 0x67, 0x1c,
+	0xd2, 0xab, 0x77, 0xf5, 0x66, 0x8a, 0xde, 0x85, 0x46, 0x10, 0x85, 0x41, 0x44, 0x9a, 0xc7, 0x71,
+	0xd2, 0x69, 0x1a, 0xaa, 0x0d, 0x9c, 0xe6, 0x55, 0x31, 0xce, 0x50, 0x79, 0x9a, 0x92, 0xaf, 0x40,
+	0x95, 0xc6, 0xa7, 0x24, 0x6a, 0xcc, 0x8b, 0xd3, 0xc6, 0x1b, 0xe8, 0x43, 0x70, 0x84, 0x1e, 0xf9,
+	0x7e, 0x42, 0xd2, 0xb4, 0xc9, 0x0c, 0x47, 0x33, 0x21, 0x5f, 0xf5, 0x82, 0x84, 0xf8, 0x8d, 0x05,
+	0xbe, 0xd7, 0xd7, 0xb8, 0x5e, 0x09, 0x00, 0xa5, 0xf2, 0x6c, 0x18, 0xbd, 0x09, 0xd5, 0x80, 0x92,
+	0x4e, 0xda, 0xa8, 0x71, 0x6e, 0x50, 0xc6, 0xcd, 0x0b, 0xae, 0xf9, 0x94, 0x74, 0x3c, 0x01, 0xa0,
+	0xe9, 0x63, 0x5d, 0xd7, 0x47, 0x46, 0x14, 0xe9, 0x30, 0xcd, 0x59, 0x14, 0x44, 0xf1, 0x86, 0x1b,
+	0x64, 0xa6, 0xf0, 0x79, 0x4c, 0x83, 0xe3, 0xfe, 0x04, 0x67, 0xac, 0x01, 0x33, 0x89, 0x80, 0xe2,
+	0xaa, 0xb3, 0xe0, 0xa9, 0x26, 0xba, 0x0e, 0x73, 0x69, 0xd0, 0x8e, 0x30, 0xed, 0x25, 0x44, 0x2a,
+	0x68, 0xde, 0xe1, 0x3e, 0x82, 0xd5, 0x81, 0xa5, 0xc6, 0x18, 0x1a, 0x46, 0x71, 0x92, 0xc4, 0x89,
+	0x32, 0x5a, 0xbc, 0xe1, 0x9e, 0x00, 0xda, 0x8b, 0xa3, 0x33, 0x92, 0x50, 0x4f, 0x33, 0xdd, 0x08,
+	0xa6, 0x8e, 0x93, 0xb8, 0x23, 0x31, 0xf0, 0xdf, 0x4c, 0xe7, 0x69, 0xcc, 0x27, 0x57, 0xbd, 0x32,
+	0x8d, 0xd1, 0x36, 0x37, 0xef, 0x82, 0xb2, 0xf9, 0x1d, 0x67, 0x5b, 0xdc, 0x2f, 0xdb, 0xea, 0x7e,
+	0xd9, 0x7e, 0xa9, 0xee, 0x17, 0x6e, 0xd4, 0x89, 0xfb, 0x4b, 0x70, 0xf6, 0x3b, 0xdd, 0x38, 0xa1,
+	0x7b, 0x52, 0x33, 0xd9, 0x82, 0xa9, 0x5a, 0x51, 0x61, 0x2b, 0x4d, 0x86, 0x0d, 0x6d, 0x43, 0x35,
+	0x61, 0xf3, 0x1b, 0x65, 0x2e, 0xc1, 0x86, 0xd0, 0xe9, 0xe1, 0x05, 0x3c, 0x01, 0xe6, 0x46, 0x80,
+	0x86, 0x07, 0x99, 0xfd, 0x54, 0xe7, 0xa4, 0xa9, 0x31, 0xbc, 0xa0, 0x3a, 0x1f, 0x33, 0xc6, 0x6f,
+	0xc0, 0x7c, 0x06, 0x94, 0xed, 0x00, 0xa8, 0xae, 0x97, 0x31, 0xdb, 0xad, 0x44, 0xed, 0x44, 0xc9,
+	0xe3, 0xbf, 0xdd, 0x5f, 0x97, 0x60, 0xc3, 0xca, 0xee, 0x85, 0xaf, 0x83, 0x15, 0xa8, 0x0a, 0x0b,
+	0x53, 0xe1, 0x13, 0x44, 0x83, 0x71, 0xd0, 0x09, 0xd2, 0x34, 0x88, 0xda, 0xcd, 0x2e, 0x0e, 0x92,
+	0xb4, 0x31, 0xb5, 0x55, 0x79, 0x73, 0xce, 0x5b, 0x90, 0x9d, 0x07, 0xac, 0xcf, 0xbd, 0x03, 0xcb,
+	0x86, 0x90, 0x25, 0x0d, 0x8a, 0xee, 0x92, 0x46, 0xf7, 0x3f, 0x96, 0xe0, 0xea, 0x8b, 0xe8, 0x28,
+	0xc6, 0x89, 0x1f, 0x44, 0xed, 0x07, 0x38, 0x3a, 0x0d, 0xa2, 0xb6, 0x61, 0x5f, 0x4a, 0x03, 0xf6,
+	0x45, 0xd9, 0xde, 0xb2, 0x66, 0x7b, 0x99, 0x3d, 0x14, 0xc7, 0x4e, 0x59, 0x56, 0xd9, 0x44, 0xaf,
+	0x43, 0x5d, 0x9a, 0xc6, 0x66, 0xd4, 0xeb, 0x1c, 0x91, 0x44, 0x5e, 0x17, 0x35, 0xd9, 0xfb, 0x9c,
+	0x77, 0x32, 0x66, 0xd3, 0xaf, 0x83, 0x63, 0x75, 0x87, 0x8b, 0x06, 0x43, 0xeb, 0x13, 0x8a, 0x83,
+	0x30, 0x95, 0x17, 0x87, 0x6a, 0xba, 0xff, 0x53, 0xd1, 0xc9, 0x56, 0x4a, 0x35, 0x68, 0xa6, 0xef,
+	0xc0, 0x14, 0xb3, 0x08, 0x9c, 0xd4, 0xf9, 0x9d, 0xd5, 0xec, 0xd4, 0x3f, 0x23, 0x49, 0xeb, 0x04,
+	0x47, 0xf4, 0xf3, 0x94, 0x24, 0x1e, 0x07, 0xc9, 0xb8, 0xaa, 0x68, 0x5c, 0xdd, 0x81, 0x25, 0x1c,
+	0x52, 0x92, 0x44, 0x98, 0x06, 0x67, 0xa4, 0xc9, 0xc7, 0x05, 0xf5, 0x8b, 0x5a, 0xff, 0x73, 0xb9,
+	0x01, 0x5f, 0x93, 0xa3, 0x34, 0xa0, 0x44, 0x72, 0xa0, 0x9a, 0x6c, 0x84, 0x33, 0x9a, 0x28, 0xdf,
+	0x43, 0x35, 0x39, 0xcf, 0x94, 0xc9, 0x63, 0x46, 0xf2, 0xcc, 0x1a, 0xec, 0x9e, 0xf8, 0x93, 0xa0,
+	0xcb, 0x4d, 0xf7, 0x9c, 0xc7, 0x7e, 0x32, 0xd2, 0x5a, 0x01, 0xed, 0x37, 0xe6, 0x04, 0x69, 0xec,
+	0xb7, 0xbe, 0xe1, 0x60, 0x6e, 0xf8, 0x3d, 0x40, 0xf2, 0x27, 0x33, 0x95, 0x01, 0x0d, 0xe2, 0x08,
+	0x87, 0xd2, 0x94, 0x5e, 0x95, 0x23, 0xbb, 0xd9, 0x00, 0xba, 0x0f, 0xcb, 0x09, 0x69, 0x07, 0x29,
+	0x4d, 0x30, 0xeb, 0x51, 0x42, 0x5a, 0xe0, 0xf0, 0x48, 0x1f, 0x92, 0x92, 0x5a, 0x85, 0x69, 0x8a,
+	0xbf, 0x61, 0x86, 0xad, 0x26, 0xcd, 0x33, 0xfe, 0x66, 0xdf, 0x47, 0x3f, 0x84, 0xd9, 0x56, 0x1c,
+	0x51, 0xdc, 0xa2, 0x29, 0xb7, 0x9c, 0xec, 0x88, 0x0e, 0x6e, 0xf7, 0x9e, 0x00, 0xf0, 0x32, 0x48,
+	0xf4, 0x03, 0x98, 0x39, 0x12, 0x2a, 0xc7, 0xed, 0xea, 0xfc, 0xce, 0x35, 0x71, 0xae, 0x87, 0x34,
+	0xd2, 0x53, 0x70, 0xee, 0x4d, 0x58, 0x7c, 0x1c, 0x44, 0xfe, 0x83, 0xfe, 0xbe, 0x5f, 0x20, 0x76,
+	0xf7, 0x3f, 0xca, 0xb0, 0xa6, 0xd6, 0x7c, 0x1a, 0xa4, 0x54, 0xd3, 0x10, 0x9b, 0xe3, 0xb0, 0x01,
+	0x73, 0x41, 0xda, 0x64, 0x96, 0x96, 0xf8, 0xf2, 0xb4, 0xcf, 0x06, 0xe9, 0x21, 0x6f, 0xa3, 0x1f,
+	0xc0, 0x6a, 0x88, 0x53, 0xda, 0xec, 0xe2, 0x7e, 0xdc, 0xa3, 0xec, 0xd6, 0x21, 0xc2, 0x72, 0x30,
+	0x45, 0xa9, 0x78, 0x88, 0x0d, 0x1e, 0xf0, 0xb1, 0x87, 0x98, 0x12, 0x6e, 0x3f, 0xee, 0xc1, 0xf2,
+	0xd0, 0x14, 0x1a, 0x73, 0xcd, 0xa9, 0x78, 0x4b, 0xe6, 0x84, 0x97, 0x31, 0xba, 0x0b, 0x48, 0x07,
+	0x37, 0x5c, 0x02, 0x0d, 0x5a, 0xde, 0xfc, 0x08, 0xa6, 0xd2, 0x38, 0x61, 0x4e, 0x01, 0x3b, 0xf6,
+	0xfc, 0x37, 0x53, 0xa4, 0x30, 0xe8, 0x04, 0x94, 0x2b, 0x52, 0xd5, 0x13, 0x0d, 0x66, 0x71, 0xe2,
+	0xe3, 0xe3, 0x94, 0x08, 0x37, 0xa0, 0xea, 0xc9, 0x16, 0x73, 0x2f, 0xbe, 0xea, 0x05, 0xad, 0xd3,
+	0x66, 0x4a, 0x70, 0xd2, 0x3a, 0x91, 0x6a, 0x35, 0xcf, 0xfb, 0x0e, 0x79, 0x17, 0x3b, 0xfe, 0xc2,
+	0x3c, 0x11, 0xa6, 0x5e, 0x15, 0xb6, 0x21, 0xaa, 0xed, 0xfe, 0x14, 0xae, 0x0d, 0xed, 0xad, 0xb4,
+	0x2f, 0x99, 0xc5, 0x2a, 0xe9, 0x16, 0xeb, 0xb6, 0xba, 0x7b, 0x85, 0xe5, 0xbe, 0x3a, 0xa4, 0x16,
+	0xf2, 0xea, 0x75, 0xbf, 0x2d, 0xc1, 0x46, 0xa6, 0x2a, 0x27, 0x38, 0x6a, 0x93, 0x43, 0xbe, 0xa8,
+	0x92, 0xdd, 0x0d, 0x98, 0xef, 0xc8, 0xe1, 0xfc, 0x5e, 0x05, 0xd5, 0xb5, 0xef, 0x33, 0xdf, 0x8b,
+	0xbb, 0x08, 0x81, 0xaf, 0xfc, 0xd7, 0x5e, 0x9a, 0xf9, 0xb5, 0xc2, 0xf8, 0x56, 0x8a, 0x8c, 0xef,
+	0x94, 0x61, 0x7c, 0xdd, 0x5f, 0xc1, 0x32, 0xbf, 0x66, 0x83, 0x16, 0xd7, 0xfd, 0x57, 0x27, 0x81,
+	0x39, 0x35, 0x01, 0x0d, 0x95, 0x81, 0x11, 0x8d, 0x11, 0x04, 0x78, 0x50, 0xd3, 0x09, 0x48, 0x0b,
+	0x36, 0xf7, 0x6d, 0x73, 0x73, 0x73, 0x13, 0x67, 0x50, 0x2f, 0x37, 0xf8, 0x0f, 0x25, 0x70, 0xa4,
+	0xcc, 0x2e, 0x97, 0x39, 0x79, 0x82, 0xfa, 0x29, 0x25, 0x9d, 0x46, 0x25, 0x3b, 0x41, 0xbc, 0x9d,
+	0x6b, 0xe7, 0x94, 0x5d, 0x3b, 0xab, 0x86, 0x76, 0x5a, 0xf4, 0xdb, 0x6d, 0xc3, 0x75, 0x49, 0xb6,
+	0x52, 0x0f, 0xc3, 0xf3, 0x44, 0x4f, 0x86, 0xbd, 0x56, 0xf1, 0x06, 0xfd, 0xde, 0x90, 0xae, 0x8d,
+	0x74, 0x59, 0xdd, 0x08, 0x6e, 0x3c, 0x21, 0xd4, 0x0e, 0x3b, 0xe9, 0x26, 0xbd, 0x05, 0x57, 0x4d,
+	0x62, 0xf2, 0xed, 0x5a, 0x34, 0x96, 0xdb, 0xf7, 0xdd, 0xbf, 0x28, 0xc1, 0x56, 0xf1, 0x82, 0x17,
+	0xf6, 0x1c, 0x76, 0x60, 0x2a, 0x50, 0x92, 0x18, 0xbf, 0x09, 0x1c, 0x96, 0x91, 0x72, 0x93, 0x6d,
+	0xb2, 0x15, 0x66, 0xf2, 0x23, 0xb8, 0x0d, 0xcb, 0x03, 0xdc, 0x6b, 0xbe, 0xc2, 0x55, 0x83, 0x7f,
+	0x7e, 0x6f, 0x2a, 0x71, 0x57, 0x34, 0x71, 0xff, 0x77, 0x19, 0xae, 0x9f, 0x47, 0x06, 0xe5, 0x21,
+	0x2a, 0x0e, 0xa1, 0x6e, 0x52, 0x21, 0xb7, 0xe2, 0xee, 0xe8, 0xad, 0xd8, 0xf7, 0x49, 0xa4, 0x1d,
+	0x8b, 0x9a, 0x41, 0x2e, 0xda, 0x07, 0x68, 0xc5, 0x1d, 0xee, 0x67, 0xc5, 0x11, 0x57, 0xe6, 0xf9,
+	0x9d, 0x3b, 0xa3, 0x11, 0xee, 0x65, 0xf0, 0xa9, 0xa7, 0x4d, 0x46, 0x9f, 0xc1, 0x7c, 0x10, 0x51,
+	0xd2, 0x16, 0x17, 0x6b, 0xa3, 0x3a, 0x09, 0xae, 0xfd, 0x7c, 0x82, 0xa7, 0xcf, 0x96, 0x87, 0x0f,
+	0xb7, 0x98, 0x2f, 0xc2, 0x5d, 0x8c, 0x59, 0x76, 0xf8, 0x76, 0x79, 0x5b, 0x3f, 0xb2, 0x33, 0xfa,
+	0x91, 0x75, 0xff, 0xac, 0x04, 0x9b, 0xff, 0x17, 0xf4, 0xee, 0x2c, 0xb7, 0xf9, 0xda, 0x49, 0x78,
+	0x05, 0x22, 0x5e, 0x37, 0x88, 0xb0, 0xdc, 0x36, 0x62, 0xdd, 0x23, 0x58, 0x7b, 0x42, 0xe8, 0x85,
+	0xcc, 0xe0, 0x6d, 0x58, 0x8c, 0xb4, 0x79, 0xb9, 0x0a, 0xd6, 0xf5, 0xee, 0x7d, 0xdf, 0xfd, 0xf7,
+	0x12, 0x2c, 0xab, 0xa8, 0xd0, 0x71, 0x2f, 0xf2, 0x27, 0x8b, 0xc0, 0x48, 0x07, 0xa0, 0x6c, 0xc4,
+	0x04, 0x36, 0x01, 0x5a, 0x0c, 0x53, 0xcc, 0x27, 0xc9, 0xc7, 0xa1, 0xec, 0x11, 0xd3, 0x12, 0x82,
+	0x53, 0xa9, 0x97, 0x73, 0x9e, 0x6c, 0xd9, 0x02, 0x60, 0x55, 0x5b, 0x00, 0x0c, 0xdd, 0x55, 0xf7,
+	0xc8, 0x34, 0x37, 0x9c, 0x6b, 0xc2, 0x0d, 0xd3, 0x89, 0xd7, 0x1e, 0xc9, 0xee, 0x13, 0x58, 0x1a,
+	0x1c, 0x62, 0x9a, 0xc6, 0x06, 0x73, 0x9e, 0xa6, 0x59, 0x73, 0xdf, 0x67, 0xce, 0xc4, 0x57, 0x3d,
+	0x1c, 0x51, 0xe6, 0xc2, 0x4a, 0xef, 0x4a, 0xb5, 0xdd, 0x0e, 0xac, 0x98, 0x1b, 0x74, 0x61, 0xb1,
+	0xdf, 0x32, 0xc4, 0xbe, 0x98, 0x89, 0x5d, 0x22, 0x16, 0x42, 0xff, 0x39, 0x20, 0x66, 0xe3, 0x44,
+	0x5f, 0x3a, 0x81, 0x38, 0xce, 0xe5, 0x59, 0xb9, 0x1e, 0x2c, 0x1b, 0xe8, 0x47, 0xba, 0x45, 0xaf,
+	0x9b, 0x37, 0xf7, 0x10, 0xc5, 0x72, 0xab, 0x3f, 0x85, 0xa5, 0x27, 0x84, 0x4e, 0xac, 0x3f, 0x1b,
+	0x30, 0x97, 0x70, 0xd8, 0x5c, 0x2b, 0x67, 0x45, 0xc7, 0xbe, 0xef, 0xfe, 0x1c, 0x16, 0xf7, 0x70,
+	0x18, 0x1e, 0xe1, 0xd6, 0xa9, 0x42, 0xd5, 0x60, 0xe1, 0xaa, 0xc8, 0x0f, 0x49, 0xa2, 0x30, 0xc9,
+	0x26, 0xb3, 0xcc, 0x47, 0xb1, 0xdf, 0x97, 0x41, 0x0a, 0xfe, 0x7b, 0x4c, 0x84, 0xe2, 0x04, 0x36,
+	0xb5, 0xe0, 0x10, 0x8b, 0xbf, 0x08, 0x2f, 0x6e, 0x12, 0xba, 0x11, 0x4c, 0xb1, 0x50, 0xaf, 0x7a,
+	0x54, 0xb2, 0xdf, 0x7a, 0x90, 0xad, 0x62, 0x04, 0xd9, 0xdc, 0x7f, 0x28, 0xc1, 0x96, 0xb6, 0x14,
+	0x7b, 0xc6, 0x89, 0xa5, 0x58, 0x60, 0xf8, 0x82, 0xab, 0x7d, 0x47, 0x31, 0x68, 0x66, 0x04, 0xde,
+	0xb2, 0xd2, 0x2a, 0x3b, 0x77, 0x05, 0x4f, 0x93, 0xc9, 0x76, 0xd0, 0xa3, 0x98, 0xed, 0xc8, 0x8b,
+	0xab, 0x78, 0xb3, 0x24, 0x63, 0x53, 0xa3, 0x18, 0xab, 0x4e, 0xc0, 0xd8, 0x60, 0xe4, 0xd6, 0x7d,
+	0x0a, 0xc0, 0x98, 0xd9, 0xef, 0x32, 0x49, 0xeb, 0xcf, 0xdc, 0x92, 0xf9, 0xcc, 0x55, 0xcf, 0xd7,
+	0xb2, 0xf6, 0x7c, 0x95, 0x8f, 0xdc, 0x4a, 0xf6, 0xc8, 0x75, 0x7f, 0x5b, 0x82, 0x9b, 0x56, 0xed,
+	0x51, 0xc7, 0x88, 0x1b, 0x99, 0xd1, 0x41, 0xc0, 0xd2, 0xe8, 0x20, 0xe0, 0x0e, 0x2c, 0xf0, 0xc9,
+	0x41, 0x97, 0xcf, 0x93, 0x51, 0x81, 0x25, 0x61, 0xea, 0x72, 0x56, 0x3c, 0xe8, 0x65, 0xbf, 0xdd,
+	0xbf, 0x2c, 0xc1, 0xe6, 0x48, 0xb2, 0x2e, 0x60, 0xaa, 0x3e, 0x34, 0x4c, 0xd5, 0xed, 0xa1, 0xe8,
+	0xac, 0x9d, 0x77, 0x69, 0xc2, 0xfa, 0x70, 0xfd, 0x20, 0x89, 0x5b, 0x24, 0x4d, 0x1f, 0x08, 0x7b,
+	0x21, 0x39, 0x9d, 0x2c, 0xf2, 0xa8, 0x44, 0x54, 0xb6, 0x8b, 0xa8, 0x32, 0x2c, 0xa2, 0xa9, 0x5c,
+	0x44, 0xdf, 0x32, 0x11, 0xd9, 0xd7, 0xd6, 0x44, 0xa4, 0x05, 0xc0, 0xcb, 0xb6, 0x00, 0x78, 0xc5,
+	0x16, 0x00, 0x9f, 0x1a, 0x19, 0x00, 0xaf, 0x0e, 0x05, 0xc0, 0x85, 0x5c, 0x46, 0xd1, 0x72, 0x69,
+	0x72, 0x19, 0xc7, 0xb0, 0x94, 0xcb, 0x01, 0xac, 0x68, 0xfe, 0xcb, 0x83, 0xfe, 0x2b, 0x3f, 0xaa,
+	0xdc, 0xdf, 0x97, 0x61, 0x5d, 0xa8, 0x81, 0xc2, 0xaa, 0x67, 0x71, 0xc6, 0xe2, 0x65, 0x81, 0xb7,
+	0x76, 0x42, 0x08, 0xf7, 0x82, 0x69, 0xbf, 0x4b, 0xe4, 0xe5, 0x5b, 0xcb, 0x7a, 0x5f, 0xf6, 0xbb,
+	0x04, 0xfd, 0x10, 0xd6, 0x98, 0xb8, 0x32, 0x5c, 0xa6, 0x7d, 0x9f, 0xf5, 0x56, 0x4e, 0x70, 0xaa,
+	0xd6, 0x3f, 0x54, 0x63, 0xec, 0x91, 0xc3, 0x66, 0x75, 0xd3, 0xae, 0x36, 0x41, 0xe4, 0xa2, 0x16,
+	0x4f, 0x70, 0x7a, 0x90, 0x76, 0x73, 0xd8, 0xff, 0x07, 0xd7, 0x72, 0x42, 0x52, 0xf6, 0xcf, 0x59,
+	0x80, 0x9b, 0x3c, 0x96, 0x5e, 0x15, 0x4b, 0x64, 0xc3, 0x87, 0x24, 0xa2, 0x5f, 0x04, 0xf8, 0x19,
+	0x0e, 0x42, 0x16, 0x16, 0x61, 0x30, 0x4d, 0x9a, 0xe0, 0x16, 0x0b, 0xfc, 0x34, 0xc3, 0x20, 0x3a,
+	0x95, 0x56, 0x68, 0x89, 0x8d, 0xbc, 0x94, 0x03, 0x4f, 0x83, 0xe8, 0xd4, 0xed, 0x81, 0x63, 0xdb,
+	0xab, 0xef, 0xda, 0x8b, 0x0c, 0x61, 0xf3, 0x30, 0x97, 0xfa, 0xe1, 0x3b, 0xbb, 0x8a, 0x93, 0xf3,
+	0x3c, 0x17, 0xd3, 0x77, 0x9a, 0xf9, 0x06, 0x69, 0xcf, 0xa5, 0xc5, 0x34, 0xc7, 0xc7, 0x1e, 0x4b,
+	0xee, 0x7f, 0xcd, 0xc0, 0xcc, 0x41, 0x12, 0xfb, 0xbd, 0xd6, 0x70, 0xa8, 0x73, 0xec, 0x9b, 0x68,
+	0x13, 0x40, 0x66, 0x79, 0x34, 0x0f, 0x52, 0xf6, 0x08, 0x0f, 0x32, 0x3e, 0xfa, 0x52, 0xa5, 0x14,
+	0xe7, 0x3c, 0xd9, 0x62, 0xa6, 0x81, 0x2b, 0x8f, 0xb8, 0x22, 0xf8, 0x6f, 0x76, 0x92, 0xd3, 0xd3,
+	0x9e, 0x94, 0x05, 0xfb, 0x89, 0xde, 0x96, 0x61, 0xb5, 0x99, 0xad, 0x4a, 0x1e, 0xc4, 0x93, 0xa4,
+	0x6e, 0x33, 0xda, 0x65, 0xb6, 0x57, 0x85, 0x55, 0x7d, 0x72, 0x8c, 0x7b, 0x21, 0x6d, 0x66, 0x41,
+	0x66, 0x11, 0xee, 0x5c, 0x94, 0xfd, 0x2a, 0x84, 0xce, 0x04, 0x44, 0x22, 0x7c, 0x14, 0x12, 0x9f,
+	0x87, 0xa9, 0x66, 0x3d, 0xd5, 0x44, 0x6f, 0xc1, 0x74, 0x37, 0x09, 0x5a, 0x32, 0x40, 0xc5, 0x52,
+	0x3a, 0xfa, 0x9a, 0x07, 0x6c, 0xc8, 0x93, 0x10, 0xe8, 0x47, 0x30, 0xef, 0x93, 0xb4, 0x95, 0x04,
+	0x5d, 0xfe, 0xdc, 0x9a, 0x97, 0xb1, 0x01, 0x83, 0xc8, 0x87, 0x39, 0x80, 0xa0, 0x55, 0x9f, 0x82,
+	0x9e, 0xc1, 0x52, 0x18, 0x47, 0xed, 0xa6, 0x8e, 0x66, 0x81, 0xa3, 0x71, 0x4d, 0x34, 0x4f, 0xe3,
+	0xa8, 0x3d, 0x84, 0x6a, 0x31, 0x34, 0x7b, 0xd1, 0xfb, 0xd2, 0x9b, 0x27, 0x7e, 0x13, 0xd3, 0x46,
+	0x6d, 0x6c, 0x0a, 0x64, 0x4e, 0x42, 0xef, 0x52, 0x36, 0xb5, 0xd7, 0xf5, 0xd5, 0xd4, 0xfa, 0xf8,
+	0xa9, 0x12, 0x7a, 0x97, 0x9b, 0xdb, 0xa0, 0x83, 0xdb, 0x24, 0x6d, 0x2c, 0xf2, 0xd7, 0xb6, 0x6c,
+	0x31, 0x71, 0xb2, 0x9c, 0xdc, 0x92, 0x10, 0x67, 0x2f, 0x09, 0xd1, 0xbb, 0xc0, 0x1c, 0x0b, 0xcc,
+	0x6f, 0xc9, 0xab, 0x9c, 0xcd, 0x0d, 0x93, 0xcd, 0x67, 0x72, 0x54, 0xf0, 0x97, 0x01, 0x8b, 0x80,
+	0x7d, 0x48, 0x28, 0xf1, 0x1b, 0x48, 0xc8, 0x4b, 0x36, 0xd9, 0x03, 0xa3, 0x1b, 0xe2, 0x28, 0x6d,
+	0x2c, 0xeb, 0x0f, 0x8c, 0xc3, 0xde, 0x51, 0xb6, 0x2b, 0x07, 0x21, 0x8e, 0x3c, 0x01, 0xc4, 0xf2,
+	0xf1, 0x99, 0xd6, 0x9c, 0x27, 0x1f, 0xef, 0x7c, 0x0c, 0x4b, 0x83, 0xdb, 0x7f, 0xae, 0xf9, 0x0f,
+	0x60, 0xc5, 0x26, 0xc2, 0x73, 0xe1, 0xf8, 0x10, 0x6a, 0xc6, 0xfe, 0x9c, 0x67, 0xb2, 0xfb, 0x9f,
+	0x25, 0x58, 0x1a, 0xdc, 0x95, 0xa1, 0xc3, 0x6e, 0x4b, 0xc1, 0x38, 0x30, 0x1b, 0x44, 0x94, 0x24,
+	0x67, 0x58, 0x65, 0xb7, 0xb3, 0x36, 0xbb, 0x0b, 0xd4, 0xef, 0x66, 0x2b, 0xbb, 0x70, 0xab, 0x5e,
+	0x4d, 0xf5, 0xee, 0xa9, 0x47, 0x26, 0x4d, 0x02, 0x1c, 0x36, 0x7d, 0xdc, 0x4f, 0x65, 0x5c, 0x6e,
+	0x8e, 0xf7, 0x3c, 0xc4, 0xfd, 0x54, 0x3b, 0x72, 0xd3, 0x63, 0x8f, 0x9c, 0x76, 0x70, 0x67, 0x8c,
+	0x83, 0xeb, 0x3e, 0x80, 0x05, 0x7d, 0x86, 0xe6, 0x04, 0x94, 0x0c, 0x27, 0x40, 0x4f, 0x41, 0x95,
+	0xcd, 0x14, 0x14, 0xf3, 0xff, 0xf9, 0x4b, 0x4b, 0x22, 0x4a, 0x47, 0x45, 0xf7, 0xa5, 0xb1, 0x2a,
+	0xe7, 0xc6, 0x2a, 0x7b, 0xd4, 0x55, 0xec, 0x8f, 0xba, 0x29, 0x23, 0x20, 0x39, 0x60, 0x58, 0xab,
+	0x63, 0x0c, 0xeb, 0xf4, 0x80, 0x61, 0x75, 0x9f, 0x81, 0xf3, 0x84, 0x64, 0x94, 0x3e, 0x8e, 0x13,
+	0x9e, 0x72, 0x56, 0x14, 0x9b, 0x93, 0x4b, 0x03, 0x93, 0x19, 0xf1, 0x81, 0x2f, 0x9e, 0x88, 0x73,
+	0x1e, 0xfb, 0xc9, 0xe2, 0x36, 0x2b, 0x26, 0xeb, 0xf9, 0x2b, 0x53, 0x70, 0x55, 0xb2, 0x73, 0x55,
+	0x36, 0xb8, 0xe2, 0x39, 0x76, 0x2a, 0x55, 0xa5, 0xea, 0x89, 0x06, 0xba, 0x03, 0xb3, 0x5d, 0x89,
+	0xb7, 0x51, 0xe5, 0x32, 0xae, 0x19, 0x32, 0xf6, 0xb2, 0x61, 0x77, 0x17, 0xea, 0x92, 0x87, 0x8b,
+	0xde, 0x48, 0xee, 0xc7, 0x80, 0xf6, 0x7f, 0xf0, 0xde, 0xf3, 0x97, 0xe4, 0x1b, 0x2a, 0xf2, 0x0e,
+	0x4c, 0x41, 0xb2, 0xb7, 0x59, 0x49, 0x7b, 0x9b, 0x59, 0x8f, 0x8b, 0x1b, 0xc3, 0xaa, 0x7c, 0x49,
+	0x89, 0xfd, 0x7a, 0x85, 0xeb, 0xfe, 0x35, 0xe3, 0xba, 0x5f, 0xca, 0x8b, 0x1d, 0x24, 0x66, 0x71,
+	0xdb, 0x1f, 0xc2, 0x55, 0x21, 0x4a, 0xb1, 0xda, 0x84, 0x37, 0xbc, 0x29, 0xe2, 0xf2, 0xa0, 0x7e,
+	0xfc, 0x53, 0xae, 0xcb, 0x5f, 0x12, 0x4d, 0x97, 0xc7, 0xe2, 0xcd, 0x04, 0x5e, 0xb6, 0x0b, 0xbc,
+	0x32, 0x32, 0xeb, 0x33, 0x35, 0x3a, 0xeb, 0x53, 0x35, 0xb3, 0x3e, 0xd6, 0xb0, 0xfc, 0x4b, 0x58,
+	0x31, 0x09, 0x1f, 0x19, 0xef, 0x78, 0xc3, 0x8c, 0x77, 0x0c, 0xef, 0xb1, 0x18, 0x76, 0xbf, 0x84,
+	0x85, 0x97, 0xac, 0xe0, 0x43, 0xed, 0xc3, 0x1b, 0x32, 0x87, 0x2b, 0x0a, 0x05, 0xf2, 0xca, 0x0d,
+	0x0e, 0xa4, 0x25, 0x70, 0x77, 0x60, 0x36, 0x25, 0x94, 0x25, 0x09, 0x52, 0xf9, 0xb2, 0x5b, 0x33,
+	0x61, 0x0f, 0xe5, 0xa8, 0x97, 0xc1, 0xb9, 0x3f, 0x81, 0x9a, 0x5c, 0xeb, 0x55, 0xb2, 0xf4, 0xa2,
+	0x58, 0xa5, 0xa2, 0x15, 0xab, 0xb8, 0x67, 0x70, 0x6b, 0xef, 0x84, 0xb4, 0x4e, 0x4d, 0x5d, 0xc9,
+	0x7c, 0x62, 0xcd, 0x5e, 0xf1, 0x18, 0x8b, 0xd4, 0x75, 0xf6, 0x7b, 0x8c, 0xba, 0x8c, 0x09, 0xc1,
+	0xfc, 0x14, 0x5e, 0x1b, 0xbd, 0xee, 0x45, 0xf9, 0x74, 0x3f, 0x81, 0xe5, 0x3d, 0xdc, 0x65, 0x48,
+	0x0c, 0xfb, 0x75, 0xfe, 0x50, 0xa6, 0x7b, 0x0f, 0x96, 0xbe, 0x88, 0x03, 0x7f, 0x42, 0x34, 0x6e,
+	0x04, 0x6b, 0x1c, 0xf4, 0x45, 0x97, 0xc8, 0xe8, 0xf7, 0xc5, 0x85, 0xe5, 0x1a, 0xc7, 0xbc, 0x6e,
+	0x56, 0x01, 0xc9, 0x43, 0xfe, 0x4b, 0x58, 0x67, 0x5a, 0xfd, 0xa2, 0x47, 0x8f, 0xe2, 0x6f, 0x9e,
+	0x89, 0x89, 0xd9, 0xa1, 0x2c, 0x5a, 0x52, 0xa7, 0xbf, 0x5c, 0x10, 0x42, 0x9c, 0xe4, 0xb6, 0x71,
+	0x7f, 0x23, 0x53, 0x74, 0x83, 0xcb, 0x5f, 0x72, 0x15, 0x49, 0x16, 0xee, 0x9d, 0x92, 0xde, 0x58,
+	0xb6, 0x13, 0xfa, 0xba, 0xea, 0x48, 0xde, 0x87, 0x0d, 0x8f, 0x74, 0x43, 0xdc, 0xb7, 0x6f, 0x8a,
+	0xbc, 0xa4, 0x4a, 0xf9, 0x25, 0x75, 0x0c, 0xd7, 0xed, 0x13, 0x2e, 0x97, 0x0d, 0xf7, 0x7d, 0xb8,
+	0xfe, 0x84, 0x50, 0x2e, 0x3d, 0x91, 0x2a, 0xfe, 0x24, 0x48, 0x69, 0x9c, 0x4c, 0x50, 0x86, 0xe5,
+	0xfe, 0xbe, 0x04, 0x9b, 0x05, 0x73, 0x5f, 0xe1, 0xd1, 0x58, 0x17, 0x2e, 0x0a, 0x6d, 0x1a, 0xe9,
+	0x66, 0x59, 0x73, 0x44, 0xc5, 0x3a, 0xe8, 0xfb, 0xe6, 0xe6, 0x3b, 0xa6, 0x1a, 0x0a, 0x20, 0x19,
+	0xff, 0x91, 0x02, 0x90, 0x09, 0xd0, 0x9f, 0x90, 0xa3, 0x93, 0x38, 0x3e, 0x7d, 0x48, 0xc2, 0xe0,
+	0x8c, 0x24, 0x01, 0x39, 0x57, 0x04, 0x7b, 0x92, 0x5b, 0xc2, 0xfd, 0x5d, 0x09, 0x36, 0x0b, 0x56,
+	0xba, 0x64, 0x0d, 0xdc, 0x36, 0x37, 0x21, 0x2f, 0x16, 0x31, 0x97, 0xee, 0xab, 0x2d, 0xd8, 0x66,
+	0x2a, 0x95, 0x92, 0xc8, 0x1f, 0x1c, 0x2f, 0xa8, 0x01, 0xf9, 0x15, 0x6c, 0x16, 0xc0, 0x5f, 0x98,
+	0x91, 0xbb, 0x86, 0xf5, 0x28, 0xa6, 0x58, 0xd8, 0x91, 0x8f, 0x60, 0x5d, 0x0f, 0x08, 0xe1, 0x10,
+	0x47, 0x2d, 0x32, 0xe9, 0xe5, 0xce, 0x8a, 0xe7, 0x6c, 0xb3, 0x2f, 0x9d, 0xf6, 0xc1, 0x15, 0x04,
+	0xed, 0x7f, 0x5b, 0x82, 0x06, 0x53, 0x83, 0xa7, 0xc4, 0x6f, 0x93, 0x84, 0x3d, 0x62, 0x34, 0x65,
+	0x1b, 0xeb, 0x98, 0x8c, 0x30, 0x86, 0xc5, 0x51, 0xea, 0x73, 0x55, 0x09, 0xb8, 0x7f, 0x55, 0x82,
+	0x75, 0x0b, 0x81, 0x97, 0xac, 0xa3, 0x6f, 0x99, 0x3a, 0xba, 0x92, 0xed, 0x5a, 0xbe, 0x6c, 0xa6,
+	0x9f, 0xbf, 0x10, 0xa9, 0x25, 0x51, 0xab, 0x33, 0xf9, 0x5e, 0xe5, 0xa4, 0x96, 0xb9, 0xc7, 0xa5,
+	0x15, 0x6f, 0x9e, 0xe3, 0xd6, 0xf8, 0x53, 0xe5, 0x43, 0xaa, 0xd5, 0x2f, 0x79, 0x23, 0x5e, 0x37,
+	0x37, 0x62, 0x51, 0x2f, 0x06, 0x8e, 0x7b, 0x99, 0xeb, 0x76, 0x17, 0x6a, 0xb2, 0x43, 0xb2, 0xbf,
+	0x01, 0x73, 0xb2, 0xaa, 0x29, 0x63, 0x7e, 0x56, 0x74, 0xec, 0xfb, 0xee, 0x1f, 0xc1, 0xea, 0x33,
+	0x9c, 0x9c, 0x8a, 0x19, 0x07, 0x38, 0xf0, 0x27, 0x99, 0xc5, 0x6c, 0x2c, 0x4d, 0x70, 0x94, 0xe2,
+	0x96, 0x99, 0x7b, 0xad, 0x69, 0xbd, 0xfb, 0xbe, 0xfb, 0x1c, 0xae, 0xe5, 0xc8, 0x59, 0x89, 0x37,
+	0x99, 0x0c, 0x7d, 0x9e, 0x48, 0x2d, 0xeb, 0x89, 0x54, 0xb7, 0x0d, 0x75, 0xc5, 0xda, 0xa5, 0xa7,
+	0x28, 0x25, 0x62, 0x71, 0xf4, 0x7e, 0x27, 0x45, 0xf9, 0x30, 0x48, 0xbb, 0x3d, 0x7a, 0x39, 0xa7,
+	0x4e, 0x2f, 0x7f, 0xb2, 0x2a, 0xd9, 0x44, 0x67, 0xee, 0xcf, 0xe5, 0xcb, 0x33, 0xa7, 0xec, 0x92,
+	0xb5, 0xec, 0x0d, 0x53, 0xcb, 0xf2, 0x17, 0x82, 0x5c, 0x51, 0xa9, 0xd9, 0x01, 0xd4, 0x55, 0x4f,
+	0xfe, 0x8a, 0xf6, 0x45, 0x8f, 0xf6, 0x8a, 0x96, 0x3d, 0xfb, 0x13, 0xbc, 0x44, 0xff, 0xbe, 0x04,
+	0xeb, 0xbb, 0xbe, 0x2f, 0xb1, 0x3e, 0x3a, 0x0b, 0x7c, 0x12, 0xb5, 0x2e, 0x0b, 0x3b, 0x0f, 0xa1,
+	0x92, 0x6f, 0xb2, 0xaf, 0x27, 0xd8, 0x6f, 0xb4, 0x03, 0xd5, 0xe3, 0x20, 0x24, 0x8a, 0xd7, 0xeb,
+	0x83, 0xbc, 0x2a, 0x1a, 0x1e, 0x07, 0x21, 0xf1, 0x04, 0xa8, 0x1b, 0xc0, 0x62, 0xc6, 0xf7, 0xa5,
+	0xbf, 0x74, 0x15, 0x66, 0xa1, 0x85, 0xff, 0x56, 0x82, 0x75, 0x91, 0x98, 0xd7, 0x63, 0x51, 0x66,
+	0xd0, 0x82, 0x3d, 0xfa, 0xcd, 0xa0, 0x05, 0xeb, 0x11, 0x19, 0x0d, 0x16, 0xc5, 0xd3, 0x32, 0x1a,
+	0xac, 0x29, 0x76, 0xaa, 0xd5, 0x4b, 0x69, 0xdc, 0x21, 0x5a, 0x15, 0x03, 0xa8, 0xae, 0x7d, 0x1f,
+	0xbd, 0x06, 0x75, 0xe6, 0x83, 0x11, 0xbf, 0xd9, 0xc2, 0x09, 0x4f, 0x73, 0x8a, 0x67, 0xea, 0x82,
+	0xe8, 0xdd, 0xc3, 0x89, 0x2f, 0x0a, 0x0a, 0xb2, 0xc8, 0x50, 0x75, 0xa0, 0x38, 0x39, 0x2b, 0xd3,
+	0x9f, 0xd6, 0xcb, 0xf4, 0x9b, 0xb0, 0x6c, 0xe3, 0xe3, 0x36, 0x2c, 0xa6, 0x5a, 0x77, 0xce, 0x4c,
+	0x5d, 0xef, 0x9e, 0x44, 0x81, 0xbe, 0x65, 0xfb, 0xc5, 0x2e, 0xd0, 0xf0, 0x3b, 0x5d, 0x07, 0xb9,
+	0x50, 0xc3, 0xb4, 0xd9, 0x25, 0x49, 0x10, 0xfb, 0x4d, 0x12, 0xf9, 0x32, 0x49, 0x33, 0x8f, 0xe9,
+	0x01, 0xef, 0x7b, 0x14, 0xf9, 0xee, 0xbf, 0xc8, 0xcb, 0x5b, 0xa7, 0x64, 0x72, 0x33, 0x32, 0x20,
+	0xa3, 0xf2, 0x90, 0x8c, 0x4c, 0xe1, 0x57, 0x06, 0x85, 0x9f, 0x6b, 0xe4, 0x94, 0xdd, 0xd6, 0x54,
+	0xed, 0xb6, 0x66, 0xda, 0xb0, 0x35, 0x7f, 0x2d, 0xef, 0xf7, 0x01, 0x1e, 0x2e, 0xd9, 0xe0, 0xbc,
+	0x6d, 0x1a, 0x9c, 0xbc, 0x78, 0xd2, 0x10, 0xa2, 0xb4, 0x3a, 0x29, 0xac, 0x18, 0xdd, 0x17, 0x27,
+	0xe6, 0x8e, 0x71, 0x04, 0x0b, 0x56, 0x15, 0xe7, 0xf0, 0x6b, 0x58, 0xcb, 0x6b, 0xc8, 0x78, 0x80,
+	0xfc, 0xe2, 0xcb, 0xbe, 0x6d, 0x2c, 0x7b, 0x2d, 0x5b, 0x76, 0x60, 0x81, 0xec, 0x1a, 0xe2, 0xef,
+	0x50, 0x73, 0x30, 0xfd, 0x2e, 0xaa, 0x20, 0xcf, 0xe9, 0xeb, 0xfc, 0xb6, 0x04, 0x1b, 0x56, 0xca,
+	0x2e, 0x59, 0x39, 0xee, 0x99, 0xca, 0x51, 0xb8, 0x5f, 0x52, 0x3d, 0xfe, 0xae, 0x24, 0xde, 0x68,
+	0xa2, 0xe8, 0xf5, 0x31, 0x21, 0x83, 0x6f, 0x51, 0xa3, 0x7a, 0xa3, 0x34, 0x50, 0xbd, 0xc1, 0xdd,
+	0x8e, 0x76, 0xa0, 0xbb, 0x1d, 0xac, 0xc5, 0xcb, 0xbe, 0x98, 0x25, 0x3c, 0x4a, 0x70, 0x94, 0x97,
+	0x7d, 0xe1, 0xc4, 0x7f, 0xc0, 0x3a, 0xce, 0x79, 0x81, 0xff, 0x46, 0x3e, 0xee, 0x2c, 0x24, 0x5e,
+	0xf2, 0xde, 0xdd, 0x31, 0xf7, 0x6e, 0x39, 0x57, 0xf1, 0x6c, 0x69, 0xbd, 0xe8, 0xdb, 0x23, 0xbc,
+	0x7a, 0x91, 0xdd, 0x36, 0xf9, 0x78, 0xc1, 0x27, 0x1d, 0x85, 0x35, 0xc8, 0xbb, 0x50, 0x27, 0xc7,
+	0xc7, 0xa4, 0xc5, 0x3f, 0xd5, 0xc8, 0x2a, 0xf4, 0x47, 0x27, 0xc7, 0x6a, 0xd9, 0x0c, 0x56, 0xb8,
+	0xef, 0xc6, 0x80, 0x74, 0x02, 0x2e, 0xbc, 0x29, 0xb7, 0x8d, 0x93, 0x66, 0xe5, 0x9e, 0x03, 0xec,
+	0xfc, 0xf3, 0x5d, 0xa8, 0xcb, 0xca, 0x82, 0x43, 0x92, 0x9c, 0xb1, 0x74, 0xc8, 0x1e, 0x20, 0x1e,
+	0x06, 0x10, 0xb7, 0xaf, 0xac, 0x3e, 0x40, 0x1b, 0x66, 0x8c, 0xc0, 0xf8, 0x9a, 0xd6, 0x19, 0x88,
+	0x63, 0xb9, 0x57, 0x50, 0xab, 0xe8, 0xe3, 0x49, 0x8e, 0xac, 0xf8, 0x7b, 0x40, 0x85, 0xf1, 0xe6,
+	0x08, 0x08, 0xf9, 0x15, 0xf0, 0x15, 0xf4, 0xe3, 0x81, 0xcf, 0x80, 0x15, 0x7a, 0xa7, 0xf8, 0xc3,
+	0x5f, 0x67, 0xc3, 0x3a, 0x96, 0xa1, 0x3c, 0x84, 0x35, 0x35, 0x24, 0xeb, 0xd4, 0xec, 0x48, 0x8d,
+	0x8f, 0xed, 0x9c, 0x0d, 0xeb, 0x58, 0x86, 0xf4, 0x7d, 0x58, 0xf0, 0xc8, 0x51, 0x2f, 0x08, 0xfd,
+	0x3d, 0xdc, 0x3a, 0x21, 0x48, 0xa6, 0xad, 0xf4, 0x8f, 0x9c, 0x9d, 0x65, 0xa3, 0x2f, 0x9b, 0xfa,
+	0x43, 0x98, 0xff, 0x9c, 0xa7, 0x4f, 0xf9, 0xc6, 0xa2, 0x81, 0x8d, 0x2e, 0x9a, 0xf5, 0x01, 0xd4,
+	0xc5, 0x2c, 0xf5, 0xb8, 0x46, 0xc3, 0xf5, 0x03, 0x45, 0x73, 0x9f, 0x40, 0xfd, 0x09, 0xa1, 0xda,
+	0xc7, 0x5b, 0x48, 0x7e, 0xe9, 0x36, 0xfc, 0xd1, 0x9e, 0xb3, 0x6e, 0x19, 0xc9, 0x10, 0xfd, 0x31,
+	0x2c, 0x5b, 0x3e, 0x47, 0x53, 0xb2, 0x2f, 0xfe, 0x30, 0xcf, 0xb9, 0x39, 0x02, 0x22, 0xc3, 0x7e,
+	0x00, 0x35, 0xa3, 0xda, 0x45, 0xc9, 0xc7, 0x56, 0x02, 0xa3, 0x30, 0x8e, 0x28, 0xf3, 0x75, 0xaf,
+	0xa0, 0xe7, 0x50, 0xd3, 0xcb, 0xcf, 0x53, 0x74, 0xdd, 0x9c, 0x65, 0x7e, 0xc7, 0xe3, 0x6c, 0x16,
+	0x8c, 0x66, 0xf8, 0x3e, 0x86, 0xba, 0x59, 0x10, 0x82, 0x86, 0x3e, 0x2d, 0x52, 0xb8, 0x86, 0xa5,
+	0xc3, 0xe9, 0x59, 0x31, 0xe7, 0xcb, 0xe0, 0xdd, 0x00, 0x33, 0x96, 0xef, 0x54, 0xec, 0xf8, 0x7e,
+	0x06, 0x68, 0xb8, 0x40, 0x05, 0xdd, 0x90, 0x22, 0x2c, 0x2a, 0xf3, 0x71, 0xb6, 0x8a, 0x01, 0x32,
+	0x56, 0x31, 0xac, 0xd9, 0x8b, 0x50, 0xd0, 0x2d, 0x99, 0x65, 0x1f, 0x55, 0xa2, 0x32, 0xd1, 0x12,
+	0x9f, 0x00, 0x12, 0x87, 0x55, 0x2f, 0x98, 0x46, 0x52, 0x01, 0x2d, 0x45, 0xd4, 0x8e, 0xfd, 0x43,
+	0x14, 0x8e, 0x69, 0x71, 0xa0, 0xee, 0x5a, 0x49, 0xda, 0x5e, 0x8e, 0x5d, 0x8c, 0xe9, 0x29, 0x5c,
+	0x65, 0x62, 0xd7, 0x7b, 0x33, 0xfd, 0x2e, 0xfe, 0xca, 0x45, 0x1d, 0x3c, 0x63, 0x9a, 0x7b, 0x05,
+	0xbd, 0x80, 0x35, 0x16, 0x30, 0xd0, 0xbb, 0x77, 0x53, 0x8f, 0x60, 0xff, 0xa2, 0xe4, 0x9d, 0x0a,
+	0x07, 0xca, 0xfe, 0x3d, 0x05, 0xba, 0x9d, 0xd3, 0x39, 0xf2, 0x8b, 0x0b, 0xc7, 0x35, 0x18, 0xb2,
+	0xc2, 0xf2, 0xc5, 0x1a, 0x45, 0xdf, 0x91, 0xa0, 0xd7, 0x87, 0x8e, 0xa6, 0xed, 0xa3, 0x0a, 0xe7,
+	0x8d, 0x71, 0x60, 0x99, 0x32, 0x9c, 0xc0, 0x86, 0xa9, 0x2c, 0xe6, 0x7a, 0xae, 0x79, 0x42, 0xac,
+	0x8b, 0xdd, 0x1a, 0x09, 0xa3, 0x59, 0xc3, 0x05, 0xbd, 0x3c, 0x5c, 0x29, 0x9c, 0xa5, 0xa6, 0xde,
+	0x71, 0x6c, 0x43, 0x19, 0xa2, 0x87, 0x30, 0xaf, 0x55, 0x66, 0x2b, 0x9b, 0x3a, 0x5c, 0x0b, 0xee,
+	0xac, 0x5b, 0x46, 0x32, 0x2c, 0xbb, 0x30, 0x97, 0xd5, 0x62, 0xa3, 0xb5, 0x6c, 0xbf, 0xce, 0x43,
+	0xc8, 0x33, 0x58, 0x95, 0x57, 0x9a, 0x18, 0x52, 0xf7, 0x1c, 0x5a, 0x95, 0xd3, 0xcc, 0xfa, 0xec,
+	0x71, 0x77, 0xdb, 0xa9, 0x71, 0xd1, 0xab, 0xca, 0x5d, 0x21, 0x1d, 0x1f, 0xbd, 0x31, 0x74, 0x8d,
+	0x5b, 0x2b, 0xa5, 0x9d, 0x5b, 0x43, 0x70, 0xc3, 0x25, 0xa8, 0xee, 0x15, 0xf4, 0x0b, 0xa3, 0xe8,
+	0xda, 0x2c, 0x5f, 0x56, 0x4b, 0x7e, 0x7f, 0xc4, 0x92, 0xd6, 0x82, 0xe7, 0x49, 0x17, 0x3f, 0xca,
+	0x36, 0xce, 0x2c, 0xc5, 0x44, 0xee, 0xc8, 0x3a, 0x4d, 0x73, 0x8d, 0x51, 0xb5, 0x9c, 0xee, 0x15,
+	0xf4, 0x2e, 0xac, 0x0a, 0xb1, 0xbd, 0x48, 0xc4, 0x05, 0xae, 0x4a, 0x1b, 0xcc, 0x2a, 0x08, 0xc7,
+	0x6c, 0x0a, 0x3d, 0xd5, 0x6b, 0x32, 0x90, 0xa6, 0x45, 0x03, 0x25, 0x2a, 0x8e, 0x63, 0x1b, 0xca,
+	0x28, 0x78, 0x07, 0x20, 0x2f, 0x16, 0x41, 0x2b, 0x02, 0xd6, 0xac, 0xb3, 0x18, 0x5e, 0xfd, 0x23,
+	0xa8, 0x3d, 0xe4, 0x55, 0x56, 0xa3, 0xe7, 0x15, 0x78, 0x1c, 0x9f, 0xc3, 0xb2, 0xa5, 0x3e, 0x45,
+	0x19, 0xd2, 0xe2, 0xd2, 0x95, 0x31, 0x9c, 0x3c, 0x82, 0xda, 0xae, 0xef, 0xe7, 0x1e, 0x2f, 0xda,
+	0xcc, 0x0c, 0xa5, 0xd1, 0x3f, 0xc6, 0x03, 0xfb, 0x0c, 0xae, 0x3d, 0x21, 0xda, 0x8b, 0xe5, 0x71,
+	0x9c, 0x48, 0x4d, 0xd1, 0x10, 0x1a, 0x10, 0x0a, 0x61, 0x1e, 0x5f, 0x7d, 0x4c, 0xc8, 0x21, 0xa1,
+	0xfc, 0xee, 0x61, 0xc8, 0x76, 0x5b, 0xb4, 0x87, 0xc3, 0x7c, 0x02, 0x63, 0xc0, 0xea, 0x14, 0x5e,
+	0xb3, 0x38, 0xee, 0x0c, 0x58, 0x68, 0xa3, 0xf5, 0x25, 0x85, 0x34, 0x73, 0x5d, 0xf4, 0x12, 0x74,
+	0x6e, 0x8d, 0x84, 0xd1, 0x04, 0xb3, 0x62, 0x7b, 0x18, 0x29, 0x0f, 0x64, 0xc4, 0xa3, 0xc9, 0x91,
+	0xf6, 0x6d, 0xf8, 0x31, 0xe3, 0x5e, 0x41, 0x3f, 0x82, 0x9a, 0x51, 0x35, 0x83, 0x86, 0x2a, 0x31,
+	0x94, 0xd1, 0xb1, 0x16, 0xd7, 0x70, 0x63, 0x0a, 0x79, 0x19, 0x8c, 0x72, 0xab, 0x86, 0x0a, 0x63,
+	0xc6, 0x61, 0xc9, 0xcf, 0xcc, 0x97, 0x64, 0xf8, 0xcc, 0xe8, 0xa5, 0x30, 0x8e, 0x63, 0x1b, 0xd2,
+	0x10, 0xe5, 0xea, 0xff, 0x4a, 0x14, 0xbd, 0x07, 0xf3, 0xe2, 0xf8, 0xf3, 0x9a, 0x10, 0xa5, 0x12,
+	0x7a, 0x31, 0x8a, 0xb3, 0x6c, 0xf4, 0x65, 0x33, 0xbf, 0x86, 0xeb, 0xa3, 0xca, 0x2e, 0xd0, 0x1d,
+	0xb5, 0xf0, 0xd8, 0x92, 0x10, 0xe7, 0xad, 0x49, 0x40, 0xb3, 0x85, 0xf7, 0x61, 0x41, 0xaf, 0xca,
+	0xc8, 0x2e, 0xc8, 0xe1, 0x4a, 0x0d, 0x47, 0xba, 0x31, 0xf6, 0x5a, 0x0a, 0xf7, 0x0a, 0xda, 0x83,
+	0xb9, 0xac, 0x2c, 0x43, 0x5d, 0x6e, 0x83, 0x75, 0x1a, 0x63, 0x91, 0x1c, 0xc1, 0xaa, 0x35, 0xa9,
+	0xae, 0xce, 0xc5, 0xa8, 0x6c, 0xbd, 0x73, 0x6b, 0x24, 0x4c, 0xb6, 0xc6, 0xcf, 0x44, 0xa6, 0xcd,
+	0x2c, 0x2d, 0x50, 0x9e, 0x74, 0x61, 0xe9, 0x86, 0xb3, 0x55, 0x0c, 0x90, 0xa1, 0x6e, 0xc2, 0x8a,
+	0xad, 0x6e, 0x21, 0x3f, 0x72, 0x85, 0x45, 0x10, 0x8e, 0x3b, 0x0a, 0x44, 0xdf, 0x1f, 0x6b, 0x7a,
+	0x5d, 0xb7, 0x1b, 0x45, 0x59, 0x7e, 0xe7, 0xd6, 0x48, 0x18, 0x7d, 0x0d, 0x6b, 0xe6, 0x1b, 0x65,
+	0x24, 0x16, 0xa7, 0xd1, 0x9d, 0x5b, 0x23, 0x61, 0x74, 0x19, 0x0c, 0xa7, 0xa7, 0x95, 0x0c, 0x0a,
+	0xd3, 0xde, 0xce, 0x56, 0x31, 0x40, 0x86, 0xfa, 0x0b, 0xe1, 0xd6, 0x1b, 0x99, 0x5d, 0xf4, 0xbd,
+	0x9c, 0x75, 0x5b, 0x4e, 0xda, 0xb9, 0x51, 0x38, 0x3e, 0xe8, 0x02, 0xca, 0x14, 0xa9, 0xee, 0x02,
+	0x9a, 0x39, 0x5b, 0x67, 0xdd, 0x32, 0x92, 0x61, 0xf9, 0x08, 0x6a, 0xbb, 0xdd, 0x6e, 0x12, 0x9f,
+	0x11, 0x31, 0x86, 0x96, 0x33, 0xf7, 0x25, 0xcf, 0x7b, 0x3a, 0x2b, 0x66, 0xa7, 0x76, 0x29, 0xd6,
+	0xcd, 0x94, 0x27, 0x92, 0x26, 0xc9, 0x9a, 0x08, 0x2d, 0x44, 0xb3, 0x0f, 0x4b, 0x83, 0xc9, 0x4d,
+	0xb4, 0x39, 0x88, 0xc8, 0x48, 0x7a, 0x16, 0xa2, 0xfa, 0x31, 0xac, 0x4b, 0xaf, 0x68, 0xef, 0x04,
+	0x27, 0x6d, 0xc2, 0x7c, 0xcf, 0x57, 0xf4, 0x49, 0xa5, 0x61, 0x57, 0x69, 0x42, 0xdd, 0xb0, 0x0f,
+	0x24, 0x35, 0x1d, 0xc7, 0x36, 0x94, 0x21, 0xfa, 0x90, 0xdf, 0x33, 0x72, 0x40, 0x39, 0x35, 0x66,
+	0xe6, 0xcf, 0x59, 0x1d, 0xe8, 0xd5, 0xe2, 0x09, 0x68, 0x38, 0xa3, 0xa7, 0x34, 0xb4, 0x30, 0xd7,
+	0x57, 0x8c, 0xef, 0x50, 0xbd, 0x80, 0xf5, 0x28, 0x7d, 0xf6, 0x7e, 0x2f, 0x4a, 0x95, 0x29, 0x0e,
+	0x6d, 0x99, 0x03, 0xf7, 0x0a, 0xfb, 0x73, 0x5b, 0xcc, 0x77, 0xd1, 0x31, 0xae, 0xdb, 0x26, 0x4c,
+	0x82, 0x4b, 0x9e, 0x1b, 0x7d, 0xd4, 0x38, 0x37, 0xb6, 0x74, 0x90, 0x73, 0xa3, 0x70, 0xdc, 0x60,
+	0x7c, 0x28, 0xb3, 0x95, 0x31, 0x5e, 0x94, 0xf3, 0x1a, 0x43, 0xec, 0x53, 0xb8, 0x7a, 0x80, 0x7b,
+	0x29, 0xb9, 0x1c, 0xd6, 0x9f, 0x01, 0xf2, 0x48, 0xda, 0xeb, 0x5c, 0x12, 0xba, 0xa7, 0x70, 0x75,
+	0xd7, 0xf7, 0xcd, 0x30, 0x3f, 0x2a, 0x8a, 0xff, 0xab, 0x2b, 0xd1, 0x9e, 0xa6, 0x11, 0x81, 0x38,
+	0x4b, 0xba, 0x42, 0x0f, 0x54, 0xd8, 0x73, 0x2c, 0xce, 0xcd, 0x11, 0x10, 0x0a, 0xfb, 0x83, 0x8f,
+	0xfe, 0xff, 0x07, 0xed, 0x80, 0x9e, 0xf4, 0x8e, 0xb6, 0x5b, 0x71, 0xe7, 0x7e, 0x17, 0xf7, 0xd3,
+	0x5e, 0x97, 0x24, 0xd9, 0x8f, 0x7b, 0x92, 0xe8, 0x7b, 0x29, 0x49, 0xce, 0x58, 0xff, 0x69, 0x5b,
+	0xfc, 0x41, 0x48, 0xfe, 0xf7, 0x22, 0x8f, 0xa6, 0xf9, 0xef, 0x77, 0xfe, 0x77, 0x00, 0xb5, 0xc3,
+	0x20, 0x72, 0x43, 0x52, 0x00, 0x00,
 }
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
-	"github.com/paysuper/paysuper-recurring-repository/tools"
+	"github.com/paysuper/paysuper-billing-server/pkg/money"
 	"time"
 )
 
@@ -31,6 +31,7 @@ type MgoProduct struct {
 	Deleted         bool                  `bson:"deleted" json:"deleted"`
 	MerchantId      bson.ObjectId         `bson:"merchant_id" json:"-"`
 	ProjectId       bson.ObjectId         `bson:"project_id" json:"project_id"`
+	Plans           []*SubscriptionPlan   `bson:"plans,omitempty" json:"plans"`
 }
 
 func (p *Product) SetBSON(raw bson.Raw) error {
@@ -56,6 +57,7 @@ func (p *Product) SetBSON(raw bson.Raw) error {
 	p.Deleted = decoded.Deleted
 	p.MerchantId = decoded.MerchantId.Hex()
 	p.ProjectId = decoded.ProjectId.Hex()
+	p.Plans = decoded.Plans
 
 	p.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
 
@@ -90,6 +92,7 @@ func (p *Product) GetBSON() (interface{}, error) {
 		Url:             p.Url,
 		Metadata:        p.Metadata,
 		Deleted:         p.Deleted,
+		Plans:           p.Plans,
 	}
 
 	if len(p.Id) <= 0 {
@@ -154,7 +157,7 @@ func (p *Product) GetBSON() (interface{}, error) {
 	for _, price := range p.Prices {
 		st.Prices = append(st.Prices, &ProductPrice{
 			Currency: price.Currency,
-			Amount:   tools.FormatAmount(price.Amount),
+			Amount:   money.Round(price.Amount, price.Currency),
 		})
 	}
 